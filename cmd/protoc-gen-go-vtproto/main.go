@@ -2,13 +2,22 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"os"
 	"strings"
 
+	_ "github.com/planetscale/vtprotobuf/features/benchmark"
 	_ "github.com/planetscale/vtprotobuf/features/clone"
 	_ "github.com/planetscale/vtprotobuf/features/equal"
+	_ "github.com/planetscale/vtprotobuf/features/extension"
+	_ "github.com/planetscale/vtprotobuf/features/freeze"
 	_ "github.com/planetscale/vtprotobuf/features/grpc"
+	_ "github.com/planetscale/vtprotobuf/features/hash"
+	_ "github.com/planetscale/vtprotobuf/features/json"
 	_ "github.com/planetscale/vtprotobuf/features/marshal"
 	_ "github.com/planetscale/vtprotobuf/features/pool"
+	_ "github.com/planetscale/vtprotobuf/features/quick"
 	_ "github.com/planetscale/vtprotobuf/features/size"
 	_ "github.com/planetscale/vtprotobuf/features/unmarshal"
 	"github.com/planetscale/vtprotobuf/generator"
@@ -19,25 +28,103 @@ import (
 func main() {
 	var cfg generator.Config
 	var features string
+	var validateUTF8 bool
 	var f flag.FlagSet
 
 	f.BoolVar(&cfg.AllowEmpty, "allow-empty", false, "allow generation of empty files")
 	cfg.Poolable = generator.NewObjectSet()
 	cfg.PoolableExclude = generator.NewObjectSet()
 	cfg.IgnoreUnknownFields = generator.NewObjectSet()
+	cfg.ObserveUnknownFields = generator.NewObjectSet()
+	cfg.ObserveUnmarshal = generator.NewObjectSet()
+	cfg.SizeCache = generator.NewObjectSet()
+	cfg.CycleCheck = generator.NewObjectSet()
+	cfg.ReuseMessages = generator.NewObjectSet()
+	cfg.ReuseStrings = generator.NewObjectSet()
+	cfg.MarshalBuffer = generator.NewObjectSet()
+	cfg.AssumeVT = generator.NewPackageSet()
 	f.Var(&cfg.Poolable, "pool", "use memory pooling for this object")
 	f.Var(&cfg.PoolableExclude, "pool-exclude", "do not use memory pooling for this object")
 	f.Var(&cfg.IgnoreUnknownFields, "ignoreUnknownFields", "ignore unknown fields instead of saving them")
+	f.Var(&cfg.ObserveUnknownFields, "observe-unknown-fields", "report the unknown fields decoded for this object to the hook registered with protohelpers.SetUnknownFieldHook")
+	f.Var(&cfg.ObserveUnmarshal, "observe-unmarshal", "report the size and result of each UnmarshalVT call for this object to the hook registered with protohelpers.SetUnmarshalHook")
+	f.Var(&cfg.SizeCache, "size-cache", "size this object once per marshal call with a cache keyed by message address, for messages shared by many parents")
+	f.Var(&cfg.CycleCheck, "cycle-check", "make the marshal methods of this object fail when it holds itself instead of overflowing the stack")
+	f.Var(&cfg.ReuseMessages, "reuse-messages", "reuse the elements of repeated message fields of this object on unmarshal")
+	f.Var(&cfg.ReuseStrings, "reuse-strings", "keep the current value of string fields of this object on unmarshal when the decoded value is unchanged")
+	f.Var(&cfg.MarshalBuffer, "marshal-buffer", "generate a MarshalVTBuffer method marshaling this object into a pooled buffer")
+	f.Var(&cfg.AssumeVT, "assume-vt", "assume messages from these Go packages have vtprotobuf helpers (e.g. example.com/protos/...)")
+	f.BoolVar(&cfg.Registry, "registry", false, "register generated messages with vtregistry and use it to dispatch to non-local messages")
 	f.BoolVar(&cfg.Wrap, "wrap", false, "generate wrapper types")
 	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
+	f.Var(&cfg.Profiles, "profile", "profile of features to generate (wire or full), optionally for the packages matching a pattern (e.g. example.com/sdk/...=wire); takes precedence over features")
+	f.IntVar(&cfg.ShardMessages, "shard-messages", 0, "generate at most this many top-level messages per file, splitting large .proto files into several _vtproto.pb.go files")
+	f.Var(&cfg.Only, "only", "only generate code for the messages whose full name matches this pattern (e.g. app.*) and the messages they depend on")
+	f.Var(&cfg.Templates, "template", "replace the code generated by a feature for a message with a text/template (<feature>:<message full name>=<path>)")
+	f.Var(&cfg.SliceGrowth, "slice-growth", "how repeated fields grow on unmarshal: append, double, chunked[:<N>] or exact")
+	f.BoolVar(&validateUTF8, "validate-utf8", true, "validate the string fields that must contain UTF-8 on unmarshal; disable for trusted peers only")
+	f.BoolVar(&cfg.ValidateUTF8OnMarshal, "validate-utf8-marshal", false, "fail marshaling when the string fields that must contain UTF-8 do not")
+	f.Uint64Var(&cfg.MaxLen, "max-len", 0, "fail unmarshaling when a string or bytes field is longer than this many bytes, unless it sets the max_len option; 0 for no limit")
+	f.BoolVar(&cfg.HashUnknownFields, "hash-unknown-fields", false, "hash the unknown fields of messages in HashVT, which ignores them otherwise")
+	f.BoolVar(&cfg.GRPCReturnToPool, "grpc-return-to-pool", false, "return pooled requests to their pool once the gRPC handlers return, and pooled messages once they are sent on a stream")
+	f.StringVar(&cfg.PoolBuildTag, "pool-build-tag", "", "only enable memory pooling when building with this tag, allocating new messages otherwise")
+	f.BoolVar(&cfg.DisableWellKnownTypes, "disable-wkt", false, "handle well-known types like other external messages instead of depending on the vtprotobuf types/known packages")
+	f.Var(&cfg.WellKnownTypesMode, "wkt", "which well-known types use the vtprotobuf types/known packages: auto (the ones of google.golang.org/protobuf/types/known), known (all, failing for vendored ones) or local (none, like disable-wkt)")
+	f.BoolVar(&cfg.StrictEditions, "strict-editions", false, "fail generation for editions files that use features protoc-gen-go-vtproto does not support")
+	f.BoolVar(&cfg.StrictWeakFields, "strict-weak-fields", false, "fail generation for fields declared with the weak option instead of generating them as regular message fields")
+	f.BoolVar(&cfg.BenchmarkJSON, "benchmark-json", false, "make the benchmark feature generate a test appending the results of its benchmarks as JSON lines to the file named by VTBENCH_JSON")
+	f.StringVar(&cfg.Report, "report", "", "write a JSON report of the generated features by message to this file")
 	f.StringVar(&cfg.BuildTag, "buildTag", "", "the go:build tag to set on generated files")
+	f.Var(&cfg.OutputMap, "out-map", "write the files that protoc names in a directory to another directory instead, e.g. for Bazel packages (<directory>=<directory>)")
 
-	protogen.Options{ParamFunc: f.Set}.Run(func(plugin *protogen.Plugin) error {
+	if len(os.Args) == 2 && (os.Args[1] == "--help" || os.Args[1] == "-h") {
+		usage(os.Stdout, &f)
+		return
+	}
+
+	param := func(name, value string) error {
+		if f.Lookup(name) == nil {
+			return fmt.Errorf("unknown option %q, run protoc-gen-go-vtproto --help for the list of options", name)
+		}
+		if err := f.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value %q for option %s: %w", value, name, err)
+		}
+		return nil
+	}
+	protogen.Options{ParamFunc: param}.Run(func(plugin *protogen.Plugin) error {
+		cfg.DisableUTF8Validation = !validateUTF8
 		gen, err := generator.NewGenerator(plugin, strings.Split(features, "+"), &cfg)
 		if err != nil {
 			return err
 		}
-		gen.Generate()
-		return nil
+		return gen.Generate()
 	})
 }
+
+// usage writes the options of the plugin, which are passed by protoc with
+// --go-vtproto_opt=<option>=<value>, and the features it can generate to w.
+func usage(w io.Writer, f *flag.FlagSet) {
+	fmt.Fprintln(w, "Usage: protoc --go-vtproto_out=<dir> [--go-vtproto_opt=<option>=<value>]... <file.proto>...")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Options:")
+	f.VisitAll(func(fl *flag.Flag) {
+		typ, help := flag.UnquoteUsage(fl)
+		if typ == "" {
+			typ = "true|false"
+		}
+		fmt.Fprintf(w, "  %s=<%s>\n    \t%s", fl.Name, typ, help)
+		if def := fl.DefValue; def != "" && def != "0" && def != "false" {
+			fmt.Fprintf(w, " (default %s)", def)
+		}
+		fmt.Fprintln(w)
+	})
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Features, separated by '+' in the features option; all selects the ones that are not opt-in:")
+	for _, name := range generator.FeatureNames() {
+		if generator.IsOptInFeature(name) {
+			fmt.Fprintf(w, "  %s (opt-in)\n", name)
+		} else {
+			fmt.Fprintf(w, "  %s\n", name)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Command vtverify checks that the code generated by protoc-gen-go-vtproto decodes and
+// re-encodes a corpus of payloads like google.golang.org/protobuf does, see the vtverify
+// package.
+//
+// The messages are looked up among the generated packages linked in the program, and
+// this command links none: build a copy of it that imports the packages to verify.
+package main
+
+import "github.com/planetscale/vtprotobuf/vtverify"
+
+func main() {
+	vtverify.Main()
+}
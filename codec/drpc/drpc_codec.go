@@ -1,49 +1,101 @@
 package drpc
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
 )
 
-type vtprotoMessage interface {
-	MarshalVT() ([]byte, error)
-	UnmarshalVT([]byte) error
+type protoResetter interface {
+	Reset()
 }
 
-type vtprotoResetter interface {
-	ResetVT()
+// Encoding implements the drpc.Encoding interface, as well as the JSON methods
+// used by drpchttp, with the vtprotobuf helpers of the messages. Messages without
+// the helpers are handled by the proto package. The zero value is ready to use
+// and behaves like the package-level functions.
+type Encoding struct {
+	// Strict makes Marshal use MarshalVTStrict, which encodes fields in field
+	// number order, for messages generated with the marshal_strict feature.
+	Strict bool
+	// ReleaseMemory makes Unmarshal reset messages with Reset instead of ResetVT.
+	// ResetVT keeps the memory held by the message so that it can be reused by
+	// the next decode, which is what pooled and long-lived stream messages want.
+	ReleaseMemory bool
 }
 
-type protoResetter interface {
-	Reset()
-}
+var defaultEncoding Encoding
 
-func Marshal(msg interface{}) ([]byte, error) {
-	return msg.(vtprotoMessage).MarshalVT()
+func (e Encoding) Marshal(msg interface{}) ([]byte, error) {
+	if e.Strict {
+		if vt, ok := msg.(vtproto.StrictMarshaler); ok {
+			return vt.MarshalVTStrict()
+		}
+	}
+	switch m := msg.(type) {
+	case vtproto.Message:
+		return m.MarshalVT()
+	case proto.Message:
+		return proto.Marshal(m)
+	default:
+		return nil, fmt.Errorf("failed to marshal, message is %T (missing vtprotobuf helpers)", msg)
+	}
 }
 
-func Unmarshal(buf []byte, msg interface{}) error {
+func (e Encoding) Unmarshal(buf []byte, msg interface{}) error {
 	// Reset the message before unmarshaling to match the semantics of the
 	// default protobuf codec, which replaces rather than merges messages.
-	if r, ok := msg.(vtprotoResetter); ok {
+	e.reset(msg)
+	switch m := msg.(type) {
+	case vtproto.Message:
+		return m.UnmarshalVT(buf)
+	case proto.Message:
+		return proto.Unmarshal(buf, m)
+	default:
+		return fmt.Errorf("failed to unmarshal, message is %T (missing vtprotobuf helpers)", msg)
+	}
+}
+
+func (e Encoding) JSONMarshal(msg interface{}) ([]byte, error) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal, message is %T (not a proto.Message)", msg)
+	}
+	return protojson.Marshal(m)
+}
+
+func (e Encoding) JSONUnmarshal(buf []byte, msg interface{}) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal, message is %T (not a proto.Message)", msg)
+	}
+	e.reset(msg)
+	return protojson.Unmarshal(buf, m)
+}
+
+func (e Encoding) reset(msg interface{}) {
+	if r, ok := msg.(vtproto.Resetter); ok && !e.ReleaseMemory {
 		r.ResetVT()
 	} else if r, ok := msg.(protoResetter); ok {
 		r.Reset()
 	}
-	return msg.(vtprotoMessage).UnmarshalVT(buf)
+}
+
+func Marshal(msg interface{}) ([]byte, error) {
+	return defaultEncoding.Marshal(msg)
+}
+
+func Unmarshal(buf []byte, msg interface{}) error {
+	return defaultEncoding.Unmarshal(buf, msg)
 }
 
 func JSONMarshal(msg interface{}) ([]byte, error) {
-	return protojson.Marshal(msg.(proto.Message))
+	return defaultEncoding.JSONMarshal(msg)
 }
 
 func JSONUnmarshal(buf []byte, msg interface{}) error {
-	// Reset the message before unmarshaling to match the semantics of the
-	// default protobuf codec, which replaces rather than merges messages.
-	if r, ok := msg.(vtprotoResetter); ok {
-		r.ResetVT()
-	} else if r, ok := msg.(protoResetter); ok {
-		r.Reset()
-	}
-	return protojson.Unmarshal(buf, msg.(proto.Message))
+	return defaultEncoding.JSONUnmarshal(buf, msg)
 }
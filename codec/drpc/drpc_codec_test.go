@@ -3,6 +3,8 @@ package drpc
 import (
 	"testing"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/planetscale/vtprotobuf/testproto/pool"
 )
 
@@ -139,3 +141,67 @@ func TestJSONUnmarshalResetsMessage(t *testing.T) {
 		t.Errorf("Foo2 = %d, want %d", target.Foo2, 42)
 	}
 }
+
+func TestEncodingReleaseMemory(t *testing.T) {
+	data, err := Marshal(&pool.Test1{Sl: []string{"a", "b", "c"}})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	// By default the memory of the message is kept for the next decode.
+	msg := &pool.Test1{}
+	if err := (Encoding{}).Unmarshal(data, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if err := (Encoding{}).Unmarshal(nil, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(msg.Sl) != 0 || cap(msg.Sl) == 0 {
+		t.Errorf("Sl = %v (cap %d), want empty slice with retained capacity", msg.Sl, cap(msg.Sl))
+	}
+
+	release := Encoding{ReleaseMemory: true}
+	if err := release.Unmarshal(data, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if err := release.Unmarshal(nil, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if msg.Sl != nil {
+		t.Errorf("Sl = %v, want nil", msg.Sl)
+	}
+}
+
+func TestEncodingStrict(t *testing.T) {
+	msg := &pool.MemoryPoolExtension{Foo1: "hello", Foo2: 42}
+	want, err := msg.MarshalVTStrict()
+	if err != nil {
+		t.Fatalf("MarshalVTStrict failed: %v", err)
+	}
+	got, err := (Encoding{Strict: true}).Marshal(msg)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Marshal = %x, want %x", got, want)
+	}
+}
+
+func TestEncodingProtoFallback(t *testing.T) {
+	var enc Encoding
+	data, err := enc.Marshal(durationpb.New(5))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	got := &durationpb.Duration{Seconds: 10}
+	if err := enc.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got.Seconds != 0 || got.Nanos != 5 {
+		t.Errorf("Unmarshal = %v, want 5ns", got)
+	}
+
+	if _, err := enc.Marshal("not a message"); err == nil {
+		t.Errorf("Marshal of a non-message succeeded")
+	}
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/planetscale/vtprotobuf/vtpool"
+)
+
+// Response holds the response of a unary method decoded by Invoke into a message of
+// a memory pool.
+type Response[T any] struct {
+	// Msg is the response message. It must not be used once the response is released.
+	Msg  *T
+	pool *vtpool.Pool[T]
+}
+
+// Release returns the response message to its pool. It must be called at most once
+// for a response, and only once the application is done with the message and all
+// the values it holds.
+func (r Response[T]) Release() {
+	if r.pool != nil {
+		r.pool.Put(r.Msg)
+	}
+}
+
+// Invoke calls the unary method of cc with the given full name, like client stubs
+// do, but decodes the response into a message from pool instead of allocating a new
+// one. It lets clients of services whose stubs were generated by protoc-gen-go-grpc
+// recycle their responses, e.g.:
+//
+//	var replies = vtpool.New(pb.ReplyFromVTPool)
+//
+//	resp, err := vtgrpc.Invoke(ctx, conn, pb.Echo_Unary_FullMethodName, req, replies)
+//	if err != nil {
+//		return err
+//	}
+//	defer resp.Release()
+//
+// The message is returned to the pool on errors. Client stubs generated by the grpc
+// feature already allocate the responses of pooled messages from their pool, which
+// can be returned with their ReturnToVTPool method.
+func Invoke[T any](ctx context.Context, cc grpc.ClientConnInterface, method string, req any, pool *vtpool.Pool[T], opts ...grpc.CallOption) (Response[T], error) {
+	out := pool.Get()
+	if err := cc.Invoke(ctx, method, req, out, opts...); err != nil {
+		pool.Put(out)
+		return Response[T]{}, err
+	}
+	return Response[T]{Msg: out, pool: pool}, nil
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/planetscale/vtprotobuf/testproto/grpcpool"
+	"github.com/planetscale/vtprotobuf/vtpool"
+)
+
+// echoConn is a grpc.ClientConnInterface replying to unary calls with the name of
+// their request. Calls with an empty name fail after decoding a partial reply.
+type echoConn struct {
+	grpc.ClientConnInterface
+	last *grpcpool.Reply
+}
+
+func (c *echoConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	c.last = reply.(*grpcpool.Reply)
+	name := args.(*grpcpool.Request).Name
+	if name == "" {
+		c.last.Name = "partial"
+		return errors.New("empty name")
+	}
+	data, err := (&grpcpool.Reply{Name: name}).MarshalVT()
+	if err != nil {
+		return err
+	}
+	return Codec{}.Unmarshal(data, reply)
+}
+
+func TestInvokeReleasesResponses(t *testing.T) {
+	replies := vtpool.New(grpcpool.ReplyFromVTPool)
+	conn := &echoConn{}
+
+	resp, err := Invoke(context.Background(), conn, "/grpcpool.Echo/Unary", &grpcpool.Request{Name: "hello"}, replies)
+	if err != nil {
+		t.Fatalf("Invoke failed: %v", err)
+	}
+	if resp.Msg != conn.last || resp.Msg.Name != "hello" {
+		t.Fatalf("Invoke returned %v, want the reply decoded by the connection", resp.Msg)
+	}
+	// Returning the message to its pool resets it.
+	resp.Release()
+	if resp.Msg.Name != "" {
+		t.Errorf("Name = %q after Release, want it reset", resp.Msg.Name)
+	}
+
+	resp, err = Invoke(context.Background(), conn, "/grpcpool.Echo/Unary", &grpcpool.Request{}, replies)
+	if err == nil {
+		t.Fatal("Invoke succeeded, want an error")
+	}
+	if resp.Msg != nil {
+		t.Errorf("Invoke returned %v with an error", resp.Msg)
+	}
+	if conn.last.Name != "" {
+		t.Errorf("Name = %q after a failed call, want the reply returned to its pool", conn.last.Name)
+	}
+	// Releasing the response of a failed call is a no-op.
+	resp.Release()
+}
@@ -1,48 +1,106 @@
 package grpc
 
-import "fmt"
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
 
 // Name is the name registered for the proto compressor.
 const Name = "proto"
 
-type Codec struct{}
+// Codec is a codec implementing grpc's encoding.Codec with the vtprotobuf helpers of the
+// messages. The zero Codec fails on messages without vtprotobuf helpers, and replaces the
+// messages it unmarshals into like the default protobuf codec; NewCodec returns a Codec
+// configured otherwise.
+type Codec struct {
+	fallback   bool
+	onFallback func(v any, marshal bool)
+	merge      bool
+}
+
+// CodecOption configures the Codec returned by NewCodec.
+type CodecOption func(*Codec)
 
-type vtprotoMessage interface {
-	MarshalVT() ([]byte, error)
-	UnmarshalVT([]byte) error
+// WithProtoFallback makes the Codec marshal and unmarshal the messages without
+// vtprotobuf helpers with the proto package, instead of failing. hook, if not nil, is
+// called with each of these messages and whether it is marshaled or unmarshaled, e.g. to
+// count them or log their types while migrating to vtprotobuf.
+func WithProtoFallback(hook func(v any, marshal bool)) CodecOption {
+	return func(c *Codec) {
+		c.fallback = true
+		c.onFallback = hook
+	}
 }
 
-type vtprotoResetter interface {
-	ResetVT()
+// WithMerge makes the Codec unmarshal data into messages without resetting them first,
+// so that the decoded fields are merged into the existing content of the messages, for
+// callers that rely on these semantics.
+func WithMerge() CodecOption {
+	return func(c *Codec) {
+		c.merge = true
+	}
+}
+
+// NewCodec returns a Codec configured with the given options.
+func NewCodec(opts ...CodecOption) Codec {
+	var c Codec
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
 }
 
 type protoResetter interface {
 	Reset()
 }
 
-func (Codec) Marshal(v interface{}) ([]byte, error) {
-	vt, ok := v.(vtprotoMessage)
+func (c Codec) Marshal(v interface{}) ([]byte, error) {
+	vt, ok := v.(vtproto.Message)
 	if !ok {
+		if m, ok := c.fallbackMessage(v, true); ok {
+			return proto.Marshal(m)
+		}
 		return nil, fmt.Errorf("failed to marshal, message is %T (missing vtprotobuf helpers)", v)
 	}
 	return vt.MarshalVT()
 }
 
-func (Codec) Unmarshal(data []byte, v interface{}) error {
-	vt, ok := v.(vtprotoMessage)
+func (c Codec) Unmarshal(data []byte, v interface{}) error {
+	vt, ok := v.(vtproto.Message)
 	if !ok {
+		if m, ok := c.fallbackMessage(v, false); ok {
+			return proto.UnmarshalOptions{Merge: c.merge}.Unmarshal(data, m)
+		}
 		return fmt.Errorf("failed to unmarshal, message is %T (missing vtprotobuf helpers)", v)
 	}
 	// Reset the message before unmarshaling to match the semantics of the
 	// default protobuf codec, which replaces rather than merges messages.
-	if r, ok := v.(vtprotoResetter); ok {
-		r.ResetVT()
-	} else if r, ok := v.(protoResetter); ok {
-		r.Reset()
+	if !c.merge {
+		if r, ok := v.(vtproto.Resetter); ok {
+			r.ResetVT()
+		} else if r, ok := v.(protoResetter); ok {
+			r.Reset()
+		}
 	}
 	return vt.UnmarshalVT(data)
 }
 
+// fallbackMessage returns v as a message handled by the proto package, if the codec
+// falls back to it.
+func (c Codec) fallbackMessage(v any, marshal bool) (proto.Message, bool) {
+	m, ok := v.(proto.Message)
+	if !ok || !c.fallback {
+		return nil, false
+	}
+	if c.onFallback != nil {
+		c.onFallback(v, marshal)
+	}
+	return m, true
+}
+
 func (Codec) Name() string {
 	return Name
 }
@@ -2,6 +2,10 @@ package grpc
 
 import (
 	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/planetscale/vtprotobuf/testproto/pool"
 )
@@ -74,3 +78,76 @@ func TestCodecUnmarshalResetsMessage(t *testing.T) {
 		t.Errorf("Foo2 = %d, want %d", target.Foo2, 42)
 	}
 }
+
+func TestCodecNonVTMessages(t *testing.T) {
+	ts := timestamppb.New(time.Unix(10, 0))
+
+	if _, err := (Codec{}).Marshal(ts); err == nil {
+		t.Fatal("Marshal of a message without vtprotobuf helpers succeeded")
+	}
+	if err := (Codec{}).Unmarshal(nil, &timestamppb.Timestamp{}); err == nil {
+		t.Fatal("Unmarshal into a message without vtprotobuf helpers succeeded")
+	}
+
+	var marshaled, unmarshaled int
+	codec := NewCodec(WithProtoFallback(func(v any, marshal bool) {
+		if _, ok := v.(*timestamppb.Timestamp); !ok {
+			t.Errorf("hook called with %T", v)
+		}
+		if marshal {
+			marshaled++
+		} else {
+			unmarshaled++
+		}
+	}))
+	data, err := codec.Marshal(ts)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	got := &timestamppb.Timestamp{Nanos: 1}
+	if err := codec.Unmarshal(data, got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !proto.Equal(ts, got) {
+		t.Errorf("Unmarshal decoded %v, want %v", got, ts)
+	}
+	if marshaled != 1 || unmarshaled != 1 {
+		t.Errorf("hook called for %d marshals and %d unmarshals, want 1 and 1", marshaled, unmarshaled)
+	}
+
+	// Values that are not messages still fail.
+	if _, err := codec.Marshal("not a message"); err == nil {
+		t.Fatal("Marshal of a string succeeded")
+	}
+	// Messages with vtprotobuf helpers do not use the fallback.
+	if _, err := codec.Marshal(&pool.MemoryPoolExtension{Foo1: "hello"}); err != nil || marshaled != 1 {
+		t.Fatalf("Marshal of a vtprotobuf message returned %v and called the hook", err)
+	}
+}
+
+func TestCodecMerge(t *testing.T) {
+	codec := NewCodec(WithMerge(), WithProtoFallback(nil))
+	data, err := codec.Marshal(&pool.MemoryPoolExtension{Foo2: 100})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	msg := &pool.MemoryPoolExtension{Foo1: "hello"}
+	if err := codec.Unmarshal(data, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if msg.Foo1 != "hello" || msg.Foo2 != 100 {
+		t.Errorf("Unmarshal decoded %v, want the fields merged", msg)
+	}
+
+	data, err = codec.Marshal(&timestamppb.Timestamp{Seconds: 10})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	ts := &timestamppb.Timestamp{Nanos: 1}
+	if err := codec.Unmarshal(data, ts); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if ts.Seconds != 10 || ts.Nanos != 1 {
+		t.Errorf("Unmarshal decoded %v, want the fields merged", ts)
+	}
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// pending holds the data of the messages unmarshaled by LazyCodec that have not
+// been decoded yet, with the codec decoding them, by message.
+var pending sync.Map
+
+type pendingData struct {
+	codec Codec
+	data  []byte
+}
+
+// LazyCodec is a server codec that defers unmarshaling requests until they are
+// needed, so that requests rejected by interceptors are never decoded. Its Unmarshal
+// method only keeps a copy of the data, which is decoded by Decode.
+//
+// LazyCodec must be installed with grpc.ForceServerCodec, together with
+// LazyUnaryServerInterceptor and LazyStreamServerInterceptor, which decode the
+// messages before they reach the handlers and release the data of the requests
+// that are rejected. The messages are decoded with the options of the embedded
+// Codec, e.g. LazyCodec{NewCodec(WithProtoFallback(nil))}.
+type LazyCodec struct {
+	Codec
+}
+
+func (c LazyCodec) Unmarshal(data []byte, v interface{}) error {
+	if _, ok := v.(vtproto.Message); !ok {
+		if _, ok := v.(proto.Message); !ok || !c.fallback {
+			return fmt.Errorf("failed to unmarshal, message is %T (missing vtprotobuf helpers)", v)
+		}
+	}
+	// gRPC reuses data once Unmarshal returns.
+	pending.Store(v, pendingData{codec: c.Codec, data: append([]byte(nil), data...)})
+	return nil
+}
+
+// Decode unmarshals v if it was unmarshaled by LazyCodec and has not been decoded yet.
+// Interceptors that need the content of the request can call it; it is a no-op for
+// messages that are already decoded.
+func Decode(v interface{}) error {
+	p, ok := pending.LoadAndDelete(v)
+	if !ok {
+		return nil
+	}
+	return p.(pendingData).codec.Unmarshal(p.(pendingData).data, v)
+}
+
+// LazyUnaryServerInterceptor returns the interceptor to install first when using
+// LazyCodec. The early interceptors, e.g. authentication or rate limiting, receive
+// the request before it is decoded; the request is decoded when they call their
+// handler, before calling the next interceptors of the server, if any.
+func LazyUnaryServerInterceptor(early ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		defer pending.Delete(req)
+
+		next := func(ctx context.Context, req any) (any, error) {
+			if err := Decode(req); err != nil {
+				return nil, status.Errorf(codes.Internal, "grpc: error unmarshalling request: %v", err)
+			}
+			return handler(ctx, req)
+		}
+		for i := len(early) - 1; i >= 0; i-- {
+			interceptor, handler := early[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, handler)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// LazyStreamServerInterceptor returns the interceptor to install with LazyCodec for
+// streaming RPCs. The messages received by the handlers are decoded when they are
+// received, as with Codec.
+func LazyStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, decodingStream{ss})
+	}
+}
+
+type decodingStream struct {
+	grpc.ServerStream
+}
+
+func (s decodingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		pending.Delete(m)
+		return err
+	}
+	if err := Decode(m); err != nil {
+		return status.Errorf(codes.Internal, "grpc: failed to unmarshal the received message: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+)
+
+func TestLazyCodecDefersUnmarshal(t *testing.T) {
+	codec := LazyCodec{}
+	data, err := codec.Marshal(&pool.MemoryPoolExtension{Foo1: "hello", Foo2: 42})
+	require.NoError(t, err)
+
+	msg := &pool.MemoryPoolExtension{Foo1: "stale"}
+	require.NoError(t, codec.Unmarshal(data, msg))
+	require.Equal(t, "stale", msg.Foo1)
+
+	// The codec keeps its own copy of the data.
+	clear(data)
+	require.NoError(t, Decode(msg))
+	require.Equal(t, "hello", msg.Foo1)
+	require.Equal(t, uint64(42), msg.Foo2)
+
+	msg.Foo1 = "changed"
+	require.NoError(t, Decode(msg))
+	require.Equal(t, "changed", msg.Foo1)
+}
+
+func TestLazyUnaryServerInterceptor(t *testing.T) {
+	codec := LazyCodec{}
+	data, err := codec.Marshal(&pool.MemoryPoolExtension{Foo1: "hello"})
+	require.NoError(t, err)
+
+	var authorized bool
+	auth := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !authorized {
+			return nil, status.Error(codes.Unauthenticated, "denied")
+		}
+		return handler(ctx, req)
+	}
+	interceptor := LazyUnaryServerInterceptor(auth)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return req.(*pool.MemoryPoolExtension).Foo1, nil
+	}
+
+	rejected := &pool.MemoryPoolExtension{}
+	require.NoError(t, codec.Unmarshal(data, rejected))
+	_, err = interceptor(context.Background(), rejected, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.NoError(t, Decode(rejected))
+	require.Empty(t, rejected.Foo1)
+
+	authorized = true
+	accepted := &pool.MemoryPoolExtension{}
+	require.NoError(t, codec.Unmarshal(data, accepted))
+	resp, err := interceptor(context.Background(), accepted, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "hello", resp)
+
+	malformed := &pool.MemoryPoolExtension{}
+	require.NoError(t, codec.Unmarshal(data[:len(data)-1], malformed))
+	_, err = interceptor(context.Background(), malformed, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestLazyCodecFallback(t *testing.T) {
+	ts := &timestamppb.Timestamp{Nanos: 1}
+	require.Error(t, LazyCodec{}.Unmarshal(nil, ts))
+
+	var fallbacks []bool
+	codec := LazyCodec{NewCodec(WithProtoFallback(func(v any, marshal bool) {
+		fallbacks = append(fallbacks, marshal)
+	}))}
+	data, err := codec.Marshal(timestamppb.New(time.Unix(10, 0)))
+	require.NoError(t, err)
+
+	require.NoError(t, codec.Unmarshal(data, ts))
+	require.Equal(t, int32(1), ts.Nanos)
+	require.NoError(t, Decode(ts))
+	require.Equal(t, int64(10), ts.Seconds)
+	require.Zero(t, ts.Nanos)
+	require.Equal(t, []bool{true, false}, fallbacks)
+}
+
+func TestLazyCodecDecodesWithItsCodec(t *testing.T) {
+	codec := LazyCodec{NewCodec(WithMerge())}
+	data, err := codec.Marshal(&pool.MemoryPoolExtension{Foo2: 42})
+	require.NoError(t, err)
+
+	msg := &pool.MemoryPoolExtension{Foo1: "kept"}
+	require.NoError(t, codec.Unmarshal(data, msg))
+	require.NoError(t, Decode(msg))
+	require.Equal(t, "kept", msg.Foo1)
+	require.Equal(t, uint64(42), msg.Foo2)
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"google.golang.org/grpc/mem"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// held holds the buffers referenced by the messages unmarshaled by MemCodec, by
+// message address.
+var held sync.Map
+
+// MemCodec is a codec implementing grpc's encoding.CodecV2 that works with the
+// buffers of the transport instead of slices. Messages are marshaled into buffers taken
+// from the default mem.BufferPool, and unmarshaled directly from the received buffers,
+// so that their bytes fields using the mem_buffer option alias the received data
+// instead of copying it.
+//
+// A message unmarshaled by MemCodec keeps a reference to the received buffer until
+// Release is called for it, after which its mem_buffer fields must not be used anymore.
+// Messages that are never released return their buffer to the garbage collector
+// instead of the pool.
+type MemCodec struct{}
+
+func (MemCodec) Marshal(v any) (mem.BufferSlice, error) {
+	vt, ok := v.(vtproto.SizedMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal, message is %T (missing vtprotobuf helpers)", v)
+	}
+	size := vt.SizeVT()
+	if mem.IsBelowBufferPoolingThreshold(size) {
+		buf := make([]byte, size)
+		if _, err := vt.MarshalToSizedBufferVT(buf); err != nil {
+			return nil, err
+		}
+		return mem.BufferSlice{mem.SliceBuffer(buf)}, nil
+	}
+	pool := mem.DefaultBufferPool()
+	buf := pool.Get(size)
+	if _, err := vt.MarshalToSizedBufferVT((*buf)[:size]); err != nil {
+		pool.Put(buf)
+		return nil, err
+	}
+	return mem.BufferSlice{mem.NewBuffer(buf, pool)}, nil
+}
+
+func (MemCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	vt, ok := v.(vtproto.Message)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal, message is %T (missing vtprotobuf helpers)", v)
+	}
+	if r, ok := v.(vtproto.Resetter); ok {
+		r.ResetVT()
+	} else if r, ok := v.(protoResetter); ok {
+		r.Reset()
+	}
+	// A single buffer is referenced rather than copied.
+	buf := data.MaterializeToBuffer(mem.DefaultBufferPool())
+	if err := vt.UnmarshalVT(buf.ReadOnlyData()); err != nil {
+		buf.Free()
+		return err
+	}
+	hold(v, buf)
+	return nil
+}
+
+func (MemCodec) Name() string {
+	return Name
+}
+
+// heldBuffer is the buffer referenced by a message, which is kept in held until the
+// message is garbage collected so that decoding it again does not add a cleanup.
+type heldBuffer struct {
+	mu  sync.Mutex
+	buf mem.Buffer
+}
+
+func hold(v any, buf mem.Buffer) {
+	ptr := reflect.ValueOf(v)
+	addr := ptr.Pointer()
+	h, ok := held.Load(addr)
+	if !ok {
+		var loaded bool
+		if h, loaded = held.LoadOrStore(addr, &heldBuffer{}); !loaded {
+			runtime.AddCleanup((*byte)(ptr.UnsafePointer()), func(addr uintptr) { held.Delete(addr) }, addr)
+		}
+	}
+	// The buffer held for a previous decoding of the message may still be aliased by
+	// the slices that were taken from it, so it is left to the garbage collector.
+	hb := h.(*heldBuffer)
+	hb.mu.Lock()
+	hb.buf = buf
+	hb.mu.Unlock()
+}
+
+// Release frees the buffer referenced by a message unmarshaled by MemCodec. The bytes
+// fields of the message that use the mem_buffer option, and the slices taken from them,
+// must not be used after Release. It is a no-op for the other messages.
+func Release(v any) {
+	h, ok := held.Load(reflect.ValueOf(v).Pointer())
+	if !ok {
+		return
+	}
+	hb := h.(*heldBuffer)
+	hb.mu.Lock()
+	buf := hb.buf
+	hb.buf = nil
+	hb.mu.Unlock()
+	if buf != nil {
+		buf.Free()
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/grpc/mem"
+
+	"github.com/planetscale/vtprotobuf/testproto/membuffer"
+)
+
+// countingPool counts the buffers returned to it.
+type countingPool struct {
+	mem.BufferPool
+	puts int
+}
+
+func (p *countingPool) Put(buf *[]byte) {
+	p.puts++
+	p.BufferPool.Put(buf)
+}
+
+func TestMemCodecAliasesBuffer(t *testing.T) {
+	codec := MemCodec{}
+	original := &membuffer.Blob{Name: "blob", Payload: bytes.Repeat([]byte("x"), 4096)}
+
+	data, err := codec.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if data.Len() != original.SizeVT() {
+		t.Fatalf("Marshal returned %d bytes, want %d", data.Len(), original.SizeVT())
+	}
+
+	pool := &countingPool{BufferPool: mem.DefaultBufferPool()}
+	received := mem.Copy(data.Materialize(), pool)
+	data.Free()
+
+	msg := &membuffer.Blob{Copied: []byte("old")}
+	if err := codec.Unmarshal(mem.BufferSlice{received}, msg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	// gRPC frees the buffers it passed to Unmarshal once it returns.
+	received.Free()
+	if !original.EqualVT(msg) {
+		t.Fatalf("Unmarshal returned %v, want %v", msg, original)
+	}
+	if &msg.Payload[0] != &received.ReadOnlyData()[len(received.ReadOnlyData())-4096] {
+		t.Errorf("Payload does not alias the received buffer")
+	}
+	if pool.puts != 0 {
+		t.Fatalf("buffer returned to the pool before Release")
+	}
+
+	Release(msg)
+	if pool.puts != 1 {
+		t.Errorf("Release returned %d buffers to the pool, want 1", pool.puts)
+	}
+	Release(msg)
+	if pool.puts != 1 {
+		t.Errorf("Release freed the buffer twice")
+	}
+}
+
+func TestMemCodecUnmarshalError(t *testing.T) {
+	pool := &countingPool{BufferPool: mem.DefaultBufferPool()}
+	received := mem.Copy(bytes.Repeat([]byte{0x12, 0xff}, 1024), pool)
+
+	err := MemCodec{}.Unmarshal(mem.BufferSlice{received}, &membuffer.Blob{})
+	received.Free()
+	if err == nil {
+		t.Fatal("Unmarshal of invalid data succeeded")
+	}
+	if pool.puts != 1 {
+		t.Errorf("buffer not returned to the pool after a failed Unmarshal")
+	}
+}
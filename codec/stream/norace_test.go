@@ -0,0 +1,7 @@
+//go:build !race
+
+package stream
+
+// raceEnabled is set when the tests are built with the race detector, which allocates
+// in the instrumented code.
+const raceEnabled = false
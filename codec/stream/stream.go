@@ -0,0 +1,208 @@
+// Package stream reads and writes messages over arbitrary byte streams, such as TCP
+// connections or files, as frames prefixed by their length encoded as a varint. This is
+// the framing used by the delimited functions of google.golang.org/protobuf/encoding/protodelim.
+//
+// The frames are marshaled into and decoded from the pooled buffers of the vtbuf
+// package, so that reading and writing messages does not allocate buffers once the
+// pools are warm.
+package stream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+	"github.com/planetscale/vtprotobuf/vtbuf"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// ErrFrameTooLarge is returned by ReadMsg and WriteMsg for frames larger than the
+// MaxFrameSize of the Reader or Writer, and by UnmarshalFrom for frames larger than
+// the MaxSize of its options.
+var ErrFrameTooLarge = errors.New("stream: frame too large")
+
+// Writer writes messages to an io.Writer as length-prefixed frames.
+type Writer struct {
+	w io.Writer
+	// MaxFrameSize is the largest size of the messages written by WriteMsg, not
+	// counting their length prefix. No limit is enforced when it is zero.
+	MaxFrameSize int
+}
+
+// NewWriter returns a Writer writing frames to w. Each frame is written with a
+// single call to w.Write.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteMsg marshals msg and writes it as a single frame. Messages generated with
+// both the marshal and size features are marshaled directly into a pooled buffer.
+func (w *Writer) WriteMsg(msg vtproto.Marshaler) error {
+	if sized, ok := msg.(vtproto.SizedMarshaler); ok {
+		size := sized.SizeVT()
+		if err := w.check(size); err != nil {
+			return err
+		}
+		n := protohelpers.SizeOfVarint(uint64(size))
+		buf := vtbuf.Get(n + size)
+		defer buf.Release()
+		frame := buf.Bytes()
+		binary.PutUvarint(frame, uint64(size))
+		if _, err := sized.MarshalToSizedBufferVT(frame[n:]); err != nil {
+			return err
+		}
+		_, err := w.w.Write(frame)
+		return err
+	}
+
+	data, err := msg.MarshalVT()
+	if err != nil {
+		return err
+	}
+	if err := w.check(len(data)); err != nil {
+		return err
+	}
+	n := protohelpers.SizeOfVarint(uint64(len(data)))
+	buf := vtbuf.Get(n + len(data))
+	defer buf.Release()
+	frame := buf.Bytes()
+	binary.PutUvarint(frame, uint64(len(data)))
+	copy(frame[n:], data)
+	_, err = w.w.Write(frame)
+	return err
+}
+
+func (w *Writer) check(size int) error {
+	if w.MaxFrameSize > 0 && size > w.MaxFrameSize {
+		return fmt.Errorf("%w: %d bytes, limit is %d", ErrFrameTooLarge, size, w.MaxFrameSize)
+	}
+	return nil
+}
+
+// Reader reads messages written as length-prefixed frames from an io.Reader.
+type Reader struct {
+	r io.Reader
+	// MaxFrameSize is the largest size of the frames read by ReadMsg, not counting
+	// their length prefix. No limit is enforced when it is zero, in which case the
+	// length of a corrupted frame may make ReadMsg allocate arbitrarily large buffers.
+	MaxFrameSize int
+	// ReleaseMemory makes ReadMsg reset messages with Reset instead of ResetVT.
+	// ResetVT keeps the memory held by the message so that it can be reused by
+	// the next read.
+	ReleaseMemory bool
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// NewReader returns a Reader reading frames from r. Unless r implements io.ByteReader,
+// it is wrapped in a bufio.Reader, which may read past the last frame returned by
+// ReadMsg.
+func NewReader(r io.Reader) *Reader {
+	if _, ok := r.(byteReader); !ok {
+		r = bufio.NewReader(r)
+	}
+	return &Reader{r: r}
+}
+
+// ReadMsg reads the next frame and decodes it into msg, which is reset first. It
+// returns io.EOF when the stream ends before a frame, and io.ErrUnexpectedEOF when
+// it ends in the middle of one.
+//
+// The data of the frame is released once msg is decoded, so msg must not alias it:
+// messages holding mem_buffer fields cannot be read with a Reader.
+func (r *Reader) ReadMsg(msg vtproto.Unmarshaler) error {
+	return readFrame(r.r.(byteReader), msg, r.MaxFrameSize, r.ReleaseMemory)
+}
+
+// DefaultMaxSize is the largest size of the frames read by UnmarshalFrom, like the
+// default of protodelim.
+const DefaultMaxSize = 4 << 20
+
+// UnmarshalOptions configures UnmarshalOptions.UnmarshalFrom.
+type UnmarshalOptions struct {
+	// MaxSize is the largest size of the frames read, not counting their length
+	// prefix. It is DefaultMaxSize when zero, and no limit is enforced when it is
+	// negative.
+	MaxSize int
+}
+
+// UnmarshalFrom reads a single length-prefixed frame from r and decodes it into msg,
+// like protodelim.UnmarshalFrom does but with UnmarshalVT, see
+// UnmarshalOptions.UnmarshalFrom.
+func UnmarshalFrom(r io.Reader, msg vtproto.Unmarshaler) error {
+	return UnmarshalOptions{}.UnmarshalFrom(r, msg)
+}
+
+// UnmarshalFrom reads a single length-prefixed frame from r and decodes it into msg,
+// which is reset first. Unlike a Reader, it does not read past the frame: if r does
+// not implement io.ByteReader, like a *bufio.Reader does, the length prefix is read one
+// byte at a time. It returns io.EOF when r ends before the frame, and
+// io.ErrUnexpectedEOF when it ends in the middle of it.
+//
+// As with ReadMsg, msg must not alias the data of the frame.
+func (o UnmarshalOptions) UnmarshalFrom(r io.Reader, msg vtproto.Unmarshaler) error {
+	limit := o.MaxSize
+	if limit == 0 {
+		limit = DefaultMaxSize
+	}
+	rd, ok := r.(byteReader)
+	if !ok {
+		rd = &singleByteReader{Reader: r}
+	}
+	return readFrame(rd, msg, limit, false)
+}
+
+// singleByteReader implements io.ByteReader with one-byte reads of an io.Reader.
+type singleByteReader struct {
+	io.Reader
+	b [1]byte
+}
+
+func (r *singleByteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(r.Reader, r.b[:]); err != nil {
+		return 0, err
+	}
+	return r.b[0], nil
+}
+
+// readFrame reads a frame of at most limit bytes, or of any size if limit is not
+// positive, from rd and decodes it into msg.
+func readFrame(rd byteReader, msg vtproto.Unmarshaler, limit int, releaseMemory bool) error {
+	size, err := binary.ReadUvarint(rd)
+	if err != nil {
+		return err
+	}
+	if size > math.MaxInt32 || (limit > 0 && size > uint64(limit)) {
+		if limit <= 0 {
+			limit = math.MaxInt32
+		}
+		return fmt.Errorf("%w: %d bytes, limit is %d", ErrFrameTooLarge, size, limit)
+	}
+
+	buf := vtbuf.Get(int(size))
+	defer buf.Release()
+	if _, err := io.ReadFull(rd, buf.Bytes()); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	reset(msg, releaseMemory)
+	return msg.UnmarshalVT(buf.Bytes())
+}
+
+// reset resets msg with ResetVT, unless releaseMemory is set.
+func reset(msg interface{}, releaseMemory bool) {
+	if vt, ok := msg.(vtproto.Resetter); ok && !releaseMemory {
+		vt.ResetVT()
+	} else if m, ok := msg.(interface{ Reset() }); ok {
+		m.Reset()
+	}
+}
@@ -0,0 +1,160 @@
+package stream
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// marshalOnly hides the size methods of a message, for WriteMsg to marshal it with
+// MarshalVT.
+type marshalOnly struct {
+	msg *pool.MemoryPoolExtension
+}
+
+func (m marshalOnly) MarshalVT() ([]byte, error)         { return m.msg.MarshalVT() }
+func (m marshalOnly) ProtoReflect() protoreflect.Message { return m.msg.ProtoReflect() }
+
+func TestRoundTrip(t *testing.T) {
+	messages := []*pool.MemoryPoolExtension{
+		{Foo1: "first", Foo2: 1},
+		{},
+		{Foo1: strings.Repeat("x", 300), Foo3: &pool.OptionalMessage{}},
+	}
+
+	// WriteMsg falls back to MarshalVT for the messages wrapped by marshalOnly.
+	_, sized := any(marshalOnly{}).(vtproto.SizedMarshaler)
+	require.False(t, sized)
+
+	var stream bytes.Buffer
+	w := NewWriter(&stream)
+	for i, msg := range messages {
+		if i%2 == 0 {
+			require.NoError(t, w.WriteMsg(msg))
+		} else {
+			require.NoError(t, w.WriteMsg(marshalOnly{msg}))
+		}
+	}
+
+	// The frames are the ones of protodelim.
+	var want bytes.Buffer
+	for _, msg := range messages {
+		_, err := protodelim.MarshalTo(&want, msg)
+		require.NoError(t, err)
+	}
+	require.Equal(t, want.Bytes(), stream.Bytes())
+
+	r := NewReader(&stream)
+	msg := &pool.MemoryPoolExtension{Foo2: 42}
+	for _, want := range messages {
+		require.NoError(t, r.ReadMsg(msg))
+		require.True(t, want.EqualVT(msg))
+	}
+	require.ErrorIs(t, r.ReadMsg(msg), io.EOF)
+}
+
+func TestTruncatedFrame(t *testing.T) {
+	var stream bytes.Buffer
+	require.NoError(t, NewWriter(&stream).WriteMsg(&pool.MemoryPoolExtension{Foo1: "truncated"}))
+
+	r := NewReader(bytes.NewReader(stream.Bytes()[:stream.Len()-1]))
+	require.ErrorIs(t, r.ReadMsg(&pool.MemoryPoolExtension{}), io.ErrUnexpectedEOF)
+}
+
+func TestMaxFrameSize(t *testing.T) {
+	large := &pool.MemoryPoolExtension{Foo1: strings.Repeat("x", 100)}
+
+	var stream bytes.Buffer
+	w := NewWriter(&stream)
+	w.MaxFrameSize = 64
+	require.ErrorIs(t, w.WriteMsg(large), ErrFrameTooLarge)
+	require.ErrorIs(t, w.WriteMsg(marshalOnly{large}), ErrFrameTooLarge)
+	require.Zero(t, stream.Len())
+
+	w.MaxFrameSize = 0
+	require.NoError(t, w.WriteMsg(large))
+	r := NewReader(&stream)
+	r.MaxFrameSize = 64
+	require.ErrorIs(t, r.ReadMsg(&pool.MemoryPoolExtension{}), ErrFrameTooLarge)
+}
+
+func TestNoAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+	var stream bytes.Buffer
+	stream.Grow(1024)
+	w := NewWriter(&stream)
+	r := NewReader(&stream)
+	sent := &pool.MemoryPoolExtension{Foo2: 7, Foo3: &pool.OptionalMessage{}}
+	received := &pool.MemoryPoolExtension{}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := w.WriteMsg(sent); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.ReadMsg(received); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.True(t, sent.EqualVT(received))
+}
+
+// onlyReader hides the io.ByteReader method of a reader.
+type onlyReader struct {
+	io.Reader
+}
+
+func TestUnmarshalFrom(t *testing.T) {
+	messages := []*pool.MemoryPoolExtension{
+		{Foo1: "first", Foo2: 1},
+		{},
+		{Foo1: strings.Repeat("x", 300)},
+	}
+	var stream bytes.Buffer
+	for _, msg := range messages {
+		_, err := protodelim.MarshalTo(&stream, msg)
+		require.NoError(t, err)
+	}
+	stream.WriteString("trailer")
+
+	// The frames are read one at a time without reading past them.
+	r := onlyReader{&stream}
+	msg := &pool.MemoryPoolExtension{Foo2: 42}
+	for _, want := range messages {
+		require.NoError(t, UnmarshalFrom(r, msg))
+		require.True(t, want.EqualVT(msg))
+	}
+	require.Equal(t, "trailer", stream.String())
+
+	stream.Reset()
+	require.ErrorIs(t, UnmarshalFrom(&stream, msg), io.EOF)
+	require.ErrorIs(t, UnmarshalFrom(onlyReader{bytes.NewReader([]byte{0x80})}, msg), io.ErrUnexpectedEOF)
+	require.ErrorIs(t, UnmarshalFrom(bytes.NewReader([]byte{3, 0x0a}), msg), io.ErrUnexpectedEOF)
+}
+
+func TestUnmarshalFromMaxSize(t *testing.T) {
+	var stream bytes.Buffer
+	_, err := protodelim.MarshalTo(&stream, &pool.MemoryPoolExtension{Foo1: strings.Repeat("x", 100)})
+	require.NoError(t, err)
+	frame := stream.Bytes()
+
+	msg := &pool.MemoryPoolExtension{}
+	err = UnmarshalOptions{MaxSize: 10}.UnmarshalFrom(bytes.NewReader(frame), msg)
+	require.ErrorIs(t, err, ErrFrameTooLarge)
+	require.NoError(t, UnmarshalOptions{MaxSize: -1}.UnmarshalFrom(bytes.NewReader(frame), msg))
+
+	// A frame larger than DefaultMaxSize is rejected before its data is read.
+	large := protohelpers.AppendVarint(nil, DefaultMaxSize+1)
+	require.ErrorIs(t, UnmarshalFrom(bytes.NewReader(large), msg), ErrFrameTooLarge)
+}
@@ -10,6 +10,7 @@ import (
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	slices "slices"
 	unsafe "unsafe"
 )
 
@@ -41,6 +42,34 @@ func (m *FailureSet) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// FailureSetCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func FailureSetCloneSliceVT(in []*FailureSet) []*FailureSet {
+	if in == nil {
+		return nil
+	}
+	out := make([]*FailureSet, len(in))
+	clones := make([]FailureSet, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Failure; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.Failure = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *ConformanceRequest) CloneVT() *ConformanceRequest {
 	if m == nil {
 		return (*ConformanceRequest)(nil)
@@ -67,6 +96,39 @@ func (m *ConformanceRequest) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ConformanceRequestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ConformanceRequestCloneSliceVT(in []*ConformanceRequest) []*ConformanceRequest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ConformanceRequest, len(in))
+	clones := make([]ConformanceRequest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.RequestedOutputFormat = m.RequestedOutputFormat
+		r.MessageType = m.MessageType
+		r.TestCategory = m.TestCategory
+		r.JspbEncodingOptions = m.JspbEncodingOptions.CloneVT()
+		r.PrintUnknownFields = m.PrintUnknownFields
+		if m.Payload != nil {
+			r.Payload = m.Payload.(interface {
+				CloneVT() isConformanceRequest_Payload
+			}).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *ConformanceRequest_ProtobufPayload) CloneVT() isConformanceRequest_Payload {
 	if m == nil {
 		return (*ConformanceRequest_ProtobufPayload)(nil)
@@ -128,6 +190,34 @@ func (m *ConformanceResponse) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ConformanceResponseCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ConformanceResponseCloneSliceVT(in []*ConformanceResponse) []*ConformanceResponse {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ConformanceResponse, len(in))
+	clones := make([]ConformanceResponse, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.Result != nil {
+			r.Result = m.Result.(interface {
+				CloneVT() isConformanceResponse_Result
+			}).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *ConformanceResponse_ParseError) CloneVT() isConformanceResponse_Result {
 	if m == nil {
 		return (*ConformanceResponse_ParseError)(nil)
@@ -221,21 +311,39 @@ func (m *JspbEncodingConfig) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// JspbEncodingConfigCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func JspbEncodingConfigCloneSliceVT(in []*JspbEncodingConfig) []*JspbEncodingConfig {
+	if in == nil {
+		return nil
+	}
+	out := make([]*JspbEncodingConfig, len(in))
+	clones := make([]JspbEncodingConfig, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.UseJspbArrayAnyFormat = m.UseJspbArrayAnyFormat
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *FailureSet) EqualVT(that *FailureSet) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Failure) != len(that.Failure) {
+	if !slices.Equal(this.Failure, that.Failure) {
 		return false
 	}
-	for i, vx := range this.Failure {
-		vy := that.Failure[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -747,10 +855,51 @@ func (m *ConformanceResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Result.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+	switch c := m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_SerializeError:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_RuntimeError:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_ProtobufPayload:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_JsonPayload:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_Skipped:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_JspbPayload:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_TextPayload:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -914,6 +1063,421 @@ func (m *JspbEncodingConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailureSet) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailureSet) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *FailureSet) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Failure) > 0 {
+		for iNdEx := len(m.Failure) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failure[iNdEx])
+			copy(dAtA[i:], m.Failure[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.Failure[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConformanceRequest) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConformanceRequest) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceRequest) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Payload.(interface {
+		MarshalToSizedBufferVTDeterministic([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.PrintUnknownFields {
+		i--
+		if m.PrintUnknownFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.JspbEncodingOptions != nil {
+		size, err := m.JspbEncodingOptions.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TestCategory != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.TestCategory))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RequestedOutputFormat != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RequestedOutputFormat))
+		i--
+		dAtA[i] = 0x18
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConformanceRequest_ProtobufPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceRequest_ProtobufPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ProtobufPayload)
+	copy(dAtA[i:], m.ProtobufPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_JsonPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceRequest_JsonPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPayload)
+	copy(dAtA[i:], m.JsonPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_JspbPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceRequest_JspbPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JspbPayload)
+	copy(dAtA[i:], m.JspbPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_TextPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceRequest_TextPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TextPayload)
+	copy(dAtA[i:], m.TextPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.TextPayload)))
+	i--
+	dAtA[i] = 0x42
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConformanceResponse) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch c := m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_SerializeError:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_RuntimeError:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_ProtobufPayload:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_JsonPayload:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_Skipped:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_JspbPayload:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *ConformanceResponse_TextPayload:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConformanceResponse_ParseError) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_ParseError) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ParseError)
+	copy(dAtA[i:], m.ParseError)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.ParseError)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_RuntimeError) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_RuntimeError) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.RuntimeError)
+	copy(dAtA[i:], m.RuntimeError)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RuntimeError)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_ProtobufPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_ProtobufPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ProtobufPayload)
+	copy(dAtA[i:], m.ProtobufPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_JsonPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_JsonPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPayload)
+	copy(dAtA[i:], m.JsonPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_Skipped) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_Skipped) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Skipped)
+	copy(dAtA[i:], m.Skipped)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.Skipped)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_SerializeError) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_SerializeError) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SerializeError)
+	copy(dAtA[i:], m.SerializeError)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SerializeError)))
+	i--
+	dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_JspbPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_JspbPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JspbPayload)
+	copy(dAtA[i:], m.JspbPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
+	i--
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_TextPayload) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *ConformanceResponse_TextPayload) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TextPayload)
+	copy(dAtA[i:], m.TextPayload)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.TextPayload)))
+	i--
+	dAtA[i] = 0x42
+	return len(dAtA) - i, nil
+}
+func (m *JspbEncodingConfig) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JspbEncodingConfig) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *JspbEncodingConfig) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.UseJspbArrayAnyFormat {
+		i--
+		if m.UseJspbArrayAnyFormat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FailureSet) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -1140,56 +1704,83 @@ func (m *ConformanceResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_TextPayload); ok {
+	var whichResult int32
+	switch m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		whichResult = 1
+	case *ConformanceResponse_SerializeError:
+		whichResult = 6
+	case *ConformanceResponse_RuntimeError:
+		whichResult = 2
+	case *ConformanceResponse_ProtobufPayload:
+		whichResult = 3
+	case *ConformanceResponse_JsonPayload:
+		whichResult = 4
+	case *ConformanceResponse_Skipped:
+		whichResult = 5
+	case *ConformanceResponse_JspbPayload:
+		whichResult = 7
+	case *ConformanceResponse_TextPayload:
+		whichResult = 8
+	}
+	if whichResult == 8 {
+		msg := m.Result.(*ConformanceResponse_TextPayload)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_JspbPayload); ok {
+	if whichResult == 7 {
+		msg := m.Result.(*ConformanceResponse_JspbPayload)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_SerializeError); ok {
+	if whichResult == 6 {
+		msg := m.Result.(*ConformanceResponse_SerializeError)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_Skipped); ok {
+	if whichResult == 5 {
+		msg := m.Result.(*ConformanceResponse_Skipped)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_JsonPayload); ok {
+	if whichResult == 4 {
+		msg := m.Result.(*ConformanceResponse_JsonPayload)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_ProtobufPayload); ok {
+	if whichResult == 3 {
+		msg := m.Result.(*ConformanceResponse_ProtobufPayload)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_RuntimeError); ok {
+	if whichResult == 2 {
+		msg := m.Result.(*ConformanceResponse_RuntimeError)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.Result.(*ConformanceResponse_ParseError); ok {
+	if whichResult == 1 {
+		msg := m.Result.(*ConformanceResponse_ParseError)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -1354,6 +1945,18 @@ func (m *JspbEncodingConfig) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *FailureSet) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ConformanceRequest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ConformanceResponse) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *JspbEncodingConfig) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *FailureSet) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1446,8 +2049,23 @@ func (m *ConformanceResponse) SizeVT() (n int) {
 	}
 	var l int
 	_ = l
-	if vtmsg, ok := m.Result.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	switch c := m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		n += c.SizeVT()
+	case *ConformanceResponse_SerializeError:
+		n += c.SizeVT()
+	case *ConformanceResponse_RuntimeError:
+		n += c.SizeVT()
+	case *ConformanceResponse_ProtobufPayload:
+		n += c.SizeVT()
+	case *ConformanceResponse_JsonPayload:
+		n += c.SizeVT()
+	case *ConformanceResponse_Skipped:
+		n += c.SizeVT()
+	case *ConformanceResponse_JspbPayload:
+		n += c.SizeVT()
+	case *ConformanceResponse_TextPayload:
+		n += c.SizeVT()
 	}
 	n += len(m.unknownFields)
 	return n
@@ -0,0 +1,84 @@
+package conformance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func deterministicMessage(t *testing.T) *TestAllTypesProto3 {
+	msg := &TestAllTypesProto3{
+		MapInt32Int32:          map[int32]int32{},
+		MapUint64Uint64:        map[uint64]uint64{},
+		MapSint64Sint64:        map[int64]int64{},
+		MapFixed32Fixed32:      map[uint32]uint32{},
+		MapInt32Double:         map[int32]float64{},
+		MapBoolBool:            map[bool]bool{true: false, false: true},
+		MapStringString:        map[string]string{},
+		MapStringBytes:         map[string][]byte{},
+		MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{},
+		MapStringForeignEnum:   map[string]ForeignEnum{},
+	}
+	for i := 0; i < 32; i++ {
+		key := fmt.Sprintf("key-%d", i*7%32)
+		msg.MapInt32Int32[int32(i-16)] = int32(i)
+		msg.MapUint64Uint64[uint64(i)<<40] = uint64(i)
+		msg.MapSint64Sint64[int64(16-i)] = int64(i)
+		msg.MapFixed32Fixed32[uint32(i*31)] = uint32(i)
+		msg.MapInt32Double[int32(i)] = float64(i) / 4
+		msg.MapStringString[key] = key
+		msg.MapStringBytes[key] = []byte(key)
+		msg.MapStringForeignEnum[key] = ForeignEnum_FOREIGN_BAZ
+		msg.MapStringNestedMessage[key] = &TestAllTypesProto3_NestedMessage{
+			A:           int32(i),
+			Corecursive: &TestAllTypesProto3{MapStringString: map[string]string{"b": key, "a": key, "c": key}},
+		}
+	}
+	var err error
+	msg.OptionalStruct, err = structpb.NewStruct(map[string]any{
+		"z": 1, "y": "two", "x": []any{true, nil}, "w": map[string]any{"b": 1, "a": 2},
+	})
+	require.NoError(t, err)
+	msg.RecursiveMessage = &TestAllTypesProto3{MapInt32Int32: map[int32]int32{3: 3, 1: 1, 2: 2}}
+	return msg
+}
+
+func TestMarshalVTDeterministic(t *testing.T) {
+	msg := deterministicMessage(t)
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		data, err := msg.MarshalVTDeterministic()
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	}
+
+	buf := make([]byte, msg.SizeVT()+8)
+	n, err := msg.MarshalToVTDeterministic(buf)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf[:n])
+
+	decoded := &TestAllTypesProto3{}
+	require.NoError(t, decoded.UnmarshalVT(expected))
+	require.True(t, msg.EqualVT(decoded))
+}
+
+func TestMarshalVTDeterministicUnknownFields(t *testing.T) {
+	msg := &TestAllTypesProto3{MapStringString: map[string]string{"b": "b", "a": "a"}}
+	unknown := protowire.AppendTag(nil, 9999, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 2)
+	unknown = protowire.AppendTag(unknown, 9998, protowire.BytesType)
+	unknown = protowire.AppendString(unknown, "unknown")
+	msg.ProtoReflect().SetUnknown(unknown)
+
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+	data, err := msg.MarshalVTDeterministic()
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+}
@@ -0,0 +1,40 @@
+package conformance
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtquick"
+)
+
+type wireMerger interface {
+	proto.Message
+	MarshalVT() ([]byte, error)
+	MergeFromWireVT([]byte) error
+	CloneMessageVT() proto.Message
+}
+
+// testMergeFromWire checks that MergeFromWireVT merges random deltas into random messages
+// like proto.UnmarshalOptions does with Merge set.
+func testMergeFromWire[T wireMerger](t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		delta, err := vtquick.New[T](r, 20).MarshalVT()
+		require.NoError(t, err)
+		got := vtquick.New[T](r, 20)
+		// proto.Clone drops the negative zeros of proto3 fields.
+		want := got.CloneMessageVT()
+
+		require.NoError(t, proto.UnmarshalOptions{Merge: true}.Unmarshal(delta, want))
+		require.NoError(t, got.MergeFromWireVT(delta))
+		require.True(t, proto.Equal(want, got), "want: %v\ngot:  %v", want, got)
+	}
+}
+
+func TestMergeFromWire(t *testing.T) {
+	t.Run("proto3", testMergeFromWire[*TestAllTypesProto3])
+	t.Run("proto2", testMergeFromWire[*TestAllTypesProto2])
+}
@@ -23,3 +23,35 @@ func TestEmptyOneof(t *testing.T) {
 		require.Equal(t, upstream, vt)
 	})
 }
+
+func TestLargeOneof(t *testing.T) {
+	// OneofField has enough fields to be dispatched with a type switch.
+	arms := []isTestAllTypesProto3_OneofField{
+		&TestAllTypesProto3_OneofUint32{OneofUint32: 1},
+		&TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1}},
+		&TestAllTypesProto3_OneofString{OneofString: "string"},
+		&TestAllTypesProto3_OneofBytes{OneofBytes: []byte("bytes")},
+		&TestAllTypesProto3_OneofBool{OneofBool: true},
+		&TestAllTypesProto3_OneofUint64{OneofUint64: 1},
+		&TestAllTypesProto3_OneofFloat{OneofFloat: 1.5},
+		&TestAllTypesProto3_OneofDouble{OneofDouble: 2.5},
+		&TestAllTypesProto3_OneofEnum{OneofEnum: TestAllTypesProto3_BAR},
+		&TestAllTypesProto3_OneofNullValue{},
+	}
+	for _, arm := range arms {
+		msg := &TestAllTypesProto3{OptionalInt32: 1, RepeatedInt32: []int32{1, 2}, OneofField: arm}
+		upstream, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, len(upstream), msg.SizeVT())
+
+		vt, err := msg.MarshalVTStrict()
+		require.NoError(t, err)
+		require.Equal(t, upstream, vt)
+
+		vt, err = msg.MarshalVT()
+		require.NoError(t, err)
+		got := &TestAllTypesProto3{}
+		require.NoError(t, got.UnmarshalVT(vt))
+		require.True(t, msg.EqualVT(got))
+	}
+}
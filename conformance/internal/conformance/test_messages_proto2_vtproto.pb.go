@@ -11,7 +11,11 @@ import (
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
 	math "math"
+	slices "slices"
+	sort "sort"
+	strings "strings"
 	unsafe "unsafe"
 )
 
@@ -43,6 +47,34 @@ func (m *TestAllTypesProto2_NestedMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2_NestedMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2_NestedMessageCloneSliceVT(in []*TestAllTypesProto2_NestedMessage) []*TestAllTypesProto2_NestedMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2_NestedMessage, len(in))
+	clones := make([]TestAllTypesProto2_NestedMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Corecursive = m.Corecursive.CloneVT()
+		if rhs := m.A; rhs != nil {
+			tmpVal := *rhs
+			r.A = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2_Data) CloneVT() *TestAllTypesProto2_Data {
 	if m == nil {
 		return (*TestAllTypesProto2_Data)(nil)
@@ -67,6 +99,37 @@ func (m *TestAllTypesProto2_Data) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2_DataCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2_DataCloneSliceVT(in []*TestAllTypesProto2_Data) []*TestAllTypesProto2_Data {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2_Data, len(in))
+	clones := make([]TestAllTypesProto2_Data, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.GroupInt32; rhs != nil {
+			tmpVal := *rhs
+			r.GroupInt32 = &tmpVal
+		}
+		if rhs := m.GroupUint32; rhs != nil {
+			tmpVal := *rhs
+			r.GroupUint32 = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrect) CloneVT() *TestAllTypesProto2_MessageSetCorrect {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrect)(nil)
@@ -76,6 +139,9 @@ func (m *TestAllTypesProto2_MessageSetCorrect) CloneVT() *TestAllTypesProto2_Mes
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
 	}
+	if len(m.extensionFields) > 0 {
+		protohelpers.CloneExtensions(r, m)
+	}
 	return r
 }
 
@@ -83,6 +149,32 @@ func (m *TestAllTypesProto2_MessageSetCorrect) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2_MessageSetCorrectCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2_MessageSetCorrectCloneSliceVT(in []*TestAllTypesProto2_MessageSetCorrect) []*TestAllTypesProto2_MessageSetCorrect {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2_MessageSetCorrect, len(in))
+	clones := make([]TestAllTypesProto2_MessageSetCorrect, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		if len(m.extensionFields) > 0 {
+			protohelpers.CloneExtensions(r, m)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrectExtension1) CloneVT() *TestAllTypesProto2_MessageSetCorrectExtension1 {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrectExtension1)(nil)
@@ -103,6 +195,33 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension1) CloneMessageVT() proto.
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2_MessageSetCorrectExtension1CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2_MessageSetCorrectExtension1CloneSliceVT(in []*TestAllTypesProto2_MessageSetCorrectExtension1) []*TestAllTypesProto2_MessageSetCorrectExtension1 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2_MessageSetCorrectExtension1, len(in))
+	clones := make([]TestAllTypesProto2_MessageSetCorrectExtension1, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Str; rhs != nil {
+			tmpVal := *rhs
+			r.Str = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrectExtension2) CloneVT() *TestAllTypesProto2_MessageSetCorrectExtension2 {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrectExtension2)(nil)
@@ -123,6 +242,33 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension2) CloneMessageVT() proto.
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2_MessageSetCorrectExtension2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2_MessageSetCorrectExtension2CloneSliceVT(in []*TestAllTypesProto2_MessageSetCorrectExtension2) []*TestAllTypesProto2_MessageSetCorrectExtension2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2_MessageSetCorrectExtension2, len(in))
+	clones := make([]TestAllTypesProto2_MessageSetCorrectExtension2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.I; rhs != nil {
+			tmpVal := *rhs
+			r.I = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 	if m == nil {
 		return (*TestAllTypesProto2)(nil)
@@ -463,102 +609,46 @@ func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 		r.UnpackedNestedEnum = tmpContainer
 	}
 	if rhs := m.MapInt32Int32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Int32 = tmpContainer
+		r.MapInt32Int32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt64Int64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt64Int64 = tmpContainer
+		r.MapInt64Int64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapUint32Uint32; rhs != nil {
-		tmpContainer := make(map[uint32]uint32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapUint32Uint32 = tmpContainer
+		r.MapUint32Uint32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapUint64Uint64; rhs != nil {
-		tmpContainer := make(map[uint64]uint64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapUint64Uint64 = tmpContainer
+		r.MapUint64Uint64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSint32Sint32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSint32Sint32 = tmpContainer
+		r.MapSint32Sint32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSint64Sint64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSint64Sint64 = tmpContainer
+		r.MapSint64Sint64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapFixed32Fixed32; rhs != nil {
-		tmpContainer := make(map[uint32]uint32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapFixed32Fixed32 = tmpContainer
+		r.MapFixed32Fixed32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapFixed64Fixed64; rhs != nil {
-		tmpContainer := make(map[uint64]uint64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapFixed64Fixed64 = tmpContainer
+		r.MapFixed64Fixed64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSfixed32Sfixed32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSfixed32Sfixed32 = tmpContainer
+		r.MapSfixed32Sfixed32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSfixed64Sfixed64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSfixed64Sfixed64 = tmpContainer
+		r.MapSfixed64Sfixed64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt32Float; rhs != nil {
-		tmpContainer := make(map[int32]float32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Float = tmpContainer
+		r.MapInt32Float = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt32Double; rhs != nil {
-		tmpContainer := make(map[int32]float64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Double = tmpContainer
+		r.MapInt32Double = maps.Clone(rhs)
 	}
 	if rhs := m.MapBoolBool; rhs != nil {
-		tmpContainer := make(map[bool]bool, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapBoolBool = tmpContainer
+		r.MapBoolBool = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringString; rhs != nil {
-		tmpContainer := make(map[string]string, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringString = tmpContainer
+		r.MapStringString = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringBytes; rhs != nil {
 		tmpContainer := make(map[string][]byte, len(rhs))
@@ -584,18 +674,10 @@ func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 		r.MapStringForeignMessage = tmpContainer
 	}
 	if rhs := m.MapStringNestedEnum; rhs != nil {
-		tmpContainer := make(map[string]TestAllTypesProto2_NestedEnum, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringNestedEnum = tmpContainer
+		r.MapStringNestedEnum = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringForeignEnum; rhs != nil {
-		tmpContainer := make(map[string]ForeignEnumProto2, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringForeignEnum = tmpContainer
+		r.MapStringForeignEnum = maps.Clone(rhs)
 	}
 	if m.OneofField != nil {
 		r.OneofField = m.OneofField.(interface {
@@ -739,6 +821,9 @@ func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
 	}
+	if len(m.extensionFields) > 0 {
+		protohelpers.CloneExtensions(r, m)
+	}
 	return r
 }
 
@@ -746,6 +831,575 @@ func (m *TestAllTypesProto2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto2CloneSliceVT(in []*TestAllTypesProto2) []*TestAllTypesProto2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto2, len(in))
+	clones := make([]TestAllTypesProto2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.OptionalNestedMessage = m.OptionalNestedMessage.CloneVT()
+		r.OptionalForeignMessage = m.OptionalForeignMessage.CloneVT()
+		r.RecursiveMessage = m.RecursiveMessage.CloneVT()
+		r.Data = m.Data.CloneVT()
+		if rhs := m.OptionalInt32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalInt32 = &tmpVal
+		}
+		if rhs := m.OptionalInt64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalInt64 = &tmpVal
+		}
+		if rhs := m.OptionalUint32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalUint32 = &tmpVal
+		}
+		if rhs := m.OptionalUint64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalUint64 = &tmpVal
+		}
+		if rhs := m.OptionalSint32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSint32 = &tmpVal
+		}
+		if rhs := m.OptionalSint64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSint64 = &tmpVal
+		}
+		if rhs := m.OptionalFixed32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFixed32 = &tmpVal
+		}
+		if rhs := m.OptionalFixed64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFixed64 = &tmpVal
+		}
+		if rhs := m.OptionalSfixed32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSfixed32 = &tmpVal
+		}
+		if rhs := m.OptionalSfixed64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSfixed64 = &tmpVal
+		}
+		if rhs := m.OptionalFloat; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFloat = &tmpVal
+		}
+		if rhs := m.OptionalDouble; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalDouble = &tmpVal
+		}
+		if rhs := m.OptionalBool; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalBool = &tmpVal
+		}
+		if rhs := m.OptionalString; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalString = &tmpVal
+		}
+		if rhs := m.OptionalBytes; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.OptionalBytes = tmpBytes
+		}
+		if rhs := m.OptionalNestedEnum; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalNestedEnum = &tmpVal
+		}
+		if rhs := m.OptionalForeignEnum; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalForeignEnum = &tmpVal
+		}
+		if rhs := m.OptionalStringPiece; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalStringPiece = &tmpVal
+		}
+		if rhs := m.OptionalCord; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalCord = &tmpVal
+		}
+		if rhs := m.RepeatedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedInt32 = tmpContainer
+		}
+		if rhs := m.RepeatedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedInt64 = tmpContainer
+		}
+		if rhs := m.RepeatedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedUint32 = tmpContainer
+		}
+		if rhs := m.RepeatedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedUint64 = tmpContainer
+		}
+		if rhs := m.RepeatedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSint32 = tmpContainer
+		}
+		if rhs := m.RepeatedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSint64 = tmpContainer
+		}
+		if rhs := m.RepeatedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFixed32 = tmpContainer
+		}
+		if rhs := m.RepeatedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFixed64 = tmpContainer
+		}
+		if rhs := m.RepeatedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSfixed32 = tmpContainer
+		}
+		if rhs := m.RepeatedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSfixed64 = tmpContainer
+		}
+		if rhs := m.RepeatedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFloat = tmpContainer
+		}
+		if rhs := m.RepeatedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedDouble = tmpContainer
+		}
+		if rhs := m.RepeatedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedBool = tmpContainer
+		}
+		if rhs := m.RepeatedString; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedString = tmpContainer
+		}
+		if rhs := m.RepeatedBytes; rhs != nil {
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.RepeatedBytes = tmpContainer
+		}
+		if rhs := m.RepeatedNestedMessage; rhs != nil {
+			tmpContainer := make([]*TestAllTypesProto2_NestedMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.RepeatedNestedMessage = tmpContainer
+		}
+		if rhs := m.RepeatedForeignMessage; rhs != nil {
+			tmpContainer := make([]*ForeignMessageProto2, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.RepeatedForeignMessage = tmpContainer
+		}
+		if rhs := m.RepeatedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto2_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedNestedEnum = tmpContainer
+		}
+		if rhs := m.RepeatedForeignEnum; rhs != nil {
+			tmpContainer := make([]ForeignEnumProto2, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedForeignEnum = tmpContainer
+		}
+		if rhs := m.RepeatedStringPiece; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedStringPiece = tmpContainer
+		}
+		if rhs := m.RepeatedCord; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedCord = tmpContainer
+		}
+		if rhs := m.PackedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedInt32 = tmpContainer
+		}
+		if rhs := m.PackedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedInt64 = tmpContainer
+		}
+		if rhs := m.PackedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedUint32 = tmpContainer
+		}
+		if rhs := m.PackedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedUint64 = tmpContainer
+		}
+		if rhs := m.PackedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSint32 = tmpContainer
+		}
+		if rhs := m.PackedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSint64 = tmpContainer
+		}
+		if rhs := m.PackedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFixed32 = tmpContainer
+		}
+		if rhs := m.PackedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFixed64 = tmpContainer
+		}
+		if rhs := m.PackedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSfixed32 = tmpContainer
+		}
+		if rhs := m.PackedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSfixed64 = tmpContainer
+		}
+		if rhs := m.PackedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFloat = tmpContainer
+		}
+		if rhs := m.PackedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedDouble = tmpContainer
+		}
+		if rhs := m.PackedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedBool = tmpContainer
+		}
+		if rhs := m.PackedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto2_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedNestedEnum = tmpContainer
+		}
+		if rhs := m.UnpackedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedInt32 = tmpContainer
+		}
+		if rhs := m.UnpackedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedInt64 = tmpContainer
+		}
+		if rhs := m.UnpackedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedUint32 = tmpContainer
+		}
+		if rhs := m.UnpackedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedUint64 = tmpContainer
+		}
+		if rhs := m.UnpackedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSint32 = tmpContainer
+		}
+		if rhs := m.UnpackedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSint64 = tmpContainer
+		}
+		if rhs := m.UnpackedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFixed32 = tmpContainer
+		}
+		if rhs := m.UnpackedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFixed64 = tmpContainer
+		}
+		if rhs := m.UnpackedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSfixed32 = tmpContainer
+		}
+		if rhs := m.UnpackedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSfixed64 = tmpContainer
+		}
+		if rhs := m.UnpackedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFloat = tmpContainer
+		}
+		if rhs := m.UnpackedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedDouble = tmpContainer
+		}
+		if rhs := m.UnpackedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedBool = tmpContainer
+		}
+		if rhs := m.UnpackedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto2_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedNestedEnum = tmpContainer
+		}
+		if rhs := m.MapInt32Int32; rhs != nil {
+			r.MapInt32Int32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt64Int64; rhs != nil {
+			r.MapInt64Int64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapUint32Uint32; rhs != nil {
+			r.MapUint32Uint32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapUint64Uint64; rhs != nil {
+			r.MapUint64Uint64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSint32Sint32; rhs != nil {
+			r.MapSint32Sint32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSint64Sint64; rhs != nil {
+			r.MapSint64Sint64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapFixed32Fixed32; rhs != nil {
+			r.MapFixed32Fixed32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapFixed64Fixed64; rhs != nil {
+			r.MapFixed64Fixed64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSfixed32Sfixed32; rhs != nil {
+			r.MapSfixed32Sfixed32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSfixed64Sfixed64; rhs != nil {
+			r.MapSfixed64Sfixed64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt32Float; rhs != nil {
+			r.MapInt32Float = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt32Double; rhs != nil {
+			r.MapInt32Double = maps.Clone(rhs)
+		}
+		if rhs := m.MapBoolBool; rhs != nil {
+			r.MapBoolBool = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringString; rhs != nil {
+			r.MapStringString = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringBytes; rhs != nil {
+			tmpContainer := make(map[string][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.MapStringBytes = tmpContainer
+		}
+		if rhs := m.MapStringNestedMessage; rhs != nil {
+			tmpContainer := make(map[string]*TestAllTypesProto2_NestedMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.MapStringNestedMessage = tmpContainer
+		}
+		if rhs := m.MapStringForeignMessage; rhs != nil {
+			tmpContainer := make(map[string]*ForeignMessageProto2, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.MapStringForeignMessage = tmpContainer
+		}
+		if rhs := m.MapStringNestedEnum; rhs != nil {
+			r.MapStringNestedEnum = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringForeignEnum; rhs != nil {
+			r.MapStringForeignEnum = maps.Clone(rhs)
+		}
+		if m.OneofField != nil {
+			r.OneofField = m.OneofField.(interface {
+				CloneVT() isTestAllTypesProto2_OneofField
+			}).CloneVT()
+		}
+		if rhs := m.DefaultInt32; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultInt32 = &tmpVal
+		}
+		if rhs := m.DefaultInt64; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultInt64 = &tmpVal
+		}
+		if rhs := m.DefaultUint32; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultUint32 = &tmpVal
+		}
+		if rhs := m.DefaultUint64; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultUint64 = &tmpVal
+		}
+		if rhs := m.DefaultSint32; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultSint32 = &tmpVal
+		}
+		if rhs := m.DefaultSint64; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultSint64 = &tmpVal
+		}
+		if rhs := m.DefaultFixed32; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultFixed32 = &tmpVal
+		}
+		if rhs := m.DefaultFixed64; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultFixed64 = &tmpVal
+		}
+		if rhs := m.DefaultSfixed32; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultSfixed32 = &tmpVal
+		}
+		if rhs := m.DefaultSfixed64; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultSfixed64 = &tmpVal
+		}
+		if rhs := m.DefaultFloat; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultFloat = &tmpVal
+		}
+		if rhs := m.DefaultDouble; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultDouble = &tmpVal
+		}
+		if rhs := m.DefaultBool; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultBool = &tmpVal
+		}
+		if rhs := m.DefaultString; rhs != nil {
+			tmpVal := *rhs
+			r.DefaultString = &tmpVal
+		}
+		if rhs := m.DefaultBytes; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.DefaultBytes = tmpBytes
+		}
+		if rhs := m.Fieldname1; rhs != nil {
+			tmpVal := *rhs
+			r.Fieldname1 = &tmpVal
+		}
+		if rhs := m.FieldName2; rhs != nil {
+			tmpVal := *rhs
+			r.FieldName2 = &tmpVal
+		}
+		if rhs := m.XFieldName3; rhs != nil {
+			tmpVal := *rhs
+			r.XFieldName3 = &tmpVal
+		}
+		if rhs := m.Field_Name4_; rhs != nil {
+			tmpVal := *rhs
+			r.Field_Name4_ = &tmpVal
+		}
+		if rhs := m.Field0Name5; rhs != nil {
+			tmpVal := *rhs
+			r.Field0Name5 = &tmpVal
+		}
+		if rhs := m.Field_0Name6; rhs != nil {
+			tmpVal := *rhs
+			r.Field_0Name6 = &tmpVal
+		}
+		if rhs := m.FieldName7; rhs != nil {
+			tmpVal := *rhs
+			r.FieldName7 = &tmpVal
+		}
+		if rhs := m.FieldName8; rhs != nil {
+			tmpVal := *rhs
+			r.FieldName8 = &tmpVal
+		}
+		if rhs := m.Field_Name9; rhs != nil {
+			tmpVal := *rhs
+			r.Field_Name9 = &tmpVal
+		}
+		if rhs := m.Field_Name10; rhs != nil {
+			tmpVal := *rhs
+			r.Field_Name10 = &tmpVal
+		}
+		if rhs := m.FIELD_NAME11; rhs != nil {
+			tmpVal := *rhs
+			r.FIELD_NAME11 = &tmpVal
+		}
+		if rhs := m.FIELDName12; rhs != nil {
+			tmpVal := *rhs
+			r.FIELDName12 = &tmpVal
+		}
+		if rhs := m.XFieldName13; rhs != nil {
+			tmpVal := *rhs
+			r.XFieldName13 = &tmpVal
+		}
+		if rhs := m.X_FieldName14; rhs != nil {
+			tmpVal := *rhs
+			r.X_FieldName14 = &tmpVal
+		}
+		if rhs := m.Field_Name15; rhs != nil {
+			tmpVal := *rhs
+			r.Field_Name15 = &tmpVal
+		}
+		if rhs := m.Field__Name16; rhs != nil {
+			tmpVal := *rhs
+			r.Field__Name16 = &tmpVal
+		}
+		if rhs := m.FieldName17__; rhs != nil {
+			tmpVal := *rhs
+			r.FieldName17__ = &tmpVal
+		}
+		if rhs := m.FieldName18__; rhs != nil {
+			tmpVal := *rhs
+			r.FieldName18__ = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		if len(m.extensionFields) > 0 {
+			protohelpers.CloneExtensions(r, m)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto2_OneofUint32) CloneVT() isTestAllTypesProto2_OneofField {
 	if m == nil {
 		return (*TestAllTypesProto2_OneofUint32)(nil)
@@ -851,6 +1505,33 @@ func (m *ForeignMessageProto2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ForeignMessageProto2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ForeignMessageProto2CloneSliceVT(in []*ForeignMessageProto2) []*ForeignMessageProto2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ForeignMessageProto2, len(in))
+	clones := make([]ForeignMessageProto2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.C; rhs != nil {
+			tmpVal := *rhs
+			r.C = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnknownToTestAllTypes_OptionalGroup) CloneVT() *UnknownToTestAllTypes_OptionalGroup {
 	if m == nil {
 		return (*UnknownToTestAllTypes_OptionalGroup)(nil)
@@ -871,6 +1552,33 @@ func (m *UnknownToTestAllTypes_OptionalGroup) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnknownToTestAllTypes_OptionalGroupCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnknownToTestAllTypes_OptionalGroupCloneSliceVT(in []*UnknownToTestAllTypes_OptionalGroup) []*UnknownToTestAllTypes_OptionalGroup {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnknownToTestAllTypes_OptionalGroup, len(in))
+	clones := make([]UnknownToTestAllTypes_OptionalGroup, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.A; rhs != nil {
+			tmpVal := *rhs
+			r.A = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnknownToTestAllTypes) CloneVT() *UnknownToTestAllTypes {
 	if m == nil {
 		return (*UnknownToTestAllTypes)(nil)
@@ -906,6 +1614,48 @@ func (m *UnknownToTestAllTypes) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnknownToTestAllTypesCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnknownToTestAllTypesCloneSliceVT(in []*UnknownToTestAllTypes) []*UnknownToTestAllTypes {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnknownToTestAllTypes, len(in))
+	clones := make([]UnknownToTestAllTypes, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.NestedMessage = m.NestedMessage.CloneVT()
+		r.Optionalgroup = m.Optionalgroup.CloneVT()
+		if rhs := m.OptionalInt32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalInt32 = &tmpVal
+		}
+		if rhs := m.OptionalString; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalString = &tmpVal
+		}
+		if rhs := m.OptionalBool; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalBool = &tmpVal
+		}
+		if rhs := m.RepeatedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedInt32 = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *NullHypothesisProto2) CloneVT() *NullHypothesisProto2 {
 	if m == nil {
 		return (*NullHypothesisProto2)(nil)
@@ -922,6 +1672,29 @@ func (m *NullHypothesisProto2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// NullHypothesisProto2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func NullHypothesisProto2CloneSliceVT(in []*NullHypothesisProto2) []*NullHypothesisProto2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*NullHypothesisProto2, len(in))
+	clones := make([]NullHypothesisProto2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *EnumOnlyProto2) CloneVT() *EnumOnlyProto2 {
 	if m == nil {
 		return (*EnumOnlyProto2)(nil)
@@ -938,6 +1711,29 @@ func (m *EnumOnlyProto2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// EnumOnlyProto2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func EnumOnlyProto2CloneSliceVT(in []*EnumOnlyProto2) []*EnumOnlyProto2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*EnumOnlyProto2, len(in))
+	clones := make([]EnumOnlyProto2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneStringProto2) CloneVT() *OneStringProto2 {
 	if m == nil {
 		return (*OneStringProto2)(nil)
@@ -958,6 +1754,33 @@ func (m *OneStringProto2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneStringProto2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func OneStringProto2CloneSliceVT(in []*OneStringProto2) []*OneStringProto2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneStringProto2, len(in))
+	clones := make([]OneStringProto2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Data; rhs != nil {
+			tmpVal := *rhs
+			r.Data = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *TestAllTypesProto2_NestedMessage) EqualVT(that *TestAllTypesProto2_NestedMessage) bool {
 	if this == that {
 		return true
@@ -1140,132 +1963,48 @@ func (this *TestAllTypesProto2) EqualVT(that *TestAllTypesProto2) bool {
 	if !this.RecursiveMessage.EqualVT(that.RecursiveMessage) {
 		return false
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+	if !slices.Equal(this.RepeatedInt32, that.RepeatedInt32) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
+	if !slices.Equal(this.RepeatedInt64, that.RepeatedInt64) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt64 {
-		vy := that.RepeatedInt64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
+	if !slices.Equal(this.RepeatedUint32, that.RepeatedUint32) {
 		return false
 	}
-	for i, vx := range this.RepeatedUint32 {
-		vy := that.RepeatedUint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
+	if !slices.Equal(this.RepeatedUint64, that.RepeatedUint64) {
 		return false
 	}
-	for i, vx := range this.RepeatedUint64 {
-		vy := that.RepeatedUint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
+	if !slices.Equal(this.RepeatedSint32, that.RepeatedSint32) {
 		return false
 	}
-	for i, vx := range this.RepeatedSint32 {
-		vy := that.RepeatedSint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
+	if !slices.Equal(this.RepeatedSint64, that.RepeatedSint64) {
 		return false
 	}
-	for i, vx := range this.RepeatedSint64 {
-		vy := that.RepeatedSint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+	if !slices.Equal(this.RepeatedFixed32, that.RepeatedFixed32) {
 		return false
 	}
-	for i, vx := range this.RepeatedFixed32 {
-		vy := that.RepeatedFixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
+	if !slices.Equal(this.RepeatedFixed64, that.RepeatedFixed64) {
 		return false
 	}
-	for i, vx := range this.RepeatedFixed64 {
-		vy := that.RepeatedFixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
+	if !slices.Equal(this.RepeatedSfixed32, that.RepeatedSfixed32) {
 		return false
 	}
-	for i, vx := range this.RepeatedSfixed32 {
-		vy := that.RepeatedSfixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
+	if !slices.Equal(this.RepeatedSfixed64, that.RepeatedSfixed64) {
 		return false
 	}
-	for i, vx := range this.RepeatedSfixed64 {
-		vy := that.RepeatedSfixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
+	if !slices.Equal(this.RepeatedFloat, that.RepeatedFloat) {
 		return false
 	}
-	for i, vx := range this.RepeatedFloat {
-		vy := that.RepeatedFloat[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
+	if !slices.Equal(this.RepeatedDouble, that.RepeatedDouble) {
 		return false
 	}
-	for i, vx := range this.RepeatedDouble {
-		vy := that.RepeatedDouble[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedBool) != len(that.RepeatedBool) {
+	if !slices.Equal(this.RepeatedBool, that.RepeatedBool) {
 		return false
 	}
-	for i, vx := range this.RepeatedBool {
-		vy := that.RepeatedBool[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedString) != len(that.RepeatedString) {
+	if !slices.Equal(this.RepeatedString, that.RepeatedString) {
 		return false
 	}
-	for i, vx := range this.RepeatedString {
-		vy := that.RepeatedString[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
 		return false
 	}
@@ -1309,42 +2048,18 @@ func (this *TestAllTypesProto2) EqualVT(that *TestAllTypesProto2) bool {
 			}
 		}
 	}
-	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
+	if !slices.Equal(this.RepeatedNestedEnum, that.RepeatedNestedEnum) {
 		return false
 	}
-	for i, vx := range this.RepeatedNestedEnum {
-		vy := that.RepeatedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
+	if !slices.Equal(this.RepeatedForeignEnum, that.RepeatedForeignEnum) {
 		return false
 	}
-	for i, vx := range this.RepeatedForeignEnum {
-		vy := that.RepeatedForeignEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
+	if !slices.Equal(this.RepeatedStringPiece, that.RepeatedStringPiece) {
 		return false
 	}
-	for i, vx := range this.RepeatedStringPiece {
-		vy := that.RepeatedStringPiece[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedCord) != len(that.RepeatedCord) {
+	if !slices.Equal(this.RepeatedCord, that.RepeatedCord) {
 		return false
 	}
-	for i, vx := range this.RepeatedCord {
-		vy := that.RepeatedCord[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
 		return false
 	}
@@ -1589,268 +2304,100 @@ func (this *TestAllTypesProto2) EqualVT(that *TestAllTypesProto2) bool {
 			return false
 		}
 	}
-	if len(this.PackedInt32) != len(that.PackedInt32) {
+	if !slices.Equal(this.PackedInt32, that.PackedInt32) {
 		return false
 	}
-	for i, vx := range this.PackedInt32 {
-		vy := that.PackedInt32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedInt64) != len(that.PackedInt64) {
+	if !slices.Equal(this.PackedInt64, that.PackedInt64) {
 		return false
 	}
-	for i, vx := range this.PackedInt64 {
-		vy := that.PackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedUint32, that.PackedUint32) {
+		return false
 	}
-	if len(this.PackedUint32) != len(that.PackedUint32) {
+	if !slices.Equal(this.PackedUint64, that.PackedUint64) {
 		return false
 	}
-	for i, vx := range this.PackedUint32 {
-		vy := that.PackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedSint32, that.PackedSint32) {
+		return false
 	}
-	if len(this.PackedUint64) != len(that.PackedUint64) {
+	if !slices.Equal(this.PackedSint64, that.PackedSint64) {
 		return false
 	}
-	for i, vx := range this.PackedUint64 {
-		vy := that.PackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedFixed32, that.PackedFixed32) {
+		return false
 	}
-	if len(this.PackedSint32) != len(that.PackedSint32) {
+	if !slices.Equal(this.PackedFixed64, that.PackedFixed64) {
 		return false
 	}
-	for i, vx := range this.PackedSint32 {
-		vy := that.PackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedSfixed32, that.PackedSfixed32) {
+		return false
 	}
-	if len(this.PackedSint64) != len(that.PackedSint64) {
+	if !slices.Equal(this.PackedSfixed64, that.PackedSfixed64) {
 		return false
 	}
-	for i, vx := range this.PackedSint64 {
-		vy := that.PackedSint64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedFloat, that.PackedFloat) {
+		return false
 	}
-	if len(this.PackedFixed32) != len(that.PackedFixed32) {
+	if !slices.Equal(this.PackedDouble, that.PackedDouble) {
 		return false
 	}
-	for i, vx := range this.PackedFixed32 {
-		vy := that.PackedFixed32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedBool, that.PackedBool) {
+		return false
 	}
-	if len(this.PackedFixed64) != len(that.PackedFixed64) {
+	if !slices.Equal(this.PackedNestedEnum, that.PackedNestedEnum) {
 		return false
 	}
-	for i, vx := range this.PackedFixed64 {
-		vy := that.PackedFixed64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedInt32, that.UnpackedInt32) {
+		return false
 	}
-	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
+	if !slices.Equal(this.UnpackedInt64, that.UnpackedInt64) {
 		return false
 	}
-	for i, vx := range this.PackedSfixed32 {
-		vy := that.PackedSfixed32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedUint32, that.UnpackedUint32) {
+		return false
 	}
-	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
+	if !slices.Equal(this.UnpackedUint64, that.UnpackedUint64) {
 		return false
 	}
-	for i, vx := range this.PackedSfixed64 {
-		vy := that.PackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedSint32, that.UnpackedSint32) {
+		return false
 	}
-	if len(this.PackedFloat) != len(that.PackedFloat) {
+	if !slices.Equal(this.UnpackedSint64, that.UnpackedSint64) {
 		return false
 	}
-	for i, vx := range this.PackedFloat {
-		vy := that.PackedFloat[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedFixed32, that.UnpackedFixed32) {
+		return false
 	}
-	if len(this.PackedDouble) != len(that.PackedDouble) {
+	if !slices.Equal(this.UnpackedFixed64, that.UnpackedFixed64) {
 		return false
 	}
-	for i, vx := range this.PackedDouble {
-		vy := that.PackedDouble[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedSfixed32, that.UnpackedSfixed32) {
+		return false
 	}
-	if len(this.PackedBool) != len(that.PackedBool) {
+	if !slices.Equal(this.UnpackedSfixed64, that.UnpackedSfixed64) {
 		return false
 	}
-	for i, vx := range this.PackedBool {
-		vy := that.PackedBool[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedFloat, that.UnpackedFloat) {
+		return false
 	}
-	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
+	if !slices.Equal(this.UnpackedDouble, that.UnpackedDouble) {
 		return false
 	}
-	for i, vx := range this.PackedNestedEnum {
-		vy := that.PackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedBool, that.UnpackedBool) {
+		return false
 	}
-	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
+	if !slices.Equal(this.UnpackedNestedEnum, that.UnpackedNestedEnum) {
 		return false
 	}
-	for i, vx := range this.UnpackedInt32 {
-		vy := that.UnpackedInt32[i]
-		if vx != vy {
-			return false
-		}
+	if !this.Data.EqualVT(that.Data) {
+		return false
 	}
-	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
+	if p, q := this.DefaultInt32, that.DefaultInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	for i, vx := range this.UnpackedInt64 {
-		vy := that.UnpackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	if p, q := this.DefaultInt64, that.DefaultInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
-		return false
-	}
-	for i, vx := range this.UnpackedUint32 {
-		vy := that.UnpackedUint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
-		return false
-	}
-	for i, vx := range this.UnpackedUint64 {
-		vy := that.UnpackedUint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
-		return false
-	}
-	for i, vx := range this.UnpackedSint32 {
-		vy := that.UnpackedSint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
-		return false
-	}
-	for i, vx := range this.UnpackedSint64 {
-		vy := that.UnpackedSint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
-		return false
-	}
-	for i, vx := range this.UnpackedFixed32 {
-		vy := that.UnpackedFixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
-		return false
-	}
-	for i, vx := range this.UnpackedFixed64 {
-		vy := that.UnpackedFixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
-		return false
-	}
-	for i, vx := range this.UnpackedSfixed32 {
-		vy := that.UnpackedSfixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
-		return false
-	}
-	for i, vx := range this.UnpackedSfixed64 {
-		vy := that.UnpackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
-		return false
-	}
-	for i, vx := range this.UnpackedFloat {
-		vy := that.UnpackedFloat[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
-		return false
-	}
-	for i, vx := range this.UnpackedDouble {
-		vy := that.UnpackedDouble[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedBool) != len(that.UnpackedBool) {
-		return false
-	}
-	for i, vx := range this.UnpackedBool {
-		vy := that.UnpackedBool[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
-		return false
-	}
-	for i, vx := range this.UnpackedNestedEnum {
-		vy := that.UnpackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if !this.Data.EqualVT(that.Data) {
-		return false
-	}
-	if p, q := this.DefaultInt32, that.DefaultInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
-	}
-	if p, q := this.DefaultInt64, that.DefaultInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
-	}
-	if p, q := this.DefaultUint32, that.DefaultUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.DefaultUint32, that.DefaultUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
 	if p, q := this.DefaultUint64, that.DefaultUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
@@ -2173,15 +2720,9 @@ func (this *UnknownToTestAllTypes) EqualVT(that *UnknownToTestAllTypes) bool {
 	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+	if !slices.Equal(this.RepeatedInt32, that.RepeatedInt32) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -2481,10 +3022,57 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.OneofField.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofNestedMessage:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofString:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofBytes:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofBool:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofUint64:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofFloat:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofDouble:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofEnum:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3155,14 +3743,2147 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.MapStringNestedEnum) > 0 {
 		for k := range m.MapStringNestedEnum {
-			v := m.MapStringNestedEnum[k]
+			v := m.MapStringNestedEnum[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k := range m.MapStringForeignMessage {
+			v := m.MapStringForeignMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k := range m.MapStringNestedMessage {
+			v := m.MapStringNestedMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k := range m.MapStringBytes {
+			v := m.MapStringBytes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k := range m.MapStringString {
+			v := m.MapStringString[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k := range m.MapBoolBool {
+			v := m.MapBoolBool[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k := range m.MapInt32Double {
+			v := m.MapInt32Double[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k := range m.MapInt32Float {
+			v := m.MapInt32Float[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k := range m.MapSfixed64Sfixed64 {
+			v := m.MapSfixed64Sfixed64[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k := range m.MapSfixed32Sfixed32 {
+			v := m.MapSfixed32Sfixed32[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k := range m.MapFixed64Fixed64 {
+			v := m.MapFixed64Fixed64[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k := range m.MapFixed32Fixed32 {
+			v := m.MapFixed32Fixed32[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k := range m.MapSint64Sint64 {
+			v := m.MapSint64Sint64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k := range m.MapSint32Sint32 {
+			v := m.MapSint32Sint32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k := range m.MapUint64Uint64 {
+			v := m.MapUint64Uint64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k := range m.MapUint32Uint32 {
+			v := m.MapUint32Uint32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k := range m.MapInt64Int64 {
+			v := m.MapInt64Int64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k := range m.MapInt32Int32 {
+			v := m.MapInt32Int32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedCord[iNdEx])
+			copy(dAtA[i:], m.RepeatedCord[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedStringPiece[iNdEx])
+			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		for iNdEx := len(m.RepeatedForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedForeignEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa0
+		}
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		for iNdEx := len(m.RepeatedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedNestedEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x98
+		}
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedBytes[iNdEx])
+			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.RepeatedString) > 0 {
+		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedString[iNdEx])
+			copy(dAtA[i:], m.RepeatedString[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.RepeatedBool) > 0 {
+		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
+	}
+	if len(m.RepeatedDouble) > 0 {
+		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f23 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f23))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd1
+		}
+	}
+	if len(m.RepeatedFloat) > 0 {
+		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f24 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f24))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xcd
+		}
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc1
+		}
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xbd
+		}
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb1
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xad
+		}
+	}
+	if len(m.RepeatedSint64) > 0 {
+		for iNdEx := len(m.RepeatedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x25 := (uint64(m.RepeatedSint64[iNdEx]) << 1) ^ uint64((m.RepeatedSint64[iNdEx] >> 63))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x25))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa0
+		}
+	}
+	if len(m.RepeatedSint32) > 0 {
+		for iNdEx := len(m.RepeatedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x26 := (uint32(m.RepeatedSint32[iNdEx]) << 1) ^ uint32((m.RepeatedSint32[iNdEx] >> 31))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x26))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+	}
+	if len(m.RepeatedUint64) > 0 {
+		for iNdEx := len(m.RepeatedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedUint64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x90
+		}
+	}
+	if len(m.RepeatedUint32) > 0 {
+		for iNdEx := len(m.RepeatedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedUint32[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
+	}
+	if len(m.RepeatedInt64) > 0 {
+		for iNdEx := len(m.RepeatedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedInt64[iNdEx]))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
+		}
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf8
+		}
+	}
+	if m.RecursiveMessage != nil {
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.OptionalCord != nil {
+		i -= len(*m.OptionalCord)
+		copy(dAtA[i:], *m.OptionalCord)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalCord)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.OptionalStringPiece != nil {
+		i -= len(*m.OptionalStringPiece)
+		copy(dAtA[i:], *m.OptionalStringPiece)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalStringPiece)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.OptionalForeignEnum != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalForeignEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.OptionalNestedEnum != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalNestedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.OptionalForeignMessage != nil {
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.OptionalNestedMessage != nil {
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.OptionalBytes != nil {
+		i -= len(m.OptionalBytes)
+		copy(dAtA[i:], m.OptionalBytes)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OptionalString != nil {
+		i -= len(*m.OptionalString)
+		copy(dAtA[i:], *m.OptionalString)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.OptionalBool != nil {
+		i--
+		if *m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.OptionalDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalDouble))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.OptionalFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalFloat))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.OptionalSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalSfixed64))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.OptionalSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalSfixed32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if m.OptionalFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalFixed64))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.OptionalFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalFixed32))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.OptionalSint64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OptionalSint32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(*m.OptionalSint32)<<1)^uint32((*m.OptionalSint32>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OptionalUint64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalUint64))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OptionalUint32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalUint32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OptionalInt64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalInt64))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OptionalInt32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint32))
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xf8
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofNestedMessage != nil {
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	copy(dAtA[i:], m.OneofString)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x8a
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofBytes)
+	copy(dAtA[i:], m.OneofBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x92
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.OneofBool {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x98
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint64))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xa0
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xad
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xb1
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofEnum))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xb8
+	return len(dAtA) - i, nil
+}
+func (m *ForeignMessageProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeignMessageProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ForeignMessageProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.C != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.C))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.A != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x3f
+			i--
+			dAtA[i] = 0x98
+		}
+	}
+	if m.OptionalBool != nil {
+		i--
+		if *m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.Optionalgroup != nil {
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xe4
+		size, err := m.Optionalgroup.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xe3
+	}
+	if m.NestedMessage != nil {
+		size, err := m.NestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.OptionalString != nil {
+		i -= len(*m.OptionalString)
+		copy(dAtA[i:], *m.OptionalString)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.OptionalInt32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullHypothesisProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NullHypothesisProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *NullHypothesisProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumOnlyProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnumOnlyProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *EnumOnlyProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OneStringProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OneStringProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OneStringProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Data != nil {
+		i -= len(*m.Data)
+		copy(dAtA[i:], *m.Data)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Corecursive != nil {
+		size, err := m.Corecursive.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.A != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_Data) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.GroupUint32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.GroupUint32))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.GroupInt32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.GroupInt32))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd0
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Str != nil {
+		i -= len(*m.Str)
+		copy(dAtA[i:], *m.Str)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.Str)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.I != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.I))
+		i--
+		dAtA[i] = 0x48
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofNestedMessage:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofString:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofBytes:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofBool:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofUint64:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofFloat:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofDouble:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto2_OneofEnum:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.FieldName18__ != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName18__))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.FieldName17__ != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName17__))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Field__Name16 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field__Name16))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Field_Name15 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field_Name15))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.X_FieldName14 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.X_FieldName14))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.XFieldName13 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.XFieldName13))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.FIELDName12 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FIELDName12))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.FIELD_NAME11 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FIELD_NAME11))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Field_Name10 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field_Name10))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.Field_Name9 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field_Name9))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.FieldName8 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName8))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.FieldName7 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName7))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Field_0Name6 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field_0Name6))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Field0Name5 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field0Name5))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Field_Name4_ != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Field_Name4_))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.XFieldName3 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.XFieldName3))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.FieldName2 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName2))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Fieldname1 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.Fieldname1))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DefaultBytes != nil {
+		i -= len(m.DefaultBytes)
+		copy(dAtA[i:], m.DefaultBytes)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.DefaultBytes)))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.DefaultString != nil {
+		i -= len(*m.DefaultString)
+		copy(dAtA[i:], *m.DefaultString)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.DefaultString)))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.DefaultBool != nil {
+		i--
+		if *m.DefaultBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.DefaultDouble != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.DefaultDouble))))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xe1
+	}
+	if m.DefaultFloat != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.DefaultFloat))))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xdd
+	}
+	if m.DefaultSfixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultSfixed64))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xd1
+	}
+	if m.DefaultSfixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultSfixed32))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xcd
+	}
+	if m.DefaultFixed64 != nil {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultFixed64))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xc1
+	}
+	if m.DefaultFixed32 != nil {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultFixed32))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xbd
+	}
+	if m.DefaultSint64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(*m.DefaultSint64)<<1)^uint64((*m.DefaultSint64>>63))))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DefaultSint32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(*m.DefaultSint32)<<1)^uint32((*m.DefaultSint32>>31))))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DefaultUint64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.DefaultUint64))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DefaultUint32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.DefaultUint32))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DefaultInt64 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.DefaultInt64))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DefaultInt32 != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.DefaultInt32))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Data != nil {
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xcc
+		size, err := m.Data.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xcb
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb0
+		}
+	}
+	if len(m.UnpackedBool) > 0 {
+		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.UnpackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa8
+		}
+	}
+	if len(m.UnpackedDouble) > 0 {
+		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa1
+		}
+	}
+	if len(m.UnpackedFloat) > 0 {
+		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x9d
+		}
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x91
+		}
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x8d
+		}
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x81
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xfd
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x3))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xf0
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x4))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xe8
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xe0
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xd8
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xd0
+		}
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xc8
+		}
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		var pksize6 int
+		for _, num := range m.PackedNestedEnum {
+			pksize6 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize6
+		j5 := i
+		for _, num1 := range m.PackedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize6))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.PackedBool) > 0 {
+		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.PackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedBool)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.PackedDouble) > 0 {
+		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.PackedFloat) > 0 {
+		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.PackedSfixed64) > 0 {
+		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.PackedSfixed32) > 0 {
+		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.PackedFixed64) > 0 {
+		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PackedFixed32) > 0 {
+		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PackedSint64) > 0 {
+		var pksize10 int
+		for _, num := range m.PackedSint64 {
+			pksize10 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize10
+		j9 := i
+		for _, num := range m.PackedSint64 {
+			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x11 >= 1<<7 {
+				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
+				j9++
+				x11 >>= 7
+			}
+			dAtA[j9] = uint8(x11)
+			j9++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize10))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PackedSint32) > 0 {
+		var pksize13 int
+		for _, num := range m.PackedSint32 {
+			pksize13 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize13
+		j12 := i
+		for _, num := range m.PackedSint32 {
+			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x14 >= 1<<7 {
+				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
+				j12++
+				x14 >>= 7
+			}
+			dAtA[j12] = uint8(x14)
+			j12++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize13))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.PackedUint64) > 0 {
+		var pksize16 int
+		for _, num := range m.PackedUint64 {
+			pksize16 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize16
+		j15 := i
+		for _, num := range m.PackedUint64 {
+			for num >= 1<<7 {
+				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA[j15] = uint8(num)
+			j15++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize16))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.PackedUint32) > 0 {
+		var pksize18 int
+		for _, num := range m.PackedUint32 {
+			pksize18 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize18
+		j17 := i
+		for _, num := range m.PackedUint32 {
+			for num >= 1<<7 {
+				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA[j17] = uint8(num)
+			j17++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize18))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.PackedInt64) > 0 {
+		var pksize20 int
+		for _, num := range m.PackedInt64 {
+			pksize20 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize20
+		j19 := i
+		for _, num1 := range m.PackedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA[j19] = uint8(num)
+			j19++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize20))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.PackedInt32) > 0 {
+		var pksize22 int
+		for _, num := range m.PackedInt32 {
+			pksize22 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize22
+		j21 := i
+		for _, num1 := range m.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA[j21] = uint8(num)
+			j21++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize22))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		keysForMapStringForeignEnum := make([]string, 0, len(m.MapStringForeignEnum))
+		for k := range m.MapStringForeignEnum {
+			keysForMapStringForeignEnum = append(keysForMapStringForeignEnum, string(k))
+		}
+		sort.Slice(keysForMapStringForeignEnum, func(i, j int) bool {
+			return keysForMapStringForeignEnum[i] < keysForMapStringForeignEnum[j]
+		})
+		for iNdEx := len(keysForMapStringForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringForeignEnum[string(keysForMapStringForeignEnum[iNdEx])]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForMapStringForeignEnum[iNdEx])
+			copy(dAtA[i:], keysForMapStringForeignEnum[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringForeignEnum[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		keysForMapStringNestedEnum := make([]string, 0, len(m.MapStringNestedEnum))
+		for k := range m.MapStringNestedEnum {
+			keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
+		}
+		sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
+			return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
+		})
+		for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringNestedEnum[iNdEx])
+			copy(dAtA[i:], keysForMapStringNestedEnum[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringNestedEnum[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3173,10 +5894,17 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringForeignMessage) > 0 {
+		keysForMapStringForeignMessage := make([]string, 0, len(m.MapStringForeignMessage))
 		for k := range m.MapStringForeignMessage {
-			v := m.MapStringForeignMessage[k]
+			keysForMapStringForeignMessage = append(keysForMapStringForeignMessage, string(k))
+		}
+		sort.Slice(keysForMapStringForeignMessage, func(i, j int) bool {
+			return keysForMapStringForeignMessage[i] < keysForMapStringForeignMessage[j]
+		})
+		for iNdEx := len(keysForMapStringForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringForeignMessage[string(keysForMapStringForeignMessage[iNdEx])]
 			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3184,9 +5912,9 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringForeignMessage[iNdEx])
+			copy(dAtA[i:], keysForMapStringForeignMessage[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringForeignMessage[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3197,10 +5925,17 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringNestedMessage) > 0 {
+		keysForMapStringNestedMessage := make([]string, 0, len(m.MapStringNestedMessage))
 		for k := range m.MapStringNestedMessage {
-			v := m.MapStringNestedMessage[k]
+			keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
+		}
+		sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
+			return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
+		})
+		for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
 			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3208,9 +5943,9 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringNestedMessage[iNdEx])
+			copy(dAtA[i:], keysForMapStringNestedMessage[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringNestedMessage[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3221,17 +5956,24 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringBytes) > 0 {
+		keysForMapStringBytes := make([]string, 0, len(m.MapStringBytes))
 		for k := range m.MapStringBytes {
-			v := m.MapStringBytes[k]
+			keysForMapStringBytes = append(keysForMapStringBytes, string(k))
+		}
+		sort.Slice(keysForMapStringBytes, func(i, j int) bool {
+			return keysForMapStringBytes[i] < keysForMapStringBytes[j]
+		})
+		for iNdEx := len(keysForMapStringBytes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringBytes[string(keysForMapStringBytes[iNdEx])]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringBytes[iNdEx])
+			copy(dAtA[i:], keysForMapStringBytes[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringBytes[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3242,17 +5984,24 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringString) > 0 {
+		keysForMapStringString := make([]string, 0, len(m.MapStringString))
 		for k := range m.MapStringString {
-			v := m.MapStringString[k]
+			keysForMapStringString = append(keysForMapStringString, string(k))
+		}
+		sort.Slice(keysForMapStringString, func(i, j int) bool {
+			return keysForMapStringString[i] < keysForMapStringString[j]
+		})
+		for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringString[string(keysForMapStringString[iNdEx])]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringString[iNdEx])
+			copy(dAtA[i:], keysForMapStringString[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringString[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3263,8 +6012,15 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapBoolBool) > 0 {
+		keysForMapBoolBool := make([]bool, 0, len(m.MapBoolBool))
 		for k := range m.MapBoolBool {
-			v := m.MapBoolBool[k]
+			keysForMapBoolBool = append(keysForMapBoolBool, bool(k))
+		}
+		sort.Slice(keysForMapBoolBool, func(i, j int) bool {
+			return !keysForMapBoolBool[i] && keysForMapBoolBool[j]
+		})
+		for iNdEx := len(keysForMapBoolBool) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapBoolBool[bool(keysForMapBoolBool[iNdEx])]
 			baseI := i
 			i--
 			if v {
@@ -3275,7 +6031,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i--
 			dAtA[i] = 0x10
 			i--
-			if k {
+			if keysForMapBoolBool[iNdEx] {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
@@ -3290,14 +6046,21 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Double) > 0 {
+		keysForMapInt32Double := make([]int32, 0, len(m.MapInt32Double))
 		for k := range m.MapInt32Double {
-			v := m.MapInt32Double[k]
+			keysForMapInt32Double = append(keysForMapInt32Double, int32(k))
+		}
+		sort.Slice(keysForMapInt32Double, func(i, j int) bool {
+			return keysForMapInt32Double[i] < keysForMapInt32Double[j]
+		})
+		for iNdEx := len(keysForMapInt32Double) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Double[int32(keysForMapInt32Double[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
 			i--
 			dAtA[i] = 0x11
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Double[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3308,14 +6071,21 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Float) > 0 {
+		keysForMapInt32Float := make([]int32, 0, len(m.MapInt32Float))
 		for k := range m.MapInt32Float {
-			v := m.MapInt32Float[k]
+			keysForMapInt32Float = append(keysForMapInt32Float, int32(k))
+		}
+		sort.Slice(keysForMapInt32Float, func(i, j int) bool {
+			return keysForMapInt32Float[i] < keysForMapInt32Float[j]
+		})
+		for iNdEx := len(keysForMapInt32Float) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Float[int32(keysForMapInt32Float[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
 			i--
 			dAtA[i] = 0x15
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Float[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3326,15 +6096,22 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSfixed64Sfixed64) > 0 {
+		keysForMapSfixed64Sfixed64 := make([]int64, 0, len(m.MapSfixed64Sfixed64))
 		for k := range m.MapSfixed64Sfixed64 {
-			v := m.MapSfixed64Sfixed64[k]
+			keysForMapSfixed64Sfixed64 = append(keysForMapSfixed64Sfixed64, int64(k))
+		}
+		sort.Slice(keysForMapSfixed64Sfixed64, func(i, j int) bool {
+			return keysForMapSfixed64Sfixed64[i] < keysForMapSfixed64Sfixed64[j]
+		})
+		for iNdEx := len(keysForMapSfixed64Sfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSfixed64Sfixed64[int64(keysForMapSfixed64Sfixed64[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
 			dAtA[i] = 0x11
 			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForMapSfixed64Sfixed64[iNdEx]))
 			i--
 			dAtA[i] = 0x9
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3345,15 +6122,22 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSfixed32Sfixed32) > 0 {
+		keysForMapSfixed32Sfixed32 := make([]int32, 0, len(m.MapSfixed32Sfixed32))
 		for k := range m.MapSfixed32Sfixed32 {
-			v := m.MapSfixed32Sfixed32[k]
+			keysForMapSfixed32Sfixed32 = append(keysForMapSfixed32Sfixed32, int32(k))
+		}
+		sort.Slice(keysForMapSfixed32Sfixed32, func(i, j int) bool {
+			return keysForMapSfixed32Sfixed32[i] < keysForMapSfixed32Sfixed32[j]
+		})
+		for iNdEx := len(keysForMapSfixed32Sfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSfixed32Sfixed32[int32(keysForMapSfixed32Sfixed32[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
 			i--
 			dAtA[i] = 0x15
 			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForMapSfixed32Sfixed32[iNdEx]))
 			i--
 			dAtA[i] = 0xd
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3364,15 +6148,22 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapFixed64Fixed64) > 0 {
+		keysForMapFixed64Fixed64 := make([]uint64, 0, len(m.MapFixed64Fixed64))
 		for k := range m.MapFixed64Fixed64 {
-			v := m.MapFixed64Fixed64[k]
+			keysForMapFixed64Fixed64 = append(keysForMapFixed64Fixed64, uint64(k))
+		}
+		sort.Slice(keysForMapFixed64Fixed64, func(i, j int) bool {
+			return keysForMapFixed64Fixed64[i] < keysForMapFixed64Fixed64[j]
+		})
+		for iNdEx := len(keysForMapFixed64Fixed64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapFixed64Fixed64[uint64(keysForMapFixed64Fixed64[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
 			dAtA[i] = 0x11
 			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForMapFixed64Fixed64[iNdEx]))
 			i--
 			dAtA[i] = 0x9
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3383,15 +6174,22 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapFixed32Fixed32) > 0 {
+		keysForMapFixed32Fixed32 := make([]uint32, 0, len(m.MapFixed32Fixed32))
 		for k := range m.MapFixed32Fixed32 {
-			v := m.MapFixed32Fixed32[k]
+			keysForMapFixed32Fixed32 = append(keysForMapFixed32Fixed32, uint32(k))
+		}
+		sort.Slice(keysForMapFixed32Fixed32, func(i, j int) bool {
+			return keysForMapFixed32Fixed32[i] < keysForMapFixed32Fixed32[j]
+		})
+		for iNdEx := len(keysForMapFixed32Fixed32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapFixed32Fixed32[uint32(keysForMapFixed32Fixed32[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
 			i--
 			dAtA[i] = 0x15
 			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForMapFixed32Fixed32[iNdEx]))
 			i--
 			dAtA[i] = 0xd
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3402,13 +6200,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSint64Sint64) > 0 {
+		keysForMapSint64Sint64 := make([]int64, 0, len(m.MapSint64Sint64))
 		for k := range m.MapSint64Sint64 {
-			v := m.MapSint64Sint64[k]
+			keysForMapSint64Sint64 = append(keysForMapSint64Sint64, int64(k))
+		}
+		sort.Slice(keysForMapSint64Sint64, func(i, j int) bool {
+			return keysForMapSint64Sint64[i] < keysForMapSint64Sint64[j]
+		})
+		for iNdEx := len(keysForMapSint64Sint64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSint64Sint64[int64(keysForMapSint64Sint64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(keysForMapSint64Sint64[iNdEx])<<1)^uint64((keysForMapSint64Sint64[iNdEx]>>63))))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3419,13 +6224,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSint32Sint32) > 0 {
+		keysForMapSint32Sint32 := make([]int32, 0, len(m.MapSint32Sint32))
 		for k := range m.MapSint32Sint32 {
-			v := m.MapSint32Sint32[k]
+			keysForMapSint32Sint32 = append(keysForMapSint32Sint32, int32(k))
+		}
+		sort.Slice(keysForMapSint32Sint32, func(i, j int) bool {
+			return keysForMapSint32Sint32[i] < keysForMapSint32Sint32[j]
+		})
+		for iNdEx := len(keysForMapSint32Sint32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSint32Sint32[int32(keysForMapSint32Sint32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(keysForMapSint32Sint32[iNdEx])<<1)^uint32((keysForMapSint32Sint32[iNdEx]>>31))))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3436,13 +6248,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapUint64Uint64) > 0 {
+		keysForMapUint64Uint64 := make([]uint64, 0, len(m.MapUint64Uint64))
 		for k := range m.MapUint64Uint64 {
-			v := m.MapUint64Uint64[k]
+			keysForMapUint64Uint64 = append(keysForMapUint64Uint64, uint64(k))
+		}
+		sort.Slice(keysForMapUint64Uint64, func(i, j int) bool {
+			return keysForMapUint64Uint64[i] < keysForMapUint64Uint64[j]
+		})
+		for iNdEx := len(keysForMapUint64Uint64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapUint64Uint64[uint64(keysForMapUint64Uint64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapUint64Uint64[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3453,13 +6272,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapUint32Uint32) > 0 {
+		keysForMapUint32Uint32 := make([]uint32, 0, len(m.MapUint32Uint32))
 		for k := range m.MapUint32Uint32 {
-			v := m.MapUint32Uint32[k]
+			keysForMapUint32Uint32 = append(keysForMapUint32Uint32, uint32(k))
+		}
+		sort.Slice(keysForMapUint32Uint32, func(i, j int) bool {
+			return keysForMapUint32Uint32[i] < keysForMapUint32Uint32[j]
+		})
+		for iNdEx := len(keysForMapUint32Uint32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapUint32Uint32[uint32(keysForMapUint32Uint32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapUint32Uint32[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3470,13 +6296,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt64Int64) > 0 {
+		keysForMapInt64Int64 := make([]int64, 0, len(m.MapInt64Int64))
 		for k := range m.MapInt64Int64 {
-			v := m.MapInt64Int64[k]
+			keysForMapInt64Int64 = append(keysForMapInt64Int64, int64(k))
+		}
+		sort.Slice(keysForMapInt64Int64, func(i, j int) bool {
+			return keysForMapInt64Int64[i] < keysForMapInt64Int64[j]
+		})
+		for iNdEx := len(keysForMapInt64Int64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt64Int64[int64(keysForMapInt64Int64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt64Int64[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3487,13 +6320,20 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Int32) > 0 {
+		keysForMapInt32Int32 := make([]int32, 0, len(m.MapInt32Int32))
 		for k := range m.MapInt32Int32 {
-			v := m.MapInt32Int32[k]
+			keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
+		}
+		sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
+			return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
+		})
+		for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Int32[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3545,7 +6385,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedForeignMessage) > 0 {
 		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3559,7 +6399,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedNestedMessage) > 0 {
 		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3726,7 +6566,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if m.RecursiveMessage != nil {
-		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3770,7 +6610,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa8
 	}
 	if m.OptionalForeignMessage != nil {
-		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3782,7 +6622,7 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x9a
 	}
 	if m.OptionalNestedMessage != nil {
-		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3886,12 +6726,12 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TestAllTypesProto2_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofUint32) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint32))
 	i--
@@ -3900,15 +6740,15 @@ func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xf8
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.OneofNestedMessage != nil {
-		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3927,12 +6767,12 @@ func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofString) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= len(m.OneofString)
 	copy(dAtA[i:], m.OneofString)
@@ -3943,12 +6783,12 @@ func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0x8a
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofBytes) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= len(m.OneofBytes)
 	copy(dAtA[i:], m.OneofBytes)
@@ -3959,12 +6799,12 @@ func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int
 	dAtA[i] = 0x92
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofBool) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i--
 	if m.OneofBool {
@@ -3978,12 +6818,12 @@ func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int,
 	dAtA[i] = 0x98
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofUint64) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint64))
 	i--
@@ -3992,12 +6832,12 @@ func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xa0
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofFloat) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= 4
 	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
@@ -4007,12 +6847,12 @@ func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int
 	dAtA[i] = 0xad
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofDouble) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= 8
 	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
@@ -4022,12 +6862,12 @@ func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xb1
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto2_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofEnum) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofEnum))
 	i--
@@ -4036,25 +6876,25 @@ func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int,
 	dAtA[i] = 0xb8
 	return len(dAtA) - i, nil
 }
-func (m *ForeignMessageProto2) MarshalVT() (dAtA []byte, err error) {
+func (m *ForeignMessageProto2) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ForeignMessageProto2) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ForeignMessageProto2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *ForeignMessageProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ForeignMessageProto2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4074,25 +6914,25 @@ func (m *ForeignMessageProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVT() (dAtA []byte, err error) {
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToVT(dAtA []byte) (int, error) {
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4112,25 +6952,25 @@ func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVT(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *UnknownToTestAllTypes) MarshalVT() (dAtA []byte, err error) {
+func (m *UnknownToTestAllTypes) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *UnknownToTestAllTypes) MarshalToVT(dAtA []byte) (int, error) {
+func (m *UnknownToTestAllTypes) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *UnknownToTestAllTypes) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4168,7 +7008,7 @@ func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		dAtA[i] = 0x3e
 		i--
 		dAtA[i] = 0xe4
-		size, err := m.Optionalgroup.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.Optionalgroup.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4179,7 +7019,7 @@ func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		dAtA[i] = 0xe3
 	}
 	if m.NestedMessage != nil {
-		size, err := m.NestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.NestedMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4209,25 +7049,25 @@ func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *NullHypothesisProto2) MarshalVT() (dAtA []byte, err error) {
+func (m *NullHypothesisProto2) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *NullHypothesisProto2) MarshalToVT(dAtA []byte) (int, error) {
+func (m *NullHypothesisProto2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *NullHypothesisProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *NullHypothesisProto2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4242,25 +7082,25 @@ func (m *NullHypothesisProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EnumOnlyProto2) MarshalVT() (dAtA []byte, err error) {
+func (m *EnumOnlyProto2) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EnumOnlyProto2) MarshalToVT(dAtA []byte) (int, error) {
+func (m *EnumOnlyProto2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *EnumOnlyProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *EnumOnlyProto2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4275,25 +7115,25 @@ func (m *EnumOnlyProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OneStringProto2) MarshalVT() (dAtA []byte, err error) {
+func (m *OneStringProto2) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *OneStringProto2) MarshalToVT(dAtA []byte) (int, error) {
+func (m *OneStringProto2) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *OneStringProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *OneStringProto2) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4553,6 +7393,27 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	var whichOneofField int32
+	switch m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		whichOneofField = 111
+	case *TestAllTypesProto2_OneofNestedMessage:
+		whichOneofField = 112
+	case *TestAllTypesProto2_OneofString:
+		whichOneofField = 113
+	case *TestAllTypesProto2_OneofBytes:
+		whichOneofField = 114
+	case *TestAllTypesProto2_OneofBool:
+		whichOneofField = 115
+	case *TestAllTypesProto2_OneofUint64:
+		whichOneofField = 116
+	case *TestAllTypesProto2_OneofFloat:
+		whichOneofField = 117
+	case *TestAllTypesProto2_OneofDouble:
+		whichOneofField = 118
+	case *TestAllTypesProto2_OneofEnum:
+		whichOneofField = 119
+	}
 	if m.FieldName18__ != nil {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.FieldName18__))
 		i--
@@ -4814,63 +7675,72 @@ func (m *TestAllTypesProto2) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0xcb
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+	if whichOneofField == 119 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofEnum)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+	if whichOneofField == 118 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofDouble)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+	if whichOneofField == 117 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofFloat)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+	if whichOneofField == 116 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofUint64)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+	if whichOneofField == 115 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofBool)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+	if whichOneofField == 114 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofBytes)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofString); ok {
+	if whichOneofField == 113 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofString)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+	if whichOneofField == 112 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+	if whichOneofField == 111 {
+		msg := m.OneofField.(*TestAllTypesProto2_OneofUint32)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -6441,6 +9311,42 @@ func (m *OneStringProto2) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *TestAllTypesProto2_NestedMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto2_Data) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto2_MessageSetCorrect) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ForeignMessageProto2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnknownToTestAllTypes_OptionalGroup) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnknownToTestAllTypes) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *NullHypothesisProto2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *EnumOnlyProto2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneStringProto2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *TestAllTypesProto2_NestedMessage) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -6976,8 +9882,25 @@ func (m *TestAllTypesProto2) SizeVT() (n int) {
 			n += 2 + protohelpers.SizeOfVarint(uint64(e))
 		}
 	}
-	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofNestedMessage:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofString:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofBytes:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofBool:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofUint64:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofFloat:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofDouble:
+		n += c.SizeVT()
+	case *TestAllTypesProto2_OneofEnum:
+		n += c.SizeVT()
 	}
 	if m.Data != nil {
 		l = m.Data.SizeVT()
@@ -7509,7 +10432,7 @@ func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if (fieldNum >= 4) && (fieldNum < 2147483647) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
+				err = proto.UnmarshalOptions{AllowPartial: true, Merge: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
 				if err != nil {
 					return err
 				}
@@ -8258,24 +11181,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
@@ -8334,24 +11257,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
 					m.RepeatedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+					m.RepeatedInt64 = append(m.RepeatedInt64, int64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
 			}
@@ -8410,24 +11333,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
 					m.RepeatedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+					m.RepeatedUint32 = append(m.RepeatedUint32, uint32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
 			}
@@ -8486,24 +11409,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
 					m.RepeatedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+					m.RepeatedUint64 = append(m.RepeatedUint64, uint64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
 			}
@@ -8563,25 +11486,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
 					m.RepeatedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+					m.RepeatedSint32 = append(m.RepeatedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
 			}
@@ -8641,25 +11563,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
 					m.RepeatedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
 			}
@@ -9028,24 +11949,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedBool) == 0 {
 					m.RepeatedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protohelpers.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
 						}
+						continue
 					}
-					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+					m.RepeatedBool = append(m.RepeatedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
 			}
@@ -9229,24 +12150,25 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
 					m.RepeatedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, e)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
 			}
@@ -9298,24 +12220,25 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
 					m.RepeatedForeignEnum = make([]ForeignEnumProto2, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v ForeignEnumProto2
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ForeignEnumProto2(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+					e := ForeignEnumProto2(v)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
 			}
@@ -10679,7 +13602,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -10708,7 +13635,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					if intStringLenmapvalue == 0 {
+						mapvalue = ""
+					} else {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
@@ -10725,7 +13656,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringString[mapkey] = mapvalue
+			if old, ok := m.MapStringString[mapkey]; !ok || old != mapvalue {
+				m.MapStringString[strings.Clone(mapkey)] = strings.Clone(mapvalue)
+			}
 			iNdEx = postIndex
 		case 70:
 			if wireType != 2 {
@@ -10806,7 +13739,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapbyteLen uint64
@@ -10853,7 +13790,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringBytes[mapkey] = mapvalue
+			m.MapStringBytes[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 71:
 			if wireType != 2 {
@@ -10934,7 +13871,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -10982,7 +13923,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringNestedMessage[mapkey] = mapvalue
+			m.MapStringNestedMessage[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 72:
 			if wireType != 2 {
@@ -11063,7 +14004,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -11111,7 +14056,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringForeignMessage[mapkey] = mapvalue
+			m.MapStringForeignMessage[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 73:
 			if wireType != 2 {
@@ -11192,7 +14137,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11224,7 +14173,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringNestedEnum[mapkey] = mapvalue
+			if old, ok := m.MapStringNestedEnum[mapkey]; !ok || old != mapvalue {
+				m.MapStringNestedEnum[strings.Clone(mapkey)] = mapvalue
+			}
 			iNdEx = postIndex
 		case 74:
 			if wireType != 2 {
@@ -11305,7 +14256,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11337,7 +14292,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringForeignEnum[mapkey] = mapvalue
+			if old, ok := m.MapStringForeignEnum[mapkey]; !ok || old != mapvalue {
+				m.MapStringForeignEnum[strings.Clone(mapkey)] = mapvalue
+			}
 			iNdEx = postIndex
 		case 75:
 			if wireType == 0 {
@@ -11394,24 +14351,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt32) == 0 {
 					m.PackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt32 = append(m.PackedInt32, v)
+					m.PackedInt32 = append(m.PackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
 			}
@@ -11470,24 +14427,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt64) == 0 {
 					m.PackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt64 = append(m.PackedInt64, v)
+					m.PackedInt64 = append(m.PackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
 			}
@@ -11546,24 +14503,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint32) == 0 {
 					m.PackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint32 = append(m.PackedUint32, v)
+					m.PackedUint32 = append(m.PackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
 			}
@@ -11622,24 +14579,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint64) == 0 {
 					m.PackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint64 = append(m.PackedUint64, v)
+					m.PackedUint64 = append(m.PackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
 			}
@@ -11699,25 +14656,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint32) == 0 {
 					m.PackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedSint32 = append(m.PackedSint32, v)
+					m.PackedSint32 = append(m.PackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
 			}
@@ -11777,25 +14733,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint64) == 0 {
 					m.PackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedSint64 = append(m.PackedSint64, int64(v))
+					m.PackedSint64 = append(m.PackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
 			}
@@ -12164,24 +15119,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedBool) == 0 {
 					m.PackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedBool = append(m.PackedBool, bool(v != 0))
+					m.PackedBool = append(m.PackedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
 			}
@@ -12233,24 +15188,25 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
 					m.PackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
 			}
@@ -12309,24 +15265,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
 					m.UnpackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+					m.UnpackedInt32 = append(m.UnpackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
 			}
@@ -12385,24 +15341,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
 					m.UnpackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+					m.UnpackedInt64 = append(m.UnpackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
 			}
@@ -12461,24 +15417,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
 					m.UnpackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+					m.UnpackedUint32 = append(m.UnpackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
 			}
@@ -12537,24 +15493,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
 					m.UnpackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+					m.UnpackedUint64 = append(m.UnpackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
 			}
@@ -12614,25 +15570,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
 					m.UnpackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+					m.UnpackedSint32 = append(m.UnpackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
 			}
@@ -12692,25 +15647,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
 					m.UnpackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
 			}
@@ -13079,24 +16033,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedBool) == 0 {
 					m.UnpackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+					m.UnpackedBool = append(m.UnpackedBool, v != 0)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
 			}
@@ -13148,24 +16102,25 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
 					m.UnpackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
 			}
@@ -13404,21 +16359,24 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
+				groupWireType := int(groupFieldWire & 0x7)
 				if groupWireType == 4 {
+					if m.Data == nil {
+						m.Data = &TestAllTypesProto2_Data{}
+					}
 					if err := m.Data.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
 						return err
 					}
 					break
 				}
-				skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
 				if err != nil {
 					return err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
 					return protohelpers.ErrInvalidLength
 				}
-				iNdEx += skippy
+				iNdEx = maybeGroupEnd + skippy
 			}
 		case 241:
 			if wireType != 0 {
@@ -14072,7 +17030,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if (fieldNum >= 120) && (fieldNum < 201) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
+				err = proto.UnmarshalOptions{AllowPartial: true, Merge: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
 				if err != nil {
 					return err
 				}
@@ -14371,21 +17329,24 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
+				groupWireType := int(groupFieldWire & 0x7)
 				if groupWireType == 4 {
+					if m.Optionalgroup == nil {
+						m.Optionalgroup = &UnknownToTestAllTypes_OptionalGroup{}
+					}
 					if err := m.Optionalgroup.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
 						return err
 					}
 					break
 				}
-				skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
 				if err != nil {
 					return err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
 					return protohelpers.ErrInvalidLength
 				}
-				iNdEx += skippy
+				iNdEx = maybeGroupEnd + skippy
 			}
 		case 1006:
 			if wireType != 0 {
@@ -14463,24 +17424,24 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
@@ -14932,7 +17893,7 @@ func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVTUnsafe(dAtA []byte) er
 				return io.ErrUnexpectedEOF
 			}
 			if (fieldNum >= 4) && (fieldNum < 2147483647) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
+				err = proto.UnmarshalOptions{AllowPartial: true, Merge: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
 				if err != nil {
 					return err
 				}
@@ -15694,24 +18655,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
@@ -15770,24 +18731,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
 					m.RepeatedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+					m.RepeatedInt64 = append(m.RepeatedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
 			}
@@ -15846,24 +18807,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
 					m.RepeatedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+					m.RepeatedUint32 = append(m.RepeatedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
 			}
@@ -15922,24 +18883,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
 					m.RepeatedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+					m.RepeatedUint64 = append(m.RepeatedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
 			}
@@ -15999,25 +18960,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
 					m.RepeatedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+					m.RepeatedSint32 = append(m.RepeatedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
 			}
@@ -16077,25 +19037,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
 					m.RepeatedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
 			}
@@ -16348,6 +19307,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedFloat) == 0 {
 					m.RepeatedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.RepeatedFloat = append(m.RepeatedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -16402,6 +19364,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedDouble) == 0 {
 					m.RepeatedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.RepeatedDouble = append(m.RepeatedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -16464,24 +19429,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedBool) == 0 {
 					m.RepeatedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+					m.RepeatedBool = append(m.RepeatedBool, v != 0)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
 			}
@@ -16668,24 +19633,25 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
 					m.RepeatedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
 			}
@@ -16737,24 +19703,25 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
 					m.RepeatedForeignEnum = make([]ForeignEnumProto2, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v ForeignEnumProto2
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ForeignEnumProto2(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+					e := ForeignEnumProto2(v)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
 			}
@@ -18868,24 +21835,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt32) == 0 {
 					m.PackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt32 = append(m.PackedInt32, v)
+					m.PackedInt32 = append(m.PackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
 			}
@@ -18944,24 +21911,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt64) == 0 {
 					m.PackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt64 = append(m.PackedInt64, v)
+					m.PackedInt64 = append(m.PackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
 			}
@@ -19020,24 +21987,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint32) == 0 {
 					m.PackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint32 = append(m.PackedUint32, v)
+					m.PackedUint32 = append(m.PackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
 			}
@@ -19096,24 +22063,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint64) == 0 {
 					m.PackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint64 = append(m.PackedUint64, v)
+					m.PackedUint64 = append(m.PackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
 			}
@@ -19173,25 +22140,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint32) == 0 {
 					m.PackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedSint32 = append(m.PackedSint32, v)
+					m.PackedSint32 = append(m.PackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
 			}
@@ -19251,25 +22217,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint64) == 0 {
 					m.PackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedSint64 = append(m.PackedSint64, int64(v))
+					m.PackedSint64 = append(m.PackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
 			}
@@ -19522,6 +22487,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedFloat) == 0 {
 					m.PackedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.PackedFloat = append(m.PackedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -19576,6 +22544,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedDouble) == 0 {
 					m.PackedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.PackedDouble = append(m.PackedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -19638,24 +22609,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedBool) == 0 {
 					m.PackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedBool = append(m.PackedBool, bool(v != 0))
+					m.PackedBool = append(m.PackedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
 			}
@@ -19707,24 +22678,25 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
 					m.PackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
 			}
@@ -19783,24 +22755,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
 					m.UnpackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+					m.UnpackedInt32 = append(m.UnpackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
 			}
@@ -19859,24 +22831,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
 					m.UnpackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+					m.UnpackedInt64 = append(m.UnpackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
 			}
@@ -19935,24 +22907,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
 					m.UnpackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+					m.UnpackedUint32 = append(m.UnpackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
 			}
@@ -20011,24 +22983,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
 					m.UnpackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+					m.UnpackedUint64 = append(m.UnpackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
 			}
@@ -20088,25 +23060,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
 					m.UnpackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+					m.UnpackedSint32 = append(m.UnpackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
 			}
@@ -20166,25 +23137,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
 					m.UnpackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
 			}
@@ -20437,6 +23407,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedFloat) == 0 {
 					m.UnpackedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.UnpackedFloat = append(m.UnpackedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -20491,6 +23464,9 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedDouble) == 0 {
 					m.UnpackedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.UnpackedDouble = append(m.UnpackedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -20553,24 +23529,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedBool) == 0 {
 					m.UnpackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+					m.UnpackedBool = append(m.UnpackedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
 			}
@@ -20622,24 +23598,25 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
 					m.UnpackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto2_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+					e := TestAllTypesProto2_NestedEnum(v)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
 			}
@@ -20877,21 +23854,24 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
+				groupWireType := int(groupFieldWire & 0x7)
 				if groupWireType == 4 {
+					if m.Data == nil {
+						m.Data = &TestAllTypesProto2_Data{}
+					}
 					if err := m.Data.UnmarshalVTUnsafe(dAtA[groupStart:maybeGroupEnd]); err != nil {
 						return err
 					}
 					break
 				}
-				skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
 				if err != nil {
 					return err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
 					return protohelpers.ErrInvalidLength
 				}
-				iNdEx += skippy
+				iNdEx = maybeGroupEnd + skippy
 			}
 		case 241:
 			if wireType != 0 {
@@ -21546,7 +24526,7 @@ func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if (fieldNum >= 120) && (fieldNum < 201) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
+				err = proto.UnmarshalOptions{AllowPartial: true, Merge: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
 				if err != nil {
 					return err
 				}
@@ -21849,21 +24829,24 @@ func (m *UnknownToTestAllTypes) UnmarshalVTUnsafe(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
+				groupWireType := int(groupFieldWire & 0x7)
 				if groupWireType == 4 {
+					if m.Optionalgroup == nil {
+						m.Optionalgroup = &UnknownToTestAllTypes_OptionalGroup{}
+					}
 					if err := m.Optionalgroup.UnmarshalVTUnsafe(dAtA[groupStart:maybeGroupEnd]); err != nil {
 						return err
 					}
 					break
 				}
-				skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
 				if err != nil {
 					return err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
 					return protohelpers.ErrInvalidLength
 				}
-				iNdEx += skippy
+				iNdEx = maybeGroupEnd + skippy
 			}
 		case 1006:
 			if wireType != 0 {
@@ -21941,24 +24924,24 @@ func (m *UnknownToTestAllTypes) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
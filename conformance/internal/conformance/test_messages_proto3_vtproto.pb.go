@@ -23,7 +23,11 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
+	maps "maps"
 	math "math"
+	slices "slices"
+	sort "sort"
+	strings "strings"
 	unsafe "unsafe"
 )
 
@@ -52,6 +56,31 @@ func (m *TestAllTypesProto3_NestedMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto3_NestedMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto3_NestedMessageCloneSliceVT(in []*TestAllTypesProto3_NestedMessage) []*TestAllTypesProto3_NestedMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto3_NestedMessage, len(in))
+	clones := make([]TestAllTypesProto3_NestedMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.A = m.A
+		r.Corecursive = m.Corecursive.CloneVT()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto3) CloneVT() *TestAllTypesProto3 {
 	if m == nil {
 		return (*TestAllTypesProto3)(nil)
@@ -372,102 +401,46 @@ func (m *TestAllTypesProto3) CloneVT() *TestAllTypesProto3 {
 		r.UnpackedNestedEnum = tmpContainer
 	}
 	if rhs := m.MapInt32Int32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Int32 = tmpContainer
+		r.MapInt32Int32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt64Int64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt64Int64 = tmpContainer
+		r.MapInt64Int64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapUint32Uint32; rhs != nil {
-		tmpContainer := make(map[uint32]uint32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapUint32Uint32 = tmpContainer
+		r.MapUint32Uint32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapUint64Uint64; rhs != nil {
-		tmpContainer := make(map[uint64]uint64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapUint64Uint64 = tmpContainer
+		r.MapUint64Uint64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSint32Sint32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSint32Sint32 = tmpContainer
+		r.MapSint32Sint32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSint64Sint64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSint64Sint64 = tmpContainer
+		r.MapSint64Sint64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapFixed32Fixed32; rhs != nil {
-		tmpContainer := make(map[uint32]uint32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapFixed32Fixed32 = tmpContainer
+		r.MapFixed32Fixed32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapFixed64Fixed64; rhs != nil {
-		tmpContainer := make(map[uint64]uint64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapFixed64Fixed64 = tmpContainer
+		r.MapFixed64Fixed64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSfixed32Sfixed32; rhs != nil {
-		tmpContainer := make(map[int32]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSfixed32Sfixed32 = tmpContainer
+		r.MapSfixed32Sfixed32 = maps.Clone(rhs)
 	}
 	if rhs := m.MapSfixed64Sfixed64; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapSfixed64Sfixed64 = tmpContainer
+		r.MapSfixed64Sfixed64 = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt32Float; rhs != nil {
-		tmpContainer := make(map[int32]float32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Float = tmpContainer
+		r.MapInt32Float = maps.Clone(rhs)
 	}
 	if rhs := m.MapInt32Double; rhs != nil {
-		tmpContainer := make(map[int32]float64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapInt32Double = tmpContainer
+		r.MapInt32Double = maps.Clone(rhs)
 	}
 	if rhs := m.MapBoolBool; rhs != nil {
-		tmpContainer := make(map[bool]bool, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapBoolBool = tmpContainer
+		r.MapBoolBool = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringString; rhs != nil {
-		tmpContainer := make(map[string]string, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringString = tmpContainer
+		r.MapStringString = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringBytes; rhs != nil {
 		tmpContainer := make(map[string][]byte, len(rhs))
@@ -493,18 +466,10 @@ func (m *TestAllTypesProto3) CloneVT() *TestAllTypesProto3 {
 		r.MapStringForeignMessage = tmpContainer
 	}
 	if rhs := m.MapStringNestedEnum; rhs != nil {
-		tmpContainer := make(map[string]TestAllTypesProto3_NestedEnum, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringNestedEnum = tmpContainer
+		r.MapStringNestedEnum = maps.Clone(rhs)
 	}
 	if rhs := m.MapStringForeignEnum; rhs != nil {
-		tmpContainer := make(map[string]ForeignEnum, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.MapStringForeignEnum = tmpContainer
+		r.MapStringForeignEnum = maps.Clone(rhs)
 	}
 	if m.OneofField != nil {
 		r.OneofField = m.OneofField.(interface {
@@ -634,6 +599,531 @@ func (m *TestAllTypesProto3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestAllTypesProto3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func TestAllTypesProto3CloneSliceVT(in []*TestAllTypesProto3) []*TestAllTypesProto3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestAllTypesProto3, len(in))
+	clones := make([]TestAllTypesProto3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.OptionalInt32 = m.OptionalInt32
+		r.OptionalInt64 = m.OptionalInt64
+		r.OptionalUint32 = m.OptionalUint32
+		r.OptionalUint64 = m.OptionalUint64
+		r.OptionalSint32 = m.OptionalSint32
+		r.OptionalSint64 = m.OptionalSint64
+		r.OptionalFixed32 = m.OptionalFixed32
+		r.OptionalFixed64 = m.OptionalFixed64
+		r.OptionalSfixed32 = m.OptionalSfixed32
+		r.OptionalSfixed64 = m.OptionalSfixed64
+		r.OptionalFloat = m.OptionalFloat
+		r.OptionalDouble = m.OptionalDouble
+		r.OptionalBool = m.OptionalBool
+		r.OptionalString = m.OptionalString
+		r.OptionalNestedMessage = m.OptionalNestedMessage.CloneVT()
+		r.OptionalForeignMessage = m.OptionalForeignMessage.CloneVT()
+		r.OptionalNestedEnum = m.OptionalNestedEnum
+		r.OptionalForeignEnum = m.OptionalForeignEnum
+		r.OptionalAliasedEnum = m.OptionalAliasedEnum
+		r.OptionalStringPiece = m.OptionalStringPiece
+		r.OptionalCord = m.OptionalCord
+		r.RecursiveMessage = m.RecursiveMessage.CloneVT()
+		r.OptionalBoolWrapper = (*wrapperspb.BoolValue)((*wrapperspb1.BoolValue)(m.OptionalBoolWrapper).CloneVT())
+		r.OptionalInt32Wrapper = (*wrapperspb.Int32Value)((*wrapperspb1.Int32Value)(m.OptionalInt32Wrapper).CloneVT())
+		r.OptionalInt64Wrapper = (*wrapperspb.Int64Value)((*wrapperspb1.Int64Value)(m.OptionalInt64Wrapper).CloneVT())
+		r.OptionalUint32Wrapper = (*wrapperspb.UInt32Value)((*wrapperspb1.UInt32Value)(m.OptionalUint32Wrapper).CloneVT())
+		r.OptionalUint64Wrapper = (*wrapperspb.UInt64Value)((*wrapperspb1.UInt64Value)(m.OptionalUint64Wrapper).CloneVT())
+		r.OptionalFloatWrapper = (*wrapperspb.FloatValue)((*wrapperspb1.FloatValue)(m.OptionalFloatWrapper).CloneVT())
+		r.OptionalDoubleWrapper = (*wrapperspb.DoubleValue)((*wrapperspb1.DoubleValue)(m.OptionalDoubleWrapper).CloneVT())
+		r.OptionalStringWrapper = (*wrapperspb.StringValue)((*wrapperspb1.StringValue)(m.OptionalStringWrapper).CloneVT())
+		r.OptionalBytesWrapper = (*wrapperspb.BytesValue)((*wrapperspb1.BytesValue)(m.OptionalBytesWrapper).CloneVT())
+		r.OptionalDuration = (*durationpb.Duration)((*durationpb1.Duration)(m.OptionalDuration).CloneVT())
+		r.OptionalTimestamp = (*timestamppb.Timestamp)((*timestamppb1.Timestamp)(m.OptionalTimestamp).CloneVT())
+		r.OptionalFieldMask = (*fieldmaskpb.FieldMask)((*fieldmaskpb1.FieldMask)(m.OptionalFieldMask).CloneVT())
+		r.OptionalStruct = (*structpb.Struct)((*structpb1.Struct)(m.OptionalStruct).CloneVT())
+		r.OptionalAny = (*anypb.Any)((*anypb1.Any)(m.OptionalAny).CloneVT())
+		r.OptionalValue = (*structpb.Value)((*structpb1.Value)(m.OptionalValue).CloneVT())
+		r.OptionalNullValue = m.OptionalNullValue
+		r.Fieldname1 = m.Fieldname1
+		r.FieldName2 = m.FieldName2
+		r.XFieldName3 = m.XFieldName3
+		r.Field_Name4_ = m.Field_Name4_
+		r.Field0Name5 = m.Field0Name5
+		r.Field_0Name6 = m.Field_0Name6
+		r.FieldName7 = m.FieldName7
+		r.FieldName8 = m.FieldName8
+		r.Field_Name9 = m.Field_Name9
+		r.Field_Name10 = m.Field_Name10
+		r.FIELD_NAME11 = m.FIELD_NAME11
+		r.FIELDName12 = m.FIELDName12
+		r.XFieldName13 = m.XFieldName13
+		r.X_FieldName14 = m.X_FieldName14
+		r.Field_Name15 = m.Field_Name15
+		r.Field__Name16 = m.Field__Name16
+		r.FieldName17__ = m.FieldName17__
+		r.FieldName18__ = m.FieldName18__
+		if rhs := m.OptionalBytes; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.OptionalBytes = tmpBytes
+		}
+		if rhs := m.RepeatedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedInt32 = tmpContainer
+		}
+		if rhs := m.RepeatedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedInt64 = tmpContainer
+		}
+		if rhs := m.RepeatedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedUint32 = tmpContainer
+		}
+		if rhs := m.RepeatedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedUint64 = tmpContainer
+		}
+		if rhs := m.RepeatedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSint32 = tmpContainer
+		}
+		if rhs := m.RepeatedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSint64 = tmpContainer
+		}
+		if rhs := m.RepeatedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFixed32 = tmpContainer
+		}
+		if rhs := m.RepeatedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFixed64 = tmpContainer
+		}
+		if rhs := m.RepeatedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSfixed32 = tmpContainer
+		}
+		if rhs := m.RepeatedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedSfixed64 = tmpContainer
+		}
+		if rhs := m.RepeatedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedFloat = tmpContainer
+		}
+		if rhs := m.RepeatedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedDouble = tmpContainer
+		}
+		if rhs := m.RepeatedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedBool = tmpContainer
+		}
+		if rhs := m.RepeatedString; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedString = tmpContainer
+		}
+		if rhs := m.RepeatedBytes; rhs != nil {
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.RepeatedBytes = tmpContainer
+		}
+		if rhs := m.RepeatedNestedMessage; rhs != nil {
+			tmpContainer := make([]*TestAllTypesProto3_NestedMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.RepeatedNestedMessage = tmpContainer
+		}
+		if rhs := m.RepeatedForeignMessage; rhs != nil {
+			tmpContainer := make([]*ForeignMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.RepeatedForeignMessage = tmpContainer
+		}
+		if rhs := m.RepeatedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto3_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedNestedEnum = tmpContainer
+		}
+		if rhs := m.RepeatedForeignEnum; rhs != nil {
+			tmpContainer := make([]ForeignEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedForeignEnum = tmpContainer
+		}
+		if rhs := m.RepeatedStringPiece; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedStringPiece = tmpContainer
+		}
+		if rhs := m.RepeatedCord; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedCord = tmpContainer
+		}
+		if rhs := m.PackedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedInt32 = tmpContainer
+		}
+		if rhs := m.PackedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedInt64 = tmpContainer
+		}
+		if rhs := m.PackedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedUint32 = tmpContainer
+		}
+		if rhs := m.PackedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedUint64 = tmpContainer
+		}
+		if rhs := m.PackedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSint32 = tmpContainer
+		}
+		if rhs := m.PackedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSint64 = tmpContainer
+		}
+		if rhs := m.PackedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFixed32 = tmpContainer
+		}
+		if rhs := m.PackedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFixed64 = tmpContainer
+		}
+		if rhs := m.PackedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSfixed32 = tmpContainer
+		}
+		if rhs := m.PackedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedSfixed64 = tmpContainer
+		}
+		if rhs := m.PackedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedFloat = tmpContainer
+		}
+		if rhs := m.PackedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedDouble = tmpContainer
+		}
+		if rhs := m.PackedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedBool = tmpContainer
+		}
+		if rhs := m.PackedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto3_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedNestedEnum = tmpContainer
+		}
+		if rhs := m.UnpackedInt32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedInt32 = tmpContainer
+		}
+		if rhs := m.UnpackedInt64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedInt64 = tmpContainer
+		}
+		if rhs := m.UnpackedUint32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedUint32 = tmpContainer
+		}
+		if rhs := m.UnpackedUint64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedUint64 = tmpContainer
+		}
+		if rhs := m.UnpackedSint32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSint32 = tmpContainer
+		}
+		if rhs := m.UnpackedSint64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSint64 = tmpContainer
+		}
+		if rhs := m.UnpackedFixed32; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFixed32 = tmpContainer
+		}
+		if rhs := m.UnpackedFixed64; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFixed64 = tmpContainer
+		}
+		if rhs := m.UnpackedSfixed32; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSfixed32 = tmpContainer
+		}
+		if rhs := m.UnpackedSfixed64; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedSfixed64 = tmpContainer
+		}
+		if rhs := m.UnpackedFloat; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedFloat = tmpContainer
+		}
+		if rhs := m.UnpackedDouble; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedDouble = tmpContainer
+		}
+		if rhs := m.UnpackedBool; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedBool = tmpContainer
+		}
+		if rhs := m.UnpackedNestedEnum; rhs != nil {
+			tmpContainer := make([]TestAllTypesProto3_NestedEnum, len(rhs))
+			copy(tmpContainer, rhs)
+			r.UnpackedNestedEnum = tmpContainer
+		}
+		if rhs := m.MapInt32Int32; rhs != nil {
+			r.MapInt32Int32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt64Int64; rhs != nil {
+			r.MapInt64Int64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapUint32Uint32; rhs != nil {
+			r.MapUint32Uint32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapUint64Uint64; rhs != nil {
+			r.MapUint64Uint64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSint32Sint32; rhs != nil {
+			r.MapSint32Sint32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSint64Sint64; rhs != nil {
+			r.MapSint64Sint64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapFixed32Fixed32; rhs != nil {
+			r.MapFixed32Fixed32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapFixed64Fixed64; rhs != nil {
+			r.MapFixed64Fixed64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSfixed32Sfixed32; rhs != nil {
+			r.MapSfixed32Sfixed32 = maps.Clone(rhs)
+		}
+		if rhs := m.MapSfixed64Sfixed64; rhs != nil {
+			r.MapSfixed64Sfixed64 = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt32Float; rhs != nil {
+			r.MapInt32Float = maps.Clone(rhs)
+		}
+		if rhs := m.MapInt32Double; rhs != nil {
+			r.MapInt32Double = maps.Clone(rhs)
+		}
+		if rhs := m.MapBoolBool; rhs != nil {
+			r.MapBoolBool = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringString; rhs != nil {
+			r.MapStringString = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringBytes; rhs != nil {
+			tmpContainer := make(map[string][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.MapStringBytes = tmpContainer
+		}
+		if rhs := m.MapStringNestedMessage; rhs != nil {
+			tmpContainer := make(map[string]*TestAllTypesProto3_NestedMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.MapStringNestedMessage = tmpContainer
+		}
+		if rhs := m.MapStringForeignMessage; rhs != nil {
+			tmpContainer := make(map[string]*ForeignMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.MapStringForeignMessage = tmpContainer
+		}
+		if rhs := m.MapStringNestedEnum; rhs != nil {
+			r.MapStringNestedEnum = maps.Clone(rhs)
+		}
+		if rhs := m.MapStringForeignEnum; rhs != nil {
+			r.MapStringForeignEnum = maps.Clone(rhs)
+		}
+		if m.OneofField != nil {
+			r.OneofField = m.OneofField.(interface {
+				CloneVT() isTestAllTypesProto3_OneofField
+			}).CloneVT()
+		}
+		if rhs := m.RepeatedBoolWrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.BoolValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.BoolValue)((*wrapperspb1.BoolValue)(v).CloneVT())
+			}
+			r.RepeatedBoolWrapper = tmpContainer
+		}
+		if rhs := m.RepeatedInt32Wrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.Int32Value, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.Int32Value)((*wrapperspb1.Int32Value)(v).CloneVT())
+			}
+			r.RepeatedInt32Wrapper = tmpContainer
+		}
+		if rhs := m.RepeatedInt64Wrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.Int64Value, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.Int64Value)((*wrapperspb1.Int64Value)(v).CloneVT())
+			}
+			r.RepeatedInt64Wrapper = tmpContainer
+		}
+		if rhs := m.RepeatedUint32Wrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.UInt32Value, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.UInt32Value)((*wrapperspb1.UInt32Value)(v).CloneVT())
+			}
+			r.RepeatedUint32Wrapper = tmpContainer
+		}
+		if rhs := m.RepeatedUint64Wrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.UInt64Value, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.UInt64Value)((*wrapperspb1.UInt64Value)(v).CloneVT())
+			}
+			r.RepeatedUint64Wrapper = tmpContainer
+		}
+		if rhs := m.RepeatedFloatWrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.FloatValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.FloatValue)((*wrapperspb1.FloatValue)(v).CloneVT())
+			}
+			r.RepeatedFloatWrapper = tmpContainer
+		}
+		if rhs := m.RepeatedDoubleWrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.DoubleValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.DoubleValue)((*wrapperspb1.DoubleValue)(v).CloneVT())
+			}
+			r.RepeatedDoubleWrapper = tmpContainer
+		}
+		if rhs := m.RepeatedStringWrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.StringValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.StringValue)((*wrapperspb1.StringValue)(v).CloneVT())
+			}
+			r.RepeatedStringWrapper = tmpContainer
+		}
+		if rhs := m.RepeatedBytesWrapper; rhs != nil {
+			tmpContainer := make([]*wrapperspb.BytesValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*wrapperspb.BytesValue)((*wrapperspb1.BytesValue)(v).CloneVT())
+			}
+			r.RepeatedBytesWrapper = tmpContainer
+		}
+		if rhs := m.RepeatedDuration; rhs != nil {
+			tmpContainer := make([]*durationpb.Duration, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*durationpb.Duration)((*durationpb1.Duration)(v).CloneVT())
+			}
+			r.RepeatedDuration = tmpContainer
+		}
+		if rhs := m.RepeatedTimestamp; rhs != nil {
+			tmpContainer := make([]*timestamppb.Timestamp, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*timestamppb.Timestamp)((*timestamppb1.Timestamp)(v).CloneVT())
+			}
+			r.RepeatedTimestamp = tmpContainer
+		}
+		if rhs := m.RepeatedFieldmask; rhs != nil {
+			tmpContainer := make([]*fieldmaskpb.FieldMask, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*fieldmaskpb.FieldMask)((*fieldmaskpb1.FieldMask)(v).CloneVT())
+			}
+			r.RepeatedFieldmask = tmpContainer
+		}
+		if rhs := m.RepeatedStruct; rhs != nil {
+			tmpContainer := make([]*structpb.Struct, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*structpb.Struct)((*structpb1.Struct)(v).CloneVT())
+			}
+			r.RepeatedStruct = tmpContainer
+		}
+		if rhs := m.RepeatedAny; rhs != nil {
+			tmpContainer := make([]*anypb.Any, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*anypb.Any)((*anypb1.Any)(v).CloneVT())
+			}
+			r.RepeatedAny = tmpContainer
+		}
+		if rhs := m.RepeatedValue; rhs != nil {
+			tmpContainer := make([]*structpb.Value, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*structpb.Value)((*structpb1.Value)(v).CloneVT())
+			}
+			r.RepeatedValue = tmpContainer
+		}
+		if rhs := m.RepeatedListValue; rhs != nil {
+			tmpContainer := make([]*structpb.ListValue, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = (*structpb.ListValue)((*structpb1.ListValue)(v).CloneVT())
+			}
+			r.RepeatedListValue = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestAllTypesProto3_OneofUint32) CloneVT() isTestAllTypesProto3_OneofField {
 	if m == nil {
 		return (*TestAllTypesProto3_OneofUint32)(nil)
@@ -745,6 +1235,30 @@ func (m *ForeignMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ForeignMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ForeignMessageCloneSliceVT(in []*ForeignMessage) []*ForeignMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ForeignMessage, len(in))
+	clones := make([]ForeignMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.C = m.C
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *NullHypothesisProto3) CloneVT() *NullHypothesisProto3 {
 	if m == nil {
 		return (*NullHypothesisProto3)(nil)
@@ -761,6 +1275,29 @@ func (m *NullHypothesisProto3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// NullHypothesisProto3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func NullHypothesisProto3CloneSliceVT(in []*NullHypothesisProto3) []*NullHypothesisProto3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*NullHypothesisProto3, len(in))
+	clones := make([]NullHypothesisProto3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *EnumOnlyProto3) CloneVT() *EnumOnlyProto3 {
 	if m == nil {
 		return (*EnumOnlyProto3)(nil)
@@ -777,6 +1314,29 @@ func (m *EnumOnlyProto3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// EnumOnlyProto3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func EnumOnlyProto3CloneSliceVT(in []*EnumOnlyProto3) []*EnumOnlyProto3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*EnumOnlyProto3, len(in))
+	clones := make([]EnumOnlyProto3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *TestAllTypesProto3_NestedMessage) EqualVT(that *TestAllTypesProto3_NestedMessage) bool {
 	if this == that {
 		return true
@@ -886,132 +1446,48 @@ func (this *TestAllTypesProto3) EqualVT(that *TestAllTypesProto3) bool {
 	if !this.RecursiveMessage.EqualVT(that.RecursiveMessage) {
 		return false
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+	if !slices.Equal(this.RepeatedInt32, that.RepeatedInt32) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
+	if !slices.Equal(this.RepeatedInt64, that.RepeatedInt64) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt64 {
-		vy := that.RepeatedInt64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
+	if !slices.Equal(this.RepeatedUint32, that.RepeatedUint32) {
 		return false
 	}
-	for i, vx := range this.RepeatedUint32 {
-		vy := that.RepeatedUint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
+	if !slices.Equal(this.RepeatedUint64, that.RepeatedUint64) {
 		return false
 	}
-	for i, vx := range this.RepeatedUint64 {
-		vy := that.RepeatedUint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
+	if !slices.Equal(this.RepeatedSint32, that.RepeatedSint32) {
 		return false
 	}
-	for i, vx := range this.RepeatedSint32 {
-		vy := that.RepeatedSint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
+	if !slices.Equal(this.RepeatedSint64, that.RepeatedSint64) {
 		return false
 	}
-	for i, vx := range this.RepeatedSint64 {
-		vy := that.RepeatedSint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+	if !slices.Equal(this.RepeatedFixed32, that.RepeatedFixed32) {
 		return false
 	}
-	for i, vx := range this.RepeatedFixed32 {
-		vy := that.RepeatedFixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
+	if !slices.Equal(this.RepeatedFixed64, that.RepeatedFixed64) {
 		return false
 	}
-	for i, vx := range this.RepeatedFixed64 {
-		vy := that.RepeatedFixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
+	if !slices.Equal(this.RepeatedSfixed32, that.RepeatedSfixed32) {
 		return false
 	}
-	for i, vx := range this.RepeatedSfixed32 {
-		vy := that.RepeatedSfixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
+	if !slices.Equal(this.RepeatedSfixed64, that.RepeatedSfixed64) {
 		return false
 	}
-	for i, vx := range this.RepeatedSfixed64 {
-		vy := that.RepeatedSfixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
+	if !slices.Equal(this.RepeatedFloat, that.RepeatedFloat) {
 		return false
 	}
-	for i, vx := range this.RepeatedFloat {
-		vy := that.RepeatedFloat[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
+	if !slices.Equal(this.RepeatedDouble, that.RepeatedDouble) {
 		return false
 	}
-	for i, vx := range this.RepeatedDouble {
-		vy := that.RepeatedDouble[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedBool) != len(that.RepeatedBool) {
+	if !slices.Equal(this.RepeatedBool, that.RepeatedBool) {
 		return false
 	}
-	for i, vx := range this.RepeatedBool {
-		vy := that.RepeatedBool[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedString) != len(that.RepeatedString) {
+	if !slices.Equal(this.RepeatedString, that.RepeatedString) {
 		return false
 	}
-	for i, vx := range this.RepeatedString {
-		vy := that.RepeatedString[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
 		return false
 	}
@@ -1055,42 +1531,18 @@ func (this *TestAllTypesProto3) EqualVT(that *TestAllTypesProto3) bool {
 			}
 		}
 	}
-	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
+	if !slices.Equal(this.RepeatedNestedEnum, that.RepeatedNestedEnum) {
 		return false
 	}
-	for i, vx := range this.RepeatedNestedEnum {
-		vy := that.RepeatedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
+	if !slices.Equal(this.RepeatedForeignEnum, that.RepeatedForeignEnum) {
 		return false
 	}
-	for i, vx := range this.RepeatedForeignEnum {
-		vy := that.RepeatedForeignEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
+	if !slices.Equal(this.RepeatedStringPiece, that.RepeatedStringPiece) {
 		return false
 	}
-	for i, vx := range this.RepeatedStringPiece {
-		vy := that.RepeatedStringPiece[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.RepeatedCord) != len(that.RepeatedCord) {
+	if !slices.Equal(this.RepeatedCord, that.RepeatedCord) {
 		return false
 	}
-	for i, vx := range this.RepeatedCord {
-		vy := that.RepeatedCord[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
 		return false
 	}
@@ -1335,283 +1787,115 @@ func (this *TestAllTypesProto3) EqualVT(that *TestAllTypesProto3) bool {
 			return false
 		}
 	}
-	if len(this.PackedInt32) != len(that.PackedInt32) {
+	if !slices.Equal(this.PackedInt32, that.PackedInt32) {
 		return false
 	}
-	for i, vx := range this.PackedInt32 {
-		vy := that.PackedInt32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedInt64, that.PackedInt64) {
+		return false
 	}
-	if len(this.PackedInt64) != len(that.PackedInt64) {
+	if !slices.Equal(this.PackedUint32, that.PackedUint32) {
 		return false
 	}
-	for i, vx := range this.PackedInt64 {
-		vy := that.PackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedUint64, that.PackedUint64) {
+		return false
 	}
-	if len(this.PackedUint32) != len(that.PackedUint32) {
+	if !slices.Equal(this.PackedSint32, that.PackedSint32) {
 		return false
 	}
-	for i, vx := range this.PackedUint32 {
-		vy := that.PackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedSint64, that.PackedSint64) {
+		return false
 	}
-	if len(this.PackedUint64) != len(that.PackedUint64) {
+	if !slices.Equal(this.PackedFixed32, that.PackedFixed32) {
 		return false
 	}
-	for i, vx := range this.PackedUint64 {
-		vy := that.PackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedFixed64, that.PackedFixed64) {
+		return false
 	}
-	if len(this.PackedSint32) != len(that.PackedSint32) {
+	if !slices.Equal(this.PackedSfixed32, that.PackedSfixed32) {
 		return false
 	}
-	for i, vx := range this.PackedSint32 {
-		vy := that.PackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedSfixed64, that.PackedSfixed64) {
+		return false
 	}
-	if len(this.PackedSint64) != len(that.PackedSint64) {
+	if !slices.Equal(this.PackedFloat, that.PackedFloat) {
 		return false
 	}
-	for i, vx := range this.PackedSint64 {
-		vy := that.PackedSint64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedDouble, that.PackedDouble) {
+		return false
 	}
-	if len(this.PackedFixed32) != len(that.PackedFixed32) {
+	if !slices.Equal(this.PackedBool, that.PackedBool) {
 		return false
 	}
-	for i, vx := range this.PackedFixed32 {
-		vy := that.PackedFixed32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.PackedNestedEnum, that.PackedNestedEnum) {
+		return false
 	}
-	if len(this.PackedFixed64) != len(that.PackedFixed64) {
+	if !slices.Equal(this.UnpackedInt32, that.UnpackedInt32) {
 		return false
 	}
-	for i, vx := range this.PackedFixed64 {
-		vy := that.PackedFixed64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedInt64, that.UnpackedInt64) {
+		return false
 	}
-	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
+	if !slices.Equal(this.UnpackedUint32, that.UnpackedUint32) {
 		return false
 	}
-	for i, vx := range this.PackedSfixed32 {
-		vy := that.PackedSfixed32[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedUint64, that.UnpackedUint64) {
+		return false
 	}
-	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
+	if !slices.Equal(this.UnpackedSint32, that.UnpackedSint32) {
 		return false
 	}
-	for i, vx := range this.PackedSfixed64 {
-		vy := that.PackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedSint64, that.UnpackedSint64) {
+		return false
 	}
-	if len(this.PackedFloat) != len(that.PackedFloat) {
+	if !slices.Equal(this.UnpackedFixed32, that.UnpackedFixed32) {
 		return false
 	}
-	for i, vx := range this.PackedFloat {
-		vy := that.PackedFloat[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedFixed64, that.UnpackedFixed64) {
+		return false
 	}
-	if len(this.PackedDouble) != len(that.PackedDouble) {
+	if !slices.Equal(this.UnpackedSfixed32, that.UnpackedSfixed32) {
 		return false
 	}
-	for i, vx := range this.PackedDouble {
-		vy := that.PackedDouble[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedSfixed64, that.UnpackedSfixed64) {
+		return false
 	}
-	if len(this.PackedBool) != len(that.PackedBool) {
+	if !slices.Equal(this.UnpackedFloat, that.UnpackedFloat) {
 		return false
 	}
-	for i, vx := range this.PackedBool {
-		vy := that.PackedBool[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedDouble, that.UnpackedDouble) {
+		return false
 	}
-	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
+	if !slices.Equal(this.UnpackedBool, that.UnpackedBool) {
 		return false
 	}
-	for i, vx := range this.PackedNestedEnum {
-		vy := that.PackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
+	if !slices.Equal(this.UnpackedNestedEnum, that.UnpackedNestedEnum) {
+		return false
 	}
-	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
+	if !(*wrapperspb1.BoolValue)(this.OptionalBoolWrapper).EqualVT((*wrapperspb1.BoolValue)(that.OptionalBoolWrapper)) {
 		return false
 	}
-	for i, vx := range this.UnpackedInt32 {
-		vy := that.UnpackedInt32[i]
-		if vx != vy {
-			return false
-		}
+	if !(*wrapperspb1.Int32Value)(this.OptionalInt32Wrapper).EqualVT((*wrapperspb1.Int32Value)(that.OptionalInt32Wrapper)) {
+		return false
 	}
-	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
+	if !(*wrapperspb1.Int64Value)(this.OptionalInt64Wrapper).EqualVT((*wrapperspb1.Int64Value)(that.OptionalInt64Wrapper)) {
 		return false
 	}
-	for i, vx := range this.UnpackedInt64 {
-		vy := that.UnpackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	if !(*wrapperspb1.UInt32Value)(this.OptionalUint32Wrapper).EqualVT((*wrapperspb1.UInt32Value)(that.OptionalUint32Wrapper)) {
+		return false
 	}
-	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
+	if !(*wrapperspb1.UInt64Value)(this.OptionalUint64Wrapper).EqualVT((*wrapperspb1.UInt64Value)(that.OptionalUint64Wrapper)) {
 		return false
 	}
-	for i, vx := range this.UnpackedUint32 {
-		vy := that.UnpackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	if !(*wrapperspb1.FloatValue)(this.OptionalFloatWrapper).EqualVT((*wrapperspb1.FloatValue)(that.OptionalFloatWrapper)) {
+		return false
 	}
-	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
+	if !(*wrapperspb1.DoubleValue)(this.OptionalDoubleWrapper).EqualVT((*wrapperspb1.DoubleValue)(that.OptionalDoubleWrapper)) {
 		return false
 	}
-	for i, vx := range this.UnpackedUint64 {
-		vy := that.UnpackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if !(*wrapperspb1.StringValue)(this.OptionalStringWrapper).EqualVT((*wrapperspb1.StringValue)(that.OptionalStringWrapper)) {
+		return false
 	}
-	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
-		return false
-	}
-	for i, vx := range this.UnpackedSint32 {
-		vy := that.UnpackedSint32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
-		return false
-	}
-	for i, vx := range this.UnpackedSint64 {
-		vy := that.UnpackedSint64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
-		return false
-	}
-	for i, vx := range this.UnpackedFixed32 {
-		vy := that.UnpackedFixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
-		return false
-	}
-	for i, vx := range this.UnpackedFixed64 {
-		vy := that.UnpackedFixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
-		return false
-	}
-	for i, vx := range this.UnpackedSfixed32 {
-		vy := that.UnpackedSfixed32[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
-		return false
-	}
-	for i, vx := range this.UnpackedSfixed64 {
-		vy := that.UnpackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
-		return false
-	}
-	for i, vx := range this.UnpackedFloat {
-		vy := that.UnpackedFloat[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
-		return false
-	}
-	for i, vx := range this.UnpackedDouble {
-		vy := that.UnpackedDouble[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedBool) != len(that.UnpackedBool) {
-		return false
-	}
-	for i, vx := range this.UnpackedBool {
-		vy := that.UnpackedBool[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
-		return false
-	}
-	for i, vx := range this.UnpackedNestedEnum {
-		vy := that.UnpackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if !(*wrapperspb1.BoolValue)(this.OptionalBoolWrapper).EqualVT((*wrapperspb1.BoolValue)(that.OptionalBoolWrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.Int32Value)(this.OptionalInt32Wrapper).EqualVT((*wrapperspb1.Int32Value)(that.OptionalInt32Wrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.Int64Value)(this.OptionalInt64Wrapper).EqualVT((*wrapperspb1.Int64Value)(that.OptionalInt64Wrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.UInt32Value)(this.OptionalUint32Wrapper).EqualVT((*wrapperspb1.UInt32Value)(that.OptionalUint32Wrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.UInt64Value)(this.OptionalUint64Wrapper).EqualVT((*wrapperspb1.UInt64Value)(that.OptionalUint64Wrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.FloatValue)(this.OptionalFloatWrapper).EqualVT((*wrapperspb1.FloatValue)(that.OptionalFloatWrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.DoubleValue)(this.OptionalDoubleWrapper).EqualVT((*wrapperspb1.DoubleValue)(that.OptionalDoubleWrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.StringValue)(this.OptionalStringWrapper).EqualVT((*wrapperspb1.StringValue)(that.OptionalStringWrapper)) {
-		return false
-	}
-	if !(*wrapperspb1.BytesValue)(this.OptionalBytesWrapper).EqualVT((*wrapperspb1.BytesValue)(that.OptionalBytesWrapper)) {
+	if !(*wrapperspb1.BytesValue)(this.OptionalBytesWrapper).EqualVT((*wrapperspb1.BytesValue)(that.OptionalBytesWrapper)) {
 		return false
 	}
 	if len(this.RepeatedBoolWrapper) != len(that.RepeatedBoolWrapper) {
@@ -2278,10 +2562,2265 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.OneofField.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofNestedMessage:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofString:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofBytes:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofBool:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofUint64:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofFloat:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofDouble:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofEnum:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofNullValue:
+		size, err := c.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.FieldName18__ != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName18__))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.FieldName17__ != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName17__))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Field__Name16 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field__Name16))
+		i--
+		dAtA[i] = 0x1a
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Field_Name15 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field_Name15))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.X_FieldName14 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.X_FieldName14))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.XFieldName13 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.XFieldName13))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.FIELDName12 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FIELDName12))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.FIELD_NAME11 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FIELD_NAME11))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Field_Name10 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field_Name10))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.Field_Name9 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field_Name9))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.FieldName8 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName8))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.FieldName7 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName7))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Field_0Name6 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field_0Name6))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Field0Name5 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field0Name5))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Field_Name4_ != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Field_Name4_))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.XFieldName3 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.XFieldName3))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.FieldName2 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName2))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Fieldname1 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Fieldname1))
+		i--
+		dAtA[i] = 0x19
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RepeatedStruct) > 0 {
+		for iNdEx := len(m.RepeatedStruct) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*structpb1.Struct)(m.RepeatedStruct[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x14
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RepeatedListValue) > 0 {
+		for iNdEx := len(m.RepeatedListValue) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*structpb1.ListValue)(m.RepeatedListValue[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.RepeatedValue) > 0 {
+		for iNdEx := len(m.RepeatedValue) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*structpb1.Value)(m.RepeatedValue[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.RepeatedAny) > 0 {
+		for iNdEx := len(m.RepeatedAny) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*anypb1.Any)(m.RepeatedAny[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.RepeatedFieldmask) > 0 {
+		for iNdEx := len(m.RepeatedFieldmask) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*fieldmaskpb1.FieldMask)(m.RepeatedFieldmask[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RepeatedTimestamp) > 0 {
+		for iNdEx := len(m.RepeatedTimestamp) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*timestamppb1.Timestamp)(m.RepeatedTimestamp[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RepeatedDuration) > 0 {
+		for iNdEx := len(m.RepeatedDuration) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*durationpb1.Duration)(m.RepeatedDuration[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x13
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.OptionalNullValue != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalNullValue))
+		i--
+		dAtA[i] = 0x13
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OptionalValue != nil {
+		size, err := (*structpb1.Value)(m.OptionalValue).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x13
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.OptionalAny != nil {
+		size, err := (*anypb1.Any)(m.OptionalAny).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x13
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.OptionalStruct != nil {
+		size, err := (*structpb1.Struct)(m.OptionalStruct).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x13
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OptionalFieldMask != nil {
+		size, err := (*fieldmaskpb1.FieldMask)(m.OptionalFieldMask).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.OptionalTimestamp != nil {
+		size, err := (*timestamppb1.Timestamp)(m.OptionalTimestamp).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.OptionalDuration != nil {
+		size, err := (*durationpb1.Duration)(m.OptionalDuration).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.RepeatedBytesWrapper) > 0 {
+		for iNdEx := len(m.RepeatedBytesWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.BytesValue)(m.RepeatedBytesWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.RepeatedStringWrapper) > 0 {
+		for iNdEx := len(m.RepeatedStringWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.StringValue)(m.RepeatedStringWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.RepeatedDoubleWrapper) > 0 {
+		for iNdEx := len(m.RepeatedDoubleWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.DoubleValue)(m.RepeatedDoubleWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.RepeatedFloatWrapper) > 0 {
+		for iNdEx := len(m.RepeatedFloatWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.FloatValue)(m.RepeatedFloatWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RepeatedUint64Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedUint64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.UInt64Value)(m.RepeatedUint64Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RepeatedUint32Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedUint32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.UInt32Value)(m.RepeatedUint32Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RepeatedInt64Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedInt64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.Int64Value)(m.RepeatedInt64Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RepeatedInt32Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedInt32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.Int32Value)(m.RepeatedInt32Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RepeatedBoolWrapper) > 0 {
+		for iNdEx := len(m.RepeatedBoolWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := (*wrapperspb1.BoolValue)(m.RepeatedBoolWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xd
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.OptionalBytesWrapper != nil {
+		size, err := (*wrapperspb1.BytesValue)(m.OptionalBytesWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xd
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.OptionalStringWrapper != nil {
+		size, err := (*wrapperspb1.StringValue)(m.OptionalStringWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xd
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.OptionalDoubleWrapper != nil {
+		size, err := (*wrapperspb1.DoubleValue)(m.OptionalDoubleWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.OptionalFloatWrapper != nil {
+		size, err := (*wrapperspb1.FloatValue)(m.OptionalFloatWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.OptionalUint64Wrapper != nil {
+		size, err := (*wrapperspb1.UInt64Value)(m.OptionalUint64Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.OptionalUint32Wrapper != nil {
+		size, err := (*wrapperspb1.UInt32Value)(m.OptionalUint32Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.OptionalInt64Wrapper != nil {
+		size, err := (*wrapperspb1.Int64Value)(m.OptionalInt64Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.OptionalInt32Wrapper != nil {
+		size, err := (*wrapperspb1.Int32Value)(m.OptionalInt32Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.OptionalBoolWrapper != nil {
+		size, err := (*wrapperspb1.BoolValue)(m.OptionalBoolWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xc
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb0
+		}
+	}
+	if len(m.UnpackedBool) > 0 {
+		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.UnpackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa8
+		}
+	}
+	if len(m.UnpackedDouble) > 0 {
+		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa1
+		}
+	}
+	if len(m.UnpackedFloat) > 0 {
+		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x9d
+		}
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x91
+		}
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x8d
+		}
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x81
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xfd
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x3))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xf0
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(x4))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xe8
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xe0
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xd8
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xd0
+		}
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xc8
+		}
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		var pksize6 int
+		for _, num := range m.PackedNestedEnum {
+			pksize6 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize6
+		j5 := i
+		for _, num1 := range m.PackedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize6))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.PackedBool) > 0 {
+		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.PackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedBool)))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.PackedDouble) > 0 {
+		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.PackedFloat) > 0 {
+		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.PackedSfixed64) > 0 {
+		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.PackedSfixed32) > 0 {
+		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.PackedFixed64) > 0 {
+		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.PackedFixed32) > 0 {
+		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PackedSint64) > 0 {
+		var pksize10 int
+		for _, num := range m.PackedSint64 {
+			pksize10 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize10
+		j9 := i
+		for _, num := range m.PackedSint64 {
+			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x11 >= 1<<7 {
+				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
+				j9++
+				x11 >>= 7
+			}
+			dAtA[j9] = uint8(x11)
+			j9++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize10))
+		i--
+		dAtA[i] = 0x5
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PackedSint32) > 0 {
+		var pksize13 int
+		for _, num := range m.PackedSint32 {
+			pksize13 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize13
+		j12 := i
+		for _, num := range m.PackedSint32 {
+			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x14 >= 1<<7 {
+				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
+				j12++
+				x14 >>= 7
+			}
+			dAtA[j12] = uint8(x14)
+			j12++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize13))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.PackedUint64) > 0 {
+		var pksize16 int
+		for _, num := range m.PackedUint64 {
+			pksize16 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize16
+		j15 := i
+		for _, num := range m.PackedUint64 {
+			for num >= 1<<7 {
+				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA[j15] = uint8(num)
+			j15++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize16))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.PackedUint32) > 0 {
+		var pksize18 int
+		for _, num := range m.PackedUint32 {
+			pksize18 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize18
+		j17 := i
+		for _, num := range m.PackedUint32 {
+			for num >= 1<<7 {
+				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA[j17] = uint8(num)
+			j17++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize18))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.PackedInt64) > 0 {
+		var pksize20 int
+		for _, num := range m.PackedInt64 {
+			pksize20 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize20
+		j19 := i
+		for _, num1 := range m.PackedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA[j19] = uint8(num)
+			j19++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize20))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.PackedInt32) > 0 {
+		var pksize22 int
+		for _, num := range m.PackedInt32 {
+			pksize22 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize22
+		j21 := i
+		for _, num1 := range m.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA[j21] = uint8(num)
+			j21++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize22))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		for k := range m.MapStringForeignEnum {
+			v := m.MapStringForeignEnum[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		for k := range m.MapStringNestedEnum {
+			v := m.MapStringNestedEnum[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k := range m.MapStringForeignMessage {
+			v := m.MapStringForeignMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k := range m.MapStringNestedMessage {
+			v := m.MapStringNestedMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k := range m.MapStringBytes {
+			v := m.MapStringBytes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k := range m.MapStringString {
+			v := m.MapStringString[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k := range m.MapBoolBool {
+			v := m.MapBoolBool[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k := range m.MapInt32Double {
+			v := m.MapInt32Double[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k := range m.MapInt32Float {
+			v := m.MapInt32Float[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k := range m.MapSfixed64Sfixed64 {
+			v := m.MapSfixed64Sfixed64[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k := range m.MapSfixed32Sfixed32 {
+			v := m.MapSfixed32Sfixed32[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k := range m.MapFixed64Fixed64 {
+			v := m.MapFixed64Fixed64[k]
+			baseI := i
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k := range m.MapFixed32Fixed32 {
+			v := m.MapFixed32Fixed32[k]
+			baseI := i
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k := range m.MapSint64Sint64 {
+			v := m.MapSint64Sint64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k := range m.MapSint32Sint32 {
+			v := m.MapSint32Sint32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k := range m.MapUint64Uint64 {
+			v := m.MapUint64Uint64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k := range m.MapUint32Uint32 {
+			v := m.MapUint32Uint32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k := range m.MapInt64Int64 {
+			v := m.MapInt64Int64[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k := range m.MapInt32Int32 {
+			v := m.MapInt32Int32[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedCord[iNdEx])
+			copy(dAtA[i:], m.RepeatedCord[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedStringPiece[iNdEx])
+			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		var pksize24 int
+		for _, num := range m.RepeatedForeignEnum {
+			pksize24 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize24
+		j23 := i
+		for _, num1 := range m.RepeatedForeignEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA[j23] = uint8(num)
+			j23++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize24))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		var pksize26 int
+		for _, num := range m.RepeatedNestedEnum {
+			pksize26 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize26
+		j25 := i
+		for _, num1 := range m.RepeatedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA[j25] = uint8(num)
+			j25++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize26))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedBytes[iNdEx])
+			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.RepeatedString) > 0 {
+		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedString[iNdEx])
+			copy(dAtA[i:], m.RepeatedString[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.RepeatedBool) > 0 {
+		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedBool)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.RepeatedDouble) > 0 {
+		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f27 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f27))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedDouble)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.RepeatedFloat) > 0 {
+		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f28 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f28))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedFloat)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedFixed64)*8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedFixed32)*4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.RepeatedSint64) > 0 {
+		var pksize30 int
+		for _, num := range m.RepeatedSint64 {
+			pksize30 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize30
+		j29 := i
+		for _, num := range m.RepeatedSint64 {
+			x31 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x31 >= 1<<7 {
+				dAtA[j29] = uint8(uint64(x31)&0x7f | 0x80)
+				j29++
+				x31 >>= 7
+			}
+			dAtA[j29] = uint8(x31)
+			j29++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize30))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.RepeatedSint32) > 0 {
+		var pksize33 int
+		for _, num := range m.RepeatedSint32 {
+			pksize33 += protohelpers.SizeOfZigzag(uint64(num))
+		}
+		i -= pksize33
+		j32 := i
+		for _, num := range m.RepeatedSint32 {
+			x34 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x34 >= 1<<7 {
+				dAtA[j32] = uint8(uint64(x34)&0x7f | 0x80)
+				j32++
+				x34 >>= 7
+			}
+			dAtA[j32] = uint8(x34)
+			j32++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize33))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RepeatedUint64) > 0 {
+		var pksize36 int
+		for _, num := range m.RepeatedUint64 {
+			pksize36 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize36
+		j35 := i
+		for _, num := range m.RepeatedUint64 {
+			for num >= 1<<7 {
+				dAtA[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA[j35] = uint8(num)
+			j35++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize36))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RepeatedUint32) > 0 {
+		var pksize38 int
+		for _, num := range m.RepeatedUint32 {
+			pksize38 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize38
+		j37 := i
+		for _, num := range m.RepeatedUint32 {
+			for num >= 1<<7 {
+				dAtA[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA[j37] = uint8(num)
+			j37++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize38))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RepeatedInt64) > 0 {
+		var pksize40 int
+		for _, num := range m.RepeatedInt64 {
+			pksize40 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize40
+		j39 := i
+		for _, num1 := range m.RepeatedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA[j39] = uint8(num)
+			j39++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize40))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RepeatedInt32) > 0 {
+		var pksize42 int
+		for _, num := range m.RepeatedInt32 {
+			pksize42 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize42
+		j41 := i
+		for _, num1 := range m.RepeatedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA[j41] = uint8(num)
+			j41++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize42))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.RecursiveMessage != nil {
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.OptionalCord) > 0 {
+		i -= len(m.OptionalCord)
+		copy(dAtA[i:], m.OptionalCord)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalCord)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.OptionalStringPiece) > 0 {
+		i -= len(m.OptionalStringPiece)
+		copy(dAtA[i:], m.OptionalStringPiece)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalStringPiece)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.OptionalAliasedEnum != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalAliasedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.OptionalForeignEnum != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalForeignEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.OptionalNestedEnum != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalNestedEnum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.OptionalForeignMessage != nil {
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.OptionalNestedMessage != nil {
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OptionalBytes) > 0 {
+		i -= len(m.OptionalBytes)
+		copy(dAtA[i:], m.OptionalBytes)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.OptionalString) > 0 {
+		i -= len(m.OptionalString)
+		copy(dAtA[i:], m.OptionalString)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalString)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.OptionalBool {
+		i--
+		if m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if math.Float64bits(float64(m.OptionalDouble)) != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OptionalDouble))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if math.Float32bits(float32(m.OptionalFloat)) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OptionalFloat))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.OptionalSfixed64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalSfixed64))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.OptionalSfixed32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalSfixed32))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if m.OptionalFixed64 != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalFixed64))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.OptionalFixed32 != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalFixed32))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.OptionalSint64 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(m.OptionalSint64)<<1)^uint64((m.OptionalSint64>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OptionalSint32 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(m.OptionalSint32)<<1)^uint32((m.OptionalSint32>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OptionalUint64 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalUint64))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OptionalUint32 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalUint32))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OptionalInt64 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalInt64))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OptionalInt32 != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OptionalInt32))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint32))
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xf8
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofNestedMessage != nil {
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	copy(dAtA[i:], m.OneofString)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x8a
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofBytes)
+	copy(dAtA[i:], m.OneofBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x92
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.OneofBool {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0x98
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint64))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xa0
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xad
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xb1
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofEnum))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xb8
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofNullValue))
+	i--
+	dAtA[i] = 0x7
+	i--
+	dAtA[i] = 0xc0
+	return len(dAtA) - i, nil
+}
+func (m *ForeignMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeignMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *ForeignMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.C != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.C))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullHypothesisProto3) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NullHypothesisProto3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *NullHypothesisProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumOnlyProto3) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnumOnlyProto3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *EnumOnlyProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Corecursive != nil {
+		size, err := m.Corecursive.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.A != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestAllTypesProto3) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *TestAllTypesProto3) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofNestedMessage:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofString:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofBytes:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofBool:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofUint64:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofFloat:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofDouble:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofEnum:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	case *TestAllTypesProto3_OneofNullValue:
+		size, err := c.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2415,7 +4954,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedStruct) > 0 {
 		for iNdEx := len(m.RepeatedStruct) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*structpb1.Struct)(m.RepeatedStruct[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*structpb1.Struct)(m.RepeatedStruct[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2429,7 +4968,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedListValue) > 0 {
 		for iNdEx := len(m.RepeatedListValue) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*structpb1.ListValue)(m.RepeatedListValue[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*structpb1.ListValue)(m.RepeatedListValue[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2443,7 +4982,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedValue) > 0 {
 		for iNdEx := len(m.RepeatedValue) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*structpb1.Value)(m.RepeatedValue[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*structpb1.Value)(m.RepeatedValue[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2457,7 +4996,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedAny) > 0 {
 		for iNdEx := len(m.RepeatedAny) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*anypb1.Any)(m.RepeatedAny[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*anypb1.Any)(m.RepeatedAny[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2471,7 +5010,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedFieldmask) > 0 {
 		for iNdEx := len(m.RepeatedFieldmask) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*fieldmaskpb1.FieldMask)(m.RepeatedFieldmask[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*fieldmaskpb1.FieldMask)(m.RepeatedFieldmask[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2485,7 +5024,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedTimestamp) > 0 {
 		for iNdEx := len(m.RepeatedTimestamp) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*timestamppb1.Timestamp)(m.RepeatedTimestamp[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*timestamppb1.Timestamp)(m.RepeatedTimestamp[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2499,7 +5038,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedDuration) > 0 {
 		for iNdEx := len(m.RepeatedDuration) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*durationpb1.Duration)(m.RepeatedDuration[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*durationpb1.Duration)(m.RepeatedDuration[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2519,7 +5058,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.OptionalValue != nil {
-		size, err := (*structpb1.Value)(m.OptionalValue).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*structpb1.Value)(m.OptionalValue).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2531,7 +5070,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x92
 	}
 	if m.OptionalAny != nil {
-		size, err := (*anypb1.Any)(m.OptionalAny).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*anypb1.Any)(m.OptionalAny).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2543,7 +5082,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.OptionalStruct != nil {
-		size, err := (*structpb1.Struct)(m.OptionalStruct).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*structpb1.Struct)(m.OptionalStruct).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2555,7 +5094,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x82
 	}
 	if m.OptionalFieldMask != nil {
-		size, err := (*fieldmaskpb1.FieldMask)(m.OptionalFieldMask).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*fieldmaskpb1.FieldMask)(m.OptionalFieldMask).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2567,7 +5106,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.OptionalTimestamp != nil {
-		size, err := (*timestamppb1.Timestamp)(m.OptionalTimestamp).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*timestamppb1.Timestamp)(m.OptionalTimestamp).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2579,7 +5118,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf2
 	}
 	if m.OptionalDuration != nil {
-		size, err := (*durationpb1.Duration)(m.OptionalDuration).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*durationpb1.Duration)(m.OptionalDuration).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2592,7 +5131,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedBytesWrapper) > 0 {
 		for iNdEx := len(m.RepeatedBytesWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.BytesValue)(m.RepeatedBytesWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.BytesValue)(m.RepeatedBytesWrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2606,7 +5145,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedStringWrapper) > 0 {
 		for iNdEx := len(m.RepeatedStringWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.StringValue)(m.RepeatedStringWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.StringValue)(m.RepeatedStringWrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2620,7 +5159,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedDoubleWrapper) > 0 {
 		for iNdEx := len(m.RepeatedDoubleWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.DoubleValue)(m.RepeatedDoubleWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.DoubleValue)(m.RepeatedDoubleWrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2634,7 +5173,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedFloatWrapper) > 0 {
 		for iNdEx := len(m.RepeatedFloatWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.FloatValue)(m.RepeatedFloatWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.FloatValue)(m.RepeatedFloatWrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2648,7 +5187,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedUint64Wrapper) > 0 {
 		for iNdEx := len(m.RepeatedUint64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.UInt64Value)(m.RepeatedUint64Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.UInt64Value)(m.RepeatedUint64Wrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2662,7 +5201,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedUint32Wrapper) > 0 {
 		for iNdEx := len(m.RepeatedUint32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.UInt32Value)(m.RepeatedUint32Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.UInt32Value)(m.RepeatedUint32Wrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2676,7 +5215,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedInt64Wrapper) > 0 {
 		for iNdEx := len(m.RepeatedInt64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.Int64Value)(m.RepeatedInt64Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.Int64Value)(m.RepeatedInt64Wrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2690,7 +5229,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedInt32Wrapper) > 0 {
 		for iNdEx := len(m.RepeatedInt32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.Int32Value)(m.RepeatedInt32Wrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.Int32Value)(m.RepeatedInt32Wrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2704,7 +5243,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedBoolWrapper) > 0 {
 		for iNdEx := len(m.RepeatedBoolWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := (*wrapperspb1.BoolValue)(m.RepeatedBoolWrapper[iNdEx]).MarshalToSizedBufferVT(dAtA[:i])
+			size, err := (*wrapperspb1.BoolValue)(m.RepeatedBoolWrapper[iNdEx]).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2717,7 +5256,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if m.OptionalBytesWrapper != nil {
-		size, err := (*wrapperspb1.BytesValue)(m.OptionalBytesWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.BytesValue)(m.OptionalBytesWrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2729,7 +5268,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.OptionalStringWrapper != nil {
-		size, err := (*wrapperspb1.StringValue)(m.OptionalStringWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.StringValue)(m.OptionalStringWrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2741,7 +5280,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x82
 	}
 	if m.OptionalDoubleWrapper != nil {
-		size, err := (*wrapperspb1.DoubleValue)(m.OptionalDoubleWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.DoubleValue)(m.OptionalDoubleWrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2753,7 +5292,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.OptionalFloatWrapper != nil {
-		size, err := (*wrapperspb1.FloatValue)(m.OptionalFloatWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.FloatValue)(m.OptionalFloatWrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2765,7 +5304,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf2
 	}
 	if m.OptionalUint64Wrapper != nil {
-		size, err := (*wrapperspb1.UInt64Value)(m.OptionalUint64Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.UInt64Value)(m.OptionalUint64Wrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2777,7 +5316,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xea
 	}
 	if m.OptionalUint32Wrapper != nil {
-		size, err := (*wrapperspb1.UInt32Value)(m.OptionalUint32Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.UInt32Value)(m.OptionalUint32Wrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2789,7 +5328,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe2
 	}
 	if m.OptionalInt64Wrapper != nil {
-		size, err := (*wrapperspb1.Int64Value)(m.OptionalInt64Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.Int64Value)(m.OptionalInt64Wrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2801,7 +5340,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xda
 	}
 	if m.OptionalInt32Wrapper != nil {
-		size, err := (*wrapperspb1.Int32Value)(m.OptionalInt32Wrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.Int32Value)(m.OptionalInt32Wrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2813,7 +5352,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd2
 	}
 	if m.OptionalBoolWrapper != nil {
-		size, err := (*wrapperspb1.BoolValue)(m.OptionalBoolWrapper).MarshalToSizedBufferVT(dAtA[:i])
+		size, err := (*wrapperspb1.BoolValue)(m.OptionalBoolWrapper).MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3208,15 +5747,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xda
 	}
 	if len(m.MapStringForeignEnum) > 0 {
+		keysForMapStringForeignEnum := make([]string, 0, len(m.MapStringForeignEnum))
 		for k := range m.MapStringForeignEnum {
-			v := m.MapStringForeignEnum[k]
+			keysForMapStringForeignEnum = append(keysForMapStringForeignEnum, string(k))
+		}
+		sort.Slice(keysForMapStringForeignEnum, func(i, j int) bool {
+			return keysForMapStringForeignEnum[i] < keysForMapStringForeignEnum[j]
+		})
+		for iNdEx := len(keysForMapStringForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringForeignEnum[string(keysForMapStringForeignEnum[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringForeignEnum[iNdEx])
+			copy(dAtA[i:], keysForMapStringForeignEnum[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringForeignEnum[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3227,15 +5773,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringNestedEnum) > 0 {
+		keysForMapStringNestedEnum := make([]string, 0, len(m.MapStringNestedEnum))
 		for k := range m.MapStringNestedEnum {
-			v := m.MapStringNestedEnum[k]
+			keysForMapStringNestedEnum = append(keysForMapStringNestedEnum, string(k))
+		}
+		sort.Slice(keysForMapStringNestedEnum, func(i, j int) bool {
+			return keysForMapStringNestedEnum[i] < keysForMapStringNestedEnum[j]
+		})
+		for iNdEx := len(keysForMapStringNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringNestedEnum[string(keysForMapStringNestedEnum[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringNestedEnum[iNdEx])
+			copy(dAtA[i:], keysForMapStringNestedEnum[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringNestedEnum[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3246,10 +5799,17 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringForeignMessage) > 0 {
+		keysForMapStringForeignMessage := make([]string, 0, len(m.MapStringForeignMessage))
 		for k := range m.MapStringForeignMessage {
-			v := m.MapStringForeignMessage[k]
+			keysForMapStringForeignMessage = append(keysForMapStringForeignMessage, string(k))
+		}
+		sort.Slice(keysForMapStringForeignMessage, func(i, j int) bool {
+			return keysForMapStringForeignMessage[i] < keysForMapStringForeignMessage[j]
+		})
+		for iNdEx := len(keysForMapStringForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringForeignMessage[string(keysForMapStringForeignMessage[iNdEx])]
 			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3257,9 +5817,9 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringForeignMessage[iNdEx])
+			copy(dAtA[i:], keysForMapStringForeignMessage[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringForeignMessage[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3270,10 +5830,17 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringNestedMessage) > 0 {
+		keysForMapStringNestedMessage := make([]string, 0, len(m.MapStringNestedMessage))
 		for k := range m.MapStringNestedMessage {
-			v := m.MapStringNestedMessage[k]
+			keysForMapStringNestedMessage = append(keysForMapStringNestedMessage, string(k))
+		}
+		sort.Slice(keysForMapStringNestedMessage, func(i, j int) bool {
+			return keysForMapStringNestedMessage[i] < keysForMapStringNestedMessage[j]
+		})
+		for iNdEx := len(keysForMapStringNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringNestedMessage[string(keysForMapStringNestedMessage[iNdEx])]
 			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3281,9 +5848,9 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringNestedMessage[iNdEx])
+			copy(dAtA[i:], keysForMapStringNestedMessage[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringNestedMessage[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3294,17 +5861,24 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringBytes) > 0 {
+		keysForMapStringBytes := make([]string, 0, len(m.MapStringBytes))
 		for k := range m.MapStringBytes {
-			v := m.MapStringBytes[k]
+			keysForMapStringBytes = append(keysForMapStringBytes, string(k))
+		}
+		sort.Slice(keysForMapStringBytes, func(i, j int) bool {
+			return keysForMapStringBytes[i] < keysForMapStringBytes[j]
+		})
+		for iNdEx := len(keysForMapStringBytes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringBytes[string(keysForMapStringBytes[iNdEx])]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringBytes[iNdEx])
+			copy(dAtA[i:], keysForMapStringBytes[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringBytes[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3315,17 +5889,24 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapStringString) > 0 {
+		keysForMapStringString := make([]string, 0, len(m.MapStringString))
 		for k := range m.MapStringString {
-			v := m.MapStringString[k]
+			keysForMapStringString = append(keysForMapStringString, string(k))
+		}
+		sort.Slice(keysForMapStringString, func(i, j int) bool {
+			return keysForMapStringString[i] < keysForMapStringString[j]
+		})
+		for iNdEx := len(keysForMapStringString) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapStringString[string(keysForMapStringString[iNdEx])]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i -= len(keysForMapStringString[iNdEx])
+			copy(dAtA[i:], keysForMapStringString[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForMapStringString[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3336,8 +5917,15 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapBoolBool) > 0 {
+		keysForMapBoolBool := make([]bool, 0, len(m.MapBoolBool))
 		for k := range m.MapBoolBool {
-			v := m.MapBoolBool[k]
+			keysForMapBoolBool = append(keysForMapBoolBool, bool(k))
+		}
+		sort.Slice(keysForMapBoolBool, func(i, j int) bool {
+			return !keysForMapBoolBool[i] && keysForMapBoolBool[j]
+		})
+		for iNdEx := len(keysForMapBoolBool) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapBoolBool[bool(keysForMapBoolBool[iNdEx])]
 			baseI := i
 			i--
 			if v {
@@ -3348,7 +5936,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 			i--
 			dAtA[i] = 0x10
 			i--
-			if k {
+			if keysForMapBoolBool[iNdEx] {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
@@ -3363,14 +5951,21 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Double) > 0 {
+		keysForMapInt32Double := make([]int32, 0, len(m.MapInt32Double))
 		for k := range m.MapInt32Double {
-			v := m.MapInt32Double[k]
+			keysForMapInt32Double = append(keysForMapInt32Double, int32(k))
+		}
+		sort.Slice(keysForMapInt32Double, func(i, j int) bool {
+			return keysForMapInt32Double[i] < keysForMapInt32Double[j]
+		})
+		for iNdEx := len(keysForMapInt32Double) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Double[int32(keysForMapInt32Double[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
 			i--
 			dAtA[i] = 0x11
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Double[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3381,14 +5976,21 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Float) > 0 {
+		keysForMapInt32Float := make([]int32, 0, len(m.MapInt32Float))
 		for k := range m.MapInt32Float {
-			v := m.MapInt32Float[k]
+			keysForMapInt32Float = append(keysForMapInt32Float, int32(k))
+		}
+		sort.Slice(keysForMapInt32Float, func(i, j int) bool {
+			return keysForMapInt32Float[i] < keysForMapInt32Float[j]
+		})
+		for iNdEx := len(keysForMapInt32Float) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Float[int32(keysForMapInt32Float[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
 			i--
 			dAtA[i] = 0x15
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Float[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3399,15 +6001,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSfixed64Sfixed64) > 0 {
+		keysForMapSfixed64Sfixed64 := make([]int64, 0, len(m.MapSfixed64Sfixed64))
 		for k := range m.MapSfixed64Sfixed64 {
-			v := m.MapSfixed64Sfixed64[k]
+			keysForMapSfixed64Sfixed64 = append(keysForMapSfixed64Sfixed64, int64(k))
+		}
+		sort.Slice(keysForMapSfixed64Sfixed64, func(i, j int) bool {
+			return keysForMapSfixed64Sfixed64[i] < keysForMapSfixed64Sfixed64[j]
+		})
+		for iNdEx := len(keysForMapSfixed64Sfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSfixed64Sfixed64[int64(keysForMapSfixed64Sfixed64[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
 			dAtA[i] = 0x11
 			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForMapSfixed64Sfixed64[iNdEx]))
 			i--
 			dAtA[i] = 0x9
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3418,15 +6027,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSfixed32Sfixed32) > 0 {
+		keysForMapSfixed32Sfixed32 := make([]int32, 0, len(m.MapSfixed32Sfixed32))
 		for k := range m.MapSfixed32Sfixed32 {
-			v := m.MapSfixed32Sfixed32[k]
+			keysForMapSfixed32Sfixed32 = append(keysForMapSfixed32Sfixed32, int32(k))
+		}
+		sort.Slice(keysForMapSfixed32Sfixed32, func(i, j int) bool {
+			return keysForMapSfixed32Sfixed32[i] < keysForMapSfixed32Sfixed32[j]
+		})
+		for iNdEx := len(keysForMapSfixed32Sfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSfixed32Sfixed32[int32(keysForMapSfixed32Sfixed32[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
 			i--
 			dAtA[i] = 0x15
 			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForMapSfixed32Sfixed32[iNdEx]))
 			i--
 			dAtA[i] = 0xd
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3437,15 +6053,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapFixed64Fixed64) > 0 {
+		keysForMapFixed64Fixed64 := make([]uint64, 0, len(m.MapFixed64Fixed64))
 		for k := range m.MapFixed64Fixed64 {
-			v := m.MapFixed64Fixed64[k]
+			keysForMapFixed64Fixed64 = append(keysForMapFixed64Fixed64, uint64(k))
+		}
+		sort.Slice(keysForMapFixed64Fixed64, func(i, j int) bool {
+			return keysForMapFixed64Fixed64[i] < keysForMapFixed64Fixed64[j]
+		})
+		for iNdEx := len(keysForMapFixed64Fixed64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapFixed64Fixed64[uint64(keysForMapFixed64Fixed64[iNdEx])]
 			baseI := i
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
 			i--
 			dAtA[i] = 0x11
 			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForMapFixed64Fixed64[iNdEx]))
 			i--
 			dAtA[i] = 0x9
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3456,15 +6079,22 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapFixed32Fixed32) > 0 {
+		keysForMapFixed32Fixed32 := make([]uint32, 0, len(m.MapFixed32Fixed32))
 		for k := range m.MapFixed32Fixed32 {
-			v := m.MapFixed32Fixed32[k]
+			keysForMapFixed32Fixed32 = append(keysForMapFixed32Fixed32, uint32(k))
+		}
+		sort.Slice(keysForMapFixed32Fixed32, func(i, j int) bool {
+			return keysForMapFixed32Fixed32[i] < keysForMapFixed32Fixed32[j]
+		})
+		for iNdEx := len(keysForMapFixed32Fixed32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapFixed32Fixed32[uint32(keysForMapFixed32Fixed32[iNdEx])]
 			baseI := i
 			i -= 4
 			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
 			i--
 			dAtA[i] = 0x15
 			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForMapFixed32Fixed32[iNdEx]))
 			i--
 			dAtA[i] = 0xd
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3475,13 +6105,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSint64Sint64) > 0 {
+		keysForMapSint64Sint64 := make([]int64, 0, len(m.MapSint64Sint64))
 		for k := range m.MapSint64Sint64 {
-			v := m.MapSint64Sint64[k]
+			keysForMapSint64Sint64 = append(keysForMapSint64Sint64, int64(k))
+		}
+		sort.Slice(keysForMapSint64Sint64, func(i, j int) bool {
+			return keysForMapSint64Sint64[i] < keysForMapSint64Sint64[j]
+		})
+		for iNdEx := len(keysForMapSint64Sint64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSint64Sint64[int64(keysForMapSint64Sint64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(keysForMapSint64Sint64[iNdEx])<<1)^uint64((keysForMapSint64Sint64[iNdEx]>>63))))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3492,13 +6129,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapSint32Sint32) > 0 {
+		keysForMapSint32Sint32 := make([]int32, 0, len(m.MapSint32Sint32))
 		for k := range m.MapSint32Sint32 {
-			v := m.MapSint32Sint32[k]
+			keysForMapSint32Sint32 = append(keysForMapSint32Sint32, int32(k))
+		}
+		sort.Slice(keysForMapSint32Sint32, func(i, j int) bool {
+			return keysForMapSint32Sint32[i] < keysForMapSint32Sint32[j]
+		})
+		for iNdEx := len(keysForMapSint32Sint32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapSint32Sint32[int32(keysForMapSint32Sint32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64((uint32(keysForMapSint32Sint32[iNdEx])<<1)^uint32((keysForMapSint32Sint32[iNdEx]>>31))))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3509,13 +6153,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapUint64Uint64) > 0 {
+		keysForMapUint64Uint64 := make([]uint64, 0, len(m.MapUint64Uint64))
 		for k := range m.MapUint64Uint64 {
-			v := m.MapUint64Uint64[k]
+			keysForMapUint64Uint64 = append(keysForMapUint64Uint64, uint64(k))
+		}
+		sort.Slice(keysForMapUint64Uint64, func(i, j int) bool {
+			return keysForMapUint64Uint64[i] < keysForMapUint64Uint64[j]
+		})
+		for iNdEx := len(keysForMapUint64Uint64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapUint64Uint64[uint64(keysForMapUint64Uint64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapUint64Uint64[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3526,13 +6177,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapUint32Uint32) > 0 {
+		keysForMapUint32Uint32 := make([]uint32, 0, len(m.MapUint32Uint32))
 		for k := range m.MapUint32Uint32 {
-			v := m.MapUint32Uint32[k]
+			keysForMapUint32Uint32 = append(keysForMapUint32Uint32, uint32(k))
+		}
+		sort.Slice(keysForMapUint32Uint32, func(i, j int) bool {
+			return keysForMapUint32Uint32[i] < keysForMapUint32Uint32[j]
+		})
+		for iNdEx := len(keysForMapUint32Uint32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapUint32Uint32[uint32(keysForMapUint32Uint32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapUint32Uint32[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3543,13 +6201,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt64Int64) > 0 {
+		keysForMapInt64Int64 := make([]int64, 0, len(m.MapInt64Int64))
 		for k := range m.MapInt64Int64 {
-			v := m.MapInt64Int64[k]
+			keysForMapInt64Int64 = append(keysForMapInt64Int64, int64(k))
+		}
+		sort.Slice(keysForMapInt64Int64, func(i, j int) bool {
+			return keysForMapInt64Int64[i] < keysForMapInt64Int64[j]
+		})
+		for iNdEx := len(keysForMapInt64Int64) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt64Int64[int64(keysForMapInt64Int64[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt64Int64[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3560,13 +6225,20 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.MapInt32Int32) > 0 {
+		keysForMapInt32Int32 := make([]int32, 0, len(m.MapInt32Int32))
 		for k := range m.MapInt32Int32 {
-			v := m.MapInt32Int32[k]
+			keysForMapInt32Int32 = append(keysForMapInt32Int32, int32(k))
+		}
+		sort.Slice(keysForMapInt32Int32, func(i, j int) bool {
+			return keysForMapInt32Int32[i] < keysForMapInt32Int32[j]
+		})
+		for iNdEx := len(keysForMapInt32Int32) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MapInt32Int32[int32(keysForMapInt32Int32[iNdEx])]
 			baseI := i
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
 			i--
 			dAtA[i] = 0x10
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForMapInt32Int32[iNdEx]))
 			i--
 			dAtA[i] = 0x8
 			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
@@ -3646,7 +6318,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedForeignMessage) > 0 {
 		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3660,7 +6332,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	if len(m.RepeatedNestedMessage) > 0 {
 		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3914,7 +6586,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.RecursiveMessage != nil {
-		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3965,7 +6637,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa8
 	}
 	if m.OptionalForeignMessage != nil {
-		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3977,7 +6649,7 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		dAtA[i] = 0x9a
 	}
 	if m.OptionalNestedMessage != nil {
-		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4012,13 +6684,13 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x68
 	}
-	if m.OptionalDouble != 0 {
+	if math.Float64bits(float64(m.OptionalDouble)) != 0 {
 		i -= 8
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OptionalDouble))))
 		i--
 		dAtA[i] = 0x61
 	}
-	if m.OptionalFloat != 0 {
+	if math.Float32bits(float32(m.OptionalFloat)) != 0 {
 		i -= 4
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OptionalFloat))))
 		i--
@@ -4081,12 +6753,12 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TestAllTypesProto3_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofUint32) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint32))
 	i--
@@ -4095,15 +6767,15 @@ func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xf8
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.OneofNestedMessage != nil {
-		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVTDeterministic(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4122,12 +6794,12 @@ func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofString) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= len(m.OneofString)
 	copy(dAtA[i:], m.OneofString)
@@ -4138,12 +6810,12 @@ func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0x8a
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofBytes) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= len(m.OneofBytes)
 	copy(dAtA[i:], m.OneofBytes)
@@ -4154,12 +6826,12 @@ func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int
 	dAtA[i] = 0x92
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofBool) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i--
 	if m.OneofBool {
@@ -4173,12 +6845,12 @@ func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int,
 	dAtA[i] = 0x98
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofUint64) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofUint64))
 	i--
@@ -4187,12 +6859,12 @@ func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xa0
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofFloat) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= 4
 	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
@@ -4202,12 +6874,12 @@ func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int
 	dAtA[i] = 0xad
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofDouble) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i -= 8
 	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
@@ -4217,12 +6889,12 @@ func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (in
 	dAtA[i] = 0xb1
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofEnum) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofEnum))
 	i--
@@ -4231,12 +6903,12 @@ func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int,
 	dAtA[i] = 0xb8
 	return len(dAtA) - i, nil
 }
-func (m *TestAllTypesProto3_OneofNullValue) MarshalToVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.OneofNullValue))
 	i--
@@ -4245,25 +6917,25 @@ func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVT(dAtA []byte)
 	dAtA[i] = 0xc0
 	return len(dAtA) - i, nil
 }
-func (m *ForeignMessage) MarshalVT() (dAtA []byte, err error) {
+func (m *ForeignMessage) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ForeignMessage) MarshalToVT(dAtA []byte) (int, error) {
+func (m *ForeignMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *ForeignMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ForeignMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4283,25 +6955,25 @@ func (m *ForeignMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NullHypothesisProto3) MarshalVT() (dAtA []byte, err error) {
+func (m *NullHypothesisProto3) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *NullHypothesisProto3) MarshalToVT(dAtA []byte) (int, error) {
+func (m *NullHypothesisProto3) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *NullHypothesisProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *NullHypothesisProto3) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4316,25 +6988,25 @@ func (m *NullHypothesisProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EnumOnlyProto3) MarshalVT() (dAtA []byte, err error) {
+func (m *EnumOnlyProto3) MarshalVTDeterministic() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *EnumOnlyProto3) MarshalToVT(dAtA []byte) (int, error) {
+func (m *EnumOnlyProto3) MarshalToVTDeterministic(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
 }
 
-func (m *EnumOnlyProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *EnumOnlyProto3) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4427,6 +7099,29 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	var whichOneofField int32
+	switch m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		whichOneofField = 111
+	case *TestAllTypesProto3_OneofNestedMessage:
+		whichOneofField = 112
+	case *TestAllTypesProto3_OneofString:
+		whichOneofField = 113
+	case *TestAllTypesProto3_OneofBytes:
+		whichOneofField = 114
+	case *TestAllTypesProto3_OneofBool:
+		whichOneofField = 115
+	case *TestAllTypesProto3_OneofUint64:
+		whichOneofField = 116
+	case *TestAllTypesProto3_OneofFloat:
+		whichOneofField = 117
+	case *TestAllTypesProto3_OneofDouble:
+		whichOneofField = 118
+	case *TestAllTypesProto3_OneofEnum:
+		whichOneofField = 119
+	case *TestAllTypesProto3_OneofNullValue:
+		whichOneofField = 120
+	}
 	if m.FieldName18__ != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.FieldName18__))
 		i--
@@ -4964,70 +7659,80 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0xca
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+	if whichOneofField == 120 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofNullValue)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+	if whichOneofField == 119 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofEnum)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+	if whichOneofField == 118 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofDouble)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+	if whichOneofField == 117 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofFloat)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+	if whichOneofField == 116 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofUint64)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+	if whichOneofField == 115 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofBool)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+	if whichOneofField == 114 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofBytes)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofString); ok {
+	if whichOneofField == 113 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofString)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+	if whichOneofField == 112 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 	}
-	if msg, ok := m.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+	if whichOneofField == 111 {
+		msg := m.OneofField.(*TestAllTypesProto3_OneofUint32)
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -6222,13 +8927,13 @@ func (m *TestAllTypesProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x68
 	}
-	if m.OptionalDouble != 0 {
+	if math.Float64bits(float64(m.OptionalDouble)) != 0 {
 		i -= 8
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OptionalDouble))))
 		i--
 		dAtA[i] = 0x61
 	}
-	if m.OptionalFloat != 0 {
+	if math.Float32bits(float32(m.OptionalFloat)) != 0 {
 		i -= 4
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OptionalFloat))))
 		i--
@@ -6559,6 +9264,21 @@ func (m *EnumOnlyProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *TestAllTypesProto3_NestedMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestAllTypesProto3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ForeignMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *NullHypothesisProto3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *EnumOnlyProto3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *TestAllTypesProto3_NestedMessage) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -6612,10 +9332,10 @@ func (m *TestAllTypesProto3) SizeVT() (n int) {
 	if m.OptionalSfixed64 != 0 {
 		n += 9
 	}
-	if m.OptionalFloat != 0 {
+	if math.Float32bits(float32(m.OptionalFloat)) != 0 {
 		n += 5
 	}
-	if m.OptionalDouble != 0 {
+	if math.Float64bits(float64(m.OptionalDouble)) != 0 {
 		n += 9
 	}
 	if m.OptionalBool {
@@ -7060,8 +9780,27 @@ func (m *TestAllTypesProto3) SizeVT() (n int) {
 			n += 2 + protohelpers.SizeOfVarint(uint64(e))
 		}
 	}
-	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofNestedMessage:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofString:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofBytes:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofBool:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofUint64:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofFloat:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofDouble:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofEnum:
+		n += c.SizeVT()
+	case *TestAllTypesProto3_OneofNullValue:
+		n += c.SizeVT()
 	}
 	if m.OptionalBoolWrapper != nil {
 		l = (*wrapperspb1.BoolValue)(m.OptionalBoolWrapper).SizeVT()
@@ -8104,24 +10843,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
@@ -8180,24 +10919,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
 					m.RepeatedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+					m.RepeatedInt64 = append(m.RepeatedInt64, int64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
 			}
@@ -8256,24 +10995,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
 					m.RepeatedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+					m.RepeatedUint32 = append(m.RepeatedUint32, uint32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
 			}
@@ -8332,24 +11071,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
 					m.RepeatedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+					m.RepeatedUint64 = append(m.RepeatedUint64, uint64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
 			}
@@ -8409,25 +11148,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
 					m.RepeatedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+					m.RepeatedSint32 = append(m.RepeatedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
 			}
@@ -8487,25 +11225,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
 					m.RepeatedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
 			}
@@ -8874,24 +11611,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedBool) == 0 {
 					m.RepeatedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+					m.RepeatedBool = append(m.RepeatedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
 			}
@@ -9078,24 +11815,25 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
 					m.RepeatedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
 			}
@@ -9147,24 +11885,25 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
 					m.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v ForeignEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ForeignEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+					e := ForeignEnum(v)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
 			}
@@ -10537,7 +13276,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -10569,7 +13312,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapvalue]); err != nil {
 						return err
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					if intStringLenmapvalue == 0 {
+						mapvalue = ""
+					} else {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
@@ -10586,7 +13333,9 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringString[mapkey] = mapvalue
+			if old, ok := m.MapStringString[mapkey]; !ok || old != mapvalue {
+				m.MapStringString[strings.Clone(mapkey)] = strings.Clone(mapvalue)
+			}
 			iNdEx = postIndex
 		case 70:
 			if wireType != 2 {
@@ -10670,7 +13419,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapbyteLen uint64
@@ -10717,7 +13470,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringBytes[mapkey] = mapvalue
+			m.MapStringBytes[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 71:
 			if wireType != 2 {
@@ -10801,7 +13554,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -10849,7 +13606,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringNestedMessage[mapkey] = mapvalue
+			m.MapStringNestedMessage[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 72:
 			if wireType != 2 {
@@ -10933,7 +13690,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -10981,7 +13742,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringForeignMessage[mapkey] = mapvalue
+			m.MapStringForeignMessage[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		case 73:
 			if wireType != 2 {
@@ -11065,7 +13826,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11097,7 +13862,9 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringNestedEnum[mapkey] = mapvalue
+			if old, ok := m.MapStringNestedEnum[mapkey]; !ok || old != mapvalue {
+				m.MapStringNestedEnum[strings.Clone(mapkey)] = mapvalue
+			}
 			iNdEx = postIndex
 		case 74:
 			if wireType != 2 {
@@ -11181,7 +13948,11 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11213,7 +13984,9 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MapStringForeignEnum[mapkey] = mapvalue
+			if old, ok := m.MapStringForeignEnum[mapkey]; !ok || old != mapvalue {
+				m.MapStringForeignEnum[strings.Clone(mapkey)] = mapvalue
+			}
 			iNdEx = postIndex
 		case 75:
 			if wireType == 0 {
@@ -11270,24 +14043,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt32) == 0 {
 					m.PackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt32 = append(m.PackedInt32, v)
+					m.PackedInt32 = append(m.PackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
 			}
@@ -11346,24 +14119,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt64) == 0 {
 					m.PackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt64 = append(m.PackedInt64, v)
+					m.PackedInt64 = append(m.PackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
 			}
@@ -11422,24 +14195,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint32) == 0 {
 					m.PackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint32 = append(m.PackedUint32, v)
+					m.PackedUint32 = append(m.PackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
 			}
@@ -11498,24 +14271,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint64) == 0 {
 					m.PackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint64 = append(m.PackedUint64, v)
+					m.PackedUint64 = append(m.PackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
 			}
@@ -11575,25 +14348,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint32) == 0 {
 					m.PackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedSint32 = append(m.PackedSint32, v)
+					m.PackedSint32 = append(m.PackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
 			}
@@ -11653,25 +14425,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint64) == 0 {
 					m.PackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedSint64 = append(m.PackedSint64, int64(v))
+					m.PackedSint64 = append(m.PackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
 			}
@@ -12040,24 +14811,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedBool) == 0 {
 					m.PackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedBool = append(m.PackedBool, bool(v != 0))
+					m.PackedBool = append(m.PackedBool, v != 0)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
 			}
@@ -12109,24 +14880,25 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
 					m.PackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
 			}
@@ -12185,24 +14957,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
 					m.UnpackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protohelpers.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
 						}
+						continue
 					}
-					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+					m.UnpackedInt32 = append(m.UnpackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
 			}
@@ -12261,24 +15033,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
 					m.UnpackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+					m.UnpackedInt64 = append(m.UnpackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
 			}
@@ -12337,24 +15109,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
 					m.UnpackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+					m.UnpackedUint32 = append(m.UnpackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
 			}
@@ -12413,24 +15185,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
 					m.UnpackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+					m.UnpackedUint64 = append(m.UnpackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
 			}
@@ -12490,25 +15262,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
 					m.UnpackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+					m.UnpackedSint32 = append(m.UnpackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
 			}
@@ -12568,25 +15339,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
 					m.UnpackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
 			}
@@ -12955,24 +15725,24 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedBool) == 0 {
 					m.UnpackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+					m.UnpackedBool = append(m.UnpackedBool, v != 0)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
 			}
@@ -13024,24 +15794,25 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
 					m.UnpackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
 			}
@@ -15623,24 +18394,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
 					m.RepeatedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+					m.RepeatedInt32 = append(m.RepeatedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
 			}
@@ -15699,24 +18470,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
 					m.RepeatedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+					m.RepeatedInt64 = append(m.RepeatedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
 			}
@@ -15775,24 +18546,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
 					m.RepeatedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+					m.RepeatedUint32 = append(m.RepeatedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
 			}
@@ -15851,24 +18622,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
 					m.RepeatedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+					m.RepeatedUint64 = append(m.RepeatedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
 			}
@@ -15928,25 +18699,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
 					m.RepeatedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+					m.RepeatedSint32 = append(m.RepeatedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
 			}
@@ -16006,25 +18776,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
 					m.RepeatedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
 			}
@@ -16277,6 +19046,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedFloat) == 0 {
 					m.RepeatedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.RepeatedFloat = append(m.RepeatedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -16331,6 +19103,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedDouble) == 0 {
 					m.RepeatedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.RepeatedDouble = append(m.RepeatedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -16393,24 +19168,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedBool) == 0 {
 					m.RepeatedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+					m.RepeatedBool = append(m.RepeatedBool, v != 0)
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
 			}
@@ -16600,24 +19375,25 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
 					m.RepeatedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
 			}
@@ -16669,24 +19445,25 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
 					m.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v ForeignEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ForeignEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+					e := ForeignEnum(v)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
 			}
@@ -18827,24 +21604,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt32) == 0 {
 					m.PackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt32 = append(m.PackedInt32, v)
+					m.PackedInt32 = append(m.PackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
 			}
@@ -18903,24 +21680,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedInt64) == 0 {
 					m.PackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedInt64 = append(m.PackedInt64, v)
+					m.PackedInt64 = append(m.PackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
 			}
@@ -18979,24 +21756,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint32) == 0 {
 					m.PackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint32 = append(m.PackedUint32, v)
+					m.PackedUint32 = append(m.PackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
 			}
@@ -19055,24 +21832,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedUint64) == 0 {
 					m.PackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedUint64 = append(m.PackedUint64, v)
+					m.PackedUint64 = append(m.PackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
 			}
@@ -19132,25 +21909,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint32) == 0 {
 					m.PackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedSint32 = append(m.PackedSint32, v)
+					m.PackedSint32 = append(m.PackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
 			}
@@ -19210,25 +21986,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedSint64) == 0 {
 					m.PackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedSint64 = append(m.PackedSint64, int64(v))
+					m.PackedSint64 = append(m.PackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
 			}
@@ -19481,6 +22256,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedFloat) == 0 {
 					m.PackedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.PackedFloat = append(m.PackedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -19535,6 +22313,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedDouble) == 0 {
 					m.PackedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.PackedDouble = append(m.PackedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -19597,24 +22378,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedBool) == 0 {
 					m.PackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedBool = append(m.PackedBool, bool(v != 0))
+					m.PackedBool = append(m.PackedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
 			}
@@ -19666,24 +22447,25 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
 					m.PackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
 			}
@@ -19742,24 +22524,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
 					m.UnpackedInt32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+					m.UnpackedInt32 = append(m.UnpackedInt32, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
 			}
@@ -19818,24 +22600,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
 					m.UnpackedInt64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+					m.UnpackedInt64 = append(m.UnpackedInt64, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
 			}
@@ -19894,24 +22676,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
 					m.UnpackedUint32 = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+					m.UnpackedUint32 = append(m.UnpackedUint32, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
 			}
@@ -19970,24 +22752,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
 					m.UnpackedUint64 = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+					m.UnpackedUint64 = append(m.UnpackedUint64, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
 			}
@@ -20047,25 +22829,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
 					m.UnpackedSint32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+					m.UnpackedSint32 = append(m.UnpackedSint32, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
 			}
@@ -20125,25 +22906,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
 					m.UnpackedSint64 = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
 			}
@@ -20396,6 +23176,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedFloat) == 0 {
 					m.UnpackedFloat = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.UnpackedFloat = append(m.UnpackedFloat, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -20450,6 +23233,9 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedDouble) == 0 {
 					m.UnpackedDouble = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.UnpackedDouble = append(m.UnpackedDouble, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -20512,24 +23298,24 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedBool) == 0 {
 					m.UnpackedBool = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+					m.UnpackedBool = append(m.UnpackedBool, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
 			}
@@ -20581,24 +23367,25 @@ func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
 					m.UnpackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v TestAllTypesProto3_NestedEnum
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+					e := TestAllTypesProto3_NestedEnum(v)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
 			}
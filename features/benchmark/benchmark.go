@@ -0,0 +1,74 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package benchmark
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const vtbenchPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtbench")
+
+func init() {
+	generator.RegisterOptInFeature("benchmark", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &benchmark{GeneratedFile: gen}
+	})
+}
+
+type benchmark struct {
+	*generator.GeneratedFile
+	messages []*protogen.Message
+}
+
+var _ generator.FeatureGenerator = (*benchmark)(nil)
+
+func (p *benchmark) GenerateFile(file *protogen.File) bool {
+	// The benchmarks compare the methods of these features with the proto package.
+	if p.Wrapper() || !p.HasFeature("marshal") || !p.HasFeature("unmarshal") || !p.HasFeature("size") {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	if len(p.messages) == 0 {
+		return false
+	}
+	if p.Config.BenchmarkJSON {
+		p.runner()
+	}
+	return true
+}
+
+func (p *benchmark) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.messages = append(p.messages, message)
+	out := p.TestFile()
+	out.P(`func BenchmarkVT_`, message.GoIdent.GoName, `(b *`, protogen.GoImportPath("testing").Ident("B"), `) {`)
+	out.P(vtbenchPackage.Ident("Benchmark"), `(b, `, vtbenchPackage.Ident("New"), `[*`, message.GoIdent, `]())`)
+	out.P(`}`)
+	out.P()
+}
+
+// runner generates the test writing the results of the benchmarks of the messages of the
+// file as JSON, named after the file since the tests of the files of a package share it.
+func (p *benchmark) runner() {
+	out := p.TestFile()
+	out.P(`func TestVTBenchmarkJSON_`, p.FileIdent(), `(t *`, protogen.GoImportPath("testing").Ident("T"), `) {`)
+	out.P(vtbenchPackage.Ident("Main"), `(t,`)
+	for _, message := range p.messages {
+		out.P(vtbenchPackage.Ident("For"), `(`, vtbenchPackage.Ident("New"), `[*`, message.GoIdent, `]()),`)
+	}
+	out.P(`)`)
+	out.P(`}`)
+	out.P()
+}
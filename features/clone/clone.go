@@ -7,14 +7,17 @@ package clone
 
 import (
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/planetscale/vtprotobuf/generator"
+	"github.com/planetscale/vtprotobuf/vtproto"
 )
 
 const (
 	cloneName        = "CloneVT"
 	cloneMessageName = "CloneMessageVT"
+	cloneSliceName   = "CloneSliceVT"
 )
 
 var (
@@ -40,10 +43,9 @@ func (p *clone) Name() string {
 
 func (p *clone) GenerateFile(file *protogen.File) bool {
 	proto3 := file.Desc.Syntax() == protoreflect.Proto3
-	proto2 := file.Desc.Syntax() == protoreflect.Proto2
 
 	for _, message := range file.Messages {
-		p.processMessage(proto3, proto2, message)
+		p.processMessage(proto3, message)
 	}
 
 	return p.once
@@ -76,8 +78,10 @@ func (p *clone) cloneFieldSingular(lhs, rhs string, kind protoreflect.Kind, mess
 		switch {
 		case p.IsWellKnownType(message):
 			p.P(lhs, ` = (*`, message.GoIdent, `)((*`, p.WellKnownTypeMap(message), `)(`, rhs, `).`, cloneName, `())`)
-		case p.IsLocalMessage(message):
+		case p.IsVTMessage(message):
 			p.P(lhs, ` = `, rhs, `.`, cloneName, `()`)
+		case p.Config.Registry:
+			p.P(lhs, ` = `, p.RegistryType(message), `.`, cloneName, `(`, rhs, `)`)
 		default:
 			// rhs is a concrete type, we need to first convert it to an interface in order to use an interface
 			// type assertion.
@@ -99,7 +103,7 @@ func (p *clone) cloneFieldSingular(lhs, rhs string, kind protoreflect.Kind, mess
 }
 
 // cloneField generates the code for cloning a field in a protobuf.
-func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, field *protogen.Field) {
+func (p *clone) cloneField(lhsBase, rhsBase string, field *protogen.Field) {
 	// At this point, if we encounter a non-synthetic oneof, we assume it to be the representative
 	// field for that oneof.
 	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
@@ -107,26 +111,40 @@ func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, fiel
 		return
 	}
 
-	if !isReference(allFieldsNullable, field) {
+	if !p.isReference(field) {
 		panic("method should not be invoked for non-reference fields")
 	}
 
 	fieldname := field.GoName
 	lhs := lhsBase + "." + fieldname
 	rhs := rhsBase + "." + fieldname
+	opaque := p.IsOpaque(field.Parent)
+	if opaque {
+		rhs = p.Get(rhsBase, field)
+	}
 
 	// At this point, we are only looking at reference types (pointers, maps, slices, interfaces), which can all
 	// be nil.
 	p.P(`if rhs := `, rhs, `; rhs != nil {`)
 	rhs = "rhs"
+	if opaque {
+		// The fields of opaque messages are cloned into a variable, stored with their setter.
+		goType, _ := p.FieldGoType(field)
+		p.P(`var tmp `, goType)
+		lhs = "tmp"
+	}
 
 	fieldKind := field.Desc.Kind()
 	msg := field.Message // possibly nil
+	immutable := isImmutable(field)
 
-	if field.Desc.Cardinality() == protoreflect.Repeated { // maps and slices
+	if field.Desc.IsMap() && (isScalar(field.Message.Fields[1].Desc.Kind()) || immutable) {
+		// Maps whose values aren't reference types are cloned in bulk as well.
+		p.P(lhs, ` = `, p.Ident("maps", "Clone"), `(`, rhs, `)`)
+	} else if field.Desc.Cardinality() == protoreflect.Repeated { // maps and slices
 		goType, _ := p.FieldGoType(field)
 		p.P(`tmpContainer := make(`, goType, `, len(`, rhs, `))`)
-		if isScalar(fieldKind) && field.Desc.IsList() {
+		if (isScalar(fieldKind) || immutable) && field.Desc.IsList() {
 			// Generated code optimization: instead of iterating over all (key/index, value) pairs,
 			// do a single copy(dst, src) invocation for slices whose elements aren't reference types.
 			p.P(`copy(tmpContainer, `, rhs, `)`)
@@ -139,23 +157,75 @@ func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, fiel
 				msg = valueField.Message
 			}
 			p.P(`for k, v := range `, rhs, ` {`)
-			p.cloneFieldSingular("tmpContainer[k]", "v", fieldKind, msg)
+			if immutable {
+				p.P(`tmpContainer[k] = v`)
+			} else {
+				p.cloneFieldSingular("tmpContainer[k]", "v", fieldKind, msg)
+			}
 			p.P(`}`)
 		}
 		p.P(lhs, ` = tmpContainer`)
 	} else if isScalar(fieldKind) {
 		p.P(`tmpVal := *`, rhs)
 		p.P(lhs, ` = &tmpVal`)
+	} else if immutable {
+		p.P(lhs, ` = `, rhs)
 	} else {
 		p.cloneFieldSingular(lhs, rhs, fieldKind, msg)
 	}
+	if opaque {
+		p.P(p.Set(lhsBase, field, lhs))
+	}
 	p.P(`}`)
 }
 
-func (p *clone) generateCloneMethodsForMessage(proto2 bool, message *protogen.Message) {
+// opaqueFields generates the statements copying the fields of the opaque message "m" into
+// the allocated message "r", through their getters and setters.
+func (p *clone) opaqueFields(message *protogen.Message) {
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if field == oneof.Fields[0] {
+				p.opaqueOneof(oneof)
+			}
+			continue
+		}
+		switch {
+		case field.Desc.Cardinality() == protoreflect.Repeated || field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind:
+			p.cloneField("r", "m", field)
+		case p.FieldPresence(field) == generator.PresenceExplicit:
+			p.P(`if `, p.Has(`m`, field), ` {`)
+			p.P(p.Set(`r`, field, p.Get(`m`, field)))
+			p.P(`}`)
+		default:
+			p.P(p.Set(`r`, field, p.Get(`m`, field)))
+		}
+	}
+}
+
+// opaqueOneof generates the statements copying the member of oneof which is set in the
+// opaque message "m" into "r".
+func (p *clone) opaqueOneof(oneof *protogen.Oneof) {
+	p.P(`switch `, p.Which(`m`, oneof), ` {`)
+	for _, field := range oneof.Fields {
+		p.P(`case `, p.OneofCase(field), `:`)
+		kind := field.Desc.Kind()
+		if isScalar(kind) {
+			p.P(p.Set(`r`, field, p.Get(`m`, field)))
+			continue
+		}
+		goType, _ := p.FieldGoType(field)
+		p.P(`rhs := `, p.Get(`m`, field))
+		p.P(`var tmp `, goType)
+		p.cloneFieldSingular("tmp", "rhs", kind, field.Message)
+		p.P(p.Set(`r`, field, `tmp`))
+	}
+	p.P(`}`)
+}
+
+func (p *clone) generateCloneMethodsForMessage(message *protogen.Message) {
 	ccTypeName := message.GoIdent.GoName
 	p.P(`func (m *`, ccTypeName, `) `, cloneName, `() *`, ccTypeName, ` {`)
-	p.body(proto2, ccTypeName, message)
+	p.body(ccTypeName, message)
 	p.P(`}`)
 	p.P()
 
@@ -164,13 +234,14 @@ func (p *clone) generateCloneMethodsForMessage(proto2 bool, message *protogen.Me
 		p.P(`return m.`, cloneName, `()`)
 		p.P(`}`)
 		p.P()
+		p.generateCloneSliceForMessage(message)
 	}
 }
 
 // body generates the code for the actual cloning logic of a structure containing the given fields.
 // In practice, those can be the fields of a message.
 // The object to be cloned is assumed to be called "m".
-func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protogen.Message) {
+func (p *clone) body(ccTypeName string, message *protogen.Message) {
 	// The method body for a message or a oneof wrapper always starts with a nil check.
 	p.P(`if m == nil {`)
 	// We use an explicitly typed nil to avoid returning the nil interface in the oneof wrapper
@@ -178,11 +249,23 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protoge
 	p.P(`return (*`, ccTypeName, `)(nil)`)
 	p.P(`}`)
 
+	// Do not require qualified name because CloneVT generates in same file with definition.
+	p.Alloc("r", message, false)
+	p.fields(message)
+	p.P(`return r`)
+}
+
+// fields generates the statements copying the fields of the message "m" into the
+// allocated message "r".
+func (p *clone) fields(message *protogen.Message) {
+	if p.IsOpaque(message) {
+		p.opaqueFields(message)
+		p.unknownFields(message)
+		return
+	}
 	fields := message.Fields
 	// Make a first pass over the fields, in which we initialize all non-reference fields via direct
 	// struct literal initialization, and extract all other (reference) fields for a second pass.
-	// Do not require qualified name because CloneVT generates in same file with definition.
-	p.Alloc("r", message, false)
 	var refFields []*protogen.Field
 	oneofFields := make(map[string]struct{}, len(fields))
 
@@ -197,7 +280,7 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protoge
 			continue
 		}
 
-		if !isReference(allFieldsNullable, field) {
+		if !p.isReference(field) {
 			p.P(`r.`, field.GoName, ` = m.`, field.GoName)
 			continue
 		}
@@ -208,7 +291,7 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protoge
 			case p.IsWellKnownType(field.Message):
 				p.P(`r.`, field.GoName, ` = (*`, field.Message.GoIdent, `)((*`, p.WellKnownTypeMap(field.Message), `)(m.`, field.GoName, `).`, cloneName, `())`)
 				continue
-			case p.IsLocalMessage(field.Message):
+			case p.IsVTMessage(field.Message):
 				p.P(`r.`, field.GoName, ` = m.`, field.GoName, `.`, cloneName, `()`)
 				continue
 			}
@@ -218,9 +301,14 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protoge
 
 	// Generate explicit assignment statements for all reference fields.
 	for _, field := range refFields {
-		p.cloneField("r", "m", allFieldsNullable, field)
+		p.cloneField("r", "m", field)
 	}
+	p.unknownFields(message)
+}
 
+// unknownFields generates the statements copying the unknown fields and the extensions of
+// the message "m" into "r".
+func (p *clone) unknownFields(message *protogen.Message) {
 	if !p.Wrapper() && !p.ShouldIgnoreUnknownFields(message) {
 		// Clone unknown fields, if any
 		p.P(`if len(m.unknownFields) > 0 {`)
@@ -229,7 +317,49 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, message *protoge
 		p.P(`}`)
 	}
 
-	p.P(`return r`)
+	if !p.Wrapper() && message.Desc.ExtensionRanges().Len() > 0 {
+		// Extension values live outside of the generated struct fields; deep-copy them
+		// so that the clone does not share extension messages with m.
+		p.P(`if len(m.extensionFields) > 0 {`)
+		p.P(p.Helper("CloneExtensions"), `(r, m)`)
+		p.P(`}`)
+	}
+}
+
+// generateCloneSliceForMessage generates the package-level function cloning a slice of
+// messages, which allocates the clones of messages without pools in a single slice.
+func (p *clone) generateCloneSliceForMessage(message *protogen.Message) {
+	ccTypeName := message.GoIdent.GoName
+	p.P(`// `, ccTypeName, cloneSliceName, ` returns a slice holding a deep copy of each message of in, or nil`)
+	if p.ShouldPool(message) {
+		p.P(`// for its nil messages. The copies are taken from the pool of `, ccTypeName, `.`)
+	} else {
+		p.P(`// for its nil messages. The copies are allocated together, so that the memory of all`)
+		p.P(`// of them is held as long as one of them is referenced.`)
+	}
+	p.P(`func `, ccTypeName, cloneSliceName, `(in []*`, ccTypeName, `) []*`, ccTypeName, ` {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P(`out := make([]*`, ccTypeName, `, len(in))`)
+	if !p.ShouldPool(message) {
+		p.P(`clones := make([]`, ccTypeName, `, len(in))`)
+	}
+	p.P(`for i, m := range in {`)
+	p.P(`if m == nil {`)
+	p.P(`continue`)
+	p.P(`}`)
+	if p.ShouldPool(message) {
+		p.P(`r := `, ccTypeName, `FromVTPool()`)
+	} else {
+		p.P(`r := &clones[i]`)
+	}
+	p.fields(message)
+	p.P(`out[i] = r`)
+	p.P(`}`)
+	p.P(`return out`)
+	p.P(`}`)
+	p.P()
 }
 
 func (p *clone) bodyForOneOf(ccTypeName string, field *protogen.Field) {
@@ -243,7 +373,7 @@ func (p *clone) bodyForOneOf(ccTypeName string, field *protogen.Field) {
 	p.P("r", " := new(", ccTypeName, `)`)
 
 	// In oneof wrappers, scalar fields are never pointers (only messages and bytes are references)
-	// Don't use isReference() here as it checks HasPresence() which is wrong for Edition 2023 oneofs
+	// Don't use isReference() here, which is true for all the members of oneofs
 	fieldIsReference := field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind ||
 		field.Desc.Cardinality() == protoreflect.Repeated
 	if !fieldIsReference {
@@ -259,7 +389,7 @@ func (p *clone) bodyForOneOf(ccTypeName string, field *protogen.Field) {
 			p.P(`r.`, field.GoName, ` = (*`, field.Message.GoIdent, `)((*`, p.WellKnownTypeMap(field.Message), `)(m.`, field.GoName, `).`, cloneName, `())`)
 			p.P(`return r`)
 			return
-		case p.IsLocalMessage(field.Message):
+		case p.IsVTMessage(field.Message):
 			p.P(`r.`, field.GoName, ` = m.`, field.GoName, `.`, cloneName, `()`)
 			p.P(`return r`)
 			return
@@ -267,7 +397,7 @@ func (p *clone) bodyForOneOf(ccTypeName string, field *protogen.Field) {
 	}
 
 	// Generate explicit assignment statements for reference field.
-	p.cloneField("r", "m", false, field)
+	p.cloneField("r", "m", field)
 
 	p.P(`return r`)
 }
@@ -294,58 +424,57 @@ func (p *clone) generateCloneMethodsForOneof(message *protogen.Message, field *p
 
 func (p *clone) processMessageOneofs(message *protogen.Message) {
 	for _, field := range message.Fields {
-		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() || p.IsOpaque(message) {
 			continue
 		}
 		p.generateCloneMethodsForOneof(message, field)
 	}
 }
 
-func (p *clone) processMessage(proto3, proto2 bool, message *protogen.Message) {
+func (p *clone) processMessage(proto3 bool, message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.processMessage(proto3, proto2, nested)
+		p.processMessage(proto3, nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if p.SkipReason(message) != "" {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	// The standard protobuf library's generated Clone will be used instead.
-	if p.IsOpaque(message) {
+	p.once = true
+	if p.Override(message) {
 		return
 	}
 
-	p.once = true
-
-	p.generateCloneMethodsForMessage(proto2, message)
+	p.generateCloneMethodsForMessage(message)
 	p.processMessageOneofs(message)
 }
 
 // isReference checks whether the Go equivalent of the given field is of reference type, i.e., can be nil.
-func isReference(allFieldsNullable bool, field *protogen.Field) bool {
-	// Check for oneof fields - but respect HasPresence for the actual field value
-	if field.Oneof != nil {
-		// For non-synthetic oneofs, the field is inside a wrapper struct
-		// and should use HasPresence to determine if it's a pointer
-		if !field.Oneof.Desc.IsSynthetic() {
-			// Use HasPresence to determine if the field value is a pointer
-			if isScalar(field.Desc.Kind()) {
-				return field.Desc.HasPresence()
-			}
-		}
-		// For synthetic oneofs (proto3 optional), the field is always a pointer
+func (p *clone) isReference(field *protogen.Field) bool {
+	if field.Desc.Kind() == protoreflect.BytesKind {
 		return true
 	}
-	
-	if allFieldsNullable || field.Message != nil || field.Desc.Cardinality() == protoreflect.Repeated || field.Desc.Kind() == protoreflect.BytesKind {
+	// Message fields and scalar fields with explicit presence are pointers, and the
+	// members of oneofs are held by their wrapper.
+	if p.FieldPresence(field) != generator.PresenceImplicit {
 		return true
 	}
 	if !isScalar(field.Desc.Kind()) {
 		panic("unexpected non-reference, non-scalar field")
 	}
-	// Scalar fields with presence (e.g., proto3 optional, proto2, or edition EXPLICIT) are pointers
-	return field.Desc.HasPresence()
+	return false
+}
+
+// isImmutable returns true if the data of the given bytes field, or of the bytes values
+// of the given map field, is shared with the clones of its message instead of copied.
+func isImmutable(field *protogen.Field) bool {
+	kind := field.Desc.Kind()
+	if field.Desc.IsMap() {
+		kind = field.Desc.MapValue().Kind()
+	}
+	// Pooled data goes back to the pools when the message is reset, so it is never shared.
+	opts := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts)
+	return kind == protoreflect.BytesKind && opts.GetImmutable() && (field.Desc.IsMap() || !opts.GetPooledBytes())
 }
 
 func isScalar(kind protoreflect.Kind) bool {
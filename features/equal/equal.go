@@ -47,21 +47,21 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 		p.message(proto3, nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if p.SkipReason(message) != "" {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	// The standard protobuf library's generated Equal will be used instead.
-	if p.IsOpaque(message) {
+	p.once = true
+	if p.Override(message) {
 		return
 	}
 
-	p.once = true
-
 	ccTypeName := message.GoIdent.GoName
 	p.P(`func (this *`, ccTypeName, `) `, equalName, `(that *`, ccTypeName, `) bool {`)
 
+	// The HashVT methods of the hash feature are not compared first: the messages do
+	// not cache a hash, and computing one walks all of their fields, while the
+	// structural walk below returns at the first difference.
 	p.P(`if this == that {`)
 	p.P(`	return true`)
 	p.P(`} else if this == nil || that == nil {`)
@@ -86,6 +86,10 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 			}
 			oneofs[fieldname] = struct{}{}
 
+			if p.IsOpaque(message) {
+				p.opaqueOneof(field.Oneof)
+				continue
+			}
 			p.P(`if this.`, fieldname, ` == nil && that.`, fieldname, ` != nil {`)
 			p.P(`	return false`)
 			p.P(`} else if this.`, fieldname, ` != nil {`)
@@ -113,14 +117,9 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 
 	for _, field := range message.Fields {
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		// Determine if field is nullable (pointer type or nil-able slice):
-		// - Message fields are always pointers
-		// - Synthetic oneofs (proto3 optional) are pointers
-		// - Scalar fields with presence (proto2, proto3 optional, editions EXPLICIT) are pointers
-		// - Bytes fields with presence can be nil (distinguishes unset from empty)
-		nullable := field.Message != nil ||
-			(field.Oneof != nil && field.Oneof.Desc.IsSynthetic()) ||
-			(field.Desc.HasPresence() && !oneof)
+		// Message fields are always pointers, and the fields with explicit presence are
+		// pointers or bytes that can be nil (which distinguishes unset from empty).
+		nullable := field.Message != nil || p.FieldPresence(field) == generator.PresenceExplicit
 		if !oneof {
 			p.field(field, nullable)
 		}
@@ -146,13 +145,36 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 
 	for _, field := range message.Fields {
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		if !oneof {
+		if !oneof || p.IsOpaque(message) {
 			continue
 		}
 		p.oneof(field)
 	}
 }
 
+// opaqueOneof generates the comparison of oneof in opaque messages, whose members are
+// read with their getters since their wrappers are not exported.
+func (p *equal) opaqueOneof(oneof *protogen.Oneof) {
+	p.P(`if `, p.Which(`this`, oneof), ` != `, p.Which(`that`, oneof), ` {`)
+	p.P(`return false`)
+	p.P(`}`)
+	p.P(`switch `, p.Which(`this`, oneof), ` {`)
+	for _, field := range oneof.Fields {
+		p.P(`case `, p.OneofCase(field), `:`)
+		lhs, rhs := p.Get(`this`, field), p.Get(`that`, field)
+		kind := field.Desc.Kind()
+		switch {
+		case isScalar(kind):
+			p.compareScalar(lhs, rhs, false)
+		case kind == protoreflect.BytesKind:
+			p.compareBytes(lhs, rhs, false)
+		default:
+			p.compareCall(lhs, rhs, field.Message, false)
+		}
+	}
+	p.P(`}`)
+}
+
 func (p *equal) oneof(field *protogen.Field) {
 	ccTypeName := field.GoIdent.GoName
 	ccInterfaceName := fmt.Sprintf("is%s", field.Oneof.GoIdent.GoName)
@@ -206,6 +228,25 @@ func (p *equal) field(field *protogen.Field, nullable bool) {
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	lhs := fmt.Sprintf("this.%s", fieldname)
 	rhs := fmt.Sprintf("that.%s", fieldname)
+	if p.IsOpaque(field.Parent) {
+		lhs, rhs = p.Get(`this`, field), p.Get(`that`, field)
+		if nullable && field.Message == nil {
+			// The getters return the values of the fields with explicit presence, whose
+			// presence is compared first.
+			p.P(`if `, p.Has(`this`, field), ` != `, p.Has(`that`, field), ` {`)
+			p.P(`return false`)
+			p.P(`}`)
+			nullable = false
+		}
+	}
+
+	if repeated && field.Desc.IsList() && isScalar(field.Desc.Kind()) {
+		// Slices of scalars are compared by a single call instead of a loop.
+		p.P(`if !`, p.Ident("slices", "Equal"), `(`, lhs, `, `, rhs, `) {`)
+		p.P(`	return false`)
+		p.P(`}`)
+		return
+	}
 
 	if repeated {
 		p.P(`if len(`, lhs, `) != len(`, rhs, `) {`)
@@ -290,10 +331,14 @@ func (p *equal) compareCall(lhs, rhs string, msg *protogen.Message, nullable boo
 		p.P(`if !(*`, wkt, `)(`, lhs, `).`, equalName, `((*`, wkt, `)(`, rhs, `)) {`)
 		p.P(`	return false`)
 		p.P(`}`)
-	case p.IsLocalMessage(msg):
+	case p.IsVTMessage(msg):
 		p.P(`if !`, lhs, `.`, equalName, `(`, rhs, `) {`)
 		p.P(`	return false`)
 		p.P(`}`)
+	case p.Config.Registry:
+		p.P(`if !`, p.RegistryType(msg), `.`, equalName, `(`, lhs, `, `, rhs, `) {`)
+		p.P(`	return false`)
+		p.P(`}`)
 	default:
 		p.P(`if equal, ok := interface{}(`, lhs, `).(interface { `, equalName, `(*`, p.QualifiedGoIdent(msg.GoIdent), `) bool }); ok {`)
 		p.P(`	if !equal.`, equalName, `(`, rhs, `) {`)
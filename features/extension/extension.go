@@ -0,0 +1,188 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package extension
+
+import (
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func init() {
+	generator.RegisterFeature("extension", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &extension{GeneratedFile: gen}
+	})
+}
+
+type extension struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*extension)(nil)
+
+func (p *extension) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, ext := range file.Extensions {
+		p.extension(file, ext)
+	}
+	for _, message := range file.Messages {
+		p.message(file, message)
+	}
+	return p.once
+}
+
+func (p *extension) message(file *protogen.File, message *protogen.Message) {
+	for _, ext := range message.Extensions {
+		p.extension(file, ext)
+	}
+	for _, nested := range message.Messages {
+		p.message(file, nested)
+	}
+}
+
+// extension generates the typed accessor of ext if it has the fast_accessor option.
+// The accessor reads the extension storage of the extended message directly when the
+// message is declared in the same Go package, and calls proto.GetExtension otherwise.
+func (p *extension) extension(file *protogen.File, ext *protogen.Extension) {
+	if ext.Desc.IsList() || !proto.GetExtension(ext.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetFastAccessor() {
+		return
+	}
+	p.once = true
+
+	goType := p.goType(ext)
+	name := `Get` + ext.GoIdent.GoName + `VT`
+	// The extension types are declared by protoc-gen-go as E_ followed by the name of the
+	// extension.
+	xt := protogen.GoIdent{GoName: `E_` + ext.GoIdent.GoName, GoImportPath: ext.GoIdent.GoImportPath}
+	p.P(`// `, name, ` returns the value of the `, ext.Desc.Name(), ` extension of m, like`)
+	p.P(`// proto.GetExtension(m, `, xt.GoName, `) but without reflection.`)
+	p.P(`func `, name, `(m *`, ext.Extendee.GoIdent, `) `, goType, ` {`)
+	if ext.Extendee.GoIdent.GoImportPath != file.GoImportPath {
+		p.P(`return `, p.Ident(generator.ProtoPkg, "GetExtension"), `(m, `, xt, `).(`, goType, `)`)
+		p.P(`}`)
+		p.P()
+		return
+	}
+	p.P(`if m != nil {`)
+	p.P(`if f, ok := m.extensionFields[`, strconv.Itoa(int(ext.Desc.Number())), `]; ok && f.IsSet() {`)
+	p.P(`return `, p.value(ext, goType, `f.Value()`))
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`return `, p.defaultValue(ext))
+	p.P(`}`)
+	p.P()
+}
+
+// goType returns the Go type of the values of ext.
+func (p *extension) goType(ext *protogen.Extension) string {
+	switch ext.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return `*` + p.QualifiedGoIdent(ext.Message.GoIdent)
+	case protoreflect.EnumKind:
+		return p.QualifiedGoIdent(ext.Enum.GoIdent)
+	}
+	goType, _ := p.FieldGoType(ext)
+	return goType
+}
+
+// value returns the expression converting the protoreflect.Value v of ext to goType.
+func (p *extension) value(ext *protogen.Extension, goType, v string) string {
+	switch ext.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return v + `.Bool()`
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return `int32(` + v + `.Int())`
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v + `.Int()`
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return `uint32(` + v + `.Uint())`
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v + `.Uint()`
+	case protoreflect.FloatKind:
+		return `float32(` + v + `.Float())`
+	case protoreflect.DoubleKind:
+		return v + `.Float()`
+	case protoreflect.StringKind:
+		return v + `.String()`
+	case protoreflect.BytesKind:
+		return v + `.Bytes()`
+	case protoreflect.EnumKind:
+		return goType + `(` + v + `.Enum())`
+	default:
+		return v + `.Message().Interface().(` + goType + `)`
+	}
+}
+
+// defaultValue returns the expression of the value of ext when it is not set.
+func (p *extension) defaultValue(ext *protogen.Extension) string {
+	xd := ext.Desc
+	switch xd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return `nil`
+	case protoreflect.EnumKind:
+		number := xd.Enum().Values().Get(0).Number()
+		if xd.HasDefault() {
+			number = xd.DefaultEnumValue().Number()
+		}
+		for _, value := range ext.Enum.Values {
+			if value.Desc.Number() == number {
+				return p.QualifiedGoIdent(value.GoIdent)
+			}
+		}
+		return p.goType(ext) + `(` + strconv.Itoa(int(number)) + `)`
+	}
+	if !xd.HasDefault() {
+		switch xd.Kind() {
+		case protoreflect.BoolKind:
+			return `false`
+		case protoreflect.StringKind:
+			return `""`
+		case protoreflect.BytesKind:
+			return `nil`
+		}
+		return `0`
+	}
+
+	def := xd.Default()
+	switch xd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(def.Bool())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(def.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(def.Uint(), 10)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		f := def.Float()
+		var literal string
+		switch {
+		case math.IsInf(f, 1):
+			literal = p.Ident("math", "Inf") + `(1)`
+		case math.IsInf(f, -1):
+			literal = p.Ident("math", "Inf") + `(-1)`
+		case math.IsNaN(f):
+			literal = p.Ident("math", "NaN") + `()`
+		default:
+			literal = strconv.FormatFloat(f, 'g', -1, 64)
+		}
+		if xd.Kind() == protoreflect.FloatKind {
+			return `float32(` + literal + `)`
+		}
+		return literal
+	case protoreflect.StringKind:
+		return strconv.Quote(def.String())
+	default:
+		return `[]byte(` + strconv.Quote(string(def.Bytes())) + `)`
+	}
+}
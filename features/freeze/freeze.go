@@ -0,0 +1,54 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package freeze
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+// Package is the import path of the package backing the FreezeVT methods.
+const Package = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtfreeze")
+
+func init() {
+	generator.RegisterOptInFeature("freeze", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &freeze{GeneratedFile: gen}
+	})
+	generator.RegisterMessageFilter("freeze", generator.SkipOpaque)
+}
+
+type freeze struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*freeze)(nil)
+
+func (p *freeze) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+func (p *freeze) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.once = true
+	p.P(`func (m *`, message.GoIdent, `) FreezeVT() {`)
+	p.P(Package.Ident("Freeze"), `(m)`)
+	p.P(`}`)
+	p.P()
+}
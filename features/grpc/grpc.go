@@ -292,7 +292,7 @@ func genClientMethod(gen *protogen.Plugin, file *protogen.File, g *generator.Gen
 
 	if genSend {
 		g.P("func (x *", streamType, ") Send(m *", method.Input.GoIdent, ") error {")
-		g.P("return x.ClientStream.SendMsg(m)")
+		sendAndReturnToPool(g, "x.ClientStream", method.Input)
 		g.P("}")
 		g.P()
 	}
@@ -341,6 +341,7 @@ func genServerMethod(gen *protogen.Plugin, file *protogen.File, g *generator.Gen
 		g.P("func ", hname, "(srv interface{}, ctx ", contextPackage.Ident("Context"), ", dec func(interface{}) error, interceptor ", grpcPackage.Ident("UnaryServerInterceptor"), ") (interface{}, error) {")
 		// g.P("in := new(", method.Input.GoIdent, ")")
 		g.Alloc("in", method.Input, true)
+		deferReturnToPool(g, "in", method.Input)
 		g.P("if err := dec(in); err != nil { return nil, err }")
 		g.P("if interceptor == nil { return srv.(", service.GoName, "Server).", method.GoName, "(ctx, in) }")
 		g.P("info := &", grpcPackage.Ident("UnaryServerInfo"), "{")
@@ -360,6 +361,7 @@ func genServerMethod(gen *protogen.Plugin, file *protogen.File, g *generator.Gen
 	if !method.Desc.IsStreamingClient() {
 		// g.P("m := new(", method.Input.GoIdent, ")")
 		g.Alloc("m", method.Input, true)
+		deferReturnToPool(g, "m", method.Input)
 		g.P("if err := stream.RecvMsg(m); err != nil { return err }")
 		g.P("return srv.(", service.GoName, "Server).", method.GoName, "(m, &", streamType, "{stream})")
 	} else {
@@ -394,13 +396,13 @@ func genServerMethod(gen *protogen.Plugin, file *protogen.File, g *generator.Gen
 
 	if genSend {
 		g.P("func (x *", streamType, ") Send(m *", method.Output.GoIdent, ") error {")
-		g.P("return x.ServerStream.SendMsg(m)")
+		sendAndReturnToPool(g, "x.ServerStream", method.Output)
 		g.P("}")
 		g.P()
 	}
 	if genSendAndClose {
 		g.P("func (x *", streamType, ") SendAndClose(m *", method.Output.GoIdent, ") error {")
-		g.P("return x.ServerStream.SendMsg(m)")
+		sendAndReturnToPool(g, "x.ServerStream", method.Output)
 		g.P("}")
 		g.P()
 	}
@@ -417,6 +419,31 @@ func genServerMethod(gen *protogen.Plugin, file *protogen.File, g *generator.Gen
 	return hname
 }
 
+// returnsToPool returns true if the messages of type message received or sent by the
+// generated stubs are returned to their memory pool once the stubs are done with them.
+func returnsToPool(g *generator.GeneratedFile, message *protogen.Message) bool {
+	return g.Config.GRPCReturnToPool && g.ShouldPool(message)
+}
+
+// deferReturnToPool returns the request in varName to its pool once the handler returns.
+func deferReturnToPool(g *generator.GeneratedFile, varName string, message *protogen.Message) {
+	if returnsToPool(g, message) {
+		g.P("defer ", varName, ".ReturnToVTPool()")
+	}
+}
+
+// sendAndReturnToPool sends m on stream and returns it to its pool, since SendMsg has
+// encoded it once it returns.
+func sendAndReturnToPool(g *generator.GeneratedFile, stream string, message *protogen.Message) {
+	if !returnsToPool(g, message) {
+		g.P("return ", stream, ".SendMsg(m)")
+		return
+	}
+	g.P("err := ", stream, ".SendMsg(m)")
+	g.P("m.ReturnToVTPool()")
+	g.P("return err")
+}
+
 const deprecationComment = "// Deprecated: Do not use."
 
 func unexport(s string) string { return strings.ToLower(s[:1]) + s[1:] }
@@ -0,0 +1,178 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const vthashPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vthash")
+
+func init() {
+	generator.RegisterOptInFeature("hash", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &hash{GeneratedFile: gen}
+	})
+	generator.RegisterMessageFilter("hash", generator.SkipOpaque)
+}
+
+// hash generates the HashVT method, which hashes the contents of messages with the
+// helpers of vthash.
+type hash struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*hash)(nil)
+
+func (p *hash) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+// hashes returns true if message has a HashVT method generated in this invocation, which
+// is called directly for the messages holding it.
+func (p *hash) hashes(message *protogen.Message) bool {
+	return p.IsLocalMessage(message) && p.IsVTMessage(message) && !p.IsOpaque(message)
+}
+
+func (p *hash) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.once = true
+	if p.Override(message) {
+		return
+	}
+
+	p.P(`// HashVT returns the hash of the contents of m from seed, which is the same for the`)
+	p.P(`// messages that EqualVT reports as equal. The extensions of m are not hashed.`)
+	p.P(`func (m *`, message.GoIdent.GoName, `) HashVT(seed uint64) uint64 {`)
+	p.P(`if m == nil {`)
+	p.P(`return seed`)
+	p.P(`}`)
+	p.P(`h := seed`)
+	for _, field := range message.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if field == oneof.Fields[0] {
+				p.oneof(oneof)
+			}
+			continue
+		}
+		p.field(field)
+	}
+	if p.Config.HashUnknownFields && !p.ShouldIgnoreUnknownFields(message) {
+		p.P(`if len(m.unknownFields) > 0 {`)
+		p.P(`h = `, vthashPackage.Ident("Bytes"), `(h, 0, m.unknownFields)`)
+		p.P(`}`)
+	}
+	p.P(`return h`)
+	p.P(`}`)
+	p.P()
+}
+
+func (p *hash) field(field *protogen.Field) {
+	v := `m.` + field.GoName
+	switch p.FieldPresence(field) {
+	case generator.PresenceRepeated:
+		if field.Desc.IsMap() {
+			p.mapField(field, v)
+			return
+		}
+		p.P(`for _, x := range `, v, ` {`)
+		p.P(`h = `, p.value(`h`, field, `x`))
+		p.P(`}`)
+	case generator.PresenceExplicit:
+		p.P(`if `, v, ` != nil {`)
+		if field.Message == nil && field.Desc.Kind() != protoreflect.BytesKind {
+			v = `*` + v
+		}
+		p.P(`h = `, p.value(`h`, field, v))
+		p.P(`}`)
+	default:
+		p.P(`if `, p.nonZero(field.Desc.Kind(), v), ` {`)
+		p.P(`h = `, p.value(`h`, field, v))
+		p.P(`}`)
+	}
+}
+
+// nonZero returns the condition under which a field with implicit presence holding v is
+// hashed: its zero value is not, like it is not encoded.
+func (p *hash) nonZero(kind protoreflect.Kind, v string) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return `len(` + v + `) > 0`
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v + ` != nil`
+	}
+	return v + ` != 0`
+}
+
+// oneof generates the code hashing the member of oneof which is set.
+func (p *hash) oneof(oneof *protogen.Oneof) {
+	p.P(`switch x := m.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent.GoName, `:`)
+		p.P(`h = `, p.value(`h`, field, `x.`+field.GoName))
+	}
+	p.P(`}`)
+}
+
+// mapField generates the code hashing the entries of the map field in v, whose hashes are
+// summed so that they do not depend on the order of iteration.
+func (p *hash) mapField(field *protogen.Field, v string) {
+	key, val := field.Message.Fields[0], field.Message.Fields[1]
+	p.P(`if len(`, v, `) > 0 {`)
+	p.P(`var sum uint64`)
+	p.P(`for k, x := range `, v, ` {`)
+	p.P(`sum += `, p.value(p.value(`h`, key, `k`), val, `x`))
+	p.P(`}`)
+	p.P(`h = `, vthashPackage.Ident("Uint64"), `(h, `, field.Desc.Number(), `, sum)`)
+	p.P(`}`)
+}
+
+// value returns the expression mixing v, a value of the type of field, into the hash h.
+func (p *hash) value(h string, field *protogen.Field, v string) string {
+	num := strconv.Itoa(int(field.Desc.Number()))
+	call := func(fn, v string) string {
+		return p.QualifiedGoIdent(vthashPackage.Ident(fn)) + `(` + h + `, ` + num + `, ` + v + `)`
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return call("Bool", v)
+	case protoreflect.FloatKind:
+		return call("Float", v)
+	case protoreflect.DoubleKind:
+		return call("Double", v)
+	case protoreflect.StringKind:
+		return call("String", v)
+	case protoreflect.BytesKind:
+		return call("Bytes", v)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		from := p.QualifiedGoIdent(vthashPackage.Ident("Field")) + `(` + h + `, ` + num + `)`
+		if p.hashes(field.Message) {
+			return v + `.HashVT(` + from + `)`
+		}
+		return p.QualifiedGoIdent(vthashPackage.Ident("Message")) + `(` + from + `, ` + v + `)`
+	default:
+		return call("Uint64", `uint64(`+v+`)`)
+	}
+}
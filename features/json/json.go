@@ -0,0 +1,377 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/planetscale/vtprotobuf/generator"
+	"github.com/planetscale/vtprotobuf/vtjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	vtjsonPackage     = "github.com/planetscale/vtprotobuf/vtjson"
+	knownTypesPackage = "google.golang.org/protobuf/types/known/"
+)
+
+func init() {
+	generator.RegisterOptInFeature("json", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &codec{GeneratedFile: gen}
+	})
+	generator.RegisterMessageFilter("json", func(p *generator.GeneratedFile, message *protogen.Message) string {
+		if specialForms[message.Desc.FullName()] {
+			return generator.SkippedSpecial
+		}
+		return generator.SkipOpaque(p, message)
+	})
+}
+
+// codec generates the MarshalJSONVT and AppendJSONVT methods, which encode messages as
+// JSON like protojson.Marshal does with its default options, without whitespace, and the
+// UnmarshalJSONVT and DecodeJSONVT methods, which decode them like protojson.Unmarshal.
+type codec struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*codec)(nil)
+
+func (p *codec) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+// specialForms lists the well-known types that protojson encodes in a special form
+// rather than as JSON objects holding their fields.
+var specialForms = map[protoreflect.FullName]bool{
+	"google.protobuf.Any":         true,
+	"google.protobuf.Timestamp":   true,
+	"google.protobuf.Duration":    true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+	"google.protobuf.Struct":      true,
+	"google.protobuf.ListValue":   true,
+	"google.protobuf.Value":       true,
+	"google.protobuf.FieldMask":   true,
+	"google.protobuf.Empty":       true,
+}
+
+// appends returns true if message has an AppendJSONVT method generated in this invocation,
+// which is called directly for the messages holding it.
+func (p *codec) appends(message *protogen.Message) bool {
+	return p.IsLocalMessage(message) && p.IsVTMessage(message) && !p.IsOpaque(message) && !specialForms[message.Desc.FullName()]
+}
+
+// known returns true if message is a well-known type from the google.golang.org/protobuf
+// module, whose special form is encoded by the vtjson helpers for the common types.
+func (p *codec) known(message *protogen.Message) bool {
+	return specialForms[message.Desc.FullName()] && strings.HasPrefix(string(message.GoIdent.GoImportPath), knownTypesPackage)
+}
+
+func (p *codec) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.once = true
+	if p.Override(message) {
+		return
+	}
+	p.marshal(message)
+	p.unmarshal(message)
+}
+
+func (p *codec) marshal(message *protogen.Message) {
+	name := message.GoIdent.GoName
+	p.P(`// MarshalJSONVT returns the JSON encoding of m, as protojson.Marshal returns it with`)
+	p.P(`// the default options and without whitespace.`)
+	p.P(`func (m *`, name, `) MarshalJSONVT() ([]byte, error) {`)
+	p.P(`return m.AppendJSONVT(nil)`)
+	p.P(`}`)
+	p.P()
+	p.P(`// AppendJSONVT appends the JSON encoding of m to b, as MarshalJSONVT returns it.`)
+	p.P(`func (m *`, name, `) AppendJSONVT(b []byte) ([]byte, error) {`)
+	p.P(`if m == nil {`)
+	p.P(`return append(b, "{}"...), nil`)
+	p.P(`}`)
+	if message.Desc.ExtensionRanges().Len() > 0 {
+		// protojson encodes the extensions after the fields, sorted by name.
+		p.P(`if len(m.extensionFields) > 0 {`)
+		p.P(`return `, p.Ident(vtjsonPackage, "AppendProto"), `(b, m)`)
+		p.P(`}`)
+	}
+	if len(message.Fields) == 0 {
+		p.P(`return append(b, "{}"...), nil`)
+		p.P(`}`)
+		p.P()
+		return
+	}
+	p.P(`b = append(b, '{')`)
+	for _, field := range message.Fields {
+		if p.fails(field) {
+			p.P(`var err error`)
+			break
+		}
+	}
+	// The fields are encoded in the order of their declaration, the members of oneofs
+	// included.
+	for i, field := range message.Fields {
+		p.field(i == 0, field)
+	}
+	p.P(`return append(b, '}'), nil`)
+	p.P(`}`)
+	p.P()
+}
+
+// fails returns true if the encoding of the values of field may fail.
+func (p *codec) fails(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		return p.fails(field.Message.Fields[0]) || p.fails(field.Message.Fields[1])
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if !p.known(field.Message) {
+			return true
+		}
+		switch field.Message.Desc.Name() {
+		case "Empty":
+			return false
+		case "Timestamp", "Duration", "Any", "Struct", "ListValue", "Value", "FieldMask":
+			return true
+		}
+		// The wrappers fail like the value they hold.
+		return p.fails(field.Message.Fields[0])
+	}
+	return false
+}
+
+// field generates the code encoding field of the message m, if it is set. The first field
+// is never preceded by a comma.
+func (p *codec) field(first bool, field *protogen.Field) {
+	v := `m.` + field.GoName
+	switch p.FieldPresence(field) {
+	case generator.PresenceRepeated:
+		p.P(`if len(`, v, `) > 0 {`)
+		p.name(first, field)
+		if field.Desc.IsMap() {
+			p.mapField(field, v)
+		} else {
+			p.P(`b = append(b, '[')`)
+			p.P(`for i, x := range `, v, ` {`)
+			p.P(`if i > 0 {`)
+			p.P(`b = append(b, ',')`)
+			p.P(`}`)
+			p.value(field, field, `x`)
+			p.P(`}`)
+			p.P(`b = append(b, ']')`)
+		}
+		p.P(`}`)
+	case generator.PresenceOneof:
+		p.P(`if x, ok := m.`, field.Oneof.GoName, `.(*`, field.GoIdent.GoName, `); ok {`)
+		p.name(first, field)
+		p.value(field, field, `x.`+field.GoName)
+		p.P(`}`)
+	case generator.PresenceExplicit:
+		if field.Desc.Cardinality() == protoreflect.Required {
+			p.P(`if `, v, ` == nil {`)
+			p.P(`return nil, `, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.FullName(), ` not set")`)
+			p.P(`}`)
+			p.name(first, field)
+			p.value(field, field, p.deref(field, v))
+			return
+		}
+		p.P(`if `, v, ` != nil {`)
+		p.name(first, field)
+		p.value(field, field, p.deref(field, v))
+		p.P(`}`)
+	default:
+		p.P(`if `, p.nonZero(field.Desc.Kind(), v), ` {`)
+		p.name(first, field)
+		p.value(field, field, v)
+		p.P(`}`)
+	}
+}
+
+// name generates the code appending the JSON name of field, preceded by a comma unless it
+// is the first member of the object.
+func (p *codec) name(first bool, field *protogen.Field) {
+	if !first {
+		p.P(`if b[len(b)-1] != '{' {`)
+		p.P(`b = append(b, ',')`)
+		p.P(`}`)
+	}
+	// The names of the fields are valid UTF-8, as all the strings of descriptors.
+	key, _ := vtjson.AppendString(nil, field.Desc.JSONName())
+	lit := string(key) + ":"
+	if strconv.CanBackquote(lit) {
+		lit = "`" + lit + "`"
+	} else {
+		lit = strconv.Quote(lit)
+	}
+	p.P(`b = append(b, `, lit, `...)`)
+}
+
+// deref returns the expression of the value of the field with explicit presence in v.
+func (p *codec) deref(field *protogen.Field, v string) string {
+	if field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind {
+		return v
+	}
+	return `*` + v
+}
+
+// nonZero returns the condition under which a field with implicit presence holding v is
+// encoded, which is the one of proto.Marshal.
+func (p *codec) nonZero(kind protoreflect.Kind, v string) string {
+	switch kind {
+	case protoreflect.DoubleKind:
+		return p.Ident("math", "Float64bits") + `(float64(` + v + `)) != 0`
+	case protoreflect.FloatKind:
+		return p.Ident("math", "Float32bits") + `(float32(` + v + `)) != 0`
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return `len(` + v + `) > 0`
+	}
+	return v + ` != 0`
+}
+
+// mapField generates the code encoding the entries of the map field in v as an object,
+// sorted by key like protojson does.
+func (p *codec) mapField(field *protogen.Field, v string) {
+	key, val := field.Message.Fields[0], field.Message.Fields[1]
+	p.P(`b = append(b, '{')`)
+	if key.Desc.Kind() == protoreflect.BoolKind {
+		p.P(`for _, k := range [...]bool{false, true} {`)
+		p.P(`x, ok := `, v, `[k]`)
+		p.P(`if !ok {`)
+		p.P(`continue`)
+		p.P(`}`)
+	} else {
+		p.P(`for _, k := range `, p.Ident("slices", "Sorted"), `(`, p.Ident("maps", "Keys"), `(`, v, `)) {`)
+		p.P(`x := `, v, `[k]`)
+	}
+	p.P(`if b[len(b)-1] != '{' {`)
+	p.P(`b = append(b, ',')`)
+	p.P(`}`)
+	// The keys are strings, whatever their type.
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		p.scalar(field, protoreflect.StringKind, `k`)
+	default:
+		p.P(`b = append(b, '"')`)
+		p.scalar(field, key.Desc.Kind(), `k`)
+		p.P(`b = append(b, '"')`)
+	}
+	p.P(`b = append(b, ':')`)
+	p.value(field, val, `x`)
+	p.P(`}`)
+	p.P(`b = append(b, '}')`)
+}
+
+// value generates the code appending v, a value of the type of value, which is field
+// itself or the value of its map entries. The errors are reported for field.
+func (p *codec) value(field, value *protogen.Field, v string) {
+	switch kind := value.Desc.Kind(); kind {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		p.messageValue(field, value.Message, v)
+	case protoreflect.EnumKind:
+		if value.Enum.Desc.FullName() == "google.protobuf.NullValue" {
+			p.P(`b = append(b, "null"...)`)
+			return
+		}
+		names := p.QualifiedGoIdent(protogen.GoIdent{GoName: value.Enum.GoIdent.GoName + "_name", GoImportPath: value.Enum.GoIdent.GoImportPath})
+		// The values unknown to the enum are encoded as numbers.
+		p.P(`if name, ok := `, names, `[int32(`, v, `)]; ok {`)
+		p.P(`b = append(b, '"')`)
+		p.P(`b = append(b, name...)`)
+		p.P(`b = append(b, '"')`)
+		p.P(`} else {`)
+		p.P(`b = `, p.Ident("strconv", "AppendInt"), `(b, int64(`, v, `), 10)`)
+		p.P(`}`)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// Like protojson, the 64-bit integers are strings, which JavaScript decodes
+		// without losing precision.
+		p.P(`b = append(b, '"')`)
+		p.scalar(field, kind, v)
+		p.P(`b = append(b, '"')`)
+	default:
+		p.scalar(field, kind, v)
+	}
+}
+
+// scalar generates the code appending the scalar value v of the given kind, with the
+// 64-bit integers unquoted.
+func (p *codec) scalar(field *protogen.Field, kind protoreflect.Kind, v string) {
+	switch kind {
+	case protoreflect.BoolKind:
+		p.P(`b = `, p.Ident("strconv", "AppendBool"), `(b, `, v, `)`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.P(`b = `, p.Ident("strconv", "AppendInt"), `(b, int64(`, v, `), 10)`)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.P(`b = `, p.Ident("strconv", "AppendUint"), `(b, uint64(`, v, `), 10)`)
+	case protoreflect.FloatKind:
+		p.P(`b = `, p.Ident(vtjsonPackage, "AppendFloat"), `(b, float64(`, v, `), 32)`)
+	case protoreflect.DoubleKind:
+		p.P(`b = `, p.Ident(vtjsonPackage, "AppendFloat"), `(b, float64(`, v, `), 64)`)
+	case protoreflect.BytesKind:
+		p.P(`b = `, p.Ident(vtjsonPackage, "AppendBytes"), `(b, `, v, `)`)
+	case protoreflect.StringKind:
+		p.P(`if b, err = `, p.Ident(vtjsonPackage, "AppendString"), `(b, `, v, `); err != nil {`)
+		p.P(`return nil, `, p.Ident("fmt", "Errorf"), `("field `, field.Desc.FullName(), `: %w", err)`)
+		p.P(`}`)
+	}
+}
+
+// messageValue generates the code appending the message v, which may be nil for the
+// elements of repeated fields and the values of maps and oneofs, which protojson encodes
+// as empty messages.
+func (p *codec) messageValue(field *protogen.Field, message *protogen.Message, v string) {
+	call := func(fn string) {
+		p.P(`if b, err = `, fn, `; err != nil {`)
+		p.P(`return nil, err`)
+		p.P(`}`)
+	}
+	switch {
+	case p.appends(message):
+		call(v + `.AppendJSONVT(b)`)
+	case p.known(message):
+		switch message.Desc.Name() {
+		case "Timestamp", "Duration":
+			call(p.Ident(vtjsonPackage, "Append"+string(message.Desc.Name())) + `(b, ` + v + `)`)
+		case "Empty":
+			p.P(`b = append(b, "{}"...)`)
+		case "Any", "Struct", "ListValue", "Value", "FieldMask":
+			call(p.Ident(vtjsonPackage, "AppendProto") + `(b, ` + v + `)`)
+		default:
+			// The wrappers are encoded as the value they hold.
+			p.value(field, message.Fields[0], v+`.GetValue()`)
+		}
+	default:
+		call(p.Ident(vtjsonPackage, "AppendMessage") + `(b, ` + v + `)`)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/planetscale/vtprotobuf/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (p *codec) unmarshal(message *protogen.Message) {
+	name := message.GoIdent.GoName
+	p.P(`// UnmarshalJSONVT decodes the JSON encoding of a message held by data into m, like`)
+	p.P(`// protojson.Unmarshal does with the default options.`)
+	p.P(`func (m *`, name, `) UnmarshalJSONVT(data []byte) error {`)
+	p.P(`return `, p.Ident(vtjsonPackage, "UnmarshalOptions"), `{}.Unmarshal(data, m)`)
+	p.P(`}`)
+	p.P()
+	p.P(`// DecodeJSONVT decodes the next value of d into m, which is empty.`)
+	p.P(`func (m *`, name, `) DecodeJSONVT(d *`, p.Ident(vtjsonPackage, "Decoder"), `) {`)
+	extended := message.Desc.ExtensionRanges().Len() > 0
+	if extended {
+		p.P(`start := d.Offset()`)
+	}
+	// A bit per field and per oneof tracks the fields read, which protojson reads once.
+	oneofs := make(map[*protogen.Oneof]int)
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			oneofs[oneof] = len(message.Fields) + len(oneofs)
+		}
+	}
+	if bits := len(message.Fields) + len(oneofs); bits > 0 {
+		p.P(`var seen [`, (bits+63)/64, `]uint64`)
+	}
+	p.P(`for d.Object(); d.Next(); {`)
+	if len(message.Fields) == 0 && !extended {
+		p.P(`d.Name()`)
+		p.P(`d.Unknown()`)
+	} else {
+		p.P(`switch d.Name() {`)
+		for i, names := range p.names(message) {
+			if len(names) == 0 {
+				continue
+			}
+			field := message.Fields[i]
+			p.P(`case `, strings.Join(names, ", "), `:`)
+			guard := `!d.Once(seen[:], ` + strconv.Itoa(i) + `)`
+			if !p.nullable(field) {
+				guard += ` || d.Null()`
+			}
+			if index, ok := oneofs[field.Oneof]; ok {
+				guard += ` || !d.Oneof(seen[:], ` + strconv.Itoa(index) + `)`
+			}
+			p.P(`if `, guard, ` {`)
+			p.P(`break`)
+			p.P(`}`)
+			p.decodeField(field)
+		}
+		p.P(`default:`)
+		if extended {
+			p.P(`if d.IsExtension() {`)
+			p.P(`d.Rewind(start, m)`)
+			p.P(`return`)
+			p.P(`}`)
+		}
+		p.P(`d.Unknown()`)
+		p.P(`}`)
+	}
+	p.P(`}`)
+	for _, field := range message.Fields {
+		if field.Desc.Cardinality() == protoreflect.Required {
+			p.P(`if m.`, field.GoName, ` == nil {`)
+			p.P(`d.Missing("`, field.Desc.FullName(), `")`)
+			p.P(`}`)
+		}
+	}
+	p.P(`}`)
+	p.P()
+}
+
+// names returns the quoted names of the fields of message in JSON: their JSON name and
+// their proto name, which protojson both accepts and looks up in this order.
+func (p *codec) names(message *protogen.Message) [][]string {
+	names := make([][]string, len(message.Fields))
+	seen := make(map[string]bool)
+	for _, textName := range []bool{false, true} {
+		for i, field := range message.Fields {
+			name := field.Desc.JSONName()
+			if textName {
+				name = field.Desc.TextName()
+			}
+			if !seen[name] {
+				seen[name] = true
+				names[i] = append(names[i], strconv.Quote(name))
+			}
+		}
+	}
+	return names
+}
+
+// nullable returns true if field holds google.protobuf.Value or NullValue, for which
+// protojson decodes null as a value rather than leaving the field unset.
+func (p *codec) nullable(field *protogen.Field) bool {
+	if field.Message != nil {
+		return field.Message.Desc.FullName() == "google.protobuf.Value"
+	}
+	return field.Enum != nil && field.Enum.Desc.FullName() == "google.protobuf.NullValue"
+}
+
+// decodeField generates the code decoding the value of field into the message m.
+func (p *codec) decodeField(field *protogen.Field) {
+	v := `m.` + field.GoName
+	switch p.FieldPresence(field) {
+	case generator.PresenceRepeated:
+		if field.Desc.IsMap() {
+			p.decodeMap(field, v)
+			return
+		}
+		p.P(`for d.Array(); d.NextItem(); {`)
+		p.store(field, func(x string) {
+			p.P(v, ` = append(`, v, `, `, x, `)`)
+		})
+		p.P(`}`)
+	case generator.PresenceOneof:
+		p.store(field, func(x string) {
+			p.P(`m.`, field.Oneof.GoName, ` = &`, field.GoIdent.GoName, `{`, field.GoName, `: `, x, `}`)
+		})
+	case generator.PresenceExplicit:
+		p.store(field, func(x string) {
+			if _, pointer := p.FieldGoType(field); pointer {
+				p.P(`v := `, x)
+				x = `&v`
+			}
+			p.P(v, ` = `, x)
+		})
+	default:
+		p.store(field, func(x string) {
+			p.P(v, ` = `, x)
+		})
+	}
+}
+
+// decodeMap generates the code decoding the entries of the map field into v, which
+// protojson reads once per key.
+func (p *codec) decodeMap(field *protogen.Field, v string) {
+	key, val := field.Message.Fields[0], field.Message.Fields[1]
+	typ, _ := p.FieldGoType(field)
+	p.P(v, ` = make(`, typ, `)`)
+	p.P(`for d.Object(); d.Next(); {`)
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		p.P(`k := d.ReadStringKey()`)
+	case protoreflect.BoolKind:
+		p.P(`k := d.ReadBoolKey()`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		p.P(`k := d.ReadInt32Key()`)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.P(`k := d.ReadInt64Key()`)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		p.P(`k := d.ReadUint32Key()`)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.P(`k := d.ReadUint64Key()`)
+	}
+	p.P(`if _, ok := `, v, `[k]; ok {`)
+	p.P(`d.Duplicate()`)
+	p.P(`break`)
+	p.P(`}`)
+	p.store(val, func(x string) {
+		p.P(v, `[k] = `, x)
+	})
+	p.P(`}`)
+}
+
+// store generates the code reading a value of the type of value, which is a field or the
+// value of its map entries, and storing it as set generates it. The enum values whose
+// names are unknown are not stored, when they are discarded.
+func (p *codec) store(value *protogen.Field, set func(x string)) {
+	switch kind := value.Desc.Kind(); kind {
+	case protoreflect.EnumKind:
+		if value.Enum.Desc.FullName() == "google.protobuf.NullValue" {
+			p.P(`if n, ok := d.ReadNullValue(); ok {`)
+		} else {
+			names := p.QualifiedGoIdent(protogen.GoIdent{GoName: value.Enum.GoIdent.GoName + "_value", GoImportPath: value.Enum.GoIdent.GoImportPath})
+			p.P(`if n, ok := d.ReadEnum(`, names, `); ok {`)
+		}
+		set(p.QualifiedGoIdent(value.Enum.GoIdent) + `(n)`)
+		p.P(`}`)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		set(p.decodeMessage(value.Message))
+	default:
+		set(p.read(kind))
+	}
+}
+
+// read returns the expression reading a scalar value of the given kind.
+func (p *codec) read(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return `d.ReadBool()`
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return `d.ReadInt32()`
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return `d.ReadInt64()`
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return `d.ReadUint32()`
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return `d.ReadUint64()`
+	case protoreflect.FloatKind:
+		return `d.ReadFloat()`
+	case protoreflect.DoubleKind:
+		return `d.ReadDouble()`
+	case protoreflect.StringKind:
+		return `d.ReadString()`
+	default:
+		return `d.ReadBytes()`
+	}
+}
+
+// decodeMessage generates the code decoding a message and returns its expression.
+func (p *codec) decodeMessage(message *protogen.Message) string {
+	ident := p.QualifiedGoIdent(message.GoIdent)
+	switch {
+	case p.appends(message):
+		p.P(`x := &`, ident, `{}`)
+		p.P(`x.DecodeJSONVT(d)`)
+		return `x`
+	case p.known(message):
+		switch message.Desc.Name() {
+		case "Timestamp", "Duration", "Empty":
+			return `d.Read` + string(message.Desc.Name()) + `()`
+		case "Any", "Struct", "ListValue", "Value", "FieldMask":
+			p.P(`x := &`, ident, `{}`)
+			p.P(`d.ReadProto(x)`)
+			return `x`
+		default:
+			// The wrappers are decoded from the value they hold.
+			return `&` + ident + `{Value: ` + p.read(message.Fields[0].Desc.Kind()) + `}`
+		}
+	default:
+		p.P(`x := &`, ident, `{}`)
+		p.P(`d.ReadMessage(x)`)
+		return `x`
+	}
+}
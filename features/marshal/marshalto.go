@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/planetscale/vtprotobuf/features/freeze"
 	"github.com/planetscale/vtprotobuf/generator"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/encoding/protowire"
@@ -24,8 +25,13 @@ func init() {
 	generator.RegisterFeature("marshal_strict", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &marshal{GeneratedFile: gen, Stable: false, strict: true}
 	})
+	generator.RegisterOptInFeature("marshal_deterministic", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &marshal{GeneratedFile: gen, Stable: true, strict: false}
+	})
 }
 
+const vtbufPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtbuf")
+
 type counter int
 
 func (cnt *counter) Next() string {
@@ -65,6 +71,9 @@ func (p *marshal) encodeVarint(varName ...string) {
 	p.P(`i = `, p.Helper("EncodeVarint"), `(dAtA, i, uint64(`, strings.Join(varName, ""), `))`)
 }
 
+// encodeKey writes the key of a field, whose varint bytes are computed at generation time
+// and stored one by one, so no varint is encoded for keys when marshaling. Writing keys of
+// several bytes with a single 16 or 32-bit store was not faster.
 func (p *marshal) encodeKey(fieldNumber protoreflect.FieldNumber, wireType protowire.Type) {
 	x := uint32(fieldNumber)<<3 | uint32(wireType)
 	i := 0
@@ -108,23 +117,73 @@ func (p *marshal) mapField(kvField *protogen.Field, varName string) {
 	case protoreflect.Sint64Kind:
 		p.encodeVarint(`(uint64(`, varName, `) << 1) ^ uint64((`, varName, ` >> 63))`)
 	case protoreflect.MessageKind:
+		p.checkEmptyRequired(varName, kvField.Message)
 		p.marshalBackward(varName, true, kvField.Message)
 	}
 }
 
+// checkEmptyRequired makes the strict marshal methods fail if the message in varName is
+// nil and message has required fields, which proto.Marshal checks on the empty message
+// encoded in its place. varName is empty when the message is known to be nil.
+func (p *marshal) checkEmptyRequired(varName string, message *protogen.Message) {
+	if !p.strict || !p.IsVTMessage(message) || !hasRequired(message) {
+		return
+	}
+	if varName != "" {
+		p.P(`if `, varName, ` == nil {`)
+	}
+	p.P(`if _, err := (&`, message.GoIdent, `{}).`, p.methodMarshalToSizedBuffer(), `(nil); err != nil {`)
+	p.P(`return 0, err`)
+	p.P(`}`)
+	if varName != "" {
+		p.P(`}`)
+	}
+}
+
+func hasRequired(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if field.Desc.Cardinality() == protoreflect.Required {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUTF8 makes the marshal method fail if the string in varName is not valid UTF-8,
+// when field is validated on marshal. value is the field holding the string, which is field
+// itself or the key or value field of its entries for maps.
+func (p *marshal) validateUTF8(field, value *protogen.Field, varName string) {
+	if !p.ValidateUTF8OnMarshal(field, value) {
+		return
+	}
+	p.P(`if err := `, p.Helper("ValidateUTF8String"), `(`, varName, `); err != nil {`)
+	p.P(`return 0, `, p.Ident("fmt", "Errorf"), `("field `, field.Desc.FullName(), `: %w", err)`)
+	p.P(`}`)
+}
+
 func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 	fieldname := field.GoName
-	nullable := field.Message != nil || (!oneof && field.Desc.HasPresence())
+	// The fields with the explicit_presence option are encoded even when they hold their
+	// zero value, like the scalar members of oneofs.
+	oneof = oneof || p.ExplicitPresence(field)
+	nullable := field.Message != nil || p.FieldPresence(field) == generator.PresenceExplicit
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
+	// v is the value of the field, pv the value of the fields with explicit presence,
+	// which are pointers unless they are read with the getters of opaque messages.
+	v, pv, present, absent := `m.`+fieldname, `*m.`+fieldname, `m.`+fieldname+` != nil`, `m.`+fieldname+` == nil`
+	if p.IsOpaque(field.Parent) {
+		v = p.Get(`m`, field)
+		pv, present, absent = v, p.Has(`m`, field), `!`+p.Has(`m`, field)
+	}
 	if repeated {
-		p.P(`if len(m.`, fieldname, `) > 0 {`)
+		p.P(`if len(`, v, `) > 0 {`)
 	} else if nullable {
 		if field.Desc.Cardinality() == protoreflect.Required {
-			p.P(`if m.`, fieldname, ` == nil {`)
+			p.P(`if `, absent, ` {`)
 			p.P(`return 0, `, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
 			p.P(`} else {`)
 		} else {
-			p.P(`if m.`, fieldname, ` != nil {`)
+			p.P(`if `, present, ` {`)
 		}
 	}
 	packed := field.Desc.IsPacked()
@@ -136,54 +195,55 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float64bits"), `(float64(`, val, `))`)
 			p.encodeFixed64("f", numGen.Current())
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 8`)
+			p.encodeVarint(`len(`, v, `) * 8`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float64bits"), `(float64(`, val, `))`)
 			p.encodeFixed64("f", numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(*m.`+fieldname, `))`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(`, pv, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(m.`, fieldname, `))`)
+			// Compare the bits rather than the value so that -0 is marshaled, like proto.Marshal does.
+			p.P(`if `, p.Ident("math", "Float64bits"), `(float64(`, v, `)) != 0 {`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(`, v, `))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(m.`+fieldname, `))`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(`, v, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.FloatKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float32bits"), `(float32(`, val, `))`)
 			p.encodeFixed32("f" + numGen.Current())
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 4`)
+			p.encodeVarint(`len(`, v, `) * 4`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float32bits"), `(float32(`, val, `))`)
 			p.encodeFixed32("f" + numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(*m.`+fieldname, `))`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(`, pv, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(m.`+fieldname, `))`)
+			p.P(`if `, p.Ident("math", "Float32bits"), `(float32(`, v, `)) != 0 {`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(`, v, `))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(m.`+fieldname, `))`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(`, v, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.EnumKind:
@@ -192,7 +252,7 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, v, ` {`)
 			p.P(total, ` += `, p.Helper("SizeOfVarint"), `(uint64(num))`)
 			p.P(`}`)
 
@@ -201,10 +261,10 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 
 			switch field.Desc.Kind() {
 			case protoreflect.Int64Kind, protoreflect.Int32Kind, protoreflect.EnumKind:
-				p.P(`for _, num1 := range m.`, fieldname, ` {`)
+				p.P(`for _, num1 := range `, v, ` {`)
 				p.P(`num := uint64(num1)`)
 			default:
-				p.P(`for _, num := range m.`, fieldname, ` {`)
+				p.P(`for _, num := range `, v, ` {`)
 			}
 			p.P(`for num >= 1<<7 {`)
 			p.P(`dAtA[`, jvar, `] = uint8(uint64(num)&0x7f|0x80)`)
@@ -218,73 +278,73 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.encodeVarint(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`*m.`, fieldname)
+			p.encodeVarint(pv)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`m.`, fieldname)
+			p.P(`if `, v, ` != 0 {`)
+			p.encodeVarint(v)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`m.`, fieldname)
+			p.encodeVarint(v)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.encodeFixed64(val)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 8`)
+			p.encodeVarint(`len(`, v, `) * 8`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.encodeFixed64(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed64("*m.", fieldname)
+			p.encodeFixed64(pv)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed64("m.", fieldname)
+			p.P(`if `, v, ` != 0 {`)
+			p.encodeFixed64(v)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed64("m.", fieldname)
+			p.encodeFixed64(v)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.encodeFixed32(val)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 4`)
+			p.encodeVarint(`len(`, v, `) * 4`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.encodeFixed32(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed32("*m." + fieldname)
+			p.encodeFixed32(pv)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed32("m." + fieldname)
+			p.P(`if `, v, ` != 0 {`)
+			p.encodeFixed32(v)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed32("m." + fieldname)
+			p.encodeFixed32(v)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.BoolKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`i--`)
 			p.P(`if `, val, ` {`)
 			p.P(`dAtA[i] = 1`)
@@ -292,10 +352,10 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.P(`dAtA[i] = 0`)
 			p.P(`}`)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.encodeVarint(`len(`, v, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`i--`)
 			p.P(`if `, val, ` {`)
 			p.P(`dAtA[i] = 1`)
@@ -306,16 +366,16 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.P(`}`)
 		} else if nullable {
 			p.P(`i--`)
-			p.P(`if *m.`, fieldname, ` {`)
+			p.P(`if `, pv, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
 			p.P(`}`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, v, ` {`)
 			p.P(`i--`)
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, v, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
@@ -324,7 +384,7 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.P(`}`)
 		} else {
 			p.P(`i--`)
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, v, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
@@ -333,34 +393,44 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 		}
 	case protoreflect.StringKind:
 		if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
+			p.validateUTF8(field, field, val)
 			p.P(`i -= len(`, val, `)`)
 			p.P(`copy(dAtA[i:], `, val, `)`)
 			p.encodeVarint(`len(`, val, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`i -= len(*m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], *m.`, fieldname, `)`)
-			p.encodeVarint(`len(*m.`, fieldname, `)`)
+			p.validateUTF8(field, field, pv)
+			p.P(`i -= len(`, pv, `)`)
+			p.P(`copy(dAtA[i:], `, pv, `)`)
+			p.encodeVarint(`len(`, pv, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if len(m.`, fieldname, `) > 0 {`)
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.P(`if len(`, v, `) > 0 {`)
+			p.validateUTF8(field, field, v)
+			p.P(`i -= len(`, v, `)`)
+			p.P(`copy(dAtA[i:], `, v, `)`)
+			p.encodeVarint(`len(`, v, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.validateUTF8(field, field, v)
+			p.P(`i -= len(`, v, `)`)
+			p.P(`copy(dAtA[i:], `, v, `)`)
+			p.encodeVarint(`len(`, v, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.GroupKind:
+		if repeated {
+			v = p.reverseListRange(v)
+		}
 		p.encodeKey(fieldNumber, protowire.EndGroupType)
-		p.marshalBackward(`m.`+fieldname, false, field.Message)
+		p.marshalBackward(v, false, field.Message)
 		p.encodeKey(fieldNumber, protowire.StartGroupType)
+		if repeated {
+			p.P(`}`)
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			goTypK, _ := p.FieldGoType(field.Message.Fields[0])
@@ -368,64 +438,72 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			valKind := field.Message.Fields[1].Desc.Kind()
 
 			var val string
-			if p.Stable && keyKind != protoreflect.BoolKind {
+			if p.Stable {
+				// Entries are marshaled in the order of their keys, like proto.Marshal does
+				// with the Deterministic option.
 				keysName := `keysFor` + fieldname
-				p.P(keysName, ` := make([]`, goTypK, `, 0, len(m.`, fieldname, `))`)
-				p.P(`for k := range m.`, fieldname, ` {`)
+				p.P(keysName, ` := make([]`, goTypK, `, 0, len(`, v, `))`)
+				p.P(`for k := range `, v, ` {`)
 				p.P(keysName, ` = append(`, keysName, `, `, goTypK, `(k))`)
 				p.P(`}`)
 				p.P(p.Ident("sort", "Slice"), `(`, keysName, `, func(i, j int) bool {`)
-				p.P(`return `, keysName, `[i] < `, keysName, `[j]`)
+				if keyKind == protoreflect.BoolKind {
+					p.P(`return !`, keysName, `[i] && `, keysName, `[j]`)
+				} else {
+					p.P(`return `, keysName, `[i] < `, keysName, `[j]`)
+				}
 				p.P(`})`)
 				val = p.reverseListRange(keysName)
 			} else {
-				p.P(`for k := range m.`, fieldname, ` {`)
+				p.P(`for k := range `, v, ` {`)
 				val = "k"
 			}
 			if p.Stable {
-				p.P(`v := m.`, fieldname, `[`, goTypK, `(`, val, `)]`)
+				p.P(`v := `, v, `[`, goTypK, `(`, val, `)]`)
 			} else {
-				p.P(`v := m.`, fieldname, `[`, val, `]`)
+				p.P(`v := `, v, `[`, val, `]`)
 			}
 			p.P(`baseI := i`)
 
 			accessor := `v`
+			p.validateUTF8(field, field.Message.Fields[1], accessor)
 			p.mapField(field.Message.Fields[1], accessor)
 			p.encodeKey(2, generator.ProtoWireType(valKind))
 
+			p.validateUTF8(field, field.Message.Fields[0], val)
 			p.mapField(field.Message.Fields[0], val)
 			p.encodeKey(1, generator.ProtoWireType(keyKind))
 			p.encodeVarint(`baseI - i`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.marshalBackward(val, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.marshalBackward(`m.`+fieldname, true, field.Message)
+			p.marshalBackward(v, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.BytesKind:
 		if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`i -= len(`, val, `)`)
 			p.P(`copy(dAtA[i:], `, val, `)`)
 			p.encodeVarint(`len(`, val, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
-		} else if !oneof && !field.Desc.HasPresence() {
-			p.P(`if len(m.`, fieldname, `) > 0 {`)
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+		} else if !oneof && p.FieldPresence(field) == generator.PresenceImplicit {
+			p.P(`if len(`, v, `) > 0 {`)
+			p.P(`i -= len(`, v, `)`)
+			p.P(`copy(dAtA[i:], `, v, `)`)
+			p.encodeVarint(`len(`, v, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.P(`i -= len(`, v, `)`)
+			p.P(`copy(dAtA[i:], `, v, `)`)
+			p.encodeVarint(`len(`, v, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Sint32Kind:
@@ -434,13 +512,13 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, v, ` {`)
 			p.P(total, ` += `, p.Helper("SizeOfZigzag"), `(uint64(num))`)
 			p.P(`}`)
 			p.P(`i -= `, total)
 			p.P(jvar, `:= i`)
 
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, v, ` {`)
 			xvar := "x" + numGen.Next()
 			p.P(xvar, ` := (uint32(num) << 1) ^ uint32((num >> 31))`)
 			p.P(`for `, xvar, ` >= 1<<7 {`)
@@ -455,21 +533,21 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`x`, numGen.Next(), ` := (uint32(`, val, `) << 1) ^ uint32((`, val, ` >> 31))`)
 			p.encodeVarint(`x`, numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`(uint32(*m.`, fieldname, `) << 1) ^ uint32((*m.`, fieldname, ` >> 31))`)
+			p.encodeVarint(`(uint32(`, pv, `) << 1) ^ uint32((`, pv, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`(uint32(m.`, fieldname, `) << 1) ^ uint32((m.`, fieldname, ` >> 31))`)
+			p.P(`if `, v, ` != 0 {`)
+			p.encodeVarint(`(uint32(`, v, `) << 1) ^ uint32((`, v, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`(uint32(m.`, fieldname, `) << 1) ^ uint32((m.`, fieldname, ` >> 31))`)
+			p.encodeVarint(`(uint32(`, v, `) << 1) ^ uint32((`, v, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Sint64Kind:
@@ -478,13 +556,13 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, v, ` {`)
 			p.P(total, ` += `, p.Helper("SizeOfZigzag"), `(uint64(num))`)
 			p.P(`}`)
 			p.P(`i -= `, total)
 			p.P(jvar, `:= i`)
 
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, v, ` {`)
 			xvar := "x" + numGen.Next()
 			p.P(xvar, ` := (uint64(num) << 1) ^ uint64((num >> 63))`)
 			p.P(`for `, xvar, ` >= 1<<7 {`)
@@ -499,21 +577,21 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(v)
 			p.P(`x`, numGen.Next(), ` := (uint64(`, val, `) << 1) ^ uint64((`, val, ` >> 63))`)
 			p.encodeVarint("x" + numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`(uint64(*m.`, fieldname, `) << 1) ^ uint64((*m.`, fieldname, ` >> 63))`)
+			p.encodeVarint(`(uint64(`, pv, `) << 1) ^ uint64((`, pv, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`(uint64(m.`, fieldname, `) << 1) ^ uint64((m.`, fieldname, ` >> 63))`)
+			p.P(`if `, v, ` != 0 {`)
+			p.encodeVarint(`(uint64(`, v, `) << 1) ^ uint64((`, v, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`(uint64(m.`, fieldname, `) << 1) ^ uint64((m.`, fieldname, ` >> 63))`)
+			p.encodeVarint(`(uint64(`, v, `) << 1) ^ uint64((`, v, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	default:
@@ -523,9 +601,16 @@ func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field) {
 	// See https://github.com/planetscale/vtprotobuf/issues/61
 	if oneof && field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsMap() && !field.Desc.IsList() {
 		p.P("} else {")
+		p.checkEmptyRequired("", field.Message)
 		p.P("i = protohelpers.EncodeVarint(dAtA, i, 0)")
 		p.encodeKey(fieldNumber, wireType)
 		p.P("}")
+	} else if oneof && field.Desc.Kind() == protoreflect.GroupKind {
+		p.P("} else {")
+		p.checkEmptyRequired("", field.Message)
+		p.encodeKey(fieldNumber, protowire.EndGroupType)
+		p.encodeKey(fieldNumber, protowire.StartGroupType)
+		p.P("}")
 	} else if repeated || nullable {
 		p.P(`}`)
 	}
@@ -535,6 +620,8 @@ func (p *marshal) methodMarshalToSizedBuffer() string {
 	switch {
 	case p.strict:
 		return "MarshalToSizedBufferVTStrict"
+	case p.Stable:
+		return "MarshalToSizedBufferVTDeterministic"
 	default:
 		return "MarshalToSizedBufferVT"
 	}
@@ -544,6 +631,8 @@ func (p *marshal) methodMarshalTo() string {
 	switch {
 	case p.strict:
 		return "MarshalToVTStrict"
+	case p.Stable:
+		return "MarshalToVTDeterministic"
 	default:
 		return "MarshalToVT"
 	}
@@ -553,6 +642,8 @@ func (p *marshal) methodMarshal() string {
 	switch {
 	case p.strict:
 		return "MarshalVTStrict"
+	case p.Stable:
+		return "MarshalVTDeterministic"
 	default:
 		return "MarshalVT"
 	}
@@ -563,18 +654,15 @@ func (p *marshal) message(message *protogen.Message) {
 		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if p.SkipReason(message) != "" {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	// The standard protobuf library's generated Marshal will be used instead.
-	if p.IsOpaque(message) {
+	p.once = true
+	if p.Override(message) {
 		return
 	}
 
-	p.once = true
-
 	var numGen counter
 	ccTypeName := message.GoIdent.GoName
 
@@ -582,7 +670,8 @@ func (p *marshal) message(message *protogen.Message) {
 	p.P(`if m == nil {`)
 	p.P(`return nil, nil`)
 	p.P(`}`)
-	p.P(`size := m.SizeVT()`)
+	p.checkCycles(message, "nil")
+	p.size(message)
 	p.P(`dAtA = make([]byte, size)`)
 	p.P(`n, err := m.`, p.methodMarshalToSizedBuffer(), `(dAtA[:size])`)
 	p.P(`if err != nil {`)
@@ -591,8 +680,25 @@ func (p *marshal) message(message *protogen.Message) {
 	p.P(`return dAtA[:n], nil`)
 	p.P(`}`)
 	p.P(``)
+	if !p.strict && !p.Stable && p.ShouldMarshalBuffer(message) {
+		p.P(`func (m *`, ccTypeName, `) MarshalVTBuffer() (*`, vtbufPackage.Ident("Buffer"), `, error) {`)
+		p.P(`if m == nil {`)
+		p.P(`return nil, nil`)
+		p.P(`}`)
+		p.checkCycles(message, "nil")
+		p.size(message)
+		p.P(`buf := `, vtbufPackage.Ident("Get"), `(size)`)
+		p.P(`if _, err := m.`, p.methodMarshalToSizedBuffer(), `(buf.Bytes()); err != nil {`)
+		p.P(`buf.Release()`)
+		p.P(`return nil, err`)
+		p.P(`}`)
+		p.P(`return buf, nil`)
+		p.P(`}`)
+		p.P(``)
+	}
 	p.P(`func (m *`, ccTypeName, `) `, p.methodMarshalTo(), `(dAtA []byte) (int, error) {`)
-	p.P(`size := m.SizeVT()`)
+	p.checkCycles(message, "0")
+	p.size(message)
 	p.P(`return m.`, p.methodMarshalToSizedBuffer(), `(dAtA[:size])`)
 	p.P(`}`)
 	p.P(``)
@@ -600,6 +706,11 @@ func (p *marshal) message(message *protogen.Message) {
 	p.P(`if m == nil {`)
 	p.P(`return 0, nil`)
 	p.P(`}`)
+	if p.HasFeature("freeze") && !p.Wrapper() {
+		p.P(`if `, freeze.Package.Ident("Enabled"), ` {`)
+		p.P(freeze.Package.Ident("Check"), `(m)`)
+		p.P(`}`)
+	}
 	p.P(`i := len(dAtA)`)
 	p.P(`_ = i`)
 	p.P(`var l int`)
@@ -628,11 +739,40 @@ func (p *marshal) message(message *protogen.Message) {
 	}
 
 	if p.strict {
+		// Fields of large oneofs are interleaved with the other fields, so find the one
+		// that is set once instead of testing for each of them at its position.
+		switched := make(map[*protogen.Oneof]string)
+		if !p.IsWellKnownType(message) && !p.IsOpaque(message) {
+			for _, oneof := range message.Oneofs {
+				if !p.SwitchOneof(oneof) {
+					continue
+				}
+				varName := "which" + oneof.GoName
+				p.P(`var `, varName, ` int32`)
+				p.P(`switch m.`, oneof.GoName, `.(type) {`)
+				for _, f := range oneof.Fields {
+					p.P(`case *`, f.GoIdent.GoName, `:`)
+					p.P(varName, ` = `, f.Desc.Number())
+				}
+				p.P(`}`)
+				switched[oneof] = varName
+			}
+		}
+
 		for i := len(message.Fields) - 1; i >= 0; i-- {
 			field := message.Fields[i]
 			oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 			if !oneof {
 				p.field(false, &numGen, field)
+			} else if p.IsOpaque(message) {
+				p.P(`if `, p.Which(`m`, field.Oneof), ` == `, p.OneofCase(field), ` {`)
+				p.field(true, &numGen, field)
+				p.P(`}`)
+			} else if varName, ok := switched[field.Oneof]; ok {
+				p.P(`if `, varName, ` == `, field.Desc.Number(), ` {`)
+				p.P(`msg := m.`, field.Oneof.GoName, `.(*`, field.GoIdent.GoName, `)`)
+				marshalForwardOneOf("msg")
+				p.P(`}`)
 			} else {
 				if p.IsWellKnownType(message) {
 					p.P(`if m, ok := m.`, field.Oneof.GoName, `.(*`, field.GoIdent, `); ok {`)
@@ -658,13 +798,29 @@ func (p *marshal) message(message *protogen.Message) {
 					continue
 				}
 				oneofs[fieldname] = struct{}{}
-				if p.IsWellKnownType(message) {
+				if p.IsOpaque(message) {
+					// The members of the oneofs of opaque messages are read with their
+					// getters, since their wrappers are not exported.
+					p.P(`switch `, p.Which(`m`, field.Oneof), ` {`)
+					for _, f := range field.Oneof.Fields {
+						p.P(`case `, p.OneofCase(f), `:`)
+						p.field(true, &numGen, f)
+					}
+					p.P(`}`)
+				} else if p.IsWellKnownType(message) {
 					p.P(`switch c := m.`, fieldname, `.(type) {`)
 					for _, f := range field.Oneof.Fields {
 						p.P(`case *`, f.GoIdent, `:`)
 						marshalForwardOneOf(`(*`, p.WellKnownFieldMap(f), `)(c)`)
 					}
 					p.P(`}`)
+				} else if p.SwitchOneof(field.Oneof) {
+					p.P(`switch c := m.`, fieldname, `.(type) {`)
+					for _, f := range field.Oneof.Fields {
+						p.P(`case *`, f.GoIdent.GoName, `:`)
+						marshalForwardOneOf("c")
+					}
+					p.P(`}`)
 				} else {
 					p.P(`if vtmsg, ok := m.`, fieldname, `.(interface{`)
 					p.P(p.methodMarshalToSizedBuffer(), ` ([]byte) (int, error)`)
@@ -690,7 +846,7 @@ func (p *marshal) message(message *protogen.Message) {
 
 	// Generate MarshalToVT methods for oneof fields
 	for _, field := range message.Fields {
-		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() || p.IsOpaque(message) {
 			continue
 		}
 		ccTypeName := field.GoIdent.GoName
@@ -707,6 +863,29 @@ func (p *marshal) message(message *protogen.Message) {
 	}
 }
 
+// size generates the declaration of the size of message, computed with SizeVTCached
+// if the message uses the size cache.
+func (p *marshal) size(message *protogen.Message) {
+	if !p.ShouldSizeCache(message) || p.IsWellKnownType(message) {
+		p.P(`size := m.SizeVT()`)
+		return
+	}
+	p.P(`cache := `, p.Helper("GetSizeCache"), `()`)
+	p.P(`size := m.SizeVTCached(cache)`)
+	p.P(p.Helper("PutSizeCache"), `(cache)`)
+}
+
+// checkCycles generates the check that message does not hold itself, returning zero and
+// the error otherwise, if the message uses the cycle check.
+func (p *marshal) checkCycles(message *protogen.Message, zero string) {
+	if !p.ShouldCheckCycles(message) {
+		return
+	}
+	p.P(`if err := `, p.Helper("CheckCycles"), `(m); err != nil {`)
+	p.P(`return `, zero, `, err`)
+	p.P(`}`)
+}
+
 func (p *marshal) reverseListRange(expression ...string) string {
 	exp := strings.Join(expression, "")
 	p.P(`for iNdEx := len(`, exp, `) - 1; iNdEx >= 0; iNdEx-- {`)
@@ -729,10 +908,14 @@ func (p *marshal) marshalBackward(varName string, varInt bool, message *protogen
 		p.P(`size, err := (*`, p.WellKnownTypeMap(message), `)(`, varName, `).`, p.methodMarshalToSizedBuffer(), `(dAtA[:i])`)
 		p.marshalBackwardSize(varInt)
 
-	case p.IsLocalMessage(message):
+	case p.IsVTMessage(message):
 		p.P(`size, err := `, varName, `.`, p.methodMarshalToSizedBuffer(), `(dAtA[:i])`)
 		p.marshalBackwardSize(varInt)
 
+	case p.Config.Registry:
+		p.P(`size, err := `, p.RegistryType(message), `.`, p.methodMarshalToSizedBuffer(), `(`, varName, `, dAtA[:i])`)
+		p.marshalBackwardSize(varInt)
+
 	default:
 		p.P(`if vtmsg, ok := interface{}(`, varName, `).(interface{`)
 		p.P(p.methodMarshalToSizedBuffer(), `([]byte) (int, error)`)
@@ -740,7 +923,11 @@ func (p *marshal) marshalBackward(varName string, varInt bool, message *protogen
 		p.P(`size, err := vtmsg.`, p.methodMarshalToSizedBuffer(), `(dAtA[:i])`)
 		p.marshalBackwardSize(varInt)
 		p.P(`} else {`)
-		p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "Marshal"), `(`, varName, `)`)
+		if p.Stable {
+			p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "MarshalOptions"), `{Deterministic: true}.Marshal(`, varName, `)`)
+		} else {
+			p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "Marshal"), `(`, varName, `)`)
+		}
 		p.P(`if err != nil {`)
 		p.P(`return 0, err`)
 		p.P(`}`)
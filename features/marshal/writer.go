@@ -0,0 +1,380 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package marshal
+
+import (
+	"sort"
+
+	"github.com/planetscale/vtprotobuf/features/freeze"
+	"github.com/planetscale/vtprotobuf/generator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const protowirePkg = "google.golang.org/protobuf/encoding/protowire"
+
+func init() {
+	generator.RegisterOptInFeature("marshal_writer", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &writer{GeneratedFile: gen}
+	})
+	generator.RegisterMessageFilter("marshal_writer", generator.SkipOpaque)
+}
+
+// writer generates the MarshalToWriterVT methods, which encode the fields of messages
+// forward, in the order of MarshalVT, through a protohelpers.Writer instead of backward
+// into a buffer holding the whole message.
+type writer struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*writer)(nil)
+
+func (p *writer) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+// streams returns true if message has a MarshalToWriterVT method writing its fields to
+// the protohelpers.Writer of the message holding it, and a SizeVT method.
+func (p *writer) streams(message *protogen.Message) bool {
+	return p.IsLocalMessage(message) && p.IsVTMessage(message) && p.HasVTFeature(message, "size") && !p.IsOpaque(message) && !p.IsWellKnownType(message)
+}
+
+// messageSize generates the code encoding the message in v if it is not streamed, and
+// returns the expression of the size of its encoding. The streamed messages are sized
+// with SizeVT, which visits the messages they hold again.
+func (p *writer) messageSize(v string, message *protogen.Message) string {
+	if p.streams(message) {
+		p.P(`size := `, v, `.SizeVT()`)
+		return `size`
+	}
+	p.encode(v, message)
+	return `len(encoded)`
+}
+
+// encode generates the declaration of the encoding of the message in v, for the messages
+// that are not streamed.
+func (p *writer) encode(v string, message *protogen.Message) {
+	switch {
+	case p.IsWellKnownType(message):
+		p.P(`encoded, err := (*`, p.WellKnownTypeMap(message), `)(`, v, `).MarshalVT()`)
+	case p.HasVTFeature(message, "marshal") && !p.IsOpaque(message):
+		p.P(`encoded, err := `, v, `.MarshalVT()`)
+	default:
+		p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "Marshal"), `(`, v, `)`)
+	}
+	p.P(`if err != nil {`)
+	p.P(`return sw.Abort(err)`)
+	p.P(`}`)
+}
+
+// messageBody generates the code writing the encoding of the message in v, without its
+// length, after messageSize or encode.
+func (p *writer) messageBody(v string, message *protogen.Message) {
+	if !p.streams(message) {
+		p.P(`sw.Write(encoded)`)
+		return
+	}
+	p.P(`if _, err := `, v, `.MarshalToWriterVT(sw); err != nil {`)
+	p.P(`return sw.Abort(err)`)
+	p.P(`}`)
+}
+
+func (p *writer) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.once = true
+	if p.Override(message) {
+		return
+	}
+
+	p.P(`// MarshalToWriterVT writes the encoding of m to w, as MarshalVT returns it, without`)
+	p.P(`// holding the whole encoding in memory, and returns the number of bytes written. The`)
+	p.P(`// writes to w are buffered. If an error is returned, a part of the encoding may have`)
+	p.P(`// been written to w.`)
+	p.P(`func (m *`, message.GoIdent.GoName, `) MarshalToWriterVT(w `, p.Ident("io", "Writer"), `) (int, error) {`)
+	p.P(`if m == nil {`)
+	p.P(`return 0, nil`)
+	p.P(`}`)
+	if p.HasFeature("freeze") {
+		p.P(`if `, freeze.Package.Ident("Enabled"), ` {`)
+		p.P(freeze.Package.Ident("Check"), `(m)`)
+		p.P(`}`)
+	}
+	p.P(`sw := `, p.Helper("NewWriter"), `(w)`)
+	p.P(`start := sw.Begin()`)
+
+	fields := make([]*protogen.Field, len(message.Fields))
+	copy(fields, message.Fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Desc.Number() < fields[j].Desc.Number()
+	})
+
+	for _, field := range fields {
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+			p.field(false, `m.`, field)
+		}
+	}
+
+	// Like MarshalVT, the oneofs are written after the other fields, in the increasing
+	// order of their last field.
+	var oneofs []*protogen.Oneof
+	seen := make(map[*protogen.Oneof]bool)
+	for i := len(fields) - 1; i >= 0; i-- {
+		oneof := fields[i].Oneof
+		if oneof != nil && !oneof.Desc.IsSynthetic() && !seen[oneof] {
+			seen[oneof] = true
+			oneofs = append([]*protogen.Oneof{oneof}, oneofs...)
+		}
+	}
+	for _, oneof := range oneofs {
+		p.P(`switch c := m.`, oneof.GoName, `.(type) {`)
+		for _, field := range oneof.Fields {
+			p.P(`case *`, field.GoIdent.GoName, `:`)
+			p.field(true, `c.`, field)
+		}
+		p.P(`}`)
+	}
+
+	if !p.ShouldIgnoreUnknownFields(message) {
+		p.P(`sw.Write(m.unknownFields)`)
+	}
+	p.P(`return sw.End(start)`)
+	p.P(`}`)
+	p.P()
+}
+
+// validateUTF8 makes the method fail if the string in varName is not valid UTF-8, like
+// marshal.validateUTF8.
+func (p *writer) validateUTF8(field, value *protogen.Field, varName string) {
+	if !p.ValidateUTF8OnMarshal(field, value) {
+		return
+	}
+	p.P(`if err := `, p.Helper("ValidateUTF8String"), `(`, varName, `); err != nil {`)
+	p.P(`return sw.Abort(`, p.Ident("fmt", "Errorf"), `("field `, field.Desc.FullName(), `: %w", err))`)
+	p.P(`}`)
+}
+
+// field generates the code writing field of the message in recv, which is "m." for the
+// fields of the message and "c." for the members of its oneofs.
+func (p *writer) field(oneof bool, recv string, field *protogen.Field) {
+	// The fields with the explicit_presence option are encoded even when they hold their
+	// zero value, like the scalar members of oneofs.
+	oneof = oneof || p.ExplicitPresence(field)
+	num := field.Desc.Number()
+	v := recv + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap():
+		p.mapField(field, v)
+		return
+	case field.Desc.IsList() && field.Desc.IsPacked():
+		p.P(`if len(`, v, `) > 0 {`)
+		p.tag(num, protowire.BytesType)
+		switch kind {
+		case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+			p.P(`sw.Varint(uint64(len(`, v, `) * 8))`)
+		case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+			p.P(`sw.Varint(uint64(len(`, v, `) * 4))`)
+		case protoreflect.BoolKind:
+			p.P(`sw.Varint(uint64(len(`, v, `)))`)
+		default:
+			p.P(`var size int`)
+			p.P(`for _, num := range `, v, ` {`)
+			p.P(`size += `, p.scalarSize(kind, `num`))
+			p.P(`}`)
+			p.P(`sw.Varint(uint64(size))`)
+		}
+		p.P(`for _, num := range `, v, ` {`)
+		p.scalar(kind, `num`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	case field.Desc.IsList():
+		p.P(`for _, v := range `, v, ` {`)
+		p.value(field, num, `v`)
+		p.P(`}`)
+		return
+	}
+
+	switch {
+	case field.Desc.Cardinality() == protoreflect.Required && p.FieldPresence(field) == generator.PresenceExplicit:
+		p.P(`if `, v, ` == nil {`)
+		p.P(`return sw.Abort(`, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.Name(), ` not set"))`)
+		p.P(`} else {`)
+		p.value(field, num, p.deref(field, v))
+		p.P(`}`)
+	case field.Message != nil:
+		if oneof {
+			// Like MarshalVT, an empty message or group is written for the oneof members
+			// holding nil.
+			p.value(field, num, v)
+			return
+		}
+		p.P(`if `, v, ` != nil {`)
+		p.value(field, num, v)
+		p.P(`}`)
+	case oneof:
+		p.value(field, num, v)
+	case p.FieldPresence(field) == generator.PresenceExplicit:
+		p.P(`if `, v, ` != nil {`)
+		p.value(field, num, p.deref(field, v))
+		p.P(`}`)
+	default:
+		p.P(`if `, p.nonZero(kind, v), ` {`)
+		p.value(field, num, v)
+		p.P(`}`)
+	}
+}
+
+// deref returns the expression of the value of the field with explicit presence in v.
+func (p *writer) deref(field *protogen.Field, v string) string {
+	if field.Message != nil || field.Desc.Kind() == protoreflect.BytesKind {
+		return v
+	}
+	return `*` + v
+}
+
+// nonZero returns the condition under which a field with implicit presence holding v is
+// written.
+func (p *writer) nonZero(kind protoreflect.Kind, v string) string {
+	switch kind {
+	case protoreflect.DoubleKind:
+		// Compare the bits rather than the value so that -0 is written, like proto.Marshal does.
+		return p.Ident("math", "Float64bits") + `(float64(` + v + `)) != 0`
+	case protoreflect.FloatKind:
+		return p.Ident("math", "Float32bits") + `(float32(` + v + `)) != 0`
+	case protoreflect.BoolKind:
+		return v
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return `len(` + v + `) > 0`
+	}
+	return v + ` != 0`
+}
+
+var wireTypeNames = map[protowire.Type]string{
+	protowire.VarintType:     "VarintType",
+	protowire.Fixed32Type:    "Fixed32Type",
+	protowire.Fixed64Type:    "Fixed64Type",
+	protowire.BytesType:      "BytesType",
+	protowire.StartGroupType: "StartGroupType",
+	protowire.EndGroupType:   "EndGroupType",
+}
+
+func (p *writer) tag(num protoreflect.FieldNumber, typ protowire.Type) {
+	p.P(`sw.Tag(`, num, `, `, p.Ident(protowirePkg, wireTypeNames[typ]), `)`)
+}
+
+// value generates the code writing the key and the value v of field, in a block opened by
+// the caller for the declarations of the generated code.
+func (p *writer) value(field *protogen.Field, num protoreflect.FieldNumber, v string) {
+	kind := field.Desc.Kind()
+	switch kind {
+	case protoreflect.GroupKind:
+		if !p.streams(field.Message) {
+			p.encode(v, field.Message)
+		}
+		p.tag(num, protowire.StartGroupType)
+		p.messageBody(v, field.Message)
+		p.tag(num, protowire.EndGroupType)
+	case protoreflect.MessageKind:
+		size := p.messageSize(v, field.Message)
+		p.tag(num, protowire.BytesType)
+		p.P(`sw.Varint(uint64(`, size, `))`)
+		p.messageBody(v, field.Message)
+	default:
+		if kind == protoreflect.StringKind {
+			p.validateUTF8(field, field, v)
+		}
+		p.tag(num, generator.ProtoWireType(kind))
+		p.scalar(kind, v)
+	}
+}
+
+// scalar generates the code writing the scalar value v of the given kind, without key.
+func (p *writer) scalar(kind protoreflect.Kind, v string) {
+	switch kind {
+	case protoreflect.DoubleKind:
+		p.P(`sw.Fixed64(`, p.Ident("math", "Float64bits"), `(float64(`, v, `)))`)
+	case protoreflect.FloatKind:
+		p.P(`sw.Fixed32(`, p.Ident("math", "Float32bits"), `(float32(`, v, `)))`)
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		p.P(`sw.Fixed64(uint64(`, v, `))`)
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		p.P(`sw.Fixed32(uint32(`, v, `))`)
+	case protoreflect.BoolKind:
+		p.P(`sw.Varint(`, p.Ident(protowirePkg, "EncodeBool"), `(`, v, `))`)
+	case protoreflect.Sint32Kind:
+		p.P(`sw.Varint(uint64((uint32(`, v, `) << 1) ^ uint32((`, v, ` >> 31))))`)
+	case protoreflect.Sint64Kind:
+		p.P(`sw.Varint((uint64(`, v, `) << 1) ^ uint64((`, v, ` >> 63)))`)
+	case protoreflect.StringKind:
+		p.P(`sw.String(`, v, `)`)
+	case protoreflect.BytesKind:
+		p.P(`sw.Bytes(`, v, `)`)
+	default:
+		p.P(`sw.Varint(uint64(`, v, `))`)
+	}
+}
+
+// scalarSize returns the expression of the size of the scalar value v of the given kind,
+// without key.
+func (p *writer) scalarSize(kind protoreflect.Kind, v string) string {
+	switch kind {
+	case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		return `8`
+	case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		return `4`
+	case protoreflect.BoolKind:
+		return `1`
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return p.QualifiedGoIdent(p.Helper("SizeOfZigzag")) + `(uint64(` + v + `))`
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return `len(` + v + `) + ` + p.QualifiedGoIdent(p.Helper("SizeOfVarint")) + `(uint64(len(` + v + `)))`
+	}
+	return p.QualifiedGoIdent(p.Helper("SizeOfVarint")) + `(uint64(` + v + `))`
+}
+
+// mapField generates the code writing the entries of the map field in v, in the random
+// order of MarshalVT.
+func (p *writer) mapField(field *protogen.Field, v string) {
+	key, val := field.Message.Fields[0], field.Message.Fields[1]
+	p.P(`for k, v := range `, v, ` {`)
+	valueSize := `1 + ` + p.scalarSize(val.Desc.Kind(), `v`)
+	var size string
+	if val.Desc.Kind() == protoreflect.MessageKind {
+		size = p.messageSize(`v`, val.Message)
+		valueSize = `1 + ` + size + ` + ` + p.QualifiedGoIdent(p.Helper("SizeOfVarint")) + `(uint64(` + size + `))`
+	}
+	p.tag(field.Desc.Number(), protowire.BytesType)
+	p.P(`sw.Varint(uint64(1 + `, p.scalarSize(key.Desc.Kind(), `k`), ` + `, valueSize, `))`)
+	p.validateUTF8(field, key, `k`)
+	p.tag(1, generator.ProtoWireType(key.Desc.Kind()))
+	p.scalar(key.Desc.Kind(), `k`)
+	if val.Desc.Kind() == protoreflect.MessageKind {
+		p.tag(2, protowire.BytesType)
+		p.P(`sw.Varint(uint64(`, size, `))`)
+		p.messageBody(`v`, val.Message)
+	} else {
+		p.validateUTF8(field, val, `v`)
+		p.tag(2, generator.ProtoWireType(val.Desc.Kind()))
+		p.scalar(val.Desc.Kind(), `v`)
+	}
+	p.P(`}`)
+}
@@ -0,0 +1,53 @@
+package pool
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// opaqueReset generates the body of the ResetVT method of a message of the opaque API,
+// whose fields are hidden behind their accessors. Zeroing the message clears its presence
+// bits, and the backing storage of its repeated and map fields is set again to be reused,
+// since an empty list or map is the same as an absent one.
+func (p *pool) opaqueReset(message *protogen.Message) {
+	var saved []*protogen.Field
+	for _, field := range message.Fields {
+		get := p.Get("m", field)
+		switch {
+		case field.Desc.IsMap():
+			if value := field.Message.Fields[1]; value.Message != nil && p.ShouldPool(value.Message) {
+				p.P(`for _, mm := range `, get, `{`)
+				p.P(`mm.ReturnToVTPool()`)
+				p.P(`}`)
+			}
+			p.P(`clear(`, get, `)`)
+			p.P(fmt.Sprintf("f%d", len(saved)), ` := `, get)
+			saved = append(saved, field)
+		case field.Desc.IsList():
+			switch field.Desc.Kind() {
+			case protoreflect.MessageKind, protoreflect.GroupKind:
+				p.P(`for _, mm := range `, get, `{`)
+				if p.ShouldPool(field.Message) {
+					p.P(`mm.ResetVT()`)
+				} else {
+					p.P(`mm.Reset()`)
+				}
+				p.P(`}`)
+			case protoreflect.BytesKind, protoreflect.StringKind:
+				p.P(`clear(`, get, `)`)
+			}
+			p.P(fmt.Sprintf("f%d", len(saved)), ` := `, get, `[:0]`)
+			saved = append(saved, field)
+		case field.Message != nil && p.ShouldPool(field.Message):
+			// The getters return nil when the field, or the member of its oneof, is not set.
+			p.P(get, `.ReturnToVTPool()`)
+		}
+	}
+
+	p.P(`*m = `, message.GoIdent, `{}`)
+	for i, field := range saved {
+		p.P(p.Set("m", field, fmt.Sprintf("f%d", i)))
+	}
+}
@@ -6,13 +6,26 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/planetscale/vtprotobuf/features/freeze"
 	"github.com/planetscale/vtprotobuf/generator"
 )
 
+// vtpoolPackage is the package defining the interface of the generated pools.
+const vtpoolPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtpool")
+
 func init() {
 	generator.RegisterFeature("pool", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &pool{GeneratedFile: gen}
 	})
+	generator.RegisterMessageFilter("pool", func(p *generator.GeneratedFile, message *protogen.Message) string {
+		switch {
+		case p.Config.PoolableExclude.Contains(message.GoIdent):
+			return generator.SkippedExcluded
+		case !p.ShouldPool(message):
+			return generator.SkippedNoPool
+		}
+		return ""
+	})
 }
 
 type pool struct {
@@ -34,26 +47,36 @@ func (p *pool) message(message *protogen.Message) {
 		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() || !p.ShouldPool(message) {
+	if message.Desc.IsMapEntry() {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	if p.IsOpaque(message) {
+	p.releaseMaps(message)
+	if p.SkipReason(message) != "" {
 		return
 	}
 
 	p.once = true
+	if p.Override(message) {
+		return
+	}
 	ccTypeName := message.GoIdent
 
-	p.P(`var vtprotoPool_`, ccTypeName, ` = `, p.Ident("sync", "Pool"), `{`)
-	p.P(`New: func() interface{} {`)
-	p.P(`return &`, ccTypeName, `{}`)
-	p.P(`},`)
-	p.P(`}`)
-
 	p.P(`func (m *`, ccTypeName, `) ResetVT() {`)
 	p.P(`if m != nil {`)
+	if p.HasFeature("freeze") && !p.Wrapper() {
+		// The message may have been frozen before it went back to its pool.
+		p.P(`if `, freeze.Package.Ident("Enabled"), ` {`)
+		p.P(freeze.Package.Ident("Thaw"), `(m)`)
+		p.P(`}`)
+	}
+	if p.IsOpaque(message) {
+		p.opaqueReset(message)
+		p.P(`}`)
+		p.P(`}`)
+		p.storage(ccTypeName)
+		return
+	}
 	var saved []*protogen.Field
 	var oneofBytes []*protogen.Field // Track oneof bytes fields
 
@@ -71,10 +94,27 @@ func (p *pool) message(message *protogen.Message) {
 				}
 				p.P(`}`)
 			case protoreflect.BytesKind, protoreflect.StringKind:
+				if p.PooledBytes(field) {
+					p.P(`for _, b := range m.`, fieldName, `{`)
+					p.P(p.Helper("PutBytes"), `(b)`)
+					p.P(`}`)
+				}
 				p.P(`clear(m.`, fieldName, `)`)
 			}
 			p.P(fmt.Sprintf("f%d", len(saved)), ` := m.`, fieldName, `[:0]`)
 			saved = append(saved, field)
+		} else if field.Desc.IsMap() {
+			// The map keeps its buckets for the next message, and its message values go
+			// back to their pool when they are pooled, for unmarshal to take them again.
+			// The other values are left to the garbage collector.
+			if value := field.Message.Fields[1]; value.Message != nil && p.ShouldPool(value.Message) {
+				p.P(`for _, mm := range m.`, fieldName, `{`)
+				p.P(`mm.ReturnToVTPool()`)
+				p.P(`}`)
+			}
+			p.P(`clear(m.`, fieldName, `)`)
+			p.P(fmt.Sprintf("f%d", len(saved)), ` := m.`, fieldName)
+			saved = append(saved, field)
 		} else if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
 			switch field.Desc.Kind() {
 			case protoreflect.MessageKind, protoreflect.GroupKind:
@@ -89,62 +129,156 @@ func (p *pool) message(message *protogen.Message) {
 		} else {
 			switch field.Desc.Kind() {
 			case protoreflect.MessageKind, protoreflect.GroupKind:
-				if !field.Desc.IsMap() && p.ShouldPool(field.Message) {
+				if p.ShouldPool(field.Message) {
 					p.P(`m.`, fieldName, `.ReturnToVTPool()`)
 				}
 			case protoreflect.BytesKind:
+				if p.PooledBytes(field) {
+					// The buffer goes back to its pool, for the next message to take one
+					// of the size class of its data.
+					p.P(p.Helper("PutBytes"), `(m.`, fieldName, `)`)
+					break
+				}
 				p.P(fmt.Sprintf("f%d", len(saved)), ` := m.`, fieldName, `[:0]`)
 				saved = append(saved, field)
 			}
 		}
 	}
 
-	// Handle oneof bytes fields - save the oneof wrapper with preserved capacity
-	if len(oneofBytes) > 0 {
-		// Group by oneof
-		oneofGroups := make(map[string][]*protogen.Field)
-		for _, field := range oneofBytes {
-			oneofGroups[field.Oneof.GoName] = append(oneofGroups[field.Oneof.GoName], field)
+	// Handle oneof bytes fields - save the oneof wrapper with preserved capacity.
+	// Group by oneof, in declaration order so that the output is deterministic.
+	var oneofNames []string
+	oneofGroups := make(map[string][]*protogen.Field)
+	for _, field := range oneofBytes {
+		if _, ok := oneofGroups[field.Oneof.GoName]; !ok {
+			oneofNames = append(oneofNames, field.Oneof.GoName)
 		}
+		oneofGroups[field.Oneof.GoName] = append(oneofGroups[field.Oneof.GoName], field)
+	}
 
-		for oneofName, fields := range oneofGroups {
-			p.P(`var saved`, oneofName, ` is`, ccTypeName, `_`, oneofName)
-			p.P(`switch c := m.`, oneofName, `.(type) {`)
-			for _, field := range fields {
-				p.P(`case *`, field.GoIdent, `:`)
-				p.P(`c.`, field.GoName, ` = c.`, field.GoName, `[:0]`)
-				p.P(`saved`, oneofName, ` = c`)
-			}
-			p.P(`}`)
+	for _, oneofName := range oneofNames {
+		p.P(`var saved`, oneofName, ` is`, ccTypeName, `_`, oneofName)
+		p.P(`switch c := m.`, oneofName, `.(type) {`)
+		for _, field := range oneofGroups[oneofName] {
+			p.P(`case *`, field.GoIdent, `:`)
+			p.P(`c.`, field.GoName, ` = c.`, field.GoName, `[:0]`)
+			p.P(`saved`, oneofName, ` = c`)
 		}
+		p.P(`}`)
 	}
 
-	p.P(`m.Reset()`)
+	// Zero the message directly rather than with Reset, which also stores the message
+	// info of the message in its state: ProtoReflect stores it again when needed.
+	p.P(`*m = `, ccTypeName, `{}`)
 	for i, field := range saved {
 		p.P(`m.`, field.GoName, ` = `, fmt.Sprintf("f%d", i))
 	}
 
 	// Restore oneof bytes fields
-	if len(oneofBytes) > 0 {
-		oneofGroups := make(map[string][]*protogen.Field)
-		for _, field := range oneofBytes {
-			oneofGroups[field.Oneof.GoName] = append(oneofGroups[field.Oneof.GoName], field)
-		}
-		for oneofName := range oneofGroups {
-			p.P(`m.`, oneofName, ` = saved`, oneofName)
-		}
+	for _, oneofName := range oneofNames {
+		p.P(`m.`, oneofName, ` = saved`, oneofName)
 	}
 	p.P(`}`)
 	p.P(`}`)
+	p.storage(ccTypeName)
+}
 
-	p.P(`func (m *`, ccTypeName, `) ReturnToVTPool() {`)
-	p.P(`if m != nil {`)
-	p.P(`m.ResetVT()`)
-	p.P(`vtprotoPool_`, ccTypeName, `.Put(m)`)
+// storage generates the memory pool of a message, in the companion files of the pool
+// build tag if any.
+func (p *pool) storage(ccTypeName protogen.GoIdent) {
+	if tag := p.Config.PoolBuildTag; tag != "" {
+		storage(p.Companion("pool", tag), ccTypeName)
+		fallback(p.Companion("nopool", "!"+tag), ccTypeName)
+	} else {
+		storage(p.GeneratedFile, ccTypeName)
+	}
+}
+
+// pooledMaps returns the map fields of message whose values are pooled messages, which
+// unmarshal takes from their pool.
+func (p *pool) pooledMaps(message *protogen.Message) []*protogen.Field {
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if !field.Desc.IsMap() {
+			continue
+		}
+		if value := field.Message.Fields[1]; value.Message != nil && p.ShouldPool(value.Message) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// releaseMaps generates the ReleaseMapVT method of message, which returns the values of
+// its pooled maps to their pool also when message itself is not pooled.
+func (p *pool) releaseMaps(message *protogen.Message) {
+	fields := p.pooledMaps(message)
+	if len(fields) == 0 || !p.Selected(message) {
+		return
+	}
+	p.once = true
+	if p.Override(message) {
+		return
+	}
+
+	p.P(`// ReleaseMapVT returns the message values of the maps of m to their pools, and`)
+	p.P(`// clears the maps. The values must not be used once they are released.`)
+	p.P(`func (m *`, message.GoIdent, `) ReleaseMapVT() {`)
+	p.P(`if m == nil {`)
+	p.P(`return`)
 	p.P(`}`)
+	for _, field := range fields {
+		v := `m.` + field.GoName
+		if p.IsOpaque(message) {
+			v = p.Get("m", field)
+		}
+		p.P(`for _, mm := range `, v, `{`)
+		p.P(`mm.ReturnToVTPool()`)
+		p.P(`}`)
+		p.P(`clear(`, v, `)`)
+	}
 	p.P(`}`)
+	p.P()
+}
 
-	p.P(`func `, ccTypeName, `FromVTPool() *`, ccTypeName, `{`)
-	p.P(`return vtprotoPool_`, ccTypeName, `.Get().(*`, ccTypeName, `)`)
-	p.P(`}`)
+// storage generates the memory pool of a message and the functions using it.
+func storage(out *generator.GeneratedFile, ccTypeName protogen.GoIdent) {
+	out.P(`var vtprotoPool_`, ccTypeName, ` `, vtpoolPackage.Ident("Backend"), ` = &`, out.Ident("sync", "Pool"), `{`)
+	out.P(`New: func() interface{} {`)
+	out.P(`return &`, ccTypeName, `{}`)
+	out.P(`},`)
+	out.P(`}`)
+
+	out.P(`// SetVTPoolBackend replaces the pool used by `, ccTypeName, `FromVTPool and ReturnToVTPool,`)
+	out.P(`// or restores the default sync.Pool if b is nil. It must be called before using the pool.`)
+	out.P(`func (*`, ccTypeName, `) SetVTPoolBackend(b `, vtpoolPackage.Ident("Backend"), `) {`)
+	out.P(`if b == nil {`)
+	out.P(`b = &`, out.Ident("sync", "Pool"), `{New: func() interface{} { return &`, ccTypeName, `{} }}`)
+	out.P(`}`)
+	out.P(`vtprotoPool_`, ccTypeName, ` = b`)
+	out.P(`}`)
+
+	out.P(`func (m *`, ccTypeName, `) ReturnToVTPool() {`)
+	out.P(`if m != nil {`)
+	out.P(`m.ResetVT()`)
+	out.P(`vtprotoPool_`, ccTypeName, `.Put(m)`)
+	out.P(`}`)
+	out.P(`}`)
+
+	out.P(`func `, ccTypeName, `FromVTPool() *`, ccTypeName, `{`)
+	out.P(`if m, ok := vtprotoPool_`, ccTypeName, `.Get().(*`, ccTypeName, `); ok {`)
+	out.P(`return m`)
+	out.P(`}`)
+	out.P(`return &`, ccTypeName, `{}`)
+	out.P(`}`)
+}
+
+// fallback generates the functions of the memory pool of a message when pooling is
+// disabled by the build tag: messages are allocated and never reused.
+func fallback(out *generator.GeneratedFile, ccTypeName protogen.GoIdent) {
+	out.P(`func (*`, ccTypeName, `) SetVTPoolBackend(`, vtpoolPackage.Ident("Backend"), `) {}`)
+	out.P(`func (m *`, ccTypeName, `) ReturnToVTPool() {}`)
+	out.P(`func `, ccTypeName, `FromVTPool() *`, ccTypeName, `{`)
+	out.P(`return &`, ccTypeName, `{}`)
+	out.P(`}`)
 }
@@ -0,0 +1,66 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quick
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const vtquickPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtquick")
+
+func init() {
+	generator.RegisterOptInFeature("quick", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &quick{GeneratedFile: gen}
+	})
+	generator.RegisterMessageFilter("quick", generator.SkipOpaque)
+}
+
+type quick struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*quick)(nil)
+
+func (p *quick) GenerateFile(file *protogen.File) bool {
+	if p.Wrapper() {
+		return false
+	}
+	for _, message := range file.Messages {
+		p.message(message)
+	}
+	return p.once
+}
+
+func (p *quick) message(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.message(nested)
+	}
+
+	if p.SkipReason(message) != "" {
+		return
+	}
+
+	p.once = true
+	out := p.TestFile()
+	name := message.GoIdent.GoName
+	generatorType := `vtprotoQuick_` + name
+
+	out.P(`// `, generatorType, ` generates arbitrary `, name, ` messages for testing/quick.`)
+	out.P(`type `, generatorType, ` struct{ m *`, message.GoIdent, ` }`)
+	out.P()
+	out.P(`func (`, generatorType, `) Generate(r *`, protogen.GoImportPath("math/rand").Ident("Rand"), `, size int) `, protogen.GoImportPath("reflect").Ident("Value"), ` {`)
+	out.P(`return `, protogen.GoImportPath("reflect").Ident("ValueOf"), `(`, generatorType, `{`, vtquickPackage.Ident("New"), `[*`, message.GoIdent, `](r, size)})`)
+	out.P(`}`)
+	out.P()
+	out.P(`func TestVTQuick_`, name, `(t *`, protogen.GoImportPath("testing").Ident("T"), `) {`)
+	out.P(vtquickPackage.Ident("Check"), `(t, func(v `, generatorType, `) error {`)
+	out.P(`return `, vtquickPackage.Ident("Agree"), `(v.m)`)
+	out.P(`}, nil)`)
+	out.P(`}`)
+	out.P()
+}
@@ -23,6 +23,9 @@ func init() {
 type size struct {
 	*generator.GeneratedFile
 	once bool
+	// cached is set while generating SizeVTCached, whose cache is passed along to the
+	// submessages that use the size cache
+	cached bool
 }
 
 var _ generator.FeatureGenerator = (*size)(nil)
@@ -44,9 +47,15 @@ func (p *size) messageSize(varName, sizeName string, message *protogen.Message)
 	case p.IsWellKnownType(message):
 		p.P(`l = (*`, p.WellKnownTypeMap(message), `)(`, varName, `).`, sizeName, `()`)
 
-	case p.IsLocalMessage(message):
+	case p.cached && p.sizeCached(message):
+		p.P(`l = `, varName, `.SizeVTCached(cache)`)
+
+	case p.IsVTMessage(message):
 		p.P(`l = `, varName, `.`, sizeName, `()`)
 
+	case p.Config.Registry:
+		p.P(`l = `, p.RegistryType(message), `.`, sizeName, `(`, varName, `)`)
+
 	default:
 		p.P(`if size, ok := interface{}(`, varName, `).(interface{`)
 		p.P(sizeName, `() int`)
@@ -60,12 +69,22 @@ func (p *size) messageSize(varName, sizeName string, message *protogen.Message)
 
 func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 	fieldname := field.GoName
-	nullable := field.Message != nil || (!oneof && field.Desc.HasPresence())
+	// The fields with the explicit_presence option are encoded even when they hold their
+	// zero value, like the scalar members of oneofs.
+	oneof = oneof || p.ExplicitPresence(field)
+	nullable := field.Message != nil || p.FieldPresence(field) == generator.PresenceExplicit
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
+	// v is the value of the field, pv the value of the fields with explicit presence,
+	// which are pointers unless they are read with the getters of opaque messages.
+	v, pv, present := `m.`+fieldname, `*m.`+fieldname, `m.`+fieldname+` != nil`
+	if p.IsOpaque(field.Parent) {
+		v = p.Get(`m`, field)
+		pv, present = v, p.Has(`m`, field)
+	}
 	if repeated {
-		p.P(`if len(m.`, fieldname, `) > 0 {`)
+		p.P(`if len(`, v, `) > 0 {`)
 	} else if nullable {
-		p.P(`if m.`, fieldname, ` != nil {`)
+		p.P(`if `, present, ` {`)
 	}
 	packed := field.Desc.IsPacked()
 	wireType := generator.ProtoWireType(field.Desc.Kind())
@@ -77,11 +96,15 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(m.`, fieldname, `)*8))`, `+len(m.`, fieldname, `)*8`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(`, v, `)*8))`, `+len(`, v, `)*8`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+8), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+8), `*len(`, v, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` != 0 {`)
+			if field.Desc.Kind() == protoreflect.DoubleKind {
+				p.P(`if `, p.Ident("math", "Float64bits"), `(float64(`, v, `)) != 0 {`)
+			} else {
+				p.P(`if `, v, ` != 0 {`)
+			}
 			p.P(`n+=`, strconv.Itoa(key+8))
 			p.P(`}`)
 		} else {
@@ -89,11 +112,15 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 		}
 	case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(m.`, fieldname, `)*4))`, `+len(m.`, fieldname, `)*4`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(`, v, `)*4))`, `+len(`, v, `)*4`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+4), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+4), `*len(`, v, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` != 0 {`)
+			if field.Desc.Kind() == protoreflect.FloatKind {
+				p.P(`if `, p.Ident("math", "Float32bits"), `(float32(`, v, `)) != 0 {`)
+			} else {
+				p.P(`if `, v, ` != 0 {`)
+			}
 			p.P(`n+=`, strconv.Itoa(key+4))
 			p.P(`}`)
 		} else {
@@ -102,30 +129,30 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 	case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Uint32Kind, protoreflect.EnumKind, protoreflect.Int32Kind:
 		if packed {
 			p.P(`l = 0`)
-			p.P(`for _, e := range m.`, fieldname, ` {`)
+			p.P(`for _, e := range `, v, ` {`)
 			p.P(`l+=`, p.Helper("SizeOfVarint"), `(uint64(e))`)
 			p.P(`}`)
 			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(l))+l`)
 		} else if repeated {
-			p.P(`for _, e := range m.`, fieldname, ` {`)
+			p.P(`for _, e := range `, v, ` {`)
 			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(e))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(*m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(`, pv, `))`)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(m.`, fieldname, `))`)
+			p.P(`if `, v, ` != 0 {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(`, v, `))`)
 			p.P(`}`)
 		} else {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(`, v, `))`)
 		}
 	case protoreflect.BoolKind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(m.`, fieldname, `)))`, `+len(m.`, fieldname, `)*1`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(len(`, v, `)))`, `+len(`, v, `)*1`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+1), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+1), `*len(`, v, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, v, ` {`)
 			p.P(`n+=`, strconv.Itoa(key+1))
 			p.P(`}`)
 		} else {
@@ -133,31 +160,38 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 		}
 	case protoreflect.StringKind:
 		if repeated {
-			p.P(`for _, s := range m.`, fieldname, ` { `)
+			p.P(`for _, s := range `, v, ` { `)
 			p.P(`l = len(s)`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`l=len(*m.`, fieldname, `)`)
+			p.P(`l=len(`, pv, `)`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 		} else if !oneof {
-			p.P(`l=len(m.`, fieldname, `)`)
+			p.P(`l=len(`, v, `)`)
 			p.P(`if l > 0 {`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.P(`l=len(m.`, fieldname, `)`)
+			p.P(`l=len(`, v, `)`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 		}
 	case protoreflect.GroupKind:
-		p.messageSize("m."+fieldname, sizeName, field.Message)
-		p.P(`n+=l+`, strconv.Itoa(2*key))
+		if repeated {
+			p.P(`for _, e := range `, v, ` { `)
+			p.messageSize("e", sizeName, field.Message)
+			p.P(`n+=l+`, strconv.Itoa(2*key))
+			p.P(`}`)
+		} else {
+			p.messageSize(v, sizeName, field.Message)
+			p.P(`n+=l+`, strconv.Itoa(2*key))
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			fieldKeySize := generator.KeySize(field.Desc.Number(), generator.ProtoWireType(field.Desc.Kind()))
 			keyKeySize := generator.KeySize(1, generator.ProtoWireType(field.Message.Fields[0].Desc.Kind()))
 			valueKeySize := generator.KeySize(2, generator.ProtoWireType(field.Message.Fields[1].Desc.Kind()))
-			p.P(`for k, v := range m.`, fieldname, ` { `)
+			p.P(`for k, v := range `, v, ` { `)
 			p.P(`_ = k`)
 			p.P(`_ = v`)
 			sum := []interface{}{strconv.Itoa(keyKeySize)}
@@ -219,48 +253,48 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 			p.P(`n+=mapEntrySize+`, fieldKeySize, `+`, p.Helper("SizeOfVarint"), `(uint64(mapEntrySize))`)
 			p.P(`}`)
 		} else if field.Desc.IsList() {
-			p.P(`for _, e := range m.`, fieldname, ` { `)
+			p.P(`for _, e := range `, v, ` { `)
 			p.messageSize("e", sizeName, field.Message)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.messageSize("m."+fieldname, sizeName, field.Message)
+			p.messageSize(v, sizeName, field.Message)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 		}
 	case protoreflect.BytesKind:
 		if repeated {
-			p.P(`for _, b := range m.`, fieldname, ` { `)
+			p.P(`for _, b := range `, v, ` { `)
 			p.P(`l = len(b)`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 			p.P(`}`)
-		} else if !oneof && !field.Desc.HasPresence() {
-			p.P(`l=len(m.`, fieldname, `)`)
+		} else if !oneof && p.FieldPresence(field) == generator.PresenceImplicit {
+			p.P(`l=len(`, v, `)`)
 			p.P(`if l > 0 {`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.P(`l=len(m.`, fieldname, `)`)
+			p.P(`l=len(`, v, `)`)
 			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("SizeOfVarint"), `(uint64(l))`)
 		}
 	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
 		if packed {
 			p.P(`l = 0`)
-			p.P(`for _, e := range m.`, fieldname, ` {`)
+			p.P(`for _, e := range `, v, ` {`)
 			p.P(`l+=`, p.Helper("SizeOfZigzag"), `(uint64(e))`)
 			p.P(`}`)
 			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfVarint"), `(uint64(l))+l`)
 		} else if repeated {
-			p.P(`for _, e := range m.`, fieldname, ` {`)
+			p.P(`for _, e := range `, v, ` {`)
 			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(e))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(*m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(`, pv, `))`)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(m.`, fieldname, `))`)
+			p.P(`if `, v, ` != 0 {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(`, v, `))`)
 			p.P(`}`)
 		} else {
-			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("SizeOfZigzag"), `(uint64(`, v, `))`)
 		}
 	default:
 		panic("not implemented")
@@ -270,6 +304,8 @@ func (p *size) field(oneof bool, field *protogen.Field, sizeName string) {
 	// Size is always keysize + 1 so just hardcode that here
 	if oneof && field.Desc.Kind() == protoreflect.MessageKind && !field.Desc.IsMap() && !field.Desc.IsList() {
 		p.P("} else { n += ", strconv.Itoa(key + 1), " }")
+	} else if oneof && field.Desc.Kind() == protoreflect.GroupKind {
+		p.P("} else { n += ", strconv.Itoa(2*key), " }")
 	} else if repeated || nullable {
 		p.P(`}`)
 	}
@@ -280,25 +316,48 @@ func (p *size) message(message *protogen.Message) {
 		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if p.SkipReason(message) != "" {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	// The standard protobuf library's generated Size will be used instead.
-	if p.IsOpaque(message) {
+	p.once = true
+	if p.Override(message) {
 		return
 	}
 
-	p.once = true
+	p.sizeMethod(message)
+	if p.sizeCached(message) {
+		p.cached = true
+		p.sizeMethod(message)
+		p.cached = false
+	}
+}
 
+// sizeCached returns true if a SizeVTCached method is generated for message.
+func (p *size) sizeCached(message *protogen.Message) bool {
+	return p.ShouldSizeCache(message) && p.IsVTMessage(message) && !p.IsWellKnownType(message)
+}
+
+// sizeMethod generates the SizeVT method of message and of its oneof fields, or their
+// SizeVTCached method if p.cached is set.
+func (p *size) sizeMethod(message *protogen.Message) {
 	sizeName := "SizeVT"
 	ccTypeName := message.GoIdent.GoName
 
-	p.P(`func (m *`, ccTypeName, `) `, sizeName, `() (n int) {`)
-	p.P(`if m == nil {`)
-	p.P(`return 0`)
-	p.P(`}`)
+	if p.cached {
+		p.P(`func (m *`, ccTypeName, `) SizeVTCached(cache *`, p.Helper("SizeCache"), `) (n int) {`)
+		p.P(`if m == nil {`)
+		p.P(`return 0`)
+		p.P(`}`)
+		p.P(`if n, ok := cache.Get(`, p.Ident("unsafe", "Pointer"), `(m)); ok {`)
+		p.P(`return n`)
+		p.P(`}`)
+	} else {
+		p.P(`func (m *`, ccTypeName, `) `, sizeName, `() (n int) {`)
+		p.P(`if m == nil {`)
+		p.P(`return 0`)
+		p.P(`}`)
+	}
 	p.P(`var l int`)
 	p.P(`_ = l`)
 	oneofs := make(map[string]struct{})
@@ -312,13 +371,46 @@ func (p *size) message(message *protogen.Message) {
 				continue
 			}
 			oneofs[fieldname] = struct{}{}
-			if p.IsWellKnownType(message) {
+			if p.IsOpaque(message) {
+				// The members of the oneofs of opaque messages are read with their getters,
+				// since their wrappers are not exported.
+				p.P(`switch `, p.Which(`m`, field.Oneof), ` {`)
+				for _, f := range field.Oneof.Fields {
+					p.P(`case `, p.OneofCase(f), `:`)
+					p.field(true, f, sizeName)
+				}
+				p.P(`}`)
+			} else if p.IsWellKnownType(message) {
 				p.P(`switch c := m.`, fieldname, `.(type) {`)
 				for _, f := range field.Oneof.Fields {
 					p.P(`case *`, f.GoIdent, `:`)
 					p.P(`n += (*`, p.WellKnownFieldMap(f), `)(c).`, sizeName, `()`)
 				}
 				p.P(`}`)
+			} else if p.SwitchOneof(field.Oneof) {
+				p.P(`switch c := m.`, fieldname, `.(type) {`)
+				for _, f := range field.Oneof.Fields {
+					p.P(`case *`, f.GoIdent.GoName, `:`)
+					if p.cached && p.oneofCached(f) {
+						p.P(`n += c.SizeVTCached(cache)`)
+					} else {
+						p.P(`n += c.`, sizeName, `()`)
+					}
+				}
+				p.P(`}`)
+			} else if p.cached {
+				p.P(`switch c := m.`, fieldname, `.(type) {`)
+				for _, f := range field.Oneof.Fields {
+					if p.oneofCached(f) {
+						p.P(`case *`, f.GoIdent.GoName, `:`)
+						p.P(`n += c.SizeVTCached(cache)`)
+					}
+				}
+				p.P(`default:`)
+				p.P(`if vtmsg, ok := c.(interface{ SizeVT() int }); ok {`)
+				p.P(`n+=vtmsg.`, sizeName, `()`)
+				p.P(`}`)
+				p.P(`}`)
 			} else {
 				p.P(`if vtmsg, ok := m.`, fieldname, `.(interface{ SizeVT() int }); ok {`)
 				p.P(`n+=vtmsg.`, sizeName, `()`)
@@ -329,18 +421,35 @@ func (p *size) message(message *protogen.Message) {
 	if !p.Wrapper() && !p.ShouldIgnoreUnknownFields(message) {
 		p.P(`n+=len(m.unknownFields)`)
 	}
+	if p.cached {
+		p.P(`cache.Put(`, p.Ident("unsafe", "Pointer"), `(m), n)`)
+	}
 	p.P(`return n`)
 	p.P(`}`)
 	p.P()
 
 	for _, field := range message.Fields {
-		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() {
+		if field.Oneof == nil || field.Oneof.Desc.IsSynthetic() || p.IsOpaque(message) {
 			continue
 		}
 		ccTypeName := field.GoIdent
 		if p.IsWellKnownType(message) && p.IsLocalMessage(message) {
 			ccTypeName.GoImportPath = ""
 		}
+		if p.cached {
+			if p.oneofCached(field) {
+				p.P(`func (m *`, ccTypeName, `) SizeVTCached(cache *`, p.Helper("SizeCache"), `) (n int) {`)
+				p.P(`if m == nil {`)
+				p.P(`return 0`)
+				p.P(`}`)
+				p.P(`var l int`)
+				p.P(`_ = l`)
+				p.field(true, field, sizeName)
+				p.P(`return n`)
+				p.P(`}`)
+			}
+			continue
+		}
 		p.P(`func (m *`, ccTypeName, `) `, sizeName, `() (n int) {`)
 		p.P(`if m == nil {`)
 		p.P(`return 0`)
@@ -352,3 +461,9 @@ func (p *size) message(message *protogen.Message) {
 		p.P(`}`)
 	}
 }
+
+// oneofCached returns true if the oneof field holds a message with a SizeVTCached method,
+// for which the field has a SizeVTCached method as well.
+func (p *size) oneofCached(field *protogen.Field) bool {
+	return field.Message != nil && p.sizeCached(field.Message)
+}
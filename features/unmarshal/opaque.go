@@ -0,0 +1,214 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unmarshal
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// opaqueField decodes field of a message generated with the opaque API, whose fields are
+// hidden behind their accessors. Each value is decoded into a local variable like the
+// keys and values of maps, and stored with the setter of the field; the messages held by
+// the field are decoded in place once they are set.
+func (p *unmarshal) opaqueField(field *protogen.Field, proto3 bool) {
+	wireType := generator.ProtoWireType(field.Desc.Kind())
+	switch {
+	case field.Desc.IsList() && wireType != protowire.BytesType && wireType != protowire.StartGroupType:
+		p.P(`if wireType == `, strconv.Itoa(int(wireType)), `{`)
+		p.opaqueValue(field, proto3)
+		p.P(p.Set("m", field, `append(`+p.Get("m", field)+`, v)`))
+		p.P(`} else if wireType == `, strconv.Itoa(int(protowire.BytesType)), `{`)
+		p.decodeLength("packedLen")
+		p.P(`list := `, p.Get("m", field))
+		p.P(`for iNdEx < postIndex {`)
+		p.opaqueValue(field, proto3)
+		p.P(`list = append(list, v)`)
+		p.P(`}`)
+		p.P(p.Set("m", field, `list`))
+		p.P(`} else {`)
+		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, field.GoName, `", wireType)`)
+		p.P(`}`)
+		return
+	}
+
+	p.P(`if wireType != `, strconv.Itoa(int(wireType)), `{`)
+	p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, field.GoName, `", wireType)`)
+	p.P(`}`)
+	switch {
+	case field.Desc.IsMap():
+		p.decodeLength("msglen")
+		p.opaqueMap(field, proto3)
+		p.P(`iNdEx = postIndex`)
+	case field.Desc.Kind() == protoreflect.GroupKind:
+		p.P(`groupStart := iNdEx`)
+		p.P(`for {`)
+		p.P(`maybeGroupEnd := iNdEx`)
+		p.P(`var groupFieldWire uint64`)
+		p.decodeVarint("groupFieldWire", "uint64")
+		p.P(`groupWireType := int(groupFieldWire & 0x7)`)
+		p.P(`if groupWireType == `, strconv.Itoa(int(protowire.EndGroupType)), `{`)
+		p.opaqueMessage(field, `dAtA[groupStart:maybeGroupEnd]`)
+		p.P(`break`)
+		p.P(`}`)
+		p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[maybeGroupEnd:])`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`if (skippy < 0) || (maybeGroupEnd + skippy) < 0 {`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
+		p.P(`}`)
+		p.P(`iNdEx = maybeGroupEnd + skippy`)
+		p.P(`}`)
+	case field.Message != nil:
+		p.decodeLength("msglen")
+		p.opaqueMessage(field, `dAtA[iNdEx:postIndex]`)
+		p.P(`iNdEx = postIndex`)
+	case field.Desc.IsList():
+		p.opaqueValue(field, proto3)
+		p.P(p.Set("m", field, `append(`+p.Get("m", field)+`, v)`))
+	default:
+		p.opaqueValue(field, proto3)
+		p.P(p.Set("m", field, `v`))
+	}
+}
+
+// decodeLength decodes the length of a length-delimited field into varName, and checks
+// that the postIndex following the field is in dAtA.
+func (p *unmarshal) decodeLength(varName string) {
+	p.P(`var `, varName, ` int`)
+	p.decodeVarint(varName, "int")
+	p.P(`if `, varName, ` < 0 {`)
+	p.P(`return `, p.Helper("ErrInvalidLength"))
+	p.P(`}`)
+	p.P(`postIndex := iNdEx + `, varName)
+	p.P(`if postIndex < 0 {`)
+	p.P(`return `, p.Helper("ErrInvalidLength"))
+	p.P(`}`)
+	p.P(`if postIndex > l {`)
+	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
+	p.P(`}`)
+}
+
+// opaqueValue declares v and decodes a scalar, string or bytes value of field into it.
+func (p *unmarshal) opaqueValue(field *protogen.Field, proto3 bool) {
+	typ := p.noStarOrSliceType(field)
+	if field.Desc.Kind() == protoreflect.BytesKind {
+		typ = "[]byte"
+	}
+	p.P(`var v `, typ)
+	if field.Desc.Kind() == protoreflect.EnumKind {
+		p.decodeVarint("v", typ)
+		p.checkEnumValue("v", field, p.isStrictEnum(field))
+		return
+	}
+	unique := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetUnique()
+	p.mapField("v", field, field, unique, false, false, proto3)
+}
+
+// opaqueMessage decodes buf into the message held by field, which is allocated and set
+// first unless the field already holds one. The elements of repeated fields are appended,
+// reusing the ones kept in the capacity of their list for the messages that reuse them.
+func (p *unmarshal) opaqueMessage(field *protogen.Field, buf string) {
+	message := field.Parent
+	alloc := p.newMessage(field.Message)
+	if p.ShouldPool(message) && p.ShouldPool(field.Message) && !p.alloc {
+		alloc = p.QualifiedGoIdent(field.Message.GoIdent) + `FromVTPool()`
+	}
+	if field.Desc.IsList() {
+		if !p.ShouldReuseMessages(message) {
+			p.P(`v := `, alloc)
+			p.decodeMessage("v", buf, field.Message)
+			p.P(p.Set("m", field, `append(`+p.Get("m", field)+`, v)`))
+			return
+		}
+		p.P(`list := `, p.Get("m", field))
+		p.P(`var v *`, field.Message.GoIdent)
+		p.P(`if len(list) < cap(list) {`)
+		p.P(`v = list[:len(list)+1][len(list)]`)
+		p.P(`}`)
+		p.P(`if v == nil {`)
+		p.P(`v = `, alloc)
+		if !p.ShouldPool(message) {
+			// ResetVT resets the elements of pooled messages before truncating their
+			// lists, but the elements of other messages may still hold old data.
+			p.P(`} else {`)
+			if p.ShouldPool(field.Message) {
+				p.P(`v.ResetVT()`)
+			} else {
+				p.P(`v.Reset()`)
+			}
+		}
+		p.P(`}`)
+		p.P(p.Set("m", field, `append(list, v)`))
+		p.decodeMessage("v", buf, field.Message)
+		return
+	}
+	p.P(`v := `, p.Get("m", field))
+	p.P(`if v == nil {`)
+	p.P(`v = `, alloc)
+	p.P(p.Set("m", field, `v`))
+	p.P(`}`)
+	p.decodeMessage("v", buf, field.Message)
+}
+
+// opaqueMap decodes a map entry of field, which ends at postIndex, into the map held by
+// the field.
+func (p *unmarshal) opaqueMap(field *protogen.Field, proto3 bool) {
+	unique := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetUnique()
+	goTyp, _ := p.FieldGoType(field)
+	goTypK, _ := p.FieldGoType(field.Message.Fields[0])
+	goTypV, _ := p.FieldGoType(field.Message.Fields[1])
+	keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+
+	p.P(`entries := `, p.Get("m", field))
+	p.P(`if entries == nil {`)
+	p.P(`entries = make(`, goTyp, `)`)
+	p.P(p.Set("m", field, `entries`))
+	p.P(`}`)
+	p.P("var mapkey ", goTypK)
+	p.P("var mapvalue ", goTypV)
+	p.P(`for iNdEx < postIndex {`)
+	p.P(`entryPreIndex := iNdEx`)
+	p.P(`var wire uint64`)
+	p.decodeVarint("wire", "uint64")
+	p.P(`fieldNum := int32(wire >> 3)`)
+	p.P(`if fieldNum == 1 {`)
+	p.mapField("mapkey", field, keyField, unique, false, false, proto3)
+	p.P(`} else if fieldNum == 2 {`)
+	p.mapField("mapvalue", field, valueField, unique, false, true, proto3)
+	if valueField.Desc.Kind() == protoreflect.EnumKind {
+		p.checkEnumValue("mapvalue", valueField, p.isStrictEnum(field))
+	}
+	p.P(`} else {`)
+	p.P(`iNdEx = entryPreIndex`)
+	p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[iNdEx:])`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
+	p.P(`return `, p.Helper("ErrInvalidLength"))
+	p.P(`}`)
+	p.P(`if (iNdEx + skippy) > postIndex {`)
+	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
+	p.P(`}`)
+	p.P(`iNdEx += skippy`)
+	p.P(`}`)
+	p.P(`}`)
+	if proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetStrictMapKeys() {
+		p.P(`if _, ok := entries[mapkey]; ok {`)
+		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: duplicate key %v in map field `, field.Desc.FullName(), `", mapkey)`)
+		p.P(`}`)
+	}
+	p.strictMapValue(valueField)
+	p.P(`entries[mapkey] = mapvalue`)
+}
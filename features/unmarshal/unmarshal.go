@@ -27,17 +27,58 @@ func init() {
 	generator.RegisterFeature("unmarshal_unsafe", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &unmarshal{GeneratedFile: gen, unsafe: true}
 	})
+
+	generator.RegisterFeature("merge_wire", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, merge: true}
+	})
+
+	generator.RegisterOptInFeature("unmarshal_strict", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, strict: true}
+	})
+
+	generator.RegisterOptInFeature("unmarshal_alloc", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, alloc: true}
+	})
+
+	generator.RegisterOptInFeature("unmarshal_arena", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, alloc: true, arena: true}
+	})
 }
 
+const (
+	vtallocPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtalloc")
+	vtarenaPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtarena")
+)
+
 type unmarshal struct {
 	*generator.GeneratedFile
 	unsafe bool
-	once   bool
+	// merge is set when generating MergeFromWireVT, which checks the required fields of
+	// the merged message instead of the ones of the decoded data
+	merge bool
+	// strict is set when generating UnmarshalVTStrict, which also checks the required
+	// fields that UnmarshalVT leaves to the protobuf runtime or does not decode: the ones
+	// of extensions and of the map values missing from their entries
+	strict bool
+	// alloc is set when generating UnmarshalVTOptions, which takes the messages, bytes
+	// and strings it decodes from the vtalloc.Allocator of its options
+	alloc bool
+	// arena is set when generating UnmarshalVTArena, along with the UnmarshalVTOptions
+	// method it calls
+	arena bool
+	once  bool
+	// counted holds the index in fieldCounts of the fields of the current message
+	// whose elements are counted before decoding, with the exact slice growth
+	counted map[*protogen.Field]int
 }
 
 var _ generator.FeatureGenerator = (*unmarshal)(nil)
 
 func (p *unmarshal) GenerateFile(file *protogen.File) bool {
+	if p.alloc && !p.arena && p.HasFeature("unmarshal_arena") {
+		// UnmarshalVTOptions is generated by unmarshal_arena.
+		return false
+	}
 	proto3 := file.Desc.Syntax() == protoreflect.Proto3
 	for _, message := range file.Messages {
 		p.message(proto3, message)
@@ -47,39 +88,158 @@ func (p *unmarshal) GenerateFile(file *protogen.File) bool {
 }
 
 func (p *unmarshal) methodUnmarshal() string {
-	if p.unsafe {
+	switch {
+	case p.unsafe:
 		return "UnmarshalVTUnsafe"
+	case p.merge:
+		return "MergeFromWireVT"
+	case p.strict:
+		return "UnmarshalVTStrict"
+	case p.alloc:
+		return "UnmarshalVTOptions"
 	}
 	return "UnmarshalVT"
 }
 
+// methodFeatures holds the features generating the methods called by decodeMessage.
+var methodFeatures = map[string]string{
+	"UnmarshalVT":       "unmarshal",
+	"UnmarshalVTUnsafe": "unmarshal_unsafe",
+	"MergeFromWireVT":   "merge_wire",
+	"UnmarshalVTStrict": "unmarshal_strict",
+}
+
+// allocates returns true if message has an UnmarshalVTOptions method, to which the
+// options are passed when decoding the messages holding it with the unmarshal_alloc
+// feature. The other messages are decoded with UnmarshalVT.
+func (p *unmarshal) allocates(message *protogen.Message) bool {
+	return p.IsLocalMessage(message) && (p.HasVTFeature(message, "unmarshal_alloc") || p.HasVTFeature(message, "unmarshal_arena")) && !p.IsWellKnownType(message)
+}
+
+// newMessage returns the expression of a new empty message, which is allocated by the
+// allocator of the options with the unmarshal_alloc feature.
+func (p *unmarshal) newMessage(message *protogen.Message) string {
+	typ := p.QualifiedGoIdent(message.GoIdent)
+	if p.alloc {
+		return `alloc.NewMessage((*` + typ + `)(nil).ProtoReflect().Type()).(*` + typ + `)`
+	}
+	return `&` + typ + `{}`
+}
+
+// appendMessage generates the code appending a new message to the repeated message field,
+// or reusing the element past the length of its slice when messages are reused.
+func (p *unmarshal) appendMessage(message *protogen.Message, field *protogen.Field, fieldname string) {
+	if p.ShouldReuseMessages(message) {
+		p.P(`if len(m.`, fieldname, `) == cap(m.`, fieldname, `) {`)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, p.newMessage(field.Message), `)`)
+		p.P(`} else {`)
+		p.P(`m.`, fieldname, ` = m.`, fieldname, `[:len(m.`, fieldname, `) + 1]`)
+		p.P(`if m.`, fieldname, `[len(m.`, fieldname, `) - 1] == nil {`)
+		p.P(`m.`, fieldname, `[len(m.`, fieldname, `) - 1] = `, p.newMessage(field.Message))
+		if !p.ShouldPool(message) {
+			// ResetVT resets the elements of pooled messages before truncating their
+			// slices, but the elements of other messages may still hold old data.
+			p.P(`} else {`)
+			if p.ShouldPool(field.Message) {
+				p.P(`m.`, fieldname, `[len(m.`, fieldname, `) - 1].ResetVT()`)
+			} else {
+				p.P(`m.`, fieldname, `[len(m.`, fieldname, `) - 1].Reset()`)
+			}
+		}
+		p.P(`}`)
+		p.P(`}`)
+	} else {
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, p.newMessage(field.Message), `)`)
+	}
+}
+
 func (p *unmarshal) decodeMessage(varName, buf string, message *protogen.Message) {
+	method := p.methodUnmarshal()
+	if p.alloc {
+		if p.allocates(message) {
+			p.P(`if err := `, varName, `.`, method, `(`, buf, `, opts); err != nil {`)
+			p.P(`return err`)
+			p.P(`}`)
+			return
+		}
+		method = "UnmarshalVT"
+	}
 	switch {
 	case p.IsWellKnownType(message):
-		p.P(`if err := (*`, p.WellKnownTypeMap(message), `)(`, varName, `).`, p.methodUnmarshal(), `(`, buf, `); err != nil {`)
+		if p.strict {
+			// The well-known types have no required fields.
+			method = "UnmarshalVT"
+		}
+		p.P(`if err := (*`, p.WellKnownTypeMap(message), `)(`, varName, `).`, method, `(`, buf, `); err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+
+	case p.HasVTFeature(message, methodFeatures[method]):
+		p.P(`if err := `, varName, `.`, method, `(`, buf, `); err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
 
-	case p.IsLocalMessage(message):
-		p.P(`if err := `, varName, `.`, p.methodUnmarshal(), `(`, buf, `); err != nil {`)
+	case p.Config.Registry && !p.merge && !p.strict:
+		p.P(`if err := `, p.RegistryType(message), `.`, method, `(`, varName, `, `, buf, `); err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
 
 	default:
 		p.P(`if unmarshal, ok := interface{}(`, varName, `).(interface{`)
-		p.P(p.methodUnmarshal(), `([]byte) error`)
+		p.P(method, `([]byte) error`)
 		p.P(`}); ok{`)
-		p.P(`if err := unmarshal.`, p.methodUnmarshal(), `(`, buf, `); err != nil {`)
+		p.P(`if err := unmarshal.`, method, `(`, buf, `); err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
 		p.P(`} else {`)
-		p.P(`if err := `, p.Ident(generator.ProtoPkg, "Unmarshal"), `(`, buf, `, `, varName, `); err != nil {`)
+		if p.merge {
+			p.P(`if err := (`, p.Ident(generator.ProtoPkg, "UnmarshalOptions"), `{Merge: true}).Unmarshal(`, buf, `, `, varName, `); err != nil {`)
+		} else {
+			p.P(`if err := `, p.Ident(generator.ProtoPkg, "Unmarshal"), `(`, buf, `, `, varName, `); err != nil {`)
+		}
 		p.P(`return err`)
 		p.P(`}`)
 		p.P(`}`)
 	}
 }
 
+// reachesStrict returns true if UnmarshalVTStrict checks more fields than UnmarshalVT for
+// the given message, or one of the messages decoded by its generated methods: when they
+// have extensions, or maps of messages reaching required fields.
+func (p *unmarshal) reachesStrict(message *protogen.Message, seen map[*protogen.Message]bool) bool {
+	if seen[message] {
+		return false
+	}
+	seen[message] = true
+	if message.Desc.ExtensionRanges().Len() > 0 {
+		return true
+	}
+	for _, field := range message.Fields {
+		if field.Desc.IsMap() {
+			if value := field.Message.Fields[1].Message; value != nil && p.ReachesRequired(value) {
+				return true
+			}
+		}
+		if field.Message != nil && (field.Message.Desc.IsMapEntry() || p.IsVTMessage(field.Message)) && p.reachesStrict(field.Message, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// strictMapValue generates the check by UnmarshalVTStrict of the value of a map entry
+// that was missing from the entry: the protobuf runtime decodes it as an empty message,
+// whose required fields are not set.
+func (p *unmarshal) strictMapValue(valueField *protogen.Field) {
+	if !p.strict || valueField.Message == nil || !p.ReachesRequired(valueField.Message) {
+		return
+	}
+	p.P(`if mapvalue == nil {`)
+	p.P(`mapvalue = `, p.newMessage(valueField.Message))
+	p.decodeMessage("mapvalue", "nil", valueField.Message)
+	p.P(`}`)
+}
+
 func (p *unmarshal) decodeVarint(varName string, typName string) {
 	p.P(`for shift := uint(0); ; shift += 7 {`)
 	p.P(`if shift >= 64 {`)
@@ -157,7 +317,12 @@ func (p *unmarshal) declareMapField(varName string, nullable bool, field *protog
 	}
 }
 
-func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool, proto3 bool) {
+// mapField decodes the key or the value of a map entry into varName. When alias is set,
+// strings refer to dAtA instead of being copied, and must be cloned before being stored.
+// When pooled is set, message values of pooled types are taken from their pool. The
+// strings and bytes are checked against the max_len of mapField, the map field holding
+// the entry.
+func (p *unmarshal) mapField(varName string, mapField, field *protogen.Field, unique, alias, pooled bool, proto3 bool) {
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		p.P(`var `, varName, `temp uint64`)
@@ -188,6 +353,7 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 		p.P(`if intStringLen`, varName, ` < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
+		p.checkMaxLen(mapField, field, `intStringLen`+varName)
 		p.P(`postStringIndex`, varName, ` := iNdEx + intStringLen`, varName)
 		p.P(`if postStringIndex`, varName, ` < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
@@ -195,14 +361,14 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 		p.P(`if postStringIndex`, varName, ` > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		// Add UTF-8 validation for proto3 string fields
-		if proto3 {
+		// Add UTF-8 validation for string fields that require it
+		if p.EnforceUTF8(field) {
 			p.P(`if err := `, p.Helper("ValidateUTF8"), `(dAtA[iNdEx:postStringIndex`, varName, `]); err != nil {`)
 			p.P(`return err`)
 			p.P(`}`)
 		}
 		switch {
-		case p.unsafe:
+		case p.unsafe || alias:
 			p.P(`if intStringLen`, varName, ` == 0 {`)
 			p.P(varName, ` = ""`)
 			p.P(`} else {`)
@@ -214,6 +380,8 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 			p.P(`} else {`)
 			p.P(varName, ` = `, p.Ident("unique", `Make`), `[string](`, p.Ident("unsafe", `String`), `(&dAtA[iNdEx], intStringLen`, varName, `)).Value()`)
 			p.P(`}`)
+		case p.alloc:
+			p.P(varName, ` = alloc.String(dAtA[iNdEx:postStringIndex`, varName, `])`)
 		default:
 			p.P(varName, ` = `, "string", `(dAtA[iNdEx:postStringIndex`, varName, `])`)
 		}
@@ -232,7 +400,11 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
 		buf := `dAtA[iNdEx:postmsgIndex]`
-		p.P(varName, ` = &`, p.noStarOrSliceType(field), `{}`)
+		if pooled && p.ShouldPool(field.Message) && !p.alloc {
+			p.P(varName, ` = `, field.Message.GoIdent, `FromVTPool()`)
+		} else {
+			p.P(varName, ` = `, p.newMessage(field.Message))
+		}
 		p.decodeMessage(varName, buf, field.Message)
 		p.P(`iNdEx = postmsgIndex`)
 	case protoreflect.BytesKind:
@@ -242,6 +414,7 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 		p.P(`if intMapbyteLen < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
+		p.checkMaxLen(mapField, field, `intMapbyteLen`)
 		p.P(`postbytesIndex := iNdEx + intMapbyteLen`)
 		p.P(`if postbytesIndex < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
@@ -251,6 +424,9 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 		p.P(`}`)
 		if p.unsafe {
 			p.P(varName, ` = dAtA[iNdEx:postbytesIndex]`)
+		} else if p.alloc {
+			p.P(varName, ` = alloc.Bytes(intMapbyteLen)`)
+			p.P(`copy(`, varName, `, dAtA[iNdEx:postbytesIndex])`)
 		} else {
 			p.P(varName, ` = make([]byte, mapbyteLen)`)
 			p.P(`copy(`, varName, `, dAtA[iNdEx:postbytesIndex])`)
@@ -278,6 +454,110 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field, unique bool,
 	}
 }
 
+// isStrictEnum returns true if unknown values for the given enum field must be
+// rejected instead of being stored as-is.
+func (p *unmarshal) isStrictEnum(field *protogen.Field) bool {
+	return proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetStrictEnum()
+}
+
+// isMemBuffer returns true if the given bytes field aliases the decoded data even when
+// unmarshaling safely.
+func (p *unmarshal) isMemBuffer(field *protogen.Field) bool {
+	return proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetMemBuffer()
+}
+
+// checkEnumValue emits a check that fails decoding when the value stored in varName
+// is not one of the values declared by the field's enum. It is a no-op unless strict is set.
+func (p *unmarshal) checkEnumValue(varName string, field *protogen.Field, strict bool) {
+	if !strict {
+		return
+	}
+	names := protogen.GoIdent{GoName: field.Enum.GoIdent.GoName + "_name", GoImportPath: field.Enum.GoIdent.GoImportPath}
+	p.P(`if _, ok := `, names, `[int32(`, varName, `)]; !ok {`)
+	p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: invalid value %d for enum field `, field.Desc.FullName(), `", `, varName, `)`)
+	p.P(`}`)
+}
+
+func (p *unmarshal) assignString(field *protogen.Field, fieldname, str string, oneof, repeated bool) {
+	switch {
+	case oneof:
+		p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", str, `}`)
+	case repeated:
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, str, `)`)
+	case p.FieldPresence(field) == generator.PresenceImplicit:
+		p.P(`m.`, fieldname, ` = `, str)
+	default:
+		p.P(`s := `, str)
+		p.P(`m.`, fieldname, ` = &s`)
+	}
+}
+
+// reuseString is like assignString, but keeps the current value of the field when it
+// is equal to the decoded one. For repeated fields, the current value is the element
+// kept in the capacity of the slice. The comparisons do not allocate.
+func (p *unmarshal) reuseString(field *protogen.Field, fieldname, str string, oneof, repeated bool) {
+	switch {
+	case oneof:
+		p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); !ok || oneof.`, field.GoName, ` != `, str, ` {`)
+		p.assignString(field, fieldname, str, oneof, repeated)
+		p.P(`}`)
+	case repeated:
+		p.P(`if n := len(m.`, fieldname, `); n < cap(m.`, fieldname, `) && m.`, fieldname, `[:n+1][n] == `, str, ` {`)
+		p.P(`m.`, fieldname, ` = m.`, fieldname, `[:n+1]`)
+		p.P(`} else {`)
+		p.assignString(field, fieldname, str, oneof, repeated)
+		p.P(`}`)
+	case p.FieldPresence(field) == generator.PresenceImplicit:
+		p.P(`if m.`, fieldname, ` != `, str, ` {`)
+		p.assignString(field, fieldname, str, oneof, repeated)
+		p.P(`}`)
+	default:
+		p.P(`if m.`, fieldname, ` == nil || *m.`, fieldname, ` != `, str, ` {`)
+		p.assignString(field, fieldname, str, oneof, repeated)
+		p.P(`}`)
+	}
+}
+
+// dedup returns the dedup option of a repeated string field. It is ignored for unsafe
+// unmarshaling and for fields with the unique option, whose strings are not allocated,
+// and when the strings are allocated by the allocator of the options.
+func (p *unmarshal) dedup(field *protogen.Field) vtproto.Dedup {
+	if p.unsafe || p.alloc || !field.Desc.IsList() || field.Desc.Kind() != protoreflect.StringKind {
+		return vtproto.Dedup_DEDUP_NONE
+	}
+	opts := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts)
+	if opts.GetUnique() {
+		return vtproto.Dedup_DEDUP_NONE
+	}
+	return opts.GetDedup()
+}
+
+// dedupString appends the decoded value to a repeated string field annotated with the
+// dedup option, reusing an equal string decoded earlier instead of allocating a new one.
+// With DEDUP_ALL, the strings are kept in the dedup<Field> set declared by message.
+func (p *unmarshal) dedupString(field *protogen.Field, fieldname, str string) {
+	switch p.dedup(field) {
+	case vtproto.Dedup_DEDUP_ADJACENT:
+		p.P(`if n := len(m.`, fieldname, `); n > 0 && m.`, fieldname, `[n-1] == `, str, ` {`)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, m.`, fieldname, `[n-1])`)
+		p.P(`} else {`)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, str, `)`)
+		p.P(`}`)
+	case vtproto.Dedup_DEDUP_ALL:
+		set := "dedup" + field.GoName
+		p.P(`if s, ok := `, set, `[`, str, `]; ok {`)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, s)`)
+		p.P(`} else {`)
+		p.P(`s := `, str)
+		p.P(`if `, set, ` == nil {`)
+		p.P(set, ` = make(map[string]string)`)
+		p.P(`}`)
+		p.P(set, `[s] = s`)
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, s)`)
+		p.P(`}`)
+	}
+}
+
 func (p *unmarshal) noStarOrSliceType(field *protogen.Field) string {
 	typ, _ := p.FieldGoType(field)
 	if typ[0] == '[' && typ[1] == ']' {
@@ -303,7 +583,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		} else if repeated {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v2)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
 		} else {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
@@ -317,7 +597,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		} else if repeated {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v2)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
 		} else {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
@@ -332,7 +612,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeVarint("m."+fieldname, typ)
 		} else {
@@ -349,7 +629,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeVarint("m."+fieldname, typ)
 		} else {
@@ -366,7 +646,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeVarint("m."+fieldname, typ)
 		} else {
@@ -383,7 +663,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeFixed64("m."+fieldname, typ)
 		} else {
@@ -400,7 +680,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeFixed32("m."+fieldname, typ)
 		} else {
@@ -416,7 +696,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: b}`)
 		} else if repeated {
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(v != 0))`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = `, typ, `(v != 0)`)
 		} else {
 			p.P(`b := `, typ, `(v != 0)`)
@@ -431,6 +711,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`if intStringLen < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
+		p.checkMaxLen(field, field, `intStringLen`)
 		p.P(`postIndex := iNdEx + intStringLen`)
 		p.P(`if postIndex < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
@@ -438,13 +719,16 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		// Add UTF-8 validation for proto3 string fields
-		if proto3 {
+		// Add UTF-8 validation for string fields that require it
+		if p.EnforceUTF8(field) {
 			p.P(`if err := `, p.Helper("ValidateUTF8"), `(dAtA[iNdEx:postIndex]); err != nil {`)
 			p.P(`return err`)
 			p.P(`}`)
 		}
 		str := "string(dAtA[iNdEx:postIndex])"
+		if p.alloc && !unique {
+			str = "alloc.String(dAtA[iNdEx:postIndex])"
+		}
 		switch {
 		case p.unsafe:
 			str = "stringValue"
@@ -459,15 +743,15 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`stringValue = `, p.Ident("unique", `Make`), `[string](`, p.Ident("unsafe", `String`), `(&dAtA[iNdEx], intStringLen)).Value()`)
 			p.P(`}`)
 		}
-		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", str, `}`)
-		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, str, `)`)
-		} else if !field.Desc.HasPresence() {
-			p.P(`m.`, fieldname, ` = `, str)
-		} else {
-			p.P(`s := `, str)
-			p.P(`m.`, fieldname, ` = &s`)
+		switch {
+		case p.unsafe || unique || p.alloc:
+			p.assignString(field, fieldname, str, oneof, repeated)
+		case p.dedup(field) != vtproto.Dedup_DEDUP_NONE:
+			p.dedupString(field, fieldname, str)
+		case p.ShouldReuseStrings(message):
+			p.reuseString(field, fieldname, str, oneof, repeated)
+		default:
+			p.assignString(field, fieldname, str, oneof, repeated)
 		}
 		p.P(`iNdEx = postIndex`)
 	case protoreflect.GroupKind:
@@ -476,19 +760,40 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`maybeGroupEnd := iNdEx`)
 		p.P(`var groupFieldWire uint64`)
 		p.decodeVarint("groupFieldWire", "uint64")
-		p.P(`groupWireType := int(wire & 0x7)`)
+		p.P(`groupWireType := int(groupFieldWire & 0x7)`)
 		p.P(`if groupWireType == `, strconv.Itoa(int(protowire.EndGroupType)), `{`)
-		p.decodeMessage("m."+fieldname, "dAtA[groupStart:maybeGroupEnd]", field.Message)
+		buf := `dAtA[groupStart:maybeGroupEnd]`
+		if oneof {
+			p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
+			p.decodeMessage("oneof."+field.GoName, buf, field.Message)
+			p.P(`} else {`)
+			if p.ShouldPool(message) && p.ShouldPool(field.Message) && !p.alloc {
+				p.P(`v := `, p.noStarOrSliceType(field), `FromVTPool()`)
+			} else {
+				p.P(`v := `, p.newMessage(field.Message))
+			}
+			p.decodeMessage("v", buf, field.Message)
+			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(`}`)
+		} else if repeated {
+			p.appendMessage(message, field, fieldname)
+			p.decodeMessage(fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname), buf, field.Message)
+		} else {
+			p.P(`if m.`, fieldname, ` == nil {`)
+			p.P(`m.`, fieldname, ` = `, p.newMessage(field.Message))
+			p.P(`}`)
+			p.decodeMessage("m."+fieldname, buf, field.Message)
+		}
 		p.P(`break`)
 		p.P(`}`)
-		p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[iNdEx:])`)
+		p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[maybeGroupEnd:])`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
-		p.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
+		p.P(`if (skippy < 0) || (maybeGroupEnd + skippy) < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
-		p.P(`iNdEx += skippy`)
+		p.P(`iNdEx = maybeGroupEnd + skippy`)
 		p.P(`}`)
 	case protoreflect.MessageKind:
 		p.P(`var msglen int`)
@@ -509,10 +814,10 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
 			p.decodeMessage("oneof."+field.GoName, buf, field.Message)
 			p.P(`} else {`)
-			if p.ShouldPool(message) && p.ShouldPool(field.Message) {
+			if p.ShouldPool(message) && p.ShouldPool(field.Message) && !p.alloc {
 				p.P(`v := `, msgname, `FromVTPool()`)
 			} else {
-				p.P(`v := &`, msgname, `{}`)
+				p.P(`v := `, p.newMessage(field.Message))
 			}
 			p.decodeMessage("v", buf, field.Message)
 			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
@@ -537,10 +842,20 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.decodeVarint("wire", "uint64")
 			p.P(`fieldNum := int32(wire >> 3)`)
 
+			// Safe string keys and values are decoded as aliases of dAtA, and only copied when the
+			// entry is stored, so that overwriting an entry with the same value does not allocate.
+			keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+			aliasKey := !p.unsafe && !p.alloc && !unique && keyField.Desc.Kind() == protoreflect.StringKind
+			aliasValue := !p.unsafe && !p.alloc && !unique && valueField.Desc.Kind() == protoreflect.StringKind
+
 			p.P(`if fieldNum == 1 {`)
-			p.mapField("mapkey", field.Message.Fields[0], unique, proto3)
+			p.mapField("mapkey", field, keyField, unique, aliasKey, false, proto3)
 			p.P(`} else if fieldNum == 2 {`)
-			p.mapField("mapvalue", field.Message.Fields[1], unique, proto3)
+			// Pooled values are returned by ResetVT or ReleaseMapVT, whether m is pooled or not.
+			p.mapField("mapvalue", field, valueField, unique, aliasValue, true, proto3)
+			if valueField.Desc.Kind() == protoreflect.EnumKind {
+				p.checkEnumValue("mapvalue", valueField, p.isStrictEnum(field))
+			}
 			p.P(`} else {`)
 			p.P(`iNdEx = entryPreIndex`)
 			p.P(`skippy, err := `, p.Helper("Skip"), `(dAtA[iNdEx:])`)
@@ -556,20 +871,15 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`iNdEx += skippy`)
 			p.P(`}`)
 			p.P(`}`)
-			p.P(`m.`, fieldname, `[mapkey] = mapvalue`)
-		} else if repeated {
-			if p.ShouldPool(message) {
-				p.P(`if len(m.`, fieldname, `) == cap(m.`, fieldname, `) {`)
-				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
-				p.P(`} else {`)
-				p.P(`m.`, fieldname, ` = m.`, fieldname, `[:len(m.`, fieldname, `) + 1]`)
-				p.P(`if m.`, fieldname, `[len(m.`, fieldname, `) - 1] == nil {`)
-				p.P(`m.`, fieldname, `[len(m.`, fieldname, `) - 1] = &`, field.Message.GoIdent, `{}`)
-				p.P(`}`)
+			if proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetStrictMapKeys() {
+				p.P(`if _, ok := m.`, fieldname, `[mapkey]; ok {`)
+				p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: duplicate key %v in map field `, field.Desc.FullName(), `", mapkey)`)
 				p.P(`}`)
-			} else {
-				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
 			}
+			p.strictMapValue(valueField)
+			p.storeMapEntry(fieldname, valueField, aliasKey, aliasValue)
+		} else if repeated {
+			p.appendMessage(message, field, fieldname)
 			varname := fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname)
 			buf := `dAtA[iNdEx:postIndex]`
 			p.decodeMessage(varname, buf, field.Message)
@@ -577,10 +887,10 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			// Always use fast vtprotobuf decode for all message fields (including lazy).
 			// This provides maximum performance with no reflection overhead.
 			p.P(`if m.`, fieldname, ` == nil {`)
-			if p.ShouldPool(message) && p.ShouldPool(field.Message) {
+			if p.ShouldPool(message) && p.ShouldPool(field.Message) && !p.alloc {
 				p.P(`m.`, fieldname, ` = `, field.Message.GoIdent, `FromVTPool()`)
 			} else {
-				p.P(`m.`, fieldname, ` = &`, field.Message.GoIdent, `{}`)
+				p.P(`m.`, fieldname, ` = `, p.newMessage(field.Message))
 			}
 			p.P(`}`)
 			p.decodeMessage("m."+fieldname, "dAtA[iNdEx:postIndex]", field.Message)
@@ -593,6 +903,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`if byteLen < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
+		p.checkMaxLen(field, field, `byteLen`)
 		p.P(`postIndex := iNdEx + byteLen`)
 		p.P(`if postIndex < 0 {`)
 		p.P(`return `, p.Helper("ErrInvalidLength"))
@@ -600,8 +911,27 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		if oneof {
-			if p.unsafe {
+		// The data of pooled fields is always copied, since ResetVT returns it to the pools.
+		pooled := p.PooledBytes(field)
+		alias := (p.unsafe || p.isMemBuffer(field)) && !pooled
+		if p.alloc && !alias && !pooled {
+			switch {
+			case oneof:
+				p.P(`v := alloc.Bytes(postIndex-iNdEx)`)
+				p.P(`copy(v, dAtA[iNdEx:postIndex])`)
+				p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			case repeated:
+				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, alloc.Bytes(postIndex-iNdEx))`)
+				p.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], dAtA[iNdEx:postIndex])`)
+			default:
+				p.P(`m.`, fieldname, ` = alloc.Bytes(postIndex-iNdEx)`)
+				p.P(`copy(m.`, fieldname, `, dAtA[iNdEx:postIndex])`)
+				p.P(`if m.`, fieldname, ` == nil {`)
+				p.P(`m.`, fieldname, ` = []byte{}`)
+				p.P(`}`)
+			}
+		} else if oneof {
+			if alias {
 				p.P(`v := dAtA[iNdEx:postIndex]`)
 				p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 			} else {
@@ -615,15 +945,20 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 				p.P(`}`)
 			}
 		} else if repeated {
-			if p.unsafe {
+			if alias {
 				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, dAtA[iNdEx:postIndex])`)
+			} else if pooled {
+				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, p.Helper("GetBytes"), `(postIndex-iNdEx))`)
+				p.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], dAtA[iNdEx:postIndex])`)
 			} else {
 				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, make([]byte, postIndex-iNdEx))`)
 				p.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], dAtA[iNdEx:postIndex])`)
 			}
 		} else {
-			if p.unsafe {
+			if alias {
 				p.P(`m.`, fieldname, ` = dAtA[iNdEx:postIndex]`)
+			} else if pooled {
+				p.P(`m.`, fieldname, ` = `, p.Helper("ReuseBytes"), `(m.`, fieldname, `, dAtA[iNdEx:postIndex])`)
 			} else {
 				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `[:0] , dAtA[iNdEx:postIndex]...)`)
 				p.P(`if m.`, fieldname, ` == nil {`)
@@ -641,7 +976,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeVarint("m."+fieldname, typ)
 		} else {
@@ -650,20 +985,25 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`m.`, fieldname, ` = &v`)
 		}
 	case protoreflect.EnumKind:
+		strict := p.isStrictEnum(field)
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
+			p.checkEnumValue("v", field, strict)
 			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
+			p.checkEnumValue("v", field, strict)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeVarint("m."+fieldname, typ)
+			p.checkEnumValue("m."+fieldname, field, strict)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
+			p.checkEnumValue("v", field, strict)
 			p.P(`m.`, fieldname, ` = &v`)
 		}
 	case protoreflect.Sfixed32Kind:
@@ -675,7 +1015,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeFixed32("m."+fieldname, typ)
 		} else {
@@ -692,7 +1032,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = 0`)
 			p.decodeFixed64("m."+fieldname, typ)
 		} else {
@@ -708,7 +1048,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = v`)
 		} else {
 			p.P(`m.`, fieldname, ` = &v`)
@@ -721,7 +1061,7 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, `(v)}`)
 		} else if repeated {
 			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(v))`)
-		} else if !field.Desc.HasPresence() {
+		} else if p.FieldPresence(field) == generator.PresenceImplicit {
 			p.P(`m.`, fieldname, ` = `, typ, `(v)`)
 		} else {
 			p.P(`v2 := `, typ, `(v)`)
@@ -732,6 +1072,186 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 	}
 }
 
+// checkMaxLen emits the check of length, the length of a string or bytes value of field
+// held by value, against the max_len option of field or the max-len flag, if any.
+func (p *unmarshal) checkMaxLen(field, value *protogen.Field, length string) {
+	maxLen := p.MaxLen(field, value)
+	if maxLen == 0 {
+		return
+	}
+	limit := strconv.FormatUint(maxLen, 10)
+	p.P(`if uint64(`, length, `) > `, limit, ` {`)
+	p.P(`return &`, p.Helper("LengthError"), `{Field: "`, field.Desc.FullName(), `", Len: `, length, `, Max: `, limit, `}`)
+	p.P(`}`)
+}
+
+// reserveMaxCount emits the bounds check for a repeated field annotated with
+// the max_count option. The backing slice is allocated with exactly maxCount
+// capacity, so it never grows during decoding; adding more elements than that
+// is reported as an error. It is a no-op when maxCount is zero.
+func (p *unmarshal) reserveMaxCount(field *protogen.Field, fieldname, errFieldname, count string, maxCount uint32) {
+	if maxCount == 0 {
+		return
+	}
+	limit := strconv.FormatUint(uint64(maxCount), 10)
+	p.P(`if len(m.`, fieldname, `)+`, count, ` > `, limit, ` {`)
+	p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: field `, errFieldname, ` exceeds max_count of `, limit, `")`)
+	p.P(`}`)
+	fieldtyp, _ := p.FieldGoType(field)
+	p.P(`if cap(m.`, fieldname, `) < `, limit, ` {`)
+	p.P(`m.`, fieldname, ` = append(make(`, fieldtyp, `, 0, `, limit, `), m.`, fieldname, `...)`)
+	p.P(`}`)
+}
+
+// storeMapEntry emits the assignment of the decoded entry to the map. Entries whose key
+// or value alias dAtA are skipped when the map already holds the same value for the key,
+// which is checked without allocating; otherwise the aliased strings are cloned.
+func (p *unmarshal) storeMapEntry(fieldname string, valueField *protogen.Field, aliasKey, aliasValue bool) {
+	if !aliasKey && !aliasValue {
+		p.P(`m.`, fieldname, `[mapkey] = mapvalue`)
+		return
+	}
+	key, value := "mapkey", "mapvalue"
+	if aliasKey {
+		key = p.Ident("strings", "Clone") + "(mapkey)"
+	}
+	if aliasValue {
+		value = p.Ident("strings", "Clone") + "(mapvalue)"
+	}
+	switch valueField.Desc.Kind() {
+	case protoreflect.BoolKind, protoreflect.EnumKind, protoreflect.StringKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.P(`if old, ok := m.`, fieldname, `[mapkey]; !ok || old != mapvalue {`)
+		p.P(`m.`, fieldname, `[`, key, `] = `, value)
+		p.P(`}`)
+	default:
+		// Floats are not compared since -0 and NaN do not round-trip with ==, and
+		// bytes and messages are allocated by their decoding anyway.
+		p.P(`m.`, fieldname, `[`, key, `] = `, value)
+	}
+}
+
+// packedVarints emits the decoding loop of a packed field of varints. The loop ranges over
+// the bytes of the packed field, which lets the compiler eliminate their bounds checks, and
+// accumulates each varint until its last byte.
+func (p *unmarshal) packedVarints(field *protogen.Field, fieldname string, maxCount uint32) {
+	typ := p.noStarOrSliceType(field)
+	p.P(`var v uint64`)
+	p.P(`var shift uint`)
+	p.P(`for _, b := range dAtA[iNdEx:postIndex] {`)
+	p.P(`v |= uint64(b&0x7F) << shift`)
+	p.P(`if b >= 0x80 {`)
+	p.P(`shift += 7`)
+	p.P(`if shift >= 70 {`)
+	p.P(`return `, p.Helper("ErrIntOverflow"))
+	p.P(`}`)
+	p.P(`continue`)
+	p.P(`}`)
+	p.growSlice(field, fieldname, maxCount)
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v != 0)`)
+	case protoreflect.EnumKind:
+		p.P(`e := `, typ, `(v)`)
+		p.checkEnumValue("e", field, p.isStrictEnum(field))
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, e)`)
+	case protoreflect.Sint32Kind:
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, int32(uint32(v) >> 1) ^ -int32(v&1))`)
+	case protoreflect.Sint64Kind:
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, int64(v >> 1) ^ -int64(v&1))`)
+	default:
+		p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(v))`)
+	}
+	p.P(`v, shift = 0, 0`)
+	p.P(`}`)
+	p.P(`if shift != 0 {`)
+	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
+	p.P(`}`)
+	p.P(`iNdEx = postIndex`)
+}
+
+// packedFloats emits, for a packed float or double field, a loop loading each element of the
+// packed field from dAtA as a whole word, without checking the bounds of each element. The
+// elements are read with encoding/binary, which the compiler lowers to a single load on the
+// targets that allow unaligned loads. The elements left, if any, are decoded by the regular
+// loop that follows.
+func (p *unmarshal) packedFloats(field *protogen.Field, fieldname string, maxCount uint32) {
+	var size, frombits, uint string
+	switch field.Desc.Kind() {
+	case protoreflect.DoubleKind:
+		size, frombits, uint = "8", "Float64frombits", "Uint64"
+	case protoreflect.FloatKind:
+		size, frombits, uint = "4", "Float32frombits", "Uint32"
+	default:
+		return
+	}
+	p.P(`for ; iNdEx+`, size, ` <= postIndex; iNdEx += `, size, ` {`)
+	p.growSlice(field, fieldname, maxCount)
+	p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, p.Ident("math", frombits), `(`, p.Ident("encoding/binary", "LittleEndian"), `.`, uint, `(dAtA[iNdEx:])))`)
+	p.P(`}`)
+}
+
+// growSlice emits the code making room for the next element of a repeated field according
+// to the slice growth of the configuration. With the append growth, or when the field has a
+// max_count, the slice is left to append.
+func (p *unmarshal) growSlice(field *protogen.Field, fieldname string, maxCount uint32) {
+	if maxCount > 0 {
+		return
+	}
+	switch p.Config.SliceGrowth.Strategy {
+	case generator.SliceGrowthDouble:
+		p.P(`if len(m.`, fieldname, `) == cap(m.`, fieldname, `) {`)
+		p.P(`m.`, fieldname, ` = `, p.Helper("GrowDoubling"), `(m.`, fieldname, `)`)
+		p.P(`}`)
+	case generator.SliceGrowthChunked:
+		p.P(`if len(m.`, fieldname, `) == cap(m.`, fieldname, `) {`)
+		p.P(`m.`, fieldname, ` = `, p.Helper("GrowChunked"), `(m.`, fieldname, `, `, strconv.Itoa(p.Config.SliceGrowth.Chunk), `)`)
+		p.P(`}`)
+	case generator.SliceGrowthExact:
+		i, ok := p.counted[field]
+		if !ok {
+			return
+		}
+		count := fmt.Sprintf("fieldCounts[%d]", i)
+		p.P(`if `, count, ` > 0 {`)
+		p.P(`m.`, fieldname, ` = `, p.Helper("Reserve"), `(m.`, fieldname, `, `, count, `)`)
+		p.P(count, ` = 0`)
+		p.P(`}`)
+	}
+}
+
+// countFields emits the pre-scan of the exact slice growth, which counts the elements of
+// the repeated fields of message that are not packed, since the length of packed fields is
+// already known when they are decoded.
+func (p *unmarshal) countFields(message *protogen.Message) {
+	p.counted = nil
+	if p.Config.SliceGrowth.Strategy != generator.SliceGrowthExact || p.IsOpaque(message) {
+		return
+	}
+	var nums []string
+	for _, field := range message.Fields {
+		if !field.Desc.IsList() || field.Desc.IsPacked() {
+			continue
+		}
+		if proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetMaxCount() > 0 {
+			continue
+		}
+		if p.counted == nil {
+			p.counted = make(map[*protogen.Field]int)
+		}
+		p.counted[field] = len(nums)
+		nums = append(nums, strconv.Itoa(int(field.Desc.Number())))
+	}
+	if len(nums) == 0 {
+		return
+	}
+	p.P(`var fieldCounts [`, strconv.Itoa(len(nums)), `]int`)
+	p.P(p.Helper("CountFields"), `(dAtA, []int32{`, strings.Join(nums, ", "), `}, fieldCounts[:])`)
+}
+
 func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *protogen.Message, required protoreflect.FieldNumbers) {
 	fieldname := field.GoName
 	errFieldname := fieldname
@@ -741,8 +1261,16 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 
 	p.P(`case `, strconv.Itoa(int(field.Desc.Number())), `:`)
 	wireType := generator.ProtoWireType(field.Desc.Kind())
-	if field.Desc.IsList() && wireType != protowire.BytesType {
+	var maxCount uint32
+	if field.Desc.IsList() {
+		maxCount = proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetMaxCount()
+	}
+	if p.IsOpaque(message) {
+		p.opaqueField(field, proto3)
+	} else if field.Desc.IsList() && wireType != protowire.BytesType {
 		p.P(`if wireType == `, strconv.Itoa(int(wireType)), `{`)
+		p.reserveMaxCount(field, fieldname, errFieldname, "1", maxCount)
+		p.growSlice(field, fieldname, maxCount)
 		p.fieldItem(field, fieldname, message, false)
 		p.P(`} else if wireType == `, strconv.Itoa(int(protowire.BytesType)), `{`)
 		p.P(`var packedLen int`)
@@ -776,19 +1304,31 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 			p.P(`elementCount = packedLen`)
 		}
 
-		if p.ShouldPool(message) {
-			p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 && cap(m.`, fieldname, `) < elementCount {`)
+		if maxCount > 0 {
+			p.reserveMaxCount(field, fieldname, errFieldname, "elementCount", maxCount)
 		} else {
-			p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 {`)
-		}
+			if p.ShouldPool(message) {
+				p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 && cap(m.`, fieldname, `) < elementCount {`)
+			} else {
+				p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 {`)
+			}
 
-		fieldtyp, _ := p.FieldGoType(field)
-		p.P(`m.`, fieldname, ` = make(`, fieldtyp, `, 0, elementCount)`)
-		p.P(`}`)
+			fieldtyp, _ := p.FieldGoType(field)
+			p.P(`m.`, fieldname, ` = make(`, fieldtyp, `, 0, elementCount)`)
+			p.P(`}`)
+		}
 
-		p.P(`for iNdEx < postIndex {`)
-		p.fieldItem(field, fieldname, message, false)
-		p.P(`}`)
+		if wireType == protowire.VarintType {
+			p.packedVarints(field, fieldname, maxCount)
+		} else {
+			if p.unsafe {
+				p.packedFloats(field, fieldname, maxCount)
+			}
+			p.P(`for iNdEx < postIndex {`)
+			p.growSlice(field, fieldname, maxCount)
+			p.fieldItem(field, fieldname, message, false)
+			p.P(`}`)
+		}
 		p.P(`} else {`)
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
 		p.P(`}`)
@@ -796,10 +1336,14 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 		p.P(`if wireType != `, strconv.Itoa(int(wireType)), `{`)
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
 		p.P(`}`)
+		p.reserveMaxCount(field, fieldname, errFieldname, "1", maxCount)
+		if field.Desc.IsList() {
+			p.growSlice(field, fieldname, maxCount)
+		}
 		p.fieldItem(field, fieldname, message, proto3)
 	}
 
-	if field.Desc.Cardinality() == protoreflect.Required {
+	if field.Desc.Cardinality() == protoreflect.Required && !p.merge {
 		var fieldBit int
 		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
 			if required.Get(fieldBit) == field.Desc.Number() {
@@ -813,29 +1357,111 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 	}
 }
 
+// checkDecodeBudget emits the check of the data against the decode budget of message,
+// if it has one.
+func (p *unmarshal) checkDecodeBudget(message *protogen.Message) {
+	budget := p.DecodeBudget(message)
+	if budget == nil {
+		return
+	}
+	var limits []string
+	for _, limit := range []struct {
+		name  string
+		value uint64
+	}{
+		{"MaxBytes", budget.GetMaxBytes()},
+		{"MaxElements", budget.GetMaxElements()},
+		{"MaxMapEntries", budget.GetMaxMapEntries()},
+	} {
+		if limit.value > 0 {
+			limits = append(limits, limit.name+": "+strconv.FormatUint(limit.value, 10))
+		}
+	}
+	p.P(`if err := `, p.Helper("CheckDecodeBudget"), `(dAtA, m.ProtoReflect().Descriptor(), `, p.Helper("DecodeBudget"), `{`, strings.Join(limits, ", "), `}); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+}
+
 func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 	for _, nested := range message.Messages {
 		p.message(proto3, nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if p.SkipReason(message) != "" {
 		return
 	}
 
-	// Skip opaque API messages - fields are private and cannot be accessed directly.
-	// The standard protobuf library's generated Unmarshal will be used instead.
-	if p.IsOpaque(message) {
+	p.once = true
+	if p.Override(message) {
 		return
 	}
-
-	p.once = true
 	ccTypeName := message.GoIdent.GoName
 	required := message.Desc.RequiredNumbers()
 
-	p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshal(), `(dAtA []byte) error {`)
-	if required.Len() > 0 {
+	if p.merge && p.HasFeature("unmarshal") && !p.ReachesRequired(message) {
+		// Without required fields to check, decoding merges into the message already.
+		p.P(`func (m *`, ccTypeName, `) MergeFromWireVT(dAtA []byte) error {`)
+		p.P(`return m.UnmarshalVT(dAtA)`)
+		p.P(`}`)
+		return
+	}
+	if p.strict && p.HasFeature("unmarshal") && !p.reachesStrict(message, make(map[*protogen.Message]bool)) {
+		// UnmarshalVT checks the same required fields.
+		p.P(`func (m *`, ccTypeName, `) UnmarshalVTStrict(dAtA []byte) error {`)
+		p.P(`return m.UnmarshalVT(dAtA)`)
+		p.P(`}`)
+		p.P()
+		return
+	}
+
+	if p.arena {
+		p.P(`// UnmarshalVTArena decodes dAtA into m like UnmarshalVT, allocating the messages,`)
+		p.P(`// bytes and strings of m from arena. They must not be used once arena is released.`)
+		p.P(`func (m *`, ccTypeName, `) UnmarshalVTArena(arena *`, vtarenaPackage.Ident("Arena"), `, dAtA []byte) error {`)
+		p.P(`return m.UnmarshalVTOptions(dAtA, `, vtallocPackage.Ident("UnmarshalOptions"), `{Allocator: arena})`)
+		p.P(`}`)
+		p.P()
+	}
+
+	params := `dAtA []byte`
+	if p.alloc {
+		params += `, opts ` + p.QualifiedGoIdent(vtallocPackage.Ident("UnmarshalOptions"))
+		p.P(`// UnmarshalVTOptions decodes dAtA into m like UnmarshalVT, allocating the messages,`)
+		p.P(`// bytes and strings of m with the allocator of opts.`)
+	}
+	if p.strict {
+		p.P(`// UnmarshalVTStrict decodes dAtA into m like UnmarshalVT, and fails like proto.Unmarshal`)
+		p.P(`// when the required fields of the extensions and of the map values missing from their`)
+		p.P(`// entries are not set.`)
+	}
+	if p.ShouldObserveUnmarshal(message) && !p.merge && !p.strict {
+		p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshal(), `(`, params, `) (err error) {`)
+		p.P(`defer func() {`)
+		p.P(p.Helper("ObserveUnmarshal"), `(`, strconv.Quote(string(message.Desc.FullName())), `, len(dAtA), err)`)
+		p.P(`}()`)
+	} else {
+		p.P(`func (m *`, ccTypeName, `) `, p.methodUnmarshal(), `(`, params, `) error {`)
+	}
+	if p.alloc {
+		p.P(`alloc := opts.Allocator`)
+		p.P(`if alloc == nil {`)
+		p.P(`alloc = `, vtallocPackage.Ident("Heap"))
+		p.P(`}`)
+		p.P(`_ = alloc`)
+	}
+	p.checkDecodeBudget(message)
+	if required.Len() > 0 && !p.merge {
 		p.P(`var hasFields [`, strconv.Itoa(1+(required.Len()-1)/64), `]uint64`)
 	}
+	if p.strict && message.Desc.ExtensionRanges().Len() > 0 {
+		p.P(`var extensions bool`)
+	}
+	for _, field := range message.Fields {
+		if p.dedup(field) == vtproto.Dedup_DEDUP_ALL && !p.IsOpaque(message) {
+			p.P(`var dedup`, field.GoName, ` map[string]string`)
+		}
+	}
+	p.countFields(message)
 	p.P(`l := len(dAtA)`)
 	p.P(`iNdEx := 0`)
 	p.P(`for iNdEx < l {`)
@@ -850,6 +1476,10 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 	p.P(`if fieldNum <= 0 {`)
 	p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: `, message.GoIdent.GoName, `: illegal tag %d (wire type %d)", fieldNum, wire)`)
 	p.P(`}`)
+	// Fields, including the fields of large oneofs, are dispatched with a single switch
+	// over constant field numbers, which the compiler turns into a jump table or a
+	// binary search. A table of decoding functions indexed by field number would add an
+	// indirect call per field, and could not share the locals of the decoding loop.
 	p.P(`switch fieldNum {`)
 	for _, field := range message.Fields {
 		p.field(proto3, false, field, message, required)
@@ -874,13 +1504,20 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 			c = append(c, `((fieldNum >= `+strconv.Itoa(int(erange[0]))+`) && (fieldNum < `+strconv.Itoa(int(erange[1]))+`))`)
 		}
 		p.P(`if `, strings.Join(c, "||"), `{`)
-		p.P(`err = `, p.Ident(generator.ProtoPkg, "UnmarshalOptions"), `{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)`)
+		// Merge keeps the fields decoded before the extension.
+		p.P(`err = `, p.Ident(generator.ProtoPkg, "UnmarshalOptions"), `{AllowPartial: true, Merge: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
+		if p.strict {
+			p.P(`extensions = true`)
+		}
 		p.P(`iNdEx += skippy`)
 		p.P(`} else {`)
 	}
+	if p.ShouldObserveUnknownFields(message) {
+		p.P(p.Helper("ObserveUnknownField"), `(`, strconv.Quote(string(message.Desc.FullName())), `, fieldNum, wireType)`)
+	}
 	if !p.Wrapper() && !p.ShouldIgnoreUnknownFields(message) {
 		p.P(`m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
 	}
@@ -904,10 +1541,28 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 		if fieldBit == required.Len() {
 			panic("missing required field")
 		}
-		p.P(`if hasFields[`, strconv.Itoa(int(fieldBit/64)), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0 {`)
+		if p.merge {
+			// Required fields have presence, and may have been set before merging.
+			if p.IsOpaque(message) {
+				p.P(`if !`, p.Has("m", field), ` {`)
+			} else {
+				p.P(`if m.`, field.GoName, ` == nil {`)
+			}
+		} else {
+			p.P(`if hasFields[`, strconv.Itoa(int(fieldBit/64)), `] & uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `) == 0 {`)
+		}
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
 		p.P(`}`)
 	}
+	if p.strict && message.Desc.ExtensionRanges().Len() > 0 {
+		// The extensions are decoded by the protobuf runtime, which checks the whole
+		// message once they are decoded.
+		p.P(`if extensions {`)
+		p.P(`if err := `, p.Ident(generator.ProtoPkg, "CheckInitialized"), `(m); err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`}`)
+	}
 	p.P()
 	p.P(`if iNdEx > l {`)
 	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
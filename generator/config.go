@@ -0,0 +1,43 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Validate returns an error listing the options of cfg that conflict with each other,
+// which would otherwise have one of them silently ignored. The values of the options are
+// checked when they are set.
+func (cfg *Config) Validate() error {
+	var errs []error
+	for _, s := range cfg.Poolable.overlap(cfg.PoolableExclude) {
+		errs = append(errs, fmt.Errorf("pattern %q is set by both pool and pool-exclude", s))
+	}
+	if cfg.DisableWellKnownTypes && cfg.WellKnownTypesMode != "" && cfg.WellKnownTypesMode != WellKnownTypesLocal {
+		errs = append(errs, fmt.Errorf("disable-wkt conflicts with wkt=%s, use wkt=local instead", cfg.WellKnownTypesMode))
+	}
+	if cfg.ShardMessages < 0 {
+		errs = append(errs, fmt.Errorf("invalid shard-messages %d, expected a positive number of messages or 0 for no limit", cfg.ShardMessages))
+	}
+	if cfg.PoolBuildTag != "" && cfg.PoolBuildTag == cfg.BuildTag {
+		errs = append(errs, fmt.Errorf("pool-build-tag %q is also the buildTag of the generated files, which would never be built without pooling", cfg.PoolBuildTag))
+	}
+	return errors.Join(errs...)
+}
+
+// overlap returns the patterns of o that are also in other, sorted.
+func (o ObjectSet) overlap(other ObjectSet) []string {
+	var both []string
+	for s := range o.mp {
+		if other.mp[s] {
+			both = append(both, s)
+		}
+	}
+	sort.Strings(both)
+	return both
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestObjectSetSet(t *testing.T) {
+	set := NewObjectSet()
+	for _, s := range []string{"example.com/app.Message", "example.com/app.*", "example.com/**", "app.Message"} {
+		if err := set.Set(s); err != nil {
+			t.Errorf("Set(%q): %v", s, err)
+		}
+	}
+	for _, s := range []string{"Message", "example.com/app/Message", "example.com/app.[", ""} {
+		if err := set.Set(s); err == nil || !strings.Contains(err.Error(), "invalid message pattern") {
+			t.Errorf("Set(%q) = %v, want an invalid message pattern error", s, err)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	newConfig := func() *Config {
+		return &Config{Poolable: NewObjectSet(), PoolableExclude: NewObjectSet()}
+	}
+	if err := newConfig().Validate(); err != nil {
+		t.Errorf("Validate() of the default config: %v", err)
+	}
+
+	cfg := newConfig()
+	cfg.Poolable.Set("example.com/app.A")
+	cfg.Poolable.Set("example.com/app.B")
+	cfg.PoolableExclude.Set("example.com/app.B")
+	cfg.DisableWellKnownTypes = true
+	cfg.WellKnownTypesMode = WellKnownTypesKnown
+	cfg.ShardMessages = -1
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() of conflicting options succeeded")
+	}
+	for _, want := range []string{
+		`pattern "example.com/app.B" is set by both pool and pool-exclude`,
+		"disable-wkt conflicts with wkt=known",
+		"invalid shard-messages -1",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() = %q, want it to contain %q", err, want)
+		}
+	}
+
+	cfg = newConfig()
+	cfg.DisableWellKnownTypes = true
+	cfg.WellKnownTypesMode = WellKnownTypesLocal
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate() of disable-wkt with wkt=local: %v", err)
+	}
+}
+
+func TestUnknownFeature(t *testing.T) {
+	RegisterFeature("test_feature", nil)
+	defer delete(defaultFeatures, "test_feature")
+
+	_, err := findFeatures([]string{"test_featur"})
+	if err == nil || !strings.Contains(err.Error(), `unknown feature: "test_featur", expected one of: all, `) || !strings.Contains(err.Error(), "test_feature") {
+		t.Errorf("findFeatures() = %v, want an error listing test_feature", err)
+	}
+
+	var templates Templates
+	if err := templates.Set("test_featur:app.Message=message.tmpl"); err == nil || !strings.Contains(err.Error(), "test_feature") {
+		t.Errorf("Templates.Set() = %v, want an error listing test_feature", err)
+	}
+
+	var profiles Profiles
+	if err := profiles.Set("wires"); err == nil || !strings.Contains(err.Error(), "expected one of: full, wire") {
+		t.Errorf("Profiles.Set() = %v, want an error listing the profiles", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/gofeaturespb"
+)
+
+// checkEditionsFeatures returns an error naming the first field of an editions file
+// that relies on an editions feature the generated code does not implement. The code
+// generated for such fields would not behave like the code of protoc-gen-go.
+func checkEditionsFeatures(files []*protogen.File) error {
+	for _, file := range files {
+		if !file.Generate || file.Desc.Syntax() != protoreflect.Editions {
+			continue
+		}
+		if err := checkEditionsMessages(file, file.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkEditionsMessages(file *protogen.File, messages []*protogen.Message) error {
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() || message.APILevel == gofeaturespb.GoFeatures_API_OPAQUE {
+			continue
+		}
+		for _, field := range message.Fields {
+			if feature := unsupportedEditionsFeature(field); feature != "" {
+				return fmt.Errorf("%s: field %s uses %s, which is not supported by protoc-gen-go-vtproto",
+					file.Desc.Path(), field.Desc.FullName(), feature)
+			}
+		}
+		if err := checkEditionsMessages(file, message.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unsupportedEditionsFeature(field *protogen.Field) string {
+	if field.Desc.IsMap() {
+		field = field.Message.Fields[1]
+	}
+	if field.Desc.Kind() == protoreflect.EnumKind && field.Desc.Enum().IsClosed() {
+		return "features.enum_type = CLOSED"
+	}
+	return ""
+}
@@ -7,23 +7,38 @@ package generator
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
 var defaultFeatures = make(map[string]Feature)
 
-func findFeatures(featureNames []string) ([]Feature, error) {
+// optInFeatures holds the names of the features that are not selected by "all".
+var optInFeatures = make(map[string]bool)
+
+// featureSet holds the features to generate for a file, sorted by name, and their names.
+type featureSet struct {
+	features []Feature
+	order    []string
+	names    map[string]bool
+}
+
+func findFeatures(featureNames []string) (featureSet, error) {
 	required := make(map[string]Feature)
 	for _, name := range featureNames {
 		if name == "all" {
-			required = defaultFeatures
-			break
+			for name, feat := range defaultFeatures {
+				if !optInFeatures[name] {
+					required[name] = feat
+				}
+			}
+			continue
 		}
 
 		feat, ok := defaultFeatures[name]
 		if !ok {
-			return nil, fmt.Errorf("unknown feature: %q", name)
+			return featureSet{}, errUnknownFeature(name)
 		}
 		required[name] = feat
 	}
@@ -41,16 +56,74 @@ func findFeatures(featureNames []string) ([]Feature, error) {
 	})
 
 	var features []Feature
+	var order []string
+	names := make(map[string]bool)
 	for _, sp := range sorted {
 		features = append(features, sp.feat)
+		order = append(order, sp.name)
+		names[sp.name] = true
+	}
+	return featureSet{features: features, order: order, names: names}, nil
+}
+
+// errUnknownFeature returns the error reporting that no feature is registered as name,
+// listing the registered ones.
+func errUnknownFeature(name string) error {
+	return fmt.Errorf("unknown feature: %q, expected one of: all, %s", name, strings.Join(FeatureNames(), ", "))
+}
+
+// FeatureNames returns the names of the registered features, sorted.
+func FeatureNames() []string {
+	names := make([]string, 0, len(defaultFeatures))
+	for name := range defaultFeatures {
+		names = append(names, name)
 	}
-	return features, nil
+	sort.Strings(names)
+	return names
+}
+
+// IsOptInFeature returns true if the feature name is only generated when it is selected
+// by name, see RegisterOptInFeature.
+func IsOptInFeature(name string) bool {
+	return optInFeatures[name]
 }
 
 func RegisterFeature(name string, feat Feature) {
 	defaultFeatures[name] = feat
 }
 
+// RegisterOptInFeature registers a feature that is only generated when it is selected
+// by name, and not by "all", like the features generating test code.
+func RegisterOptInFeature(name string, feat Feature) {
+	RegisterFeature(name, feat)
+	optInFeatures[name] = true
+}
+
+// messageFilters holds the filters of the features, see RegisterMessageFilter.
+var messageFilters = make(map[string]MessageFilter)
+
+// MessageFilter returns why a feature is not generated for message, or "" if it is.
+type MessageFilter func(p *GeneratedFile, message *protogen.Message) string
+
+// RegisterMessageFilter registers the reasons for which the feature name is not generated
+// for a message, in addition to the ones of all the features. The features check them
+// with GeneratedFile.SkipReason, which the report option lists as well.
+func RegisterMessageFilter(name string, filter MessageFilter) {
+	messageFilters[name] = filter
+}
+
+// SkipOpaque is the MessageFilter of the features that are not generated for the
+// messages of the opaque API, nor for the wrapper types.
+func SkipOpaque(p *GeneratedFile, message *protogen.Message) string {
+	switch {
+	case p.Wrapper():
+		return SkippedWrapper
+	case p.IsOpaque(message):
+		return SkippedOpaque
+	}
+	return ""
+}
+
 type Feature func(gen *GeneratedFile) FeatureGenerator
 
 type FeatureGenerator interface {
@@ -6,6 +6,7 @@ package generator
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/planetscale/vtprotobuf/vtproto"
 
@@ -20,6 +21,60 @@ type GeneratedFile struct {
 	*protogen.GeneratedFile
 	Config        *Config
 	LocalPackages map[protoreflect.FullName]bool
+
+	// features contains the names of the features being generated
+	features map[string]bool
+	// fileIdent uniquely identifies the generated file within its Go package, to name
+	// package-level declarations that are private to the file
+	fileIdent     string
+	registryTypes []protogen.GoIdent
+	// lines counts the lines generated by P, for the report
+	lines int
+	// companion returns the companion file with the given suffix, see Companion
+	companion func(suffix string, tags []string) *GeneratedFile
+	// feature is the name of the feature being generated, see Override
+	feature string
+	// fail reports an error that makes the generation fail
+	fail func(error)
+	// selected holds the messages selected by the only option, see Selected
+	selected map[protoreflect.FullName]bool
+	// fileFeatures holds the names of the features generated for each file of this
+	// invocation, by path, see HasVTFeature
+	fileFeatures map[string]map[string]bool
+}
+
+// P prints a line to the generated output, like protogen.GeneratedFile.P.
+func (p *GeneratedFile) P(v ...any) {
+	p.lines++
+	p.GeneratedFile.P(v...)
+}
+
+// HasFeature returns true if the feature with the given name is being generated, for the
+// features whose code depends on another one.
+func (p *GeneratedFile) HasFeature(name string) bool {
+	return p.features[name]
+}
+
+// Companion returns the file generated next to the current one with the given suffix,
+// which is only compiled when the build constraints in tags (e.g. "vtpool" or "!vtpool")
+// are satisfied, for the code of features that depends on a build tag.
+func (p *GeneratedFile) Companion(suffix string, tags ...string) *GeneratedFile {
+	return p.companion(suffix, tags)
+}
+
+// testSuffix is the companion suffix of the test file, see TestFile.
+const testSuffix = "test"
+
+// TestFile returns the test file generated next to the current one, named after it
+// with a _test.go suffix, for the features generating tests.
+func (p *GeneratedFile) TestFile() *GeneratedFile {
+	return p.companion(testSuffix, nil)
+}
+
+// FileIdent returns the identifier of the generated file within its Go package, e.g. to
+// name the package-level declarations generated once per file.
+func (p *GeneratedFile) FileIdent() string {
+	return p.fileIdent
 }
 
 func (p *GeneratedFile) Ident(path, ident string) string {
@@ -28,7 +83,11 @@ func (p *GeneratedFile) Ident(path, ident string) string {
 
 func (b *GeneratedFile) ShouldPool(message *protogen.Message) bool {
 	// Do not generate pool if message is nil or message excluded by external rules
-	if message == nil || b.Config.PoolableExclude.Contains(message.GoIdent) {
+	if message == nil || b.Config.PoolableExclude.Contains(message.GoIdent) || !b.Selected(message) {
+		return false
+	}
+
+	if b.IsLocalMessage(message) && !b.HasVTFeature(message, "pool") {
 		return false
 	}
 
@@ -36,21 +95,132 @@ func (b *GeneratedFile) ShouldPool(message *protogen.Message) bool {
 		return true
 	}
 
-	ext := proto.GetExtension(message.Desc.Options(), vtproto.E_Mempool)
-	if mempool, ok := ext.(bool); ok {
-		return mempool
+	return messageOption(message, vtproto.E_Mempool, (*vtproto.FileOpts).GetPoolAll)
+}
+
+// messageOption returns the value of the boolean message option ext for message, or
+// the value returned by fileOption for the vtproto file options of its file when
+// message does not set ext.
+func messageOption(message *protogen.Message, ext protoreflect.ExtensionType, fileOption func(*vtproto.FileOpts) bool) bool {
+	if opts := message.Desc.Options(); proto.HasExtension(opts, ext) {
+		return proto.GetExtension(opts, ext).(bool)
+	}
+	return fileOption(FileOptions(message.Desc.ParentFile()))
+}
+
+// FileOptions returns the vtproto file options of file, or nil if it has none.
+func FileOptions(file protoreflect.FileDescriptor) *vtproto.FileOpts {
+	opts, _ := proto.GetExtension(file.Options(), vtproto.E_File).(*vtproto.FileOpts)
+	return opts
+}
+
+// ReachesRequired returns true if the given message, or one of the messages encoded and
+// decoded by its generated methods, has required fields.
+func (p *GeneratedFile) ReachesRequired(message *protogen.Message) bool {
+	return p.reachesRequired(message, make(map[*protogen.Message]bool))
+}
+
+func (p *GeneratedFile) reachesRequired(message *protogen.Message, seen map[*protogen.Message]bool) bool {
+	if seen[message] {
+		return false
+	}
+	seen[message] = true
+	for _, field := range message.Fields {
+		if field.Desc.Cardinality() == protoreflect.Required {
+			return true
+		}
+		if field.Message != nil && (field.Message.Desc.IsMapEntry() || p.IsVTMessage(field.Message)) && p.reachesRequired(field.Message, seen) {
+			return true
+		}
 	}
 	return false
 }
 
+// ShouldReuseMessages returns true if the elements of the repeated message fields of
+// message are reused on unmarshal. Pooled messages always reuse them, since ResetVT
+// keeps the elements of their slices around for that purpose.
+func (b *GeneratedFile) ShouldReuseMessages(message *protogen.Message) bool {
+	if b.ShouldPool(message) || b.Config.ReuseMessages.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_ReuseMessages, (*vtproto.FileOpts).GetReuseMessages)
+}
+
+// ShouldReuseStrings returns true if the string fields of message are only assigned
+// on unmarshal when the decoded value differs from their current value.
+func (b *GeneratedFile) ShouldReuseStrings(message *protogen.Message) bool {
+	if b.Config.ReuseStrings.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_ReuseStrings, (*vtproto.FileOpts).GetReuseStrings)
+}
+
+// ShouldMarshalBuffer returns true if a MarshalVTBuffer method is generated for message.
+func (b *GeneratedFile) ShouldMarshalBuffer(message *protogen.Message) bool {
+	if b.Config.MarshalBuffer.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_MarshalBuffer, (*vtproto.FileOpts).GetMarshalBuffer)
+}
+
+// ShouldObserveUnknownFields returns true if UnmarshalVT reports the unknown fields of
+// message to the hook registered with protohelpers.SetUnknownFieldHook.
+func (b *GeneratedFile) ShouldObserveUnknownFields(message *protogen.Message) bool {
+	if b.Config.ObserveUnknownFields.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_ObserveUnknownFields, (*vtproto.FileOpts).GetObserveUnknownFields)
+}
+
+// ShouldObserveUnmarshal returns true if UnmarshalVT reports the decoding of message to
+// the hook registered with protohelpers.SetUnmarshalHook.
+func (b *GeneratedFile) ShouldObserveUnmarshal(message *protogen.Message) bool {
+	if b.Config.ObserveUnmarshal.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_ObserveUnmarshal, (*vtproto.FileOpts).GetObserveUnmarshal)
+}
+
+// ShouldSizeCache returns true if the size of message is computed by a SizeVTCached
+// method when it is marshaled, which caches the sizes of the messages it holds.
+func (b *GeneratedFile) ShouldSizeCache(message *protogen.Message) bool {
+	if b.Config.SizeCache.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_SizeCache, (*vtproto.FileOpts).GetSizeCache)
+}
+
+// ShouldCheckCycles returns true if the marshal methods of message check that it does
+// not hold itself before marshaling it.
+func (b *GeneratedFile) ShouldCheckCycles(message *protogen.Message) bool {
+	if b.Config.CycleCheck.Contains(message.GoIdent) {
+		return true
+	}
+
+	return messageOption(message, vtproto.E_CycleCheck, (*vtproto.FileOpts).GetCycleCheck)
+}
+
+// DecodeBudget returns the budget UnmarshalVT checks the data of message against, set by
+// the decode_budget message or file option, or nil if it has none.
+func (b *GeneratedFile) DecodeBudget(message *protogen.Message) *vtproto.DecodeBudget {
+	if opts := message.Desc.Options(); proto.HasExtension(opts, vtproto.E_DecodeBudget) {
+		return proto.GetExtension(opts, vtproto.E_DecodeBudget).(*vtproto.DecodeBudget)
+	}
+	return FileOptions(message.Desc.ParentFile()).GetDecodeBudget()
+}
+
 func (b *GeneratedFile) ShouldIgnoreUnknownFields(message *protogen.Message) bool {
 	if b.Config.IgnoreUnknownFields.Contains(message.GoIdent) {
 		return true
 	}
 
-	ext := proto.GetExtension(message.Desc.Options(), vtproto.E_IgnoreUnknownFields)
-	ignoreUnknownFields, ok := ext.(bool)
-	return ok && ignoreUnknownFields
+	return messageOption(message, vtproto.E_IgnoreUnknownFields, (*vtproto.FileOpts).GetIgnoreUnknownFields)
 }
 
 // IsLazy returns true if the field is marked with the lazy option.
@@ -84,6 +254,70 @@ func (b *GeneratedFile) GetEdition(file *protogen.File) descriptorpb.Edition {
 	return file.Proto.GetEdition()
 }
 
+// EnforceUTF8 returns true if the string field must contain valid UTF-8, which is the
+// case in proto3 files and, unless features.utf8_validation is NONE, in editions files.
+// Validation can be disabled for all the fields by the configuration.
+func (b *GeneratedFile) EnforceUTF8(field *protogen.Field) bool {
+	return !b.Config.DisableUTF8Validation && requiresUTF8(field)
+}
+
+// requiresUTF8 returns true if the string field must contain valid UTF-8 according to
+// the syntax or the features of its file.
+func requiresUTF8(field *protogen.Field) bool {
+	if fd, ok := field.Desc.(interface{ EnforceUTF8() bool }); ok {
+		return fd.EnforceUTF8()
+	}
+	return field.Desc.Syntax() == protoreflect.Proto3
+}
+
+// ValidateUTF8OnMarshal returns true if MarshalVT fails when the string field is not
+// valid UTF-8, according to the validate_utf8_marshal option of field or else to the
+// configuration, which only applies to the fields that must contain valid UTF-8. value
+// is the field holding the string, which is field itself or the key or value field of
+// its entries for maps.
+func (b *GeneratedFile) ValidateUTF8OnMarshal(field, value *protogen.Field) bool {
+	if value.Desc.Kind() != protoreflect.StringKind {
+		return false
+	}
+	if opts := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts); opts != nil && opts.ValidateUtf8Marshal != nil {
+		return opts.GetValidateUtf8Marshal()
+	}
+	return b.Config.ValidateUTF8OnMarshal && requiresUTF8(value)
+}
+
+// MaxLen returns the maximum length of the string and bytes values of field decoded by
+// UnmarshalVT, according to the max_len option of field or else to the configuration, or
+// 0 if their length is not capped. value is the field holding the values, which is field
+// itself or the key or value field of its entries for maps.
+func (b *GeneratedFile) MaxLen(field, value *protogen.Field) uint64 {
+	if kind := value.Desc.Kind(); kind != protoreflect.StringKind && kind != protoreflect.BytesKind {
+		return 0
+	}
+	if opts := proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts); opts != nil && opts.MaxLen != nil {
+		return opts.GetMaxLen()
+	}
+	return b.Config.MaxLen
+}
+
+// PooledBytes returns true if the data of the given bytes field is held in buffers of
+// the pools of protohelpers.GetBuffer, according to its pooled_bytes option. Oneof and
+// map fields are never pooled.
+func (b *GeneratedFile) PooledBytes(field *protogen.Field) bool {
+	if field.Desc.Kind() != protoreflect.BytesKind || field.Desc.IsMap() || (field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()) {
+		return false
+	}
+	return proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetPooledBytes()
+}
+
+// ExplicitPresence returns true if the given singular scalar field with implicit presence
+// is always encoded, according to its explicit_presence option.
+func (b *GeneratedFile) ExplicitPresence(field *protogen.Field) bool {
+	if b.FieldPresence(field) != PresenceImplicit {
+		return false
+	}
+	return proto.GetExtension(field.Desc.Options(), vtproto.E_Options).(*vtproto.Opts).GetExplicitPresence()
+}
+
 // IsOpaque returns true if the message uses the opaque API.
 // In the opaque API, fields are private and accessed via getters/setters.
 func (b *GeneratedFile) IsOpaque(message *protogen.Message) bool {
@@ -109,6 +343,39 @@ func (b *GeneratedFile) UsesAccessors(message *protogen.Message) bool {
 	return b.IsOpaque(message) || b.IsHybrid(message)
 }
 
+// Get returns the call of the getter of field on the message in varName. The fields of
+// opaque messages are read with their getters, which hold the field values for the
+// fields with explicit presence too.
+func (b *GeneratedFile) Get(varName string, field *protogen.Field) string {
+	name, _ := field.MethodName("Get")
+	return varName + "." + name + "()"
+}
+
+// Set returns the call of the setter of field on the message in varName, storing value.
+func (b *GeneratedFile) Set(varName string, field *protogen.Field, value string) string {
+	name, _ := field.MethodName("Set")
+	return varName + "." + name + "(" + value + ")"
+}
+
+// Has returns the call of the method of the message in varName reporting whether field,
+// which has explicit presence, is set.
+func (b *GeneratedFile) Has(varName string, field *protogen.Field) string {
+	name, _ := field.MethodName("Has")
+	return varName + "." + name + "()"
+}
+
+// Which returns the call of the method of the message in varName returning the case of
+// oneof, which is compared with the constants of OneofCase.
+func (b *GeneratedFile) Which(varName string, oneof *protogen.Oneof) string {
+	return varName + "." + oneof.MethodName("Which") + "()"
+}
+
+// OneofCase returns the name of the constant of the case of the oneof field of an opaque
+// message, which is declared in the package of the message.
+func (b *GeneratedFile) OneofCase(field *protogen.Field) string {
+	return field.Parent.GoIdent.GoName + "_" + field.GoName + "_case"
+}
+
 func (b *GeneratedFile) Alloc(vname string, message *protogen.Message, isQualifiedIdent bool) {
 	ident := message.GoIdent.GoName
 	if isQualifiedIdent {
@@ -123,11 +390,7 @@ func (b *GeneratedFile) Alloc(vname string, message *protogen.Message, isQualifi
 }
 
 func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, pointer bool) {
-	if field.Desc.IsWeak() {
-		return "struct{}", false
-	}
-
-	pointer = field.Desc.HasPresence()
+	pointer = p.FieldPresence(field) == PresenceExplicit
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
 		goType = "bool"
@@ -173,6 +436,58 @@ func (p *GeneratedFile) IsLocalMessage(message *protogen.Message) bool {
 	return p.LocalPackages[pkg]
 }
 
+// IsVTMessage returns true if the message is known to have the methods of the feature
+// being generated, see HasVTFeature. Such messages can be (un)marshaled, sized, cloned
+// and compared by calling their VT methods directly.
+func (p *GeneratedFile) IsVTMessage(message *protogen.Message) bool {
+	return p.HasVTFeature(message, p.feature)
+}
+
+// HasVTFeature returns true if the message is known to have the methods of the feature
+// with the given name, either because it is selected in a file generated with the feature
+// in this invocation, whose profile or file options may narrow its features, or because
+// its package has been listed with the assume-vt option.
+func (p *GeneratedFile) HasVTFeature(message *protogen.Message, feature string) bool {
+	switch {
+	case message == nil:
+		return false
+	case p.IsLocalMessage(message):
+		return p.fileFeatures[message.Desc.ParentFile().Path()][feature] && p.Selected(message)
+	default:
+		return p.Config.AssumeVT.Contains(message.GoIdent.GoImportPath)
+	}
+}
+
+// RegistryType returns the name of the package-level vtregistry.Type handle used to
+// dispatch to the VT helpers of a non-local message. The handle is declared at the
+// end of the generated file.
+func (p *GeneratedFile) RegistryType(message *protogen.Message) string {
+	for _, ident := range p.registryTypes {
+		if ident == message.GoIdent {
+			return p.registryTypeName(ident)
+		}
+	}
+	p.registryTypes = append(p.registryTypes, message.GoIdent)
+	return p.registryTypeName(message.GoIdent)
+}
+
+func (p *GeneratedFile) registryTypeName(ident protogen.GoIdent) string {
+	return "vtregistry_" + p.fileIdent + "_" + strings.ReplaceAll(p.QualifiedGoIdent(ident), ".", "_")
+}
+
+// oneofSwitchMinFields is the number of fields from which a oneof is dispatched with a
+// type switch over its wrapper types instead of with interface type assertions.
+const oneofSwitchMinFields = 8
+
+// SwitchOneof returns true if the generated code must find the field set in the oneof with
+// a type switch, and then call the helpers of its wrapper type directly. A type switch is
+// compiled into a binary search over the wrapper types, which becomes cheaper than
+// dynamic dispatch (and much cheaper than testing each wrapper type in turn) for oneofs
+// with many fields.
+func (p *GeneratedFile) SwitchOneof(oneof *protogen.Oneof) bool {
+	return !p.Wrapper() && !oneof.Desc.IsSynthetic() && len(oneof.Fields) >= oneofSwitchMinFields
+}
+
 func (p *GeneratedFile) IsLocalField(field *protogen.Field) bool {
 	if field == nil {
 		return false
@@ -183,6 +498,8 @@ func (p *GeneratedFile) IsLocalField(field *protogen.Field) bool {
 
 const vtHelpersPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/protohelpers")
 
+const vtRegistryPackage = protogen.GoImportPath("github.com/planetscale/vtprotobuf/vtregistry")
+
 var helpers = map[string]protogen.GoIdent{
 	"EncodeVarint":            {GoName: "EncodeVarint", GoImportPath: vtHelpersPackage},
 	"SizeOfVarint":            {GoName: "SizeOfVarint", GoImportPath: vtHelpersPackage},
@@ -193,6 +510,25 @@ var helpers = map[string]protogen.GoIdent{
 	"ErrUnexpectedEndOfGroup": {GoName: "ErrUnexpectedEndOfGroup", GoImportPath: vtHelpersPackage},
 	"ErrInvalidUTF8":          {GoName: "ErrInvalidUTF8", GoImportPath: vtHelpersPackage},
 	"ValidateUTF8":            {GoName: "ValidateUTF8", GoImportPath: vtHelpersPackage},
+	"ValidateUTF8String":      {GoName: "ValidateUTF8String", GoImportPath: vtHelpersPackage},
+	"CloneExtensions":         {GoName: "CloneExtensions", GoImportPath: vtHelpersPackage},
+	"GrowDoubling":            {GoName: "GrowDoubling", GoImportPath: vtHelpersPackage},
+	"GrowChunked":             {GoName: "GrowChunked", GoImportPath: vtHelpersPackage},
+	"Reserve":                 {GoName: "Reserve", GoImportPath: vtHelpersPackage},
+	"CountFields":             {GoName: "CountFields", GoImportPath: vtHelpersPackage},
+	"ObserveUnknownField":     {GoName: "ObserveUnknownField", GoImportPath: vtHelpersPackage},
+	"ObserveUnmarshal":        {GoName: "ObserveUnmarshal", GoImportPath: vtHelpersPackage},
+	"SizeCache":               {GoName: "SizeCache", GoImportPath: vtHelpersPackage},
+	"GetSizeCache":            {GoName: "GetSizeCache", GoImportPath: vtHelpersPackage},
+	"PutSizeCache":            {GoName: "PutSizeCache", GoImportPath: vtHelpersPackage},
+	"CheckCycles":             {GoName: "CheckCycles", GoImportPath: vtHelpersPackage},
+	"CheckDecodeBudget":       {GoName: "CheckDecodeBudget", GoImportPath: vtHelpersPackage},
+	"DecodeBudget":            {GoName: "DecodeBudget", GoImportPath: vtHelpersPackage},
+	"LengthError":             {GoName: "LengthError", GoImportPath: vtHelpersPackage},
+	"GetBytes":                {GoName: "GetBytes", GoImportPath: vtHelpersPackage},
+	"PutBytes":                {GoName: "PutBytes", GoImportPath: vtHelpersPackage},
+	"ReuseBytes":              {GoName: "ReuseBytes", GoImportPath: vtHelpersPackage},
+	"NewWriter":               {GoName: "NewWriter", GoImportPath: vtHelpersPackage},
 }
 
 func (p *GeneratedFile) Helper(name string) protogen.GoIdent {
@@ -230,12 +566,30 @@ var wellKnownFields = map[protoreflect.FullName]protogen.GoIdent{
 	"google.protobuf.Value.list_value":   {GoName: "Value_ListValue", GoImportPath: vtWellKnownPackage + "structpb"},
 }
 
+// knownTypesPackage is the prefix of the Go packages of the well-known types wrapped
+// by the types/known packages.
+const knownTypesPackage = "google.golang.org/protobuf/types/known/"
+
+// IsWellKnownType returns true if message is a well-known type handled by the helpers
+// of the types/known packages. Well-known types are handled like other messages when
+// generating these packages or when they are disabled by the configuration, and by
+// default when they are generated in other Go packages than the ones the helpers wrap.
 func (p *GeneratedFile) IsWellKnownType(message *protogen.Message) bool {
 	if message == nil {
 		return false
 	}
 	_, ok := wellKnownTypes[message.Desc.FullName()]
-	return ok
+	if !ok || p.Wrapper() {
+		return ok
+	}
+	switch {
+	case p.Config.DisableWellKnownTypes || p.Config.WellKnownTypesMode == WellKnownTypesLocal:
+		return false
+	case p.Config.WellKnownTypesMode == WellKnownTypesKnown:
+		// checkWellKnownTypes has rejected the other well-known types
+		return true
+	}
+	return isKnownType(message)
 }
 
 func (p *GeneratedFile) WellKnownFieldMap(field *protogen.Field) protogen.GoIdent {
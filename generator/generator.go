@@ -6,7 +6,11 @@ package generator
 
 import (
 	"fmt"
+	"os"
 	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -28,7 +32,12 @@ func NewObjectSet() ObjectSet {
 }
 
 func (o ObjectSet) String() string {
-	return fmt.Sprintf("%#v", o)
+	patterns := make([]string, 0, len(o.mp))
+	for s := range o.mp {
+		patterns = append(patterns, s)
+	}
+	sort.Strings(patterns)
+	return strings.Join(patterns, ",")
 }
 
 func (o ObjectSet) Contains(g protogen.GoIdent) bool {
@@ -46,12 +55,149 @@ func (o ObjectSet) Contains(g protogen.GoIdent) bool {
 
 func (o ObjectSet) Set(s string) error {
 	if !pattern.ValidatePattern(s) {
-		return pattern.ErrBadPattern
+		return fmt.Errorf("invalid message pattern %q: %w", s, pattern.ErrBadPattern)
+	}
+	// The patterns are matched against "<import path>.<Go name>", which they could never
+	// match without a dot or a wildcard after their last slash.
+	if !strings.ContainsAny(s[strings.LastIndexByte(s, '/')+1:], ".*?[{") {
+		return fmt.Errorf("invalid message pattern %q, expected <Go import path>.<message Go name>", s)
+	}
+	o.mp[s] = true
+	return nil
+}
+
+// PackageSet is a set of Go import path patterns. A pattern ending in "/..."
+// matches the import path before it and all the import paths below it, like
+// the go command does; any other pattern is matched as a glob against the full
+// import path.
+type PackageSet struct {
+	mp map[string]bool
+}
+
+func NewPackageSet() PackageSet {
+	return PackageSet{
+		mp: map[string]bool{},
+	}
+}
+
+func (o PackageSet) String() string {
+	patterns := make([]string, 0, len(o.mp))
+	for s := range o.mp {
+		patterns = append(patterns, s)
+	}
+	sort.Strings(patterns)
+	return strings.Join(patterns, ",")
+}
+
+func (o PackageSet) Contains(importPath protogen.GoImportPath) bool {
+	for wildcard := range o.mp {
+		if matchPackage(wildcard, importPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o PackageSet) Set(s string) error {
+	if !validPackagePattern(s) {
+		return fmt.Errorf("invalid package pattern %q: %w", s, pattern.ErrBadPattern)
 	}
 	o.mp[s] = true
 	return nil
 }
 
+func validPackagePattern(s string) bool {
+	return pattern.ValidatePattern(strings.TrimSuffix(s, "/..."))
+}
+
+// matchPackage reports whether importPath matches the package pattern wildcard, which
+// has been validated by validPackagePattern.
+func matchPackage(wildcard string, importPath protogen.GoImportPath) bool {
+	path := string(importPath)
+	if prefix, ok := strings.CutSuffix(wildcard, "/..."); ok {
+		return path == prefix || strings.HasPrefix(path, prefix+"/")
+	}
+	// Ignore malformed pattern error because pattern already checked in Set
+	ok, _ := pattern.Match(wildcard, path)
+	return ok
+}
+
+// SliceGrowth selects how UnmarshalVT grows the slices of repeated fields. It is set
+// from one of "append" (the default, which uses the growth of the append builtin),
+// "double", "chunked" or "chunked:<N>" (which adds N elements, 64 by default), and
+// "exact" (which counts the elements of each field before decoding the message).
+type SliceGrowth struct {
+	Strategy string
+	Chunk    int
+}
+
+const (
+	SliceGrowthAppend  = "append"
+	SliceGrowthDouble  = "double"
+	SliceGrowthChunked = "chunked"
+	SliceGrowthExact   = "exact"
+)
+
+func (g *SliceGrowth) String() string {
+	if g.Strategy == SliceGrowthChunked {
+		return fmt.Sprintf("%s:%d", g.Strategy, g.Chunk)
+	}
+	return g.Strategy
+}
+
+func (g *SliceGrowth) Set(s string) error {
+	strategy, chunk, hasChunk := strings.Cut(s, ":")
+	switch strategy {
+	case SliceGrowthAppend, SliceGrowthDouble, SliceGrowthExact:
+		if hasChunk {
+			return fmt.Errorf("slice growth %q does not take a chunk size", strategy)
+		}
+		g.Strategy, g.Chunk = strategy, 0
+	case SliceGrowthChunked:
+		g.Strategy, g.Chunk = strategy, 64
+		if hasChunk {
+			n, err := strconv.Atoi(chunk)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid chunk size %q", chunk)
+			}
+			g.Chunk = n
+		}
+	default:
+		return fmt.Errorf("unknown slice growth %q", s)
+	}
+	return nil
+}
+
+// WellKnownTypesMode selects which messages of the well-known types are handled by the
+// helpers of the types/known packages. It is set from one of "auto" (the default, for
+// the messages of the Go packages of google.golang.org/protobuf/types/known only),
+// "known" (for all of them, failing the generation for the ones vendored in other Go
+// packages) and "local" (for none of them, like disable-wkt).
+type WellKnownTypesMode string
+
+const (
+	WellKnownTypesAuto  = "auto"
+	WellKnownTypesKnown = "known"
+	WellKnownTypesLocal = "local"
+)
+
+func (w *WellKnownTypesMode) String() string {
+	if *w == "" {
+		return WellKnownTypesAuto
+	}
+	return string(*w)
+}
+
+func (w *WellKnownTypesMode) Set(s string) error {
+	switch s {
+	case WellKnownTypesAuto, WellKnownTypesKnown, WellKnownTypesLocal:
+		*w = WellKnownTypesMode(s)
+		return nil
+	}
+	return fmt.Errorf("unknown well-known types mode %q", s)
+}
+
 type Config struct {
 	// Poolable rules determines if pool feature generate for particular message
 	Poolable ObjectSet
@@ -59,17 +205,107 @@ type Config struct {
 	PoolableExclude ObjectSet
 	// IgnoreUnknownFields contains messages for which unknown fields shall be ignored
 	IgnoreUnknownFields ObjectSet
-	Wrap                bool
-	WellKnownTypes      bool
-	AllowEmpty          bool
-	BuildTag            string
+	// ObserveUnknownFields contains messages for which UnmarshalVT reports the unknown
+	// fields it decodes to the hook registered with protohelpers.SetUnknownFieldHook
+	ObserveUnknownFields ObjectSet
+	// ObserveUnmarshal contains messages for which UnmarshalVT reports its input size
+	// and result to the hook registered with protohelpers.SetUnmarshalHook
+	ObserveUnmarshal ObjectSet
+	// SizeCache contains messages whose size is computed with a cache keyed by message
+	// address when they are marshaled, for graphs sharing messages between parents
+	SizeCache ObjectSet
+	// CycleCheck contains messages whose marshal methods fail when the message holds
+	// itself instead of overflowing the stack
+	CycleCheck ObjectSet
+	// ReuseMessages contains messages whose repeated message fields are decoded into
+	// the elements kept in the capacity of their slices
+	ReuseMessages ObjectSet
+	// ReuseStrings contains messages whose string fields keep their current value on
+	// unmarshal when the decoded value is unchanged, instead of allocating a new string
+	ReuseStrings ObjectSet
+	// MarshalBuffer contains messages for which a MarshalVTBuffer method marshaling
+	// into a pooled vtbuf.Buffer is generated
+	MarshalBuffer ObjectSet
+	// AssumeVT contains packages whose messages are known to have been generated
+	// with vtprotobuf, so their VT methods can be called directly
+	AssumeVT PackageSet
+	// Registry registers the messages of each generated file with vtregistry and
+	// dispatches to the VT helpers of non-local messages through it
+	Registry bool
+	// ShardMessages is the maximum number of top-level messages of a .proto file
+	// generated into the same _vtproto.pb.go file, or 0 for no limit
+	ShardMessages int
+	// Profiles selects the features generated for each package by profile name,
+	// instead of the list of features passed to NewGenerator
+	Profiles Profiles
+	// SliceGrowth selects how UnmarshalVT grows the slices of repeated fields
+	SliceGrowth SliceGrowth
+	// Templates replace the code generated by a feature for some messages
+	Templates Templates
+	// Only restricts the generated code to the messages it matches and the messages
+	// they depend on
+	Only MessagePatterns
+	// StrictEditions fails generation for editions files that use features the
+	// generated code does not implement, instead of generating code for them
+	StrictEditions bool
+	// StrictWeakFields fails generation for the fields declared with the weak option,
+	// instead of warning that they are generated as regular message fields
+	StrictWeakFields bool
+	// DisableWellKnownTypes treats the fields of well-known types like the fields of
+	// other external messages, instead of using the helpers of the types/known packages
+	DisableWellKnownTypes bool
+	// WellKnownTypesMode selects the messages of well-known types that are handled by the
+	// helpers of the types/known packages. Vendored copies of the well-known types,
+	// generated in other Go packages, cannot be converted to the types of these helpers
+	WellKnownTypesMode WellKnownTypesMode
+	// DisableUTF8Validation skips the validation of string fields by UnmarshalVT, even
+	// where proto3 or the editions features require valid UTF-8
+	DisableUTF8Validation bool
+	// ValidateUTF8OnMarshal makes MarshalVT fail when the string fields that must contain
+	// valid UTF-8 do not
+	ValidateUTF8OnMarshal bool
+	// MaxLen caps the length of the string and bytes fields decoded by UnmarshalVT, unless
+	// they set their own max_len option. It is not enforced if zero
+	MaxLen uint64
+	// HashUnknownFields makes HashVT hash the unknown fields of messages too, which EqualVT
+	// compares
+	HashUnknownFields bool
+	// GRPCReturnToPool makes the gRPC stubs return the pooled messages they receive and
+	// send to their memory pool once they are done with them
+	GRPCReturnToPool bool
+	// PoolBuildTag is the build tag enabling the memory pools, which are replaced by
+	// functions allocating new messages without it. Pools are always enabled if empty
+	PoolBuildTag   string
+	Wrap           bool
+	WellKnownTypes bool
+	AllowEmpty     bool
+	BuildTag       string
+	// BenchmarkJSON makes the benchmark feature generate a test writing the results of
+	// its benchmarks as JSON, see the vtbench package
+	BenchmarkJSON bool
+	// Report is the name of the JSON report of the generated code to write, if any
+	Report string
+	// OutputMap moves the generated files to other directories than the ones protoc
+	// names them in
+	OutputMap OutputMap
 }
 
 type Generator struct {
 	plugin   *protogen.Plugin
 	cfg      *Config
-	features []Feature
-	local    map[protoreflect.FullName]bool
+	features featureSet
+	profiles map[string]featureSet
+	// files holds the features of the files setting them with the vtproto file
+	// options, by path
+	files map[string]featureSet
+	// fileFeatures holds the names of the features generated for each file, by path
+	fileFeatures map[string]map[string]bool
+	local        map[protoreflect.FullName]bool
+	// selected holds the messages selected by the only option, or nil if it is not set
+	selected map[protoreflect.FullName]bool
+	report   Report
+	// err is the first error reported by the features, see GeneratedFile.Override
+	err error
 }
 
 const SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL) |
@@ -80,27 +316,65 @@ func NewGenerator(plugin *protogen.Plugin, featureNames []string, cfg *Config) (
 	plugin.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
 	plugin.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2024
 
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
 	features, err := findFeatures(featureNames)
 	if err != nil {
 		return nil, err
 	}
+	profiles := make(map[string]featureSet)
+	for _, name := range cfg.Profiles.names() {
+		if profiles[name], err = findFeatures(profileFeatures[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.StrictEditions {
+		if err := checkEditionsFeatures(plugin.Files); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkWeakFields(plugin.Files, cfg.StrictWeakFields, os.Stderr); err != nil {
+		return nil, err
+	}
+	if err := checkWellKnownTypes(plugin.Files, cfg.WellKnownTypesMode); err != nil {
+		return nil, err
+	}
 
 	local := make(map[protoreflect.FullName]bool)
+	files := make(map[string]featureSet)
 	for _, f := range plugin.Files {
-		if f.Generate {
-			local[f.Desc.Package()] = true
+		if !f.Generate {
+			continue
+		}
+		local[f.Desc.Package()] = true
+		if names := FileOptions(f.Desc).GetFeatures(); names != "" {
+			if files[f.Desc.Path()], err = findFeatures(strings.Split(names, "+")); err != nil {
+				return nil, fmt.Errorf("%s: %w", f.Desc.Path(), err)
+			}
 		}
 	}
 
-	return &Generator{
+	gen := &Generator{
 		plugin:   plugin,
 		cfg:      cfg,
 		features: features,
+		profiles: profiles,
+		files:    files,
 		local:    local,
-	}, nil
+		selected: selectMessages(plugin, &cfg.Only),
+	}
+	gen.fileFeatures = make(map[string]map[string]bool)
+	for _, f := range plugin.Files {
+		if f.Generate {
+			gen.fileFeatures[f.Desc.Path()] = gen.featuresFor(f).names
+		}
+	}
+	return gen, nil
 }
 
-func (gen *Generator) Generate() {
+func (gen *Generator) Generate() error {
 	for _, file := range gen.plugin.Files {
 		if !file.Generate {
 			continue
@@ -111,22 +385,151 @@ func (gen *Generator) Generate() {
 			importPath = file.GoImportPath
 		}
 
-		gf := gen.plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_vtproto.pb.go", importPath)
-		gen.generateFile(gf, file)
+		for n, shard := range gen.shards(file) {
+			filename := file.GeneratedFilenamePrefix + "_vtproto.pb.go"
+			fileIdent := strings.TrimPrefix(file.GoDescriptorIdent.GoName, "File_")
+			if n > 0 {
+				filename = fmt.Sprintf("%s_vtproto_%d.pb.go", file.GeneratedFilenamePrefix, n)
+				fileIdent = fmt.Sprintf("%s_%d", fileIdent, n)
+			}
+			filename = gen.cfg.OutputMap.Map(filename)
+			gf := gen.plugin.NewGeneratedFile(filename, importPath)
+			companions := make(map[string]*GeneratedFile)
+			companion := func(suffix string, tags []string) *GeneratedFile {
+				if c, ok := companions[suffix]; ok {
+					return c
+				}
+				name := strings.TrimSuffix(filename, ".pb.go") + "_" + suffix + ".pb.go"
+				if suffix == testSuffix {
+					name = strings.TrimSuffix(filename, ".pb.go") + "_test.go"
+				}
+				c := &GeneratedFile{
+					GeneratedFile: gen.plugin.NewGeneratedFile(name, importPath),
+					Config:        gen.cfg,
+					LocalPackages: gen.local,
+					fileIdent:     fileIdent + "_" + suffix,
+					selected:      gen.selected,
+					fileFeatures:  gen.fileFeatures,
+				}
+				gen.writeHeader(c, shard, tags)
+				companions[suffix] = c
+				return c
+			}
+			report, written := gen.generateFile(gf, shard, fileIdent, companion)
+			if written {
+				report.GoFile = filename
+			}
+			gen.report.Files = append(gen.report.Files, report)
+		}
+	}
+
+	if gen.err != nil {
+		return gen.err
+	}
+	if gen.cfg.Report != "" {
+		return gen.writeReport()
+	}
+	return nil
+}
+
+// shards splits the top-level messages of file into groups of at most
+// Config.ShardMessages messages, each of which is generated into its own file.
+// The services and the top-level extensions of file are only generated with the first
+// group.
+func (gen *Generator) shards(file *protogen.File) []*protogen.File {
+	size := gen.cfg.ShardMessages
+	if size <= 0 || len(file.Messages) <= size {
+		return []*protogen.File{file}
+	}
+
+	var shards []*protogen.File
+	for start := 0; start < len(file.Messages); start += size {
+		shard := *file
+		shard.Messages = file.Messages[start:min(start+size, len(file.Messages))]
+		if start > 0 {
+			shard.Services = nil
+			shard.Extensions = nil
+		}
+		shards = append(shards, &shard)
+	}
+	return shards
+}
+
+// featuresFor returns the features to generate for file, according to its vtproto
+// file options or its profile.
+func (gen *Generator) featuresFor(file *protogen.File) featureSet {
+	if features, ok := gen.files[file.Desc.Path()]; ok {
+		return features
 	}
+	if name, ok := gen.cfg.Profiles.For(file.GoImportPath); ok {
+		return gen.profiles[name]
+	}
+	return gen.features
 }
 
-func (gen *Generator) generateFile(gf *protogen.GeneratedFile, file *protogen.File) {
+// generateFile generates file into gf, and returns its report and whether gf was written.
+func (gen *Generator) generateFile(gf *protogen.GeneratedFile, file *protogen.File, fileIdent string, companion func(string, []string) *GeneratedFile) (*FileReport, bool) {
+	features := gen.featuresFor(file)
 	p := &GeneratedFile{
 		GeneratedFile: gf,
 		Config:        gen.cfg,
 		LocalPackages: gen.local,
+		features:      features.names,
+		fileIdent:     fileIdent,
+		companion:     companion,
+		selected:      gen.selected,
+		fileFeatures:  gen.fileFeatures,
+		fail: func(err error) {
+			if gen.err == nil {
+				gen.err = err
+			}
+		},
+	}
+	gen.writeHeader(p, file, nil)
+
+	if p.Wrapper() {
+		for _, msg := range file.Messages {
+			p.P(`type `, msg.GoIdent.GoName, ` `, msg.GoIdent)
+			for _, one := range msg.Oneofs {
+				for _, field := range one.Fields {
+					p.P(`type `, field.GoIdent.GoName, ` `, field.GoIdent)
+				}
+			}
+		}
 	}
 
+	var generated bool
+	lines := make(map[string]int)
+	for i, feat := range features.features {
+		p.lines = 0
+		p.feature = features.order[i]
+		featGenerator := feat(p)
+		if featGenerator.GenerateFile(file) {
+			generated = true
+		}
+		lines[features.order[i]] = p.lines
+	}
+
+	if generated && p.Config.Registry {
+		gen.generateRegistry(p, file)
+	}
+
+	if !generated && !gen.cfg.AllowEmpty {
+		gf.Skip()
+	}
+	return p.fileReport(file, features, lines), generated || gen.cfg.AllowEmpty
+}
+
+// writeHeader writes the header of a generated file for file, which is only compiled
+// when the build constraints in tags and the build tag of the configuration are satisfied.
+func (gen *Generator) writeHeader(p *GeneratedFile, file *protogen.File, tags []string) {
 	if p.Config.BuildTag != "" {
+		tags = append([]string{p.Config.BuildTag}, tags...)
+	}
+	if len(tags) > 0 {
 		// Support both forms of tags for maximum compatibility
-		p.P("//go:build ", p.Config.BuildTag)
-		p.P("// +build ", p.Config.BuildTag)
+		p.P("//go:build ", strings.Join(tags, " && "))
+		p.P("// +build ", strings.Join(tags, ","))
 	}
 	p.P("// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.")
 	if bi, ok := debug.ReadBuildInfo(); ok {
@@ -145,27 +548,48 @@ func (gen *Generator) generateFile(gf *protogen.GeneratedFile, file *protogen.Fi
 	p.P("_ = ", protoimplPackage.Ident("EnforceVersion"), "(", protoimplPackage.Ident("MaxVersion"), " - ", protoimpl.GenVersion, ")")
 	p.P(")")
 	p.P()
+}
 
-	if p.Wrapper() {
-		for _, msg := range file.Messages {
-			p.P(`type `, msg.GoIdent.GoName, ` `, msg.GoIdent)
-			for _, one := range msg.Oneofs {
-				for _, field := range one.Fields {
-					p.P(`type `, field.GoIdent.GoName, ` `, field.GoIdent)
-				}
-			}
+// generateRegistry declares the vtregistry handles used by the features and
+// registers the messages of the file with vtregistry.
+func (gen *Generator) generateRegistry(p *GeneratedFile, file *protogen.File) {
+	if len(p.registryTypes) > 0 {
+		p.P(`var (`)
+		for _, ident := range p.registryTypes {
+			p.P(p.registryTypeName(ident), ` `, vtRegistryPackage.Ident("Type"), `[*`, ident, `]`)
 		}
+		p.P(`)`)
+		p.P()
 	}
 
-	var generated bool
-	for _, feat := range gen.features {
-		featGenerator := feat(p)
-		if featGenerator.GenerateFile(file) {
-			generated = true
+	if p.Wrapper() {
+		// Wrapper types are not proto.Message implementations.
+		return
+	}
+
+	var messages []*protogen.Message
+	var collect func([]*protogen.Message)
+	collect = func(msgs []*protogen.Message) {
+		for _, message := range msgs {
+			if !message.Desc.IsMapEntry() && !p.IsOpaque(message) && p.Selected(message) {
+				messages = append(messages, message)
+			}
+			collect(message.Messages)
 		}
 	}
+	collect(file.Messages)
+	if len(messages) == 0 {
+		return
+	}
 
-	if !generated && !gen.cfg.AllowEmpty {
-		gf.Skip()
+	p.P(`func init() {`)
+	for _, message := range messages {
+		name := strconv.Quote(string(message.Desc.FullName()))
+		if p.HasFeature("pool") && p.ShouldPool(message) {
+			p.P(vtRegistryPackage.Ident("RegisterPooledType"), `[`, message.GoIdent.GoName, `](`, name, `, `, message.GoIdent.GoName, `FromVTPool)`)
+			continue
+		}
+		p.P(vtRegistryPackage.Ident("RegisterType"), `[`, message.GoIdent.GoName, `](`, name, `)`)
 	}
+	p.P(`}`)
 }
@@ -0,0 +1,96 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator/pattern"
+)
+
+// MessagePatterns is a set of patterns matching the full names of messages, for the
+// only option. The name components of a pattern are matched like the elements of a
+// path by pattern.Match: "app.*" matches the messages of the app package and "app.**"
+// matches the messages of app and of the packages below it, nested messages included.
+type MessagePatterns struct {
+	patterns []string
+}
+
+func (m *MessagePatterns) String() string {
+	if m == nil {
+		return ""
+	}
+	return strings.Join(m.patterns, ",")
+}
+
+func (m *MessagePatterns) Set(s string) error {
+	if !pattern.ValidatePattern(namePath(s)) {
+		return fmt.Errorf("invalid message pattern %q: %w", s, pattern.ErrBadPattern)
+	}
+	m.patterns = append(m.patterns, s)
+	return nil
+}
+
+// Match returns true if name matches one of the patterns.
+func (m *MessagePatterns) Match(name protoreflect.FullName) bool {
+	for _, p := range m.patterns {
+		// Ignore malformed pattern error because pattern already checked in Set
+		if ok, _ := pattern.Match(namePath(p), namePath(string(name))); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// namePath returns the full name or pattern s with its components separated by
+// slashes, to match them with pattern.Match.
+func namePath(s string) string {
+	return strings.ReplaceAll(s, ".", "/")
+}
+
+// selectMessages returns the messages matching the only option and the messages
+// they depend on through their fields, or nil if the option is not set.
+func selectMessages(plugin *protogen.Plugin, only *MessagePatterns) map[protoreflect.FullName]bool {
+	if len(only.patterns) == 0 {
+		return nil
+	}
+	selected := make(map[protoreflect.FullName]bool)
+	var add func(md protoreflect.MessageDescriptor)
+	add = func(md protoreflect.MessageDescriptor) {
+		if selected[md.FullName()] {
+			return
+		}
+		selected[md.FullName()] = true
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if dep := fields.Get(i).Message(); dep != nil {
+				add(dep)
+			}
+		}
+	}
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			if only.Match(message.Desc.FullName()) {
+				add(message.Desc)
+			}
+			walk(message.Messages)
+		}
+	}
+	for _, file := range plugin.Files {
+		walk(file.Messages)
+	}
+	return selected
+}
+
+// Selected returns true if code is generated for message, which is the case of all
+// the messages unless the only option is set.
+func (p *GeneratedFile) Selected(message *protogen.Message) bool {
+	return p.selected == nil || p.selected[message.Desc.FullName()]
+}
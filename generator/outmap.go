@@ -0,0 +1,64 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// OutputMap moves the generated files to other directories, e.g. to the directory of the
+// Bazel package of their Go package when it is not the one protoc writes them to. It is
+// set from "<directory>=<directory>" values, the first of which is the directory of the
+// files as named by protoc (according to the paths and module options), and the second
+// the directory they are written to instead, "." being the output directory of protoc.
+// The longest matching directory wins.
+type OutputMap struct {
+	moves []outputMove
+}
+
+type outputMove struct {
+	from, to string
+}
+
+func (m *OutputMap) String() string {
+	if m == nil {
+		return ""
+	}
+	values := make([]string, 0, len(m.moves))
+	for _, move := range m.moves {
+		values = append(values, move.from+"="+move.to)
+	}
+	return strings.Join(values, ",")
+}
+
+func (m *OutputMap) Set(s string) error {
+	from, to, ok := strings.Cut(s, "=")
+	if !ok || from == "" || to == "" {
+		return fmt.Errorf("invalid output mapping %q, expected <directory>=<directory>", s)
+	}
+	from, to = path.Clean(from), path.Clean(to)
+	if path.IsAbs(from) || path.IsAbs(to) || strings.HasPrefix(to, "../") || to == ".." {
+		return fmt.Errorf("invalid output mapping %q, directories must be relative to the output directory", s)
+	}
+	m.moves = append(m.moves, outputMove{from: from, to: to})
+	return nil
+}
+
+// Map returns the name of the generated file named filename by protoc.
+func (m *OutputMap) Map(filename string) string {
+	best, rest := -1, filename
+	for _, move := range m.moves {
+		dir := move.from + "/"
+		if move.from == "." {
+			dir = ""
+		}
+		if len(dir) > best && strings.HasPrefix(filename, dir) {
+			best, rest = len(dir), path.Join(move.to, filename[len(dir):])
+		}
+	}
+	return rest
+}
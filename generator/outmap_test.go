@@ -0,0 +1,76 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOutputMapSet(t *testing.T) {
+	var m OutputMap
+	for _, s := range []string{"a=b", "a/b/=c/d", "./x=.", "a=b/../in"} {
+		if err := m.Set(s); err != nil {
+			t.Errorf("Set(%q): %v", s, err)
+		}
+	}
+	if got, want := m.String(), "a=b,a/b=c/d,x=.,a=in"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	for _, s := range []string{"", "a", "=b", "a=", "/a=b", "a=/b", "a=..", "a=../b"} {
+		if err := m.Set(s); err == nil || !strings.Contains(err.Error(), "invalid output mapping") {
+			t.Errorf("Set(%q) = %v, want an invalid output mapping error", s, err)
+		}
+	}
+}
+
+func TestOutputMapMap(t *testing.T) {
+	var m OutputMap
+	for _, s := range []string{
+		"example.com/app=app",
+		"example.com/app/api=services/api",
+		"example.com/app/api/v1=v1",
+		"example.com/lib=.",
+	} {
+		if err := m.Set(s); err != nil {
+			t.Fatalf("Set(%q): %v", s, err)
+		}
+	}
+	for _, tt := range []struct {
+		filename, want string
+	}{
+		// Exact match of the directory of the file.
+		{"example.com/app/app.pb.go", "app/app.pb.go"},
+		{"example.com/lib/lib.pb.go", "lib.pb.go"},
+		// The longest of the overlapping directories wins.
+		{"example.com/app/api/api.pb.go", "services/api/api.pb.go"},
+		{"example.com/app/api/v1/api.pb.go", "v1/api.pb.go"},
+		{"example.com/app/api/v2/api.pb.go", "services/api/v2/api.pb.go"},
+		{"example.com/app/internal/app.pb.go", "app/internal/app.pb.go"},
+		// Directories only match whole path elements.
+		{"example.com/application/app.pb.go", "example.com/application/app.pb.go"},
+		{"example.com/app/apis/api.pb.go", "app/apis/api.pb.go"},
+		// No match.
+		{"example.org/app/app.pb.go", "example.org/app/app.pb.go"},
+		{"app.pb.go", "app.pb.go"},
+	} {
+		if got := m.Map(tt.filename); got != tt.want {
+			t.Errorf("Map(%q) = %q, want %q", tt.filename, got, tt.want)
+		}
+	}
+
+	// The mapping of "." matches all the files, and is overridden by longer ones.
+	var all OutputMap
+	for _, s := range []string{"gen=app", ".=out"} {
+		if err := all.Set(s); err != nil {
+			t.Fatalf("Set(%q): %v", s, err)
+		}
+	}
+	for filename, want := range map[string]string{
+		"app.pb.go":           "out/app.pb.go",
+		"gen/app.pb.go":       "app/app.pb.go",
+		"generated/app.pb.go": "out/generated/app.pb.go",
+	} {
+		if got := all.Map(filename); got != want {
+			t.Errorf("Map(%q) = %q, want %q", filename, got, want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageGenerator generates the code of a feature for a single message, see
+// RegisterOverride.
+type MessageGenerator func(p *GeneratedFile, message *protogen.Message) error
+
+type overrideKey struct {
+	feature string
+	message protoreflect.FullName
+}
+
+var overrides = make(map[overrideKey]MessageGenerator)
+
+// RegisterOverride replaces the code generated by the feature with the given name for
+// the message with the given full name by the code generated by gen, for plugins built
+// on top of this package that hand-tune the methods of a few messages. gen must generate
+// all the methods the feature generates for the message, since the code generated for
+// other messages may call them.
+func RegisterOverride(feature string, message protoreflect.FullName, gen MessageGenerator) {
+	overrides[overrideKey{feature, message}] = gen
+}
+
+// Override generates the code of the current feature for message with the generator
+// registered for them with RegisterOverride or the template option, if any, and returns
+// whether it did. Features call it before generating the code of each message.
+func (p *GeneratedFile) Override(message *protogen.Message) bool {
+	key := overrideKey{p.feature, message.Desc.FullName()}
+	gen, ok := p.Config.Templates.generators[key]
+	if !ok {
+		if gen, ok = overrides[key]; !ok {
+			return false
+		}
+	}
+	if err := gen(p, message); err != nil {
+		p.fail(fmt.Errorf("%s override for %s: %w", p.feature, message.Desc.FullName(), err))
+	}
+	return true
+}
+
+// Templates holds the templates replacing the code generated by a feature for a message.
+// It is set from "<feature>:<message full name>=<template path>", where the template is
+// a text/template executed with a TemplateData, e.g. "marshal:app.Hot=hot_marshal.tmpl".
+type Templates struct {
+	generators map[overrideKey]MessageGenerator
+	names      []string
+}
+
+// TemplateData is the data the templates of Templates are executed with.
+type TemplateData struct {
+	// Message is the message to generate the code of the feature for
+	Message *protogen.Message
+	// Type is the Go type of the message in the generated file
+	Type string
+}
+
+func (t *Templates) String() string {
+	if t == nil {
+		return ""
+	}
+	sort.Strings(t.names)
+	return strings.Join(t.names, ",")
+}
+
+func (t *Templates) Set(s string) error {
+	target, path, ok := strings.Cut(s, "=")
+	feature, message, ok2 := strings.Cut(target, ":")
+	if !ok || !ok2 || !protoreflect.FullName(message).IsValid() {
+		return fmt.Errorf("invalid template %q, expected <feature>:<message>=<path>", s)
+	}
+	if _, ok := defaultFeatures[feature]; !ok {
+		return errUnknownFeature(feature)
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var withFile *GeneratedFile
+	tmpl, err := template.New(path).Funcs(template.FuncMap{
+		// ident returns the qualified name of the identifier name of the Go package
+		// importPath, importing the package in the generated file
+		"ident": func(importPath, name string) string {
+			return withFile.Ident(importPath, name)
+		},
+		// helper returns the name of the helper with the given name, see GeneratedFile.Helper
+		"helper": func(name string) string {
+			return withFile.QualifiedGoIdent(withFile.Helper(name))
+		},
+	}).Parse(string(src))
+	if err != nil {
+		return err
+	}
+
+	if t.generators == nil {
+		t.generators = make(map[overrideKey]MessageGenerator)
+	}
+	t.generators[overrideKey{feature, protoreflect.FullName(message)}] = func(p *GeneratedFile, m *protogen.Message) error {
+		withFile = p
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, TemplateData{Message: m, Type: p.QualifiedGoIdent(m.GoIdent)}); err != nil {
+			return err
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			p.P(line)
+		}
+		return nil
+	}
+	t.names = append(t.names, s)
+	return nil
+}
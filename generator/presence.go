@@ -0,0 +1,68 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// PresenceKind describes how the presence of a field is tracked by the Go struct of its
+// message, which depends on the syntax of its file, its label and, with editions, its
+// field_presence feature.
+type PresenceKind int
+
+const (
+	// PresenceImplicit fields are set when they do not hold their zero value: the
+	// singular scalar, string, enum and bytes fields of proto3 files without the optional
+	// label, and the ones of editions files with the IMPLICIT field presence.
+	PresenceImplicit PresenceKind = iota
+	// PresenceExplicit fields are set when they are not nil: message fields, bytes
+	// fields with explicit presence, and the other scalar fields with explicit presence,
+	// which are pointers to their value. These are the singular fields of proto2 files,
+	// the proto3 optional fields, and the ones of editions files with the EXPLICIT or
+	// LEGACY_REQUIRED field presence.
+	PresenceExplicit
+	// PresenceOneof fields are members of a oneof, which are set when the oneof holds
+	// their wrapper type. The wrapper holds the value of scalar, string, enum and bytes
+	// fields, and a pointer to the message of message fields. Proto3 optional fields
+	// are not part of their synthetic oneof.
+	PresenceOneof
+	// PresenceRepeated fields are repeated and map fields, which are set when they hold
+	// elements.
+	PresenceRepeated
+)
+
+func (k PresenceKind) String() string {
+	switch k {
+	case PresenceImplicit:
+		return "implicit"
+	case PresenceExplicit:
+		return "explicit"
+	case PresenceOneof:
+		return "oneof"
+	case PresenceRepeated:
+		return "repeated"
+	}
+	return "unknown"
+}
+
+// FieldPresence returns the kind of presence of field. Features should rely on it,
+// rather than on the syntax of files or on protoreflect.FieldDescriptor.HasPresence
+// (which is true for the members of oneofs too), to tell the fields whose Go type is
+// a pointer from the ones compared with their zero value.
+//
+// The explicit_presence option does not change the kind of presence of a field, whose
+// Go type is the same: see ExplicitPresence.
+func (p *GeneratedFile) FieldPresence(field *protogen.Field) PresenceKind {
+	switch {
+	case field.Desc.IsList() || field.Desc.IsMap():
+		return PresenceRepeated
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		return PresenceOneof
+	case field.Message != nil || field.Desc.HasPresence():
+		return PresenceExplicit
+	}
+	return PresenceImplicit
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/gofeaturespb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/planetscale/vtprotobuf/testproto/editions"
+	"github.com/planetscale/vtprotobuf/testproto/proto2"
+	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+)
+
+// plugin returns a plugin generating files, as protoc would invoke it.
+func plugin(t *testing.T, files ...protoreflect.FileDescriptor) *protogen.Plugin {
+	req := &pluginpb.CodeGeneratorRequest{}
+	seen := make(map[string]bool)
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, fd := range files {
+		add(fd)
+		req.FileToGenerate = append(req.FileToGenerate, fd.Path())
+	}
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plugin
+}
+
+// TestFieldPresence checks that the kinds of presence of the fields agree with the Go
+// types that protoc-gen-go generates for them, in all the syntaxes.
+func TestFieldPresence(t *testing.T) {
+	p := &GeneratedFile{}
+	kinds := make(map[PresenceKind]int)
+	var check func(*protogen.Message)
+	check = func(message *protogen.Message) {
+		for _, nested := range message.Messages {
+			check(nested)
+		}
+		if message.Desc.IsMapEntry() || message.APILevel == gofeaturespb.GoFeatures_API_OPAQUE {
+			return
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(message.Desc.FullName())
+		if err != nil {
+			t.Fatal(err)
+		}
+		typ := reflect.TypeOf(mt.Zero().Interface()).Elem()
+		for _, field := range message.Fields {
+			kind := p.FieldPresence(field)
+			kinds[kind]++
+			name := field.GoName
+			if kind == PresenceOneof {
+				name = field.Oneof.GoName
+			}
+			sf, ok := typ.FieldByName(name)
+			if !ok {
+				t.Fatalf("%s: no Go field %s", field.Desc.FullName(), name)
+			}
+			var valid bool
+			switch kind {
+			case PresenceRepeated:
+				valid = sf.Type.Kind() == reflect.Slice || sf.Type.Kind() == reflect.Map
+			case PresenceOneof:
+				valid = sf.Type.Kind() == reflect.Interface
+			case PresenceExplicit:
+				valid = sf.Type.Kind() == reflect.Pointer || field.Desc.Kind() == protoreflect.BytesKind
+			case PresenceImplicit:
+				valid = sf.Type.Kind() != reflect.Pointer && field.Message == nil
+			}
+			if !valid {
+				t.Errorf("%s: presence %v for Go type %v", field.Desc.FullName(), kind, sf.Type)
+			}
+		}
+	}
+	for _, file := range plugin(t, editions.File_editions_matrix_proto, proto2.File_proto2_scalars_proto, proto3opt.File_proto3opt_opt_proto).Files {
+		if !file.Generate {
+			continue
+		}
+		for _, message := range file.Messages {
+			check(message)
+		}
+	}
+	for _, kind := range []PresenceKind{PresenceImplicit, PresenceExplicit, PresenceOneof, PresenceRepeated} {
+		if kinds[kind] == 0 {
+			t.Errorf("no field with presence %v", kind)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/generator/pattern"
+)
+
+// profileFeatures lists the features generated by each profile.
+var profileFeatures = map[string][]string{
+	// wire only generates the (de)serialization methods, e.g. for client SDKs
+	"wire": {"marshal", "size", "unmarshal"},
+	// full generates all the features
+	"full": {"all"},
+}
+
+// profileNames returns the names of the profiles, sorted.
+func profileNames() []string {
+	names := make([]string, 0, len(profileFeatures))
+	for name := range profileFeatures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profiles selects the features to generate with named profiles. It is set from
+// either a profile name, which applies to all the packages, or from
+// "<package pattern>=<profile name>", which overrides the profile of the packages
+// matching the pattern. Patterns are matched like the ones of PackageSet, and the
+// last matching override wins.
+type Profiles struct {
+	// Default is the profile of the packages without override, if any
+	Default   string
+	overrides []profileOverride
+}
+
+type profileOverride struct {
+	pattern string
+	profile string
+}
+
+func (p *Profiles) String() string {
+	if p == nil {
+		return ""
+	}
+	values := make([]string, 0, len(p.overrides)+1)
+	if p.Default != "" {
+		values = append(values, p.Default)
+	}
+	for _, o := range p.overrides {
+		values = append(values, o.pattern+"="+o.profile)
+	}
+	return strings.Join(values, ",")
+}
+
+func (p *Profiles) Set(s string) error {
+	pkg, profile, override := strings.Cut(s, "=")
+	if !override {
+		profile = s
+	}
+	if _, ok := profileFeatures[profile]; !ok {
+		return fmt.Errorf("unknown profile %q, expected one of: %s", profile, strings.Join(profileNames(), ", "))
+	}
+
+	if !override {
+		p.Default = profile
+		return nil
+	}
+	if !validPackagePattern(pkg) {
+		return fmt.Errorf("invalid package pattern %q: %w", pkg, pattern.ErrBadPattern)
+	}
+	p.overrides = append(p.overrides, profileOverride{pattern: pkg, profile: profile})
+	return nil
+}
+
+// For returns the profile of the package importPath, if any.
+func (p *Profiles) For(importPath protogen.GoImportPath) (string, bool) {
+	for i := len(p.overrides) - 1; i >= 0; i-- {
+		if matchPackage(p.overrides[i].pattern, importPath) {
+			return p.overrides[i].profile, true
+		}
+	}
+	return p.Default, p.Default != ""
+}
+
+// names returns the names of the profiles in use, sorted.
+func (p *Profiles) names() []string {
+	seen := make(map[string]bool)
+	if p.Default != "" {
+		seen[p.Default] = true
+	}
+	for _, o := range p.overrides {
+		seen[o.profile] = true
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Report describes the code generated by a run of the generator, for the report
+// option. It is written as JSON.
+type Report struct {
+	Files []*FileReport `json:"files"`
+}
+
+// FileReport describes the code generated for a .proto file, or for a shard of it.
+type FileReport struct {
+	// Proto is the path of the .proto file the file is generated from
+	Proto string `json:"proto"`
+	// GoFile is the name of the generated file, or empty if nothing was generated
+	GoFile string `json:"go_file,omitempty"`
+	// Lines is the number of lines generated by each feature
+	Lines map[string]int `json:"lines"`
+	// Messages lists the messages of the file, including nested ones
+	Messages []*MessageReport `json:"messages"`
+}
+
+// MessageReport describes the features generated for a message.
+type MessageReport struct {
+	// Name is the full name of the message
+	Name string `json:"name"`
+	// Features lists the features generated for the message
+	Features []string `json:"features,omitempty"`
+	// Skipped gives the reason why each of the other features was not generated
+	Skipped map[string]string `json:"skipped,omitempty"`
+}
+
+// Reasons for which features are not generated for a message.
+const (
+	SkippedMapEntry = "map entry"
+	SkippedOpaque   = "opaque"
+	SkippedExcluded = "excluded"
+	SkippedNoPool   = "not pooled"
+	SkippedWrapper  = "wrapper"
+	SkippedOnly     = "not selected"
+	SkippedSpecial  = "special form"
+)
+
+// fileReport returns the report of the code generated for file.
+func (p *GeneratedFile) fileReport(file *protogen.File, features featureSet, lines map[string]int) *FileReport {
+	report := &FileReport{
+		Proto: file.Desc.Path(),
+		Lines: lines,
+	}
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			report.Messages = append(report.Messages, p.messageReport(message, features))
+			walk(message.Messages)
+		}
+	}
+	walk(file.Messages)
+	return report
+}
+
+func (p *GeneratedFile) messageReport(message *protogen.Message, features featureSet) *MessageReport {
+	report := &MessageReport{Name: string(message.Desc.FullName())}
+	for _, name := range features.order {
+		if name == "grpc" || name == "extension" {
+			// Services and extensions are not generated per message.
+			continue
+		}
+		// The filters of the features check the features of the messages, which are
+		// relative to the feature being generated.
+		p.feature = name
+		if reason := p.skipReason(name, message); reason != "" {
+			if report.Skipped == nil {
+				report.Skipped = make(map[string]string)
+			}
+			report.Skipped[name] = reason
+			continue
+		}
+		report.Features = append(report.Features, name)
+	}
+	return report
+}
+
+// SkipReason returns why the feature being generated is not generated for message, or ""
+// if it is. The features check it before generating the code of each message.
+func (p *GeneratedFile) SkipReason(message *protogen.Message) string {
+	return p.skipReason(p.feature, message)
+}
+
+// skipReason returns why the feature name is not generated for message, or "" if it is.
+func (p *GeneratedFile) skipReason(name string, message *protogen.Message) string {
+	switch {
+	case message.Desc.IsMapEntry():
+		return SkippedMapEntry
+	case !p.Selected(message):
+		return SkippedOnly
+	}
+	if filter := messageFilters[name]; filter != nil {
+		return filter(p, message)
+	}
+	return ""
+}
+
+// writeReport generates the report file of the run.
+func (gen *Generator) writeReport() error {
+	data, err := json.MarshalIndent(gen.report, "", "  ")
+	if err != nil {
+		return err
+	}
+	gf := gen.plugin.NewGeneratedFile(gen.cfg.Report, "")
+	_, err = gf.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/testproto/proto2"
+)
+
+// recorder is a feature recording the messages it generates code for, skipping the
+// ones its filter rejects.
+type recorder struct {
+	*GeneratedFile
+	generated map[string]bool
+}
+
+func (p *recorder) GenerateFile(file *protogen.File) bool {
+	var walk func([]*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			walk(message.Messages)
+			if p.SkipReason(message) == "" {
+				p.generated[string(message.Desc.FullName())] = true
+			}
+		}
+	}
+	walk(file.Messages)
+	return true
+}
+
+// TestReportSkipReasons checks that the report lists the messages a feature generates
+// code for, and the reasons of its filter for the other ones.
+func TestReportSkipReasons(t *testing.T) {
+	generated := make(map[string]bool)
+	RegisterFeature("test_recorder", func(gen *GeneratedFile) FeatureGenerator {
+		return &recorder{GeneratedFile: gen, generated: generated}
+	})
+	RegisterMessageFilter("test_recorder", func(p *GeneratedFile, message *protogen.Message) string {
+		if message.Desc.Name() == "Inner" {
+			return "filtered"
+		}
+		return ""
+	})
+	defer func() {
+		delete(defaultFeatures, "test_recorder")
+		delete(messageFilters, "test_recorder")
+	}()
+
+	gen, err := NewGenerator(plugin(t, proto2.File_proto2_groups_proto), []string{"test_recorder"}, &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.Generate(); err != nil {
+		t.Fatal(err)
+	}
+	reported := make(map[string]bool)
+	skipped := make(map[string]string)
+	for _, file := range gen.report.Files {
+		for _, message := range file.Messages {
+			for _, name := range message.Features {
+				if name == "test_recorder" {
+					reported[message.Name] = true
+				}
+			}
+			if reason, ok := message.Skipped["test_recorder"]; ok {
+				skipped[message.Name] = reason
+			}
+		}
+	}
+	if len(generated) == 0 || !reflect.DeepEqual(reported, generated) {
+		t.Errorf("report lists %v, the feature generated %v", reported, generated)
+	}
+	if want := map[string]string{"proto2.Inner": "filtered"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("report skips %v, want %v", skipped, want)
+	}
+}
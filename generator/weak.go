@@ -0,0 +1,56 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// checkWeakFields reports the fields of the generated files declared with the weak
+// option. protoc-gen-go no longer implements weak fields, and generates them like any
+// other message field, which the features then handle like it: the fields are written
+// as warnings to w, or returned as an error if strict is set.
+func checkWeakFields(files []*protogen.File, strict bool, w io.Writer) error {
+	for _, file := range files {
+		if !file.Generate {
+			continue
+		}
+		if err := checkWeakMessages(file, file.Messages, strict, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkWeakMessages(file *protogen.File, messages []*protogen.Message, strict bool, w io.Writer) error {
+	for _, message := range messages {
+		for _, field := range message.Fields {
+			if !isWeak(field) {
+				continue
+			}
+			if strict {
+				return fmt.Errorf("%s: field %s is weak, which is not supported by protoc-gen-go-vtproto",
+					file.Desc.Path(), field.Desc.FullName())
+			}
+			fmt.Fprintf(w, "protoc-gen-go-vtproto: warning: %s: weak field %s is generated as a regular field\n",
+				file.Desc.Path(), field.Desc.FullName())
+		}
+		if err := checkWeakMessages(file, message.Messages, strict, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isWeak returns true if field is declared with the weak option, which
+// protoreflect.FieldDescriptor.IsWeak does not report anymore.
+func isWeak(field *protogen.Field) bool {
+	opts, ok := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetWeak()
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// checkWellKnownTypes returns an error for the well-known types of files generated in
+// other Go packages than the ones of google.golang.org/protobuf/types/known when mode
+// is WellKnownTypesKnown: the helpers of the types/known packages only wrap the Go
+// types of these packages, and cannot handle vendored copies of the well-known types.
+func checkWellKnownTypes(files []*protogen.File, mode WellKnownTypesMode) error {
+	if mode != WellKnownTypesKnown {
+		return nil
+	}
+	for _, file := range files {
+		for _, message := range file.Messages {
+			if _, ok := wellKnownTypes[message.Desc.FullName()]; ok && !isKnownType(message) {
+				return fmt.Errorf("%s: wkt=known cannot handle %s of Go package %s with the types/known helpers, use wkt=auto or wkt=local",
+					file.Desc.Path(), message.Desc.FullName(), message.GoIdent.GoImportPath)
+			}
+		}
+	}
+	return nil
+}
+
+// isKnownType returns true if the well-known type message is generated in the Go
+// package of google.golang.org/protobuf/types/known wrapped by the types/known helpers.
+func isKnownType(message *protogen.Message) bool {
+	ident := wellKnownTypes[message.Desc.FullName()]
+	return message.GoIdent.GoImportPath == knownTypesPackage+protogen.GoImportPath(path.Base(string(ident.GoImportPath)))
+}
@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/planetscale/vtprotobuf/testproto/wkt"
+)
+
+// vendoredPlugin returns a plugin generating a file with a field of a copy of
+// google/protobuf/timestamp.proto vendored in another Go package.
+func vendoredPlugin(t *testing.T) *protogen.Plugin {
+	vendored := protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto)
+	vendored.Options.GoPackage = proto.String("example.com/vendored/timestamppb")
+	event := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("event.proto"),
+		Package:    proto.String("event"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{vendored.GetName()},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/event")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("at"),
+				JsonName: proto.String("at"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".google.protobuf.Timestamp"),
+			}},
+		}},
+	}
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{vendored.GetName(), event.GetName()},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{vendored, event},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plugin
+}
+
+// TestWellKnownTypesMode checks the well-known types that each mode accepts: known fails
+// for the vendored ones, which the helpers of the types/known packages cannot wrap.
+func TestWellKnownTypesMode(t *testing.T) {
+	for _, mode := range []WellKnownTypesMode{"", WellKnownTypesAuto, WellKnownTypesKnown, WellKnownTypesLocal} {
+		if _, err := NewGenerator(plugin(t, wkt.File_wkt_wkt_proto), nil, &Config{WellKnownTypesMode: mode}); err != nil {
+			t.Errorf("wkt=%s: NewGenerator() of the types/known well-known types: %v", &mode, err)
+		}
+		_, err := NewGenerator(vendoredPlugin(t), nil, &Config{WellKnownTypesMode: mode})
+		switch {
+		case mode != WellKnownTypesKnown && err != nil:
+			t.Errorf("wkt=%s: NewGenerator() of vendored well-known types: %v", &mode, err)
+		case mode == WellKnownTypesKnown && (err == nil || !strings.Contains(err.Error(), "wkt=known cannot handle google.protobuf.Timestamp")):
+			t.Errorf("wkt=%s: NewGenerator() of vendored well-known types = %v, want a wkt=known error", &mode, err)
+		}
+	}
+}
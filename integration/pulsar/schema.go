@@ -0,0 +1,107 @@
+// Package pulsar implements the Schema interface of the Apache Pulsar Go client
+// with the methods generated by protoc-gen-go-vtproto. It lives in its own module,
+// so that the vtprotobuf module does not depend on the Pulsar client.
+package pulsar
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// Message is implemented by pointers to messages generated with the marshal and
+// unmarshal features.
+type Message[T any] interface {
+	*T
+	proto.Message
+	vtproto.Message
+}
+
+// Schema is a Pulsar ProtoNative schema for the messages of type T, which encodes and
+// decodes them with MarshalVT and UnmarshalVT instead of the proto package, e.g.:
+//
+//	schema, err := vtpulsar.NewSchema[pb.Event](nil)
+//	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: topic, Schema: schema})
+type Schema[T any, P Message[T]] struct {
+	info pulsar.SchemaInfo
+}
+
+// NewSchema returns the schema of T, whose schema info holds the descriptors of the
+// file of T and of its dependencies, like the ProtoNative schemas of the Pulsar client.
+func NewSchema[T any, P Message[T]](properties map[string]string) (*Schema[T, P], error) {
+	desc := P(new(T)).ProtoReflect().Descriptor()
+	files := &descriptorpb.FileDescriptorSet{}
+	addFile(files, desc.ParentFile(), make(map[string]bool))
+	set, err := proto.Marshal(files)
+	if err != nil {
+		return nil, err
+	}
+	def, err := json.Marshal(pulsar.ProtoNativeSchemaData{
+		FileDescriptorSet:      set,
+		RootMessageTypeName:    string(desc.FullName()),
+		RootFileDescriptorName: desc.ParentFile().Path(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Schema[T, P]{info: pulsar.SchemaInfo{
+		Name:       "ProtoNative",
+		Schema:     string(def),
+		Type:       pulsar.ProtoNative,
+		Properties: properties,
+	}}, nil
+}
+
+// addFile adds file and its transitive dependencies to files, dependencies first.
+func addFile(files *descriptorpb.FileDescriptorSet, file protoreflect.FileDescriptor, seen map[string]bool) {
+	if seen[file.Path()] {
+		return
+	}
+	seen[file.Path()] = true
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		addFile(files, imports.Get(i).FileDescriptor, seen)
+	}
+	files.File = append(files.File, protodesc.ToFileDescriptorProto(file))
+}
+
+// Encode marshals v, which must be a P, with MarshalVT.
+func (s *Schema[T, P]) Encode(v interface{}) ([]byte, error) {
+	m, ok := v.(P)
+	if !ok {
+		return nil, fmt.Errorf("failed to encode, message is %T, schema is for %T", v, P(nil))
+	}
+	return m.MarshalVT()
+}
+
+// Decode unmarshals data into v, which must be a P, with UnmarshalVT. v is reset first,
+// like proto.Unmarshal does.
+func (s *Schema[T, P]) Decode(data []byte, v interface{}) error {
+	m, ok := v.(P)
+	if !ok {
+		return fmt.Errorf("failed to decode, message is %T, schema is for %T", v, P(nil))
+	}
+	if r, ok := v.(vtproto.Resetter); ok {
+		r.ResetVT()
+	} else {
+		proto.Reset(m)
+	}
+	return m.UnmarshalVT(data)
+}
+
+// Validate checks that data decodes into a T.
+func (s *Schema[T, P]) Validate(data []byte) error {
+	return P(new(T)).UnmarshalVT(data)
+}
+
+// GetSchemaInfo returns the ProtoNative schema info of T.
+func (s *Schema[T, P]) GetSchemaInfo() *pulsar.SchemaInfo {
+	return &s.info
+}
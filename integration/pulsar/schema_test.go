@@ -0,0 +1,53 @@
+package pulsar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+)
+
+func TestSchema(t *testing.T) {
+	var schema pulsar.Schema
+	schema, err := NewSchema[pool.MemoryPoolExtension](map[string]string{"team": "storage"})
+	require.NoError(t, err)
+
+	original := &pool.MemoryPoolExtension{Foo1: "foo", Foo2: 42, Foo3: &pool.OptionalMessage{}}
+	data, err := schema.Encode(original)
+	require.NoError(t, err)
+	require.NoError(t, schema.Validate(data))
+
+	got := &pool.MemoryPoolExtension{Foo1: "previous"}
+	require.NoError(t, schema.Decode(data, got))
+	require.True(t, original.EqualVT(got))
+
+	require.ErrorContains(t, schema.Decode(data, &pool.OptionalMessage{}), "schema is for")
+	_, err = schema.Encode(&pool.OptionalMessage{})
+	require.ErrorContains(t, err, "schema is for")
+	require.Error(t, schema.Validate([]byte{0xff}))
+
+	// The schema info matches the one of the ProtoNative schemas of the client.
+	info := schema.GetSchemaInfo()
+	want := pulsar.NewProtoNativeSchemaWithMessage(original, map[string]string{"team": "storage"}).GetSchemaInfo()
+	require.Equal(t, want.Name, info.Name)
+	require.Equal(t, want.Type, info.Type)
+	require.Equal(t, want.Properties, info.Properties)
+
+	var def pulsar.ProtoNativeSchemaData
+	require.NoError(t, json.Unmarshal([]byte(info.Schema), &def))
+	require.Equal(t, "MemoryPoolExtension", def.RootMessageTypeName)
+	require.Equal(t, "pool/pool.proto", def.RootFileDescriptorName)
+
+	var set descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(def.FileDescriptorSet, &set))
+	files, err := protodesc.NewFiles(&set)
+	require.NoError(t, err)
+	_, err = files.FindDescriptorByName("MemoryPoolExtension")
+	require.NoError(t, err)
+}
@@ -0,0 +1,25 @@
+#!/bin/bash
+
+# Builds the fuzz targets for OSS-Fuzz, from the build.sh of the project in the
+# oss-fuzz repository. compile_native_go_fuzzer and the $OUT and $WORK directories
+# are provided by the OSS-Fuzz base image.
+
+set -e
+
+ROOT=$(cd -- "$(dirname -- "${BASH_SOURCE[0]}")" &> /dev/null && pwd)
+cd "$ROOT"
+
+compile_native_go_fuzzer github.com/planetscale/vtprotobuf/protohelpers FuzzConsumeVarint fuzz_consume_varint
+compile_native_go_fuzzer github.com/planetscale/vtprotobuf/protohelpers FuzzSkip fuzz_skip
+
+TARGETS="FuzzProto2Int64 FuzzProto2String FuzzProto2Enum FuzzProto3Optional FuzzOneof FuzzWellKnownTypes FuzzEditions"
+
+# The seed corpora are the encodings of the messages generated by the targets, which
+# only run their seeds with go test.
+go test -count=1 -run '^Fuzz' ./testproto/fuzz -args -corpus "$WORK/corpus"
+
+for target in $TARGETS; do
+    name=$(echo "$target" | sed -e 's/\([A-Z]\)/_\1/g' -e 's/^_//' | tr '[:upper:]' '[:lower:]')
+    compile_native_go_fuzzer github.com/planetscale/vtprotobuf/testproto/fuzz "$target" "$name"
+    (cd "$WORK/corpus/$target" && zip -q "$OUT/${name}_seed_corpus.zip" *)
+done
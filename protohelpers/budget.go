@@ -0,0 +1,151 @@
+package protohelpers
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DecodeBudget bounds the resources allocated to decode a message and all the messages
+// it holds, see CheckDecodeBudget. The limits that are zero are not enforced.
+type DecodeBudget struct {
+	// MaxBytes caps the total size of the string, bytes and unknown fields
+	MaxBytes uint64
+	// MaxElements caps the total number of elements of the repeated fields
+	MaxElements uint64
+	// MaxMapEntries caps the total number of entries of the map fields
+	MaxMapEntries uint64
+}
+
+// BudgetError is returned by CheckDecodeBudget when the data exceeds a limit of the
+// budget.
+type BudgetError struct {
+	// Message is the full name of the message the budget applies to
+	Message protoreflect.FullName
+	// Limit is the name of the exceeded limit in the decode_budget option, e.g.
+	// "max_elements"
+	Limit string
+	// Max is the value of the exceeded limit
+	Max uint64
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("proto: %s exceeds the %s decode budget of %d", e.Message, e.Limit, e.Max)
+}
+
+// CheckDecodeBudget returns a *BudgetError if decoding data as a message of type md
+// would exceed budget. The UnmarshalVT methods of the messages generated with the
+// decode_budget option call it before decoding their data.
+//
+// Data that is not valid protobuf is not reported: it is left to the decoder to fail on
+// it, after checking the budget of the part of the data that precedes the error.
+func CheckDecodeBudget(data []byte, md protoreflect.MessageDescriptor, budget DecodeBudget) error {
+	c := budgetChecker{budget: budget, name: md.FullName()}
+	return c.message(data, md, 0)
+}
+
+type budgetChecker struct {
+	budget                   DecodeBudget
+	name                     protoreflect.FullName
+	bytes, elements, entries uint64
+}
+
+func (c *budgetChecker) addBytes(n int) error {
+	c.bytes += uint64(n)
+	if c.budget.MaxBytes > 0 && c.bytes > c.budget.MaxBytes {
+		return &BudgetError{Message: c.name, Limit: "max_bytes", Max: c.budget.MaxBytes}
+	}
+	return nil
+}
+
+func (c *budgetChecker) addElements(n int) error {
+	c.elements += uint64(n)
+	if c.budget.MaxElements > 0 && c.elements > c.budget.MaxElements {
+		return &BudgetError{Message: c.name, Limit: "max_elements", Max: c.budget.MaxElements}
+	}
+	return nil
+}
+
+func (c *budgetChecker) addEntry() error {
+	c.entries++
+	if c.budget.MaxMapEntries > 0 && c.entries > c.budget.MaxMapEntries {
+		return &BudgetError{Message: c.name, Limit: "max_map_entries", Max: c.budget.MaxMapEntries}
+	}
+	return nil
+}
+
+func (c *budgetChecker) message(data []byte, md protoreflect.MessageDescriptor, depth int) error {
+	if depth > protowire.DefaultRecursionLimit {
+		return nil
+	}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil
+		}
+		m := protowire.ConsumeFieldValue(num, typ, data[n:])
+		if m < 0 {
+			return nil
+		}
+		value := data[n : n+m]
+		fd := md.Fields().ByNumber(num)
+		if err := c.field(fd, num, typ, value, n+m, depth); err != nil {
+			return err
+		}
+		data = data[n+m:]
+	}
+	return nil
+}
+
+// field checks the value of the field fd, or of an unknown field if fd is nil, whose
+// encoding is size bytes long.
+func (c *budgetChecker) field(fd protoreflect.FieldDescriptor, num protowire.Number, typ protowire.Type, value []byte, size int, depth int) error {
+	if fd == nil {
+		return c.addBytes(size)
+	}
+	if fd.IsMap() {
+		if err := c.addEntry(); err != nil {
+			return err
+		}
+	} else if fd.IsList() {
+		count := 1
+		if typ == protowire.BytesType && fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind && fd.Message() == nil {
+			count = packedCount(fd.Kind(), value)
+		}
+		if err := c.addElements(count); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case typ == protowire.BytesType && (fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind):
+		v, _ := protowire.ConsumeBytes(value)
+		return c.addBytes(len(v))
+	case typ == protowire.BytesType && fd.Message() != nil:
+		v, _ := protowire.ConsumeBytes(value)
+		return c.message(v, fd.Message(), depth+1)
+	case typ == protowire.StartGroupType && fd.Message() != nil:
+		v, _ := protowire.ConsumeGroup(num, value)
+		return c.message(v, fd.Message(), depth+1)
+	}
+	return nil
+}
+
+// packedCount returns the number of elements of kind in the value of a packed field.
+func packedCount(kind protoreflect.Kind, value []byte) int {
+	v, _ := protowire.ConsumeBytes(value)
+	switch kind {
+	case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		return len(v) / 8
+	case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		return len(v) / 4
+	}
+	var count int
+	for _, b := range v {
+		if b < 0x80 {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,113 @@
+package protohelpers
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const (
+	// minBufferClass is the log2 of the capacity of the smallest pooled buffers.
+	minBufferClass = 6
+	// maxBufferClass is the log2 of the capacity of the largest pooled buffers. Larger
+	// buffers are allocated for each call and left to the garbage collector.
+	maxBufferClass = 24
+)
+
+var bufferPools [maxBufferClass - minBufferClass + 1]sync.Pool
+
+// bufferClass returns the size class of buffers able to hold size bytes, or -1 if
+// size is too large to be pooled. Empty buffers are of the smallest class.
+func bufferClass(size int) int {
+	if size <= 0 {
+		return 0
+	}
+	c := bits.Len(uint(size - 1))
+	if c < minBufferClass {
+		c = minBufferClass
+	}
+	if c > maxBufferClass {
+		return -1
+	}
+	return c - minBufferClass
+}
+
+// GetBuffer returns a buffer of length size from a pool of buffers whose capacity is
+// the power of two above size, or a newly allocated one if the pool is empty or size
+// is larger than 16MB. The content of the buffer is not zeroed. The buffer is handed
+// back to its pool with PutBuffer.
+//
+// The codecs and the vtbuf package share these pools, which applications can use for
+// their own buffers too.
+func GetBuffer(size int) *[]byte {
+	c := bufferClass(size)
+	if c < 0 {
+		buf := make([]byte, size)
+		return &buf
+	}
+	if buf, ok := bufferPools[c].Get().(*[]byte); ok {
+		*buf = (*buf)[:size]
+		return buf
+	}
+	buf := make([]byte, size, 1<<(c+minBufferClass))
+	return &buf
+}
+
+// PutBuffer returns buf to the pool of its size class. Neither buf nor its content can
+// be used after calling PutBuffer. Buffers whose capacity is not a size class, which
+// includes the ones not returned by GetBuffer, are left to the garbage collector, as
+// is a nil buf.
+func PutBuffer(buf *[]byte) {
+	if buf == nil {
+		return
+	}
+	c := bufferClass(cap(*buf))
+	if c < 0 || cap(*buf) != 1<<(c+minBufferClass) {
+		return
+	}
+	*buf = (*buf)[:0]
+	bufferPools[c].Put(buf)
+}
+
+// bufferHeaders holds the pointers handed out by GetBuffer for the buffers of GetBytes,
+// so that PutBytes returns buffers to their pools without allocating.
+var bufferHeaders sync.Pool
+
+// GetBytes returns a slice of length size whose backing array comes from the pools of
+// GetBuffer, like GetBuffer does. It is handed back to its pool with PutBytes.
+func GetBytes(size int) []byte {
+	buf := GetBuffer(size)
+	b := *buf
+	*buf = nil
+	bufferHeaders.Put(buf)
+	return b
+}
+
+// PutBytes returns the backing array of b to the pool of its size class, like PutBuffer
+// does. Neither b nor the slices sharing its backing array can be used after calling
+// PutBytes.
+func PutBytes(b []byte) {
+	c := bufferClass(cap(b))
+	if c < 0 || cap(b) != 1<<(c+minBufferClass) {
+		return
+	}
+	buf, ok := bufferHeaders.Get().(*[]byte)
+	if !ok {
+		buf = new([]byte)
+	}
+	*buf = b
+	PutBuffer(buf)
+}
+
+// ReuseBytes returns a copy of data held in a buffer of the pools of GetBytes. The backing
+// array of b is reused if it is a buffer of the size class of data, and otherwise returned
+// to its pool with PutBytes. The generated code uses it to decode the bytes fields using
+// the pooled_bytes option.
+func ReuseBytes(b, data []byte) []byte {
+	if c := bufferClass(len(data)); c < 0 || cap(b) != 1<<(c+minBufferClass) {
+		PutBytes(b)
+		b = GetBytes(len(data))
+	}
+	b = b[:len(data)]
+	copy(b, data)
+	return b
+}
@@ -0,0 +1,115 @@
+package protohelpers
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaxMarshalDepth is the maximum depth of the messages marshaled by the messages
+// generated with the cycle_check option, which is the depth limit of the proto package
+// when unmarshaling.
+const MaxMarshalDepth = protowire.DefaultRecursionLimit
+
+// CycleError is returned by CheckCycles when a message holds itself, or when its tree of
+// messages is deeper than MaxMarshalDepth.
+type CycleError struct {
+	// Message is the full name of the message held by itself, or of the message found
+	// deeper than MaxMarshalDepth
+	Message protoreflect.FullName
+	// Depth is the depth at which the message was found
+	Depth int
+	// Cycle is set if the message holds itself, and unset if it is too deep
+	Cycle bool
+}
+
+func (e *CycleError) Error() string {
+	if e.Cycle {
+		return fmt.Sprintf("proto: message %s holds itself at depth %d", e.Message, e.Depth)
+	}
+	return fmt.Sprintf("proto: message %s exceeds the maximum depth of %d", e.Message, MaxMarshalDepth)
+}
+
+// CheckCycles returns a *CycleError if m holds itself, directly or through other
+// messages, or if its tree of messages is deeper than MaxMarshalDepth. Messages held by
+// many parents are only checked once, so that checking a graph takes linear time.
+func CheckCycles(m proto.Message) error {
+	c := cycleChecker{onPath: make(map[proto.Message]bool), checked: make(map[proto.Message]subtree)}
+	_, err := c.check(m.ProtoReflect(), 0)
+	return err
+}
+
+type cycleChecker struct {
+	// onPath holds the messages of the current path
+	onPath map[proto.Message]bool
+	// checked holds the tree of the messages that are checked, for the messages held by
+	// many parents to be checked again at the depth of each of them
+	checked map[proto.Message]subtree
+}
+
+// subtree is the tree of messages held by a message.
+type subtree struct {
+	// height is the depth of the deepest message of the tree, relative to its root
+	height int
+	// deepest is the full name of the deepest message of the tree, or empty if the root
+	// is not a valid message
+	deepest protoreflect.FullName
+}
+
+func (c *cycleChecker) check(m protoreflect.Message, depth int) (subtree, error) {
+	if !m.IsValid() {
+		return subtree{}, nil
+	}
+	if depth > MaxMarshalDepth {
+		return subtree{}, &CycleError{Message: m.Descriptor().FullName(), Depth: depth}
+	}
+	key := m.Interface()
+	if c.onPath[key] {
+		return subtree{}, &CycleError{Message: m.Descriptor().FullName(), Depth: depth, Cycle: true}
+	}
+	if t, ok := c.checked[key]; ok {
+		if depth+t.height > MaxMarshalDepth {
+			return subtree{}, &CycleError{Message: t.deepest, Depth: depth + t.height}
+		}
+		return t, nil
+	}
+	c.onPath[key] = true
+
+	t := subtree{deepest: m.Descriptor().FullName()}
+	var err error
+	child := func(v protoreflect.Value) {
+		var ct subtree
+		if ct, err = c.check(v.Message(), depth+1); err == nil && ct.deepest != "" && ct.height+1 > t.height {
+			t = subtree{height: ct.height + 1, deepest: ct.deepest}
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				child(v)
+				return err == nil
+			})
+		case fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				child(list.Get(i))
+			}
+		default:
+			child(v)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return subtree{}, err
+	}
+	delete(c.onPath, key)
+	c.checked[key] = t
+	return t, nil
+}
@@ -0,0 +1,56 @@
+package protohelpers
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// seedRecords are records of every wire type, including nested groups, used as the seed
+// corpus of the fuzz targets.
+var seedRecords = [][]byte{
+	protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 300),
+	protowire.AppendFixed32(protowire.AppendTag(nil, 2, protowire.Fixed32Type), 1),
+	protowire.AppendFixed64(protowire.AppendTag(nil, 3, protowire.Fixed64Type), 1),
+	protowire.AppendBytes(protowire.AppendTag(nil, 4, protowire.BytesType), []byte("bytes")),
+	protowire.AppendTag(protowire.AppendTag(protowire.AppendVarint(protowire.AppendTag(
+		protowire.AppendTag(nil, 5, protowire.StartGroupType), 6, protowire.VarintType), 1),
+		6, protowire.StartGroupType), 6, protowire.EndGroupType),
+	{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+}
+
+func FuzzConsumeVarint(f *testing.F) {
+	for _, v := range varints {
+		f.Add(protowire.AppendVarint(nil, v))
+	}
+	f.Add([]byte{0x80})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v, n := ConsumeVarint(data)
+		want, wantN := protowire.ConsumeVarint(data)
+		if (n < 0) != (wantN < 0) || n >= 0 && (v != want || n != wantN) {
+			t.Fatalf("ConsumeVarint(%x) = %d, %d, protowire returned %d, %d", data, v, n, want, wantN)
+		}
+	})
+}
+
+func FuzzSkip(f *testing.F) {
+	for _, record := range seedRecords {
+		f.Add(record)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		field, n, err := SkipField(data)
+		if err != nil {
+			if _, _, m := protowire.ConsumeField(data); m >= 0 {
+				t.Fatalf("SkipField(%x) failed with %v, protowire consumed %d bytes", data, err, m)
+			}
+			return
+		}
+		if n <= 0 || n > len(data) || field.Start > field.End || field.End > n {
+			t.Fatalf("SkipField(%x) returned %+v, %d", data, field, n)
+		}
+		if _, _, m := protowire.ConsumeField(data); m >= 0 && m != n {
+			t.Fatalf("SkipField(%x) skipped %d bytes, protowire consumed %d", data, n, m)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package protohelpers
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// LengthError is returned by UnmarshalVT when a string or bytes value is longer than the
+// max_len option of its field, or the max-len flag of the generator.
+type LengthError struct {
+	// Field is the full name of the field holding the value
+	Field protoreflect.FullName
+	// Len is the length of the value in the data
+	Len int
+	// Max is the maximum length of the values of the field
+	Max uint64
+}
+
+func (e *LengthError) Error() string {
+	return fmt.Sprintf("proto: field %s has a value of %d bytes, longer than its max_len of %d", e.Field, e.Len, e.Max)
+}
@@ -7,6 +7,10 @@ import (
 	"io"
 	"math/bits"
 	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var (
@@ -16,7 +20,7 @@ var (
 	ErrIntOverflow = fmt.Errorf("proto: integer overflow")
 	// ErrUnexpectedEndOfGroup is returned when decoding a group end without a corresponding group start.
 	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
-	// ErrInvalidUTF8 is returned when decoding a string field that contains invalid UTF-8.
+	// ErrInvalidUTF8 is returned when decoding or marshaling a string field that contains invalid UTF-8.
 	ErrInvalidUTF8 = fmt.Errorf("proto: invalid UTF-8 in string")
 )
 
@@ -28,6 +32,14 @@ func ValidateUTF8(b []byte) error {
 	return nil
 }
 
+// ValidateUTF8String returns an error if the string is not valid UTF-8.
+func ValidateUTF8String(s string) error {
+	if !utf8.ValidString(s) {
+		return ErrInvalidUTF8
+	}
+	return nil
+}
+
 // EncodeVarint encodes a uint64 into a varint-encoded byte slice and returns the offset of the encoded value.
 // The provided offset is the offset after the last byte of the encoded value.
 func EncodeVarint(dAtA []byte, offset int, v uint64) int {
@@ -52,82 +64,243 @@ func SizeOfZigzag(x uint64) (n int) {
 	return SizeOfVarint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 
+// Negative lengths returned by the Consume functions, which protowire.ParseError turns
+// into errors, like the ones of the protowire package.
+const (
+	errCodeTruncated = -1
+	errCodeOverflow  = -3
+)
+
+// AppendVarint appends v to b as a varint, like protowire.AppendVarint.
+func AppendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// AppendTag appends the key of a field with number num and wire type typ to b, like
+// protowire.AppendTag.
+func AppendTag(b []byte, num protowire.Number, typ protowire.Type) []byte {
+	return AppendVarint(b, uint64(num)<<3|uint64(typ&7))
+}
+
+// ConsumeVarint parses b as a varint and returns its value and length, like
+// protowire.ConsumeVarint. It returns a negative length on error, which can be turned
+// into an error with protowire.ParseError.
+func ConsumeVarint(b []byte) (v uint64, n int) {
+	if len(b) > 0 && b[0] < 0x80 {
+		return uint64(b[0]), 1
+	}
+	for i, c := range b {
+		if i == 9 {
+			if c > 1 {
+				return 0, errCodeOverflow
+			}
+			return v | uint64(c)<<63, 10
+		}
+		v |= uint64(c&0x7F) << (7 * uint(i))
+		if c < 0x80 {
+			return v, i + 1
+		}
+	}
+	return 0, errCodeTruncated
+}
+
+// ConsumeBytes parses b as a length-prefixed bytes value and returns it with the length of
+// its encoding, like protowire.ConsumeBytes. The returned slice refers to b. It returns a
+// negative length on error, which can be turned into an error with protowire.ParseError.
+func ConsumeBytes(b []byte) (v []byte, n int) {
+	m, n := ConsumeVarint(b)
+	if n < 0 {
+		return nil, n
+	}
+	if m > uint64(len(b)-n) {
+		return nil, errCodeTruncated
+	}
+	return b[n:][:m], n + int(m)
+}
+
+// varintError returns the error for a negative length returned by ConsumeVarint.
+func varintError(n int) error {
+	if n == errCodeTruncated {
+		return io.ErrUnexpectedEOF
+	}
+	return ErrIntOverflow
+}
+
+// Field describes the first record of a byte slice, as parsed by SkipField.
+type Field struct {
+	// Num and Type are the field number and the wire type of the record.
+	Num  protowire.Number
+	Type protowire.Type
+	// Start and End delimit the value of the record in the byte slice: the bytes
+	// of a varint or of a fixed-size value, the content of a length-delimited
+	// value without its length, or the records of a group without its end tag.
+	Start, End int
+}
+
 // Skip the first record of the byte slice and return the offset of the next record.
 func Skip(dAtA []byte) (n int, err error) {
+	_, n, err = SkipField(dAtA)
+	return n, err
+}
+
+// SkipField parses the first record of the byte slice and returns its description,
+// along with the offset of the next record.
+func SkipField(dAtA []byte) (f Field, n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
 	depth := 0
 	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+		tagIndex := iNdEx
+		wire, n := ConsumeVarint(dAtA[iNdEx:])
+		if n < 0 {
+			return Field{}, 0, varintError(n)
 		}
+		iNdEx += n
 		wireType := int(wire & 0x7)
+		if depth == 0 {
+			f = Field{Num: protowire.Number(wire >> 3), Type: protowire.Type(wireType), Start: iNdEx}
+		}
 		switch wireType {
 		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
+			_, n := ConsumeVarint(dAtA[iNdEx:])
+			if n < 0 {
+				return Field{}, 0, varintError(n)
 			}
+			iNdEx += n
 		case 1:
 			iNdEx += 8
 		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			length, n := ConsumeVarint(dAtA[iNdEx:])
+			if n < 0 {
+				return Field{}, 0, varintError(n)
 			}
-			if length < 0 {
-				return 0, ErrInvalidLength
+			iNdEx += n
+			if int(length) < 0 {
+				return Field{}, 0, ErrInvalidLength
+			}
+			if depth == 0 {
+				f.Start = iNdEx
 			}
-			iNdEx += length
+			iNdEx += int(length)
 		case 3:
 			depth++
 		case 4:
 			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroup
+				return Field{}, 0, ErrUnexpectedEndOfGroup
 			}
 			depth--
+			if depth == 0 {
+				f.End = tagIndex
+				return f, iNdEx, nil
+			}
 		case 5:
 			iNdEx += 4
 		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+			return Field{}, 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
 		if iNdEx < 0 {
-			return 0, ErrInvalidLength
+			return Field{}, 0, ErrInvalidLength
 		}
 		if depth == 0 {
-			return iNdEx, nil
+			if iNdEx > l {
+				return Field{}, 0, io.ErrUnexpectedEOF
+			}
+			f.End = iNdEx
+			return f, iNdEx, nil
+		}
+	}
+	return Field{}, 0, io.ErrUnexpectedEOF
+}
+
+// GrowDoubling returns s with room for at least one more element. When s is full, its
+// elements are copied to a new slice with twice its capacity.
+func GrowDoubling[T any](s []T) []T {
+	if len(s) < cap(s) {
+		return s
+	}
+	return grow(s, max(2*cap(s), 1))
+}
+
+// GrowChunked returns s with room for at least one more element. When s is full, its
+// elements are copied to a new slice with chunk more elements of capacity.
+func GrowChunked[T any](s []T, chunk int) []T {
+	if len(s) < cap(s) {
+		return s
+	}
+	return grow(s, cap(s)+max(chunk, 1))
+}
+
+// Reserve returns s with room for at least n more elements. When s does not have room,
+// its elements are copied to a new slice with a capacity of exactly len(s)+n.
+func Reserve[T any](s []T, n int) []T {
+	if cap(s)-len(s) >= n {
+		return s
+	}
+	return grow(s, len(s)+n)
+}
+
+func grow[T any](s []T, capacity int) []T {
+	grown := make([]T, len(s), capacity)
+	copy(grown, s)
+	return grown
+}
+
+// CountFields stores in counts[i] the number of records of field number nums[i] in dAtA.
+// It stops counting at the first malformed record, which the caller is expected to report
+// when decoding dAtA.
+func CountFields(dAtA []byte, nums []int32, counts []int) {
+	for len(dAtA) > 0 {
+		f, n, err := SkipField(dAtA)
+		if err != nil {
+			return
+		}
+		for i, num := range nums {
+			if protowire.Number(num) == f.Num {
+				counts[i]++
+				break
+			}
+		}
+		dAtA = dAtA[n:]
+	}
+}
+
+// CloneExtensions sets on dst a deep copy of every extension field populated in src.
+// Extension messages are cloned with their CloneMessageVT method if they have one,
+// and with proto.Clone otherwise.
+func CloneExtensions(dst, src proto.Message) {
+	d := dst.ProtoReflect()
+	proto.RangeExtensions(src, func(xt protoreflect.ExtensionType, v interface{}) bool {
+		xd := xt.TypeDescriptor()
+		value := xt.ValueOf(v)
+		switch {
+		case xd.IsList():
+			list := d.Mutable(xd).List()
+			src := value.List()
+			for i := 0; i < src.Len(); i++ {
+				list.Append(cloneValue(xd, src.Get(i)))
+			}
+		default:
+			d.Set(xd, cloneValue(xd, value))
+		}
+		return true
+	})
+}
+
+func cloneValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message().Interface()
+		if vt, ok := msg.(interface{ CloneMessageVT() proto.Message }); ok {
+			return protoreflect.ValueOfMessage(vt.CloneMessageVT().ProtoReflect())
 		}
+		return protoreflect.ValueOfMessage(proto.Clone(msg).ProtoReflect())
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...))
+	default:
+		return v
 	}
-	return 0, io.ErrUnexpectedEOF
 }
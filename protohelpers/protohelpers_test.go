@@ -0,0 +1,245 @@
+package protohelpers
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var varints = []uint64{0, 1, 127, 128, 300, 1 << 21, 1<<28 - 1, 1 << 35, 1 << 56, math.MaxInt64, math.MaxUint64}
+
+func TestAppendVarint(t *testing.T) {
+	for _, v := range varints {
+		require.Equal(t, protowire.AppendVarint([]byte{1}, v), AppendVarint([]byte{1}, v))
+	}
+}
+
+func TestAppendTag(t *testing.T) {
+	for _, num := range []protowire.Number{1, 15, 16, 2047, 2048, protowire.MaxValidNumber} {
+		for _, typ := range []protowire.Type{protowire.VarintType, protowire.BytesType, protowire.Fixed32Type} {
+			require.Equal(t, protowire.AppendTag(nil, num, typ), AppendTag(nil, num, typ))
+		}
+	}
+}
+
+func TestConsumeVarint(t *testing.T) {
+	for _, v := range varints {
+		b := protowire.AppendVarint(nil, v)
+		got, n := ConsumeVarint(append(b, 0xff))
+		require.Equal(t, v, got)
+		require.Equal(t, len(b), n)
+
+		// Truncated varints are reported like protowire does.
+		_, n = ConsumeVarint(b[:len(b)-1])
+		_, expected := protowire.ConsumeVarint(b[:len(b)-1])
+		require.Equal(t, expected, n)
+	}
+
+	for _, b := range [][]byte{
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x02},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	} {
+		_, n := ConsumeVarint(b)
+		_, expected := protowire.ConsumeVarint(b)
+		require.Equal(t, expected, n)
+		require.Error(t, protowire.ParseError(n))
+	}
+}
+
+func TestConsumeBytes(t *testing.T) {
+	b := protowire.AppendBytes(nil, []byte("hello"))
+	v, n := ConsumeBytes(append(b, 1, 2))
+	require.Equal(t, []byte("hello"), v)
+	require.Equal(t, len(b), n)
+
+	for _, truncated := range [][]byte{b[:len(b)-1], {0x80}, {}} {
+		_, n := ConsumeBytes(truncated)
+		_, expected := protowire.ConsumeBytes(truncated)
+		require.Equal(t, expected, n)
+	}
+}
+
+func TestSkipField(t *testing.T) {
+	group := protowire.AppendTag(nil, 1, protowire.VarintType)
+	group = protowire.AppendVarint(group, 1)
+	group = protowire.AppendTag(group, 2, protowire.StartGroupType)
+	group = protowire.AppendTag(group, 2, protowire.EndGroupType)
+
+	for _, record := range []struct {
+		num   protowire.Number
+		typ   protowire.Type
+		value []byte
+	}{
+		{1, protowire.VarintType, protowire.AppendVarint(nil, 300)},
+		{2, protowire.Fixed32Type, protowire.AppendFixed32(nil, 1)},
+		{3, protowire.Fixed64Type, protowire.AppendFixed64(nil, 1)},
+		{4, protowire.BytesType, []byte("hello")},
+		{5, protowire.BytesType, nil},
+		{2048, protowire.StartGroupType, group},
+	} {
+		b := protowire.AppendTag(nil, record.num, record.typ)
+		switch record.typ {
+		case protowire.BytesType:
+			b = protowire.AppendBytes(b, record.value)
+		case protowire.StartGroupType:
+			b = append(b, record.value...)
+			b = protowire.AppendTag(b, record.num, protowire.EndGroupType)
+		default:
+			b = append(b, record.value...)
+		}
+
+		f, n, err := SkipField(append(b, 0x08, 0x01))
+		require.NoError(t, err)
+		require.Equal(t, len(b), n)
+		require.Equal(t, protowire.ConsumeFieldValue(record.num, record.typ, b[protowire.SizeTag(record.num):])+protowire.SizeTag(record.num), n)
+		require.Equal(t, record.num, f.Num)
+		require.Equal(t, record.typ, f.Type)
+		require.Equal(t, string(record.value), string(b[f.Start:f.End]))
+
+		_, _, err = SkipField(b[:len(b)-1])
+		require.Error(t, err)
+	}
+
+	_, _, err := SkipField(protowire.AppendTag(nil, 1, protowire.EndGroupType))
+	require.ErrorIs(t, err, ErrUnexpectedEndOfGroup)
+}
+
+func TestValidateUTF8(t *testing.T) {
+	ascii := strings.Repeat("abcdefgh", 9)
+	for _, invalid := range []string{"\xff", "\xc3", "\xe2\x82", "\xed\xa0\x80", "\xf4\x90\x80\x80", "\xc0\xaf"} {
+		for n := 0; n <= len(ascii); n++ {
+			for _, s := range []string{ascii[:n] + invalid, ascii[:n] + invalid + ascii[n:], invalid + ascii[:n]} {
+				require.ErrorIs(t, ValidateUTF8([]byte(s)), ErrInvalidUTF8, "%q", s)
+				require.ErrorIs(t, ValidateUTF8String(s), ErrInvalidUTF8, "%q", s)
+			}
+		}
+	}
+	for _, valid := range []string{"", "é", "€", "𝄞", "héllo wörld"} {
+		for n := 0; n <= len(ascii); n++ {
+			for _, s := range []string{ascii[:n] + valid, ascii[:n] + valid + ascii[n:], valid + ascii[:n]} {
+				require.NoError(t, ValidateUTF8([]byte(s)), "%q", s)
+				require.NoError(t, ValidateUTF8String(s), "%q", s)
+			}
+		}
+	}
+}
+
+func TestGetBuffer(t *testing.T) {
+	for _, size := range []int{0, 1, 64, 65, 1000, 1 << 24, 1<<24 + 1} {
+		buf := GetBuffer(size)
+		require.Len(t, *buf, size)
+		if size <= 1<<24 {
+			require.Zero(t, cap(*buf)&(cap(*buf)-1), "capacity %d is not a size class", cap(*buf))
+		}
+		PutBuffer(buf)
+	}
+
+	// Foreign buffers are dropped instead of being handed out by GetBuffer.
+	foreign := make([]byte, 100)
+	PutBuffer(&foreign)
+	require.Len(t, foreign, 100)
+	PutBuffer(nil)
+}
+
+func TestBufferClass(t *testing.T) {
+	for size, want := range map[int]int{-1: 0, 0: 0, 1: 0, 64: 0, 65: 1, 128: 1, 1 << 24: 18, 1<<24 + 1: -1} {
+		require.Equal(t, want, bufferClass(size), "size %d", size)
+	}
+}
+
+func TestGetBufferNoAllocs(t *testing.T) {
+	// Empty buffers, e.g. for the empty frames of the stream codec, are pooled too.
+	for _, size := range []int{0, 512} {
+		PutBuffer(GetBuffer(size))
+		allocs := testing.AllocsPerRun(100, func() {
+			PutBuffer(GetBuffer(size))
+		})
+		require.Zero(t, allocs, "size %d", size)
+	}
+}
+
+func TestReuseBytes(t *testing.T) {
+	b := ReuseBytes(nil, []byte("short"))
+	require.Equal(t, []byte("short"), b)
+	require.Equal(t, 64, cap(b))
+
+	// Data of the same size class is copied into the same buffer.
+	same := ReuseBytes(b, []byte("other"))
+	require.Same(t, &b[0], &same[0])
+
+	large := ReuseBytes(same, make([]byte, 200))
+	require.Len(t, large, 200)
+	require.Equal(t, 256, cap(large))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		PutBytes(GetBytes(512))
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkAppendVarint(b *testing.B) {
+	buf := make([]byte, 0, 10*len(varints))
+	b.Run("protohelpers", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, v := range varints {
+				buf = AppendVarint(buf, v)
+			}
+		}
+	})
+	b.Run("protowire", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, v := range varints {
+				buf = protowire.AppendVarint(buf, v)
+			}
+		}
+	})
+}
+
+func BenchmarkConsumeVarint(b *testing.B) {
+	var data []byte
+	for i := 0; i < 100; i++ {
+		data = protowire.AppendVarint(data, uint64(i*i))
+	}
+	b.Run("protohelpers", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for p := data; len(p) > 0; {
+				_, n := ConsumeVarint(p)
+				p = p[n:]
+			}
+		}
+	})
+	b.Run("protowire", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for p := data; len(p) > 0; {
+				_, n := protowire.ConsumeVarint(p)
+				p = p[n:]
+			}
+		}
+	})
+}
+
+func BenchmarkValidateUTF8(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		data []byte
+	}{
+		{"short", []byte("hello world")},
+		{"label", []byte("service.instance.region-eu-1")},
+		{"ascii", []byte(strings.Repeat("abcdefghij", 100))},
+		{"mixed", []byte(strings.Repeat("héllo wörld ", 20))},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.SetBytes(int64(len(bench.data)))
+			for i := 0; i < b.N; i++ {
+				if err := ValidateUTF8(bench.data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package protohelpers
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// SizeCache holds the sizes of the messages computed by the SizeVTCached methods generated
+// for the messages using the size_cache option, by message address. A cache is scoped to
+// a single marshal call, during which the messages must not be modified, so that a message
+// held by many parents is only sized once.
+type SizeCache struct {
+	sizes map[unsafe.Pointer]int
+}
+
+var sizeCaches = sync.Pool{New: func() any {
+	return &SizeCache{sizes: make(map[unsafe.Pointer]int)}
+}}
+
+// GetSizeCache returns an empty SizeCache from a pool, which is returned to it by
+// PutSizeCache.
+func GetSizeCache() *SizeCache {
+	return sizeCaches.Get().(*SizeCache)
+}
+
+// PutSizeCache empties c and returns it to the pool of GetSizeCache.
+func PutSizeCache(c *SizeCache) {
+	clear(c.sizes)
+	sizeCaches.Put(c)
+}
+
+// Get returns the size cached for the message at address m, if any.
+func (c *SizeCache) Get(m unsafe.Pointer) (int, bool) {
+	n, ok := c.sizes[m]
+	return n, ok
+}
+
+// Put caches the size n of the message at address m.
+func (c *SizeCache) Put(m unsafe.Pointer, n int) {
+	c.sizes[m] = n
+}
@@ -0,0 +1,32 @@
+package protohelpers
+
+import (
+	"sync/atomic"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnknownFieldHook is called by the UnmarshalVT methods of messages generated with the
+// observe_unknown_fields option for each unknown field they decode.
+type UnknownFieldHook func(message protoreflect.FullName, num protowire.Number, typ protowire.Type)
+
+var unknownFieldHook atomic.Pointer[UnknownFieldHook]
+
+// SetUnknownFieldHook registers the hook called for unknown fields, replacing the
+// previous one. A nil hook disables the observation. The hook can be called from
+// several goroutines at once and must not retain the decoded message.
+func SetUnknownFieldHook(hook UnknownFieldHook) {
+	if hook == nil {
+		unknownFieldHook.Store(nil)
+		return
+	}
+	unknownFieldHook.Store(&hook)
+}
+
+// ObserveUnknownField calls the registered UnknownFieldHook, if any.
+func ObserveUnknownField(message protoreflect.FullName, fieldNum int32, wireType int) {
+	if hook := unknownFieldHook.Load(); hook != nil {
+		(*hook)(message, protowire.Number(fieldNum), protowire.Type(wireType))
+	}
+}
@@ -0,0 +1,32 @@
+package protohelpers
+
+import (
+	"sync/atomic"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnmarshalHook is called by the UnmarshalVT methods of messages generated with the
+// observe_unmarshal option once they return, with the size of the decoded data and
+// the error they return, if any.
+type UnmarshalHook func(message protoreflect.FullName, size int, err error)
+
+var unmarshalHook atomic.Pointer[UnmarshalHook]
+
+// SetUnmarshalHook registers the hook called by observed UnmarshalVT methods, replacing
+// the previous one. A nil hook disables the observation. The hook can be called from
+// several goroutines at once and must not retain the decoded message.
+func SetUnmarshalHook(hook UnmarshalHook) {
+	if hook == nil {
+		unmarshalHook.Store(nil)
+		return
+	}
+	unmarshalHook.Store(&hook)
+}
+
+// ObserveUnmarshal calls the registered UnmarshalHook, if any.
+func ObserveUnmarshal(message protoreflect.FullName, size int, err error) {
+	if hook := unmarshalHook.Load(); hook != nil {
+		(*hook)(message, size, err)
+	}
+}
@@ -0,0 +1,166 @@
+package protohelpers
+
+import (
+	"encoding/binary"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// writerBufferSize is the size of the buffers of Writers. Larger bytes and string fields
+// are written to the io.Writer directly, without being copied to the buffer.
+const writerBufferSize = 4096
+
+// Writer buffers the encoding written to an io.Writer by the MarshalToWriterVT methods of
+// the generated messages. The messages nested in a message are written by the Writer of
+// the outermost one, which holds a single buffer from the pools of GetBuffer while the
+// message is written.
+//
+// The first error returned by the io.Writer is kept by the Writer, which then ignores
+// the following writes, and is returned by End.
+type Writer struct {
+	w     io.Writer
+	buf   *[]byte
+	n     int
+	depth int
+	err   error
+}
+
+// NewWriter returns a Writer writing to w, or w itself if it is a *Writer, so that nested
+// messages share the Writer of the outermost message.
+func NewWriter(w io.Writer) *Writer {
+	if sw, ok := w.(*Writer); ok {
+		return sw
+	}
+	return &Writer{w: w}
+}
+
+// Begin starts writing a message, and returns the offset of its first byte to be passed
+// to End.
+func (w *Writer) Begin() int {
+	if w.depth == 0 {
+		w.buf = GetBuffer(writerBufferSize)
+		*w.buf = (*w.buf)[:0]
+	}
+	w.depth++
+	return w.Offset()
+}
+
+// End completes writing the message started at offset start, and returns its size.
+// Completing the outermost message flushes the buffer to the io.Writer and returns the
+// buffer to its pool.
+func (w *Writer) End(start int) (int, error) {
+	w.depth--
+	if w.depth == 0 {
+		w.flush()
+		PutBuffer(w.buf)
+		w.buf = nil
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.Offset() - start, nil
+}
+
+// Abort completes writing the message started by Begin after it failed with err, and
+// returns 0 and err. Aborting the outermost message returns the buffer to its pool
+// without flushing it.
+func (w *Writer) Abort(err error) (int, error) {
+	w.depth--
+	if w.depth == 0 {
+		PutBuffer(w.buf)
+		w.buf = nil
+	}
+	return 0, err
+}
+
+// Offset returns the number of bytes written by w so far, including the buffered ones.
+func (w *Writer) Offset() int {
+	if w.buf == nil {
+		return w.n
+	}
+	return w.n + len(*w.buf)
+}
+
+// Write writes b as is, which makes Writer an io.Writer for the messages written through
+// their MarshalToWriterVT method.
+func (w *Writer) Write(b []byte) (int, error) {
+	if len(b) >= writerBufferSize {
+		w.flush()
+		w.write(b)
+	} else {
+		*w.buf = append(*w.buf, b...)
+		w.grow()
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+	return len(b), nil
+}
+
+// Tag writes the key of a field with number num and wire type typ.
+func (w *Writer) Tag(num protowire.Number, typ protowire.Type) {
+	*w.buf = AppendTag(*w.buf, num, typ)
+	w.grow()
+}
+
+// Varint writes v as a varint.
+func (w *Writer) Varint(v uint64) {
+	*w.buf = AppendVarint(*w.buf, v)
+	w.grow()
+}
+
+// Fixed32 writes v as 4 little-endian bytes.
+func (w *Writer) Fixed32(v uint32) {
+	*w.buf = binary.LittleEndian.AppendUint32(*w.buf, v)
+	w.grow()
+}
+
+// Fixed64 writes v as 8 little-endian bytes.
+func (w *Writer) Fixed64(v uint64) {
+	*w.buf = binary.LittleEndian.AppendUint64(*w.buf, v)
+	w.grow()
+}
+
+// Bytes writes b prefixed by its length.
+func (w *Writer) Bytes(b []byte) {
+	w.Varint(uint64(len(b)))
+	w.Write(b)
+}
+
+// String writes s prefixed by its length.
+func (w *Writer) String(s string) {
+	w.Varint(uint64(len(s)))
+	if len(s) >= writerBufferSize {
+		w.flush()
+		if w.err == nil {
+			var n int
+			n, w.err = io.WriteString(w.w, s)
+			w.n += n
+		}
+		return
+	}
+	*w.buf = append(*w.buf, s...)
+	w.grow()
+}
+
+// grow flushes the buffer once it holds writerBufferSize bytes.
+func (w *Writer) grow() {
+	if len(*w.buf) >= writerBufferSize {
+		w.flush()
+	}
+}
+
+func (w *Writer) flush() {
+	w.write(*w.buf)
+	*w.buf = (*w.buf)[:0]
+}
+
+func (w *Writer) write(b []byte) {
+	if w.err != nil || len(b) == 0 {
+		return
+	}
+	var n int
+	n, w.err = w.w.Write(b)
+	w.n += n
+}
@@ -0,0 +1,87 @@
+package alloc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/planetscale/vtprotobuf/vtalloc"
+)
+
+// counter is an instrumented allocator counting the allocations of the decoded messages.
+type counter struct {
+	messages map[protoreflect.FullName]int
+	bytes    int
+	strings  int
+}
+
+func (c *counter) NewMessage(mt protoreflect.MessageType) proto.Message {
+	c.messages[mt.Descriptor().FullName()]++
+	return mt.New().Interface()
+}
+
+func (c *counter) Bytes(n int) []byte {
+	c.bytes++
+	return make([]byte, n)
+}
+
+func (c *counter) String(b []byte) string {
+	c.strings++
+	return string(b)
+}
+
+func mail() *Mail {
+	return &Mail{
+		Subject:     "subject",
+		Body:        []byte("body"),
+		Recipients:  []string{"a", "b"},
+		Signatures:  [][]byte{[]byte("sig"), {}},
+		Cover:       &Attachment{Name: "cover", Content: []byte("cover")},
+		Attachments: []*Attachment{{Name: "a"}, {Content: []byte("b")}},
+		Headers:     map[string]string{"k": "v"},
+		Parts:       map[string][]byte{"p": []byte("part")},
+		Inline:      map[int32]*Attachment{1: {Name: "inline"}},
+		Reply:       &Mail_ReplyQuote{ReplyQuote: &Attachment{Name: "quote"}},
+		Sent:        timestamppb.Now(),
+		Folder:      proto.String("inbox"),
+	}
+}
+
+func TestUnmarshalOptions(t *testing.T) {
+	data, err := mail().MarshalVT()
+	require.NoError(t, err)
+
+	expected := &Mail{}
+	require.NoError(t, expected.UnmarshalVT(data))
+
+	c := &counter{messages: make(map[protoreflect.FullName]int)}
+	decoded := &Mail{}
+	require.NoError(t, decoded.UnmarshalVTOptions(data, vtalloc.UnmarshalOptions{Allocator: c}))
+	require.True(t, expected.EqualVT(decoded), "unexpected %v", decoded)
+	require.Equal(t, map[protoreflect.FullName]int{
+		"alloc.Attachment":          5,
+		"google.protobuf.Timestamp": 1,
+	}, c.messages)
+	// The bytes and strings of the nested messages and of the maps are allocated too.
+	require.Equal(t, 6, c.bytes)
+	require.Equal(t, 11, c.strings)
+
+	for _, opts := range []vtalloc.UnmarshalOptions{{}, {Allocator: vtalloc.NewSlab(64)}} {
+		decoded := &Mail{}
+		require.NoError(t, decoded.UnmarshalVTOptions(data, opts))
+		require.True(t, expected.EqualVT(decoded), "unexpected %v", decoded)
+	}
+}
+
+func TestUnmarshalOptionsInvalid(t *testing.T) {
+	data, err := mail().MarshalVT()
+	require.NoError(t, err)
+	for i := range data {
+		err := (&Mail{}).UnmarshalVT(data[:i])
+		errOptions := (&Mail{}).UnmarshalVTOptions(data[:i], vtalloc.UnmarshalOptions{})
+		require.Equal(t, err, errOptions)
+	}
+}
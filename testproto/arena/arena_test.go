@@ -0,0 +1,58 @@
+package arena
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/vtarena"
+)
+
+func request(id string) *Request {
+	return &Request{
+		Id:      id,
+		Payload: []byte("payload"),
+		Main:    &Item{Name: "main", Data: []byte("main")},
+		Items:   []*Item{{Name: "a"}, {Data: []byte("b")}},
+		Labels:  map[string]string{"k": "v"},
+	}
+}
+
+func TestUnmarshalVTArena(t *testing.T) {
+	a := vtarena.New(0)
+	data, err := request("first").MarshalVT()
+	require.NoError(t, err)
+
+	m := &Request{}
+	require.NoError(t, m.UnmarshalVTArena(a, data))
+	require.True(t, request("first").EqualVT(m), "unexpected %v", m)
+	main := m.Main
+
+	// Once the arena is released, the next request reuses its memory.
+	a.Release()
+	data, err = request("second").MarshalVT()
+	require.NoError(t, err)
+	m = &Request{}
+	require.NoError(t, m.UnmarshalVTArena(a, data))
+	require.True(t, request("second").EqualVT(m), "unexpected %v", m)
+	require.Same(t, main, m.Main)
+
+	// A nil arena allocates with the Go runtime.
+	m = &Request{}
+	require.NoError(t, m.UnmarshalVTArena(nil, data))
+	require.True(t, request("second").EqualVT(m), "unexpected %v", m)
+}
+
+func BenchmarkUnmarshalVTArena(b *testing.B) {
+	data, err := request("bench").MarshalVT()
+	require.NoError(b, err)
+	a := vtarena.New(0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var m Request
+		if err := m.UnmarshalVTArena(a, data); err != nil {
+			b.Fatal(err)
+		}
+		a.Release()
+	}
+}
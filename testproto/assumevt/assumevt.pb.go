@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: assumevt/assumevt.proto
+
+package assumevt
+
+import (
+	proto3opt "github.com/planetscale/vtprotobuf/testproto/proto3opt"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Wrapper embeds messages from another package that is also generated with
+// vtprotobuf. It is generated with assume-vt for that package, so the VT
+// methods of the embedded messages are called directly.
+type Wrapper struct {
+	state  protoimpl.MessageState                      `protogen:"open.v1"`
+	Single *proto3opt.OptionalFieldInProto3            `protobuf:"bytes,1,opt,name=single,proto3" json:"single,omitempty"`
+	List   []*proto3opt.OptionalFieldInProto3          `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	ByName map[string]*proto3opt.OptionalFieldInProto3 `protobuf:"bytes,3,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Wrapper_Picked
+	Choice        isWrapper_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wrapper) Reset() {
+	*x = Wrapper{}
+	mi := &file_assumevt_assumevt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wrapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wrapper) ProtoMessage() {}
+
+func (x *Wrapper) ProtoReflect() protoreflect.Message {
+	mi := &file_assumevt_assumevt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wrapper.ProtoReflect.Descriptor instead.
+func (*Wrapper) Descriptor() ([]byte, []int) {
+	return file_assumevt_assumevt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Wrapper) GetSingle() *proto3opt.OptionalFieldInProto3 {
+	if x != nil {
+		return x.Single
+	}
+	return nil
+}
+
+func (x *Wrapper) GetList() []*proto3opt.OptionalFieldInProto3 {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *Wrapper) GetByName() map[string]*proto3opt.OptionalFieldInProto3 {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Wrapper) GetChoice() isWrapper_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Wrapper) GetPicked() *proto3opt.OptionalFieldInProto3 {
+	if x != nil {
+		if x, ok := x.Choice.(*Wrapper_Picked); ok {
+			return x.Picked
+		}
+	}
+	return nil
+}
+
+type isWrapper_Choice interface {
+	isWrapper_Choice()
+}
+
+type Wrapper_Picked struct {
+	Picked *proto3opt.OptionalFieldInProto3 `protobuf:"bytes,4,opt,name=picked,proto3,oneof"`
+}
+
+func (*Wrapper_Picked) isWrapper_Choice() {}
+
+var File_assumevt_assumevt_proto protoreflect.FileDescriptor
+
+const file_assumevt_assumevt_proto_rawDesc = "" +
+	"\n" +
+	"\x17assumevt/assumevt.proto\x12\bassumevt\x1a\x13proto3opt/opt.proto\"\xac\x02\n" +
+	"\aWrapper\x12.\n" +
+	"\x06single\x18\x01 \x01(\v2\x16.OptionalFieldInProto3R\x06single\x12*\n" +
+	"\x04list\x18\x02 \x03(\v2\x16.OptionalFieldInProto3R\x04list\x126\n" +
+	"\aby_name\x18\x03 \x03(\v2\x1d.assumevt.Wrapper.ByNameEntryR\x06byName\x120\n" +
+	"\x06picked\x18\x04 \x01(\v2\x16.OptionalFieldInProto3H\x00R\x06picked\x1aQ\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.OptionalFieldInProto3R\x05value:\x028\x01B\b\n" +
+	"\x06choiceB\x14Z\x12testproto/assumevtb\x06proto3"
+
+var (
+	file_assumevt_assumevt_proto_rawDescOnce sync.Once
+	file_assumevt_assumevt_proto_rawDescData []byte
+)
+
+func file_assumevt_assumevt_proto_rawDescGZIP() []byte {
+	file_assumevt_assumevt_proto_rawDescOnce.Do(func() {
+		file_assumevt_assumevt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_assumevt_assumevt_proto_rawDesc), len(file_assumevt_assumevt_proto_rawDesc)))
+	})
+	return file_assumevt_assumevt_proto_rawDescData
+}
+
+var file_assumevt_assumevt_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_assumevt_assumevt_proto_goTypes = []any{
+	(*Wrapper)(nil),                         // 0: assumevt.Wrapper
+	nil,                                     // 1: assumevt.Wrapper.ByNameEntry
+	(*proto3opt.OptionalFieldInProto3)(nil), // 2: OptionalFieldInProto3
+}
+var file_assumevt_assumevt_proto_depIdxs = []int32{
+	2, // 0: assumevt.Wrapper.single:type_name -> OptionalFieldInProto3
+	2, // 1: assumevt.Wrapper.list:type_name -> OptionalFieldInProto3
+	1, // 2: assumevt.Wrapper.by_name:type_name -> assumevt.Wrapper.ByNameEntry
+	2, // 3: assumevt.Wrapper.picked:type_name -> OptionalFieldInProto3
+	2, // 4: assumevt.Wrapper.ByNameEntry.value:type_name -> OptionalFieldInProto3
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_assumevt_assumevt_proto_init() }
+func file_assumevt_assumevt_proto_init() {
+	if File_assumevt_assumevt_proto != nil {
+		return
+	}
+	file_assumevt_assumevt_proto_msgTypes[0].OneofWrappers = []any{
+		(*Wrapper_Picked)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_assumevt_assumevt_proto_rawDesc), len(file_assumevt_assumevt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_assumevt_assumevt_proto_goTypes,
+		DependencyIndexes: file_assumevt_assumevt_proto_depIdxs,
+		MessageInfos:      file_assumevt_assumevt_proto_msgTypes,
+	}.Build()
+	File_assumevt_assumevt_proto = out.File
+	file_assumevt_assumevt_proto_goTypes = nil
+	file_assumevt_assumevt_proto_depIdxs = nil
+}
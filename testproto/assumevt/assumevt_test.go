@@ -0,0 +1,41 @@
+package assumevt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+)
+
+func TestAssumeVTRoundTrip(t *testing.T) {
+	msg := &Wrapper{
+		Single: &proto3opt.OptionalFieldInProto3{OptionalString: proto.String("single")},
+		List: []*proto3opt.OptionalFieldInProto3{
+			{OptionalInt32: proto.Int32(1)},
+			{OptionalBool: proto.Bool(true)},
+		},
+		ByName: map[string]*proto3opt.OptionalFieldInProto3{
+			"a": {OptionalBytes: []byte("a")},
+		},
+		Choice: &Wrapper_Picked{Picked: &proto3opt.OptionalFieldInProto3{OptionalDouble: proto.Float64(1.5)}},
+	}
+
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(msg), msg.SizeVT())
+
+	expected, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(data))
+
+	got := &Wrapper{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, proto.Equal(msg, got))
+	require.True(t, msg.EqualVT(got))
+
+	clone := msg.CloneVT()
+	require.True(t, clone.EqualVT(msg))
+	require.NotSame(t, msg.Single, clone.Single)
+}
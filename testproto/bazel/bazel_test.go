@@ -0,0 +1,24 @@
+package bazelpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOutputMap(t *testing.T) {
+	m := &Target{
+		Label: "//app:server",
+		Deps:  []string{"//lib:a", "//lib:b"},
+		Attrs: map[string]string{"visibility": "public"},
+		Embed: &Target{Label: "//app:lib"},
+	}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+
+	decoded := &Target{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	require.True(t, proto.Equal(m, decoded))
+	require.True(t, m.EqualVT(m.CloneVT()))
+}
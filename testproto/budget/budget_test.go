@@ -0,0 +1,93 @@
+package budget
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+func requireBudget(t *testing.T, err error, message, limit string) {
+	t.Helper()
+	var budget *protohelpers.BudgetError
+	require.True(t, errors.As(err, &budget), "unexpected error: %v", err)
+	require.EqualValues(t, message, budget.Message)
+	require.Equal(t, limit, budget.Limit)
+}
+
+func TestDecodeBudget(t *testing.T) {
+	within := &Request{
+		Name:    "name",
+		Payload: []byte("payload"),
+		Values:  []int64{1, 1 << 40, -1},
+		Fixed:   []uint32{1, 2},
+		Items:   []*Item{{Label: "a", Tags: []string{"x", "y"}}},
+		ByName:  map[string]*Item{"a": {}, "b": {}, "c": {}},
+		Item:    &Item{Label: "item"},
+	}
+	data, err := within.MarshalVT()
+	require.NoError(t, err)
+	decoded := &Request{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, within.EqualVT(decoded))
+
+	for name, tc := range map[string]struct {
+		msg   *Request
+		limit string
+	}{
+		"bytes":        {&Request{Name: strings.Repeat("n", 40), Payload: make([]byte, 25)}, "max_bytes"},
+		"nested bytes": {&Request{Item: &Item{Tags: []string{strings.Repeat("t", 40), strings.Repeat("t", 40)}}}, "max_bytes"},
+		"map keys":     {&Request{ByName: map[string]*Item{strings.Repeat("k", 65): {}}}, "max_bytes"},
+		"packed":       {&Request{Values: make([]int64, 11)}, "max_elements"},
+		"fixed":        {&Request{Values: make([]int64, 5), Fixed: make([]uint32, 6)}, "max_elements"},
+		"messages":     {&Request{Items: make([]*Item, 11)}, "max_elements"},
+		"nested":       {&Request{Items: []*Item{{Tags: make([]string, 10)}}}, "max_elements"},
+		"map entries":  {&Request{ByName: map[string]*Item{"a": {}, "b": {}, "c": {}, "d": {}}}, "max_map_entries"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := range tc.msg.Items {
+				if tc.msg.Items[i] == nil {
+					tc.msg.Items[i] = &Item{}
+				}
+			}
+			data, err := tc.msg.MarshalVT()
+			require.NoError(t, err)
+			requireBudget(t, (&Request{}).UnmarshalVT(data), "budget.Request", tc.limit)
+			requireBudget(t, (&Request{}).UnmarshalVTUnsafe(data), "budget.Request", tc.limit)
+		})
+	}
+}
+
+func TestDecodeBudgetUnknownFields(t *testing.T) {
+	data := protowire.AppendTag(nil, 100, protowire.BytesType)
+	data = protowire.AppendBytes(data, make([]byte, 64))
+	requireBudget(t, (&Request{}).UnmarshalVT(data), "budget.Request", "max_bytes")
+}
+
+func TestDecodeBudgetFileOption(t *testing.T) {
+	// Item has the budget of the file option, which also applies when it is decoded
+	// as a field of Request.
+	item := &Item{Tags: []string{strings.Repeat("t", 1000), strings.Repeat("t", 25)}}
+	data, err := item.MarshalVT()
+	require.NoError(t, err)
+	requireBudget(t, (&Item{}).UnmarshalVT(data), "budget.Item", "max_bytes")
+
+	item.Tags = item.Tags[:1]
+	data, err = item.MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, (&Item{}).UnmarshalVT(data))
+}
+
+func TestDecodeBudgetInvalidData(t *testing.T) {
+	// Invalid data is reported by the decoder.
+	data := protowire.AppendTag(nil, 1, protowire.BytesType)
+	data = protowire.AppendVarint(data, 10)
+	err := (&Request{}).UnmarshalVT(data)
+	require.Error(t, err)
+	var budget *protohelpers.BudgetError
+	require.False(t, errors.As(err, &budget))
+}
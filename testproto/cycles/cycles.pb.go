@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: cycles/cycles.proto
+
+package cycles
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tree struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Children []*Tree                `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	ByName   map[string]*Tree       `protobuf:"bytes,3,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Link:
+	//
+	//	*Tree_Next
+	//	*Tree_Label
+	Link          isTree_Link `protobuf_oneof:"link"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	mi := &file_cycles_cycles_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_cycles_cycles_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_cycles_cycles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tree) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tree) GetChildren() []*Tree {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Tree) GetByName() map[string]*Tree {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Tree) GetLink() isTree_Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *Tree) GetNext() *Tree {
+	if x != nil {
+		if x, ok := x.Link.(*Tree_Next); ok {
+			return x.Next
+		}
+	}
+	return nil
+}
+
+func (x *Tree) GetLabel() string {
+	if x != nil {
+		if x, ok := x.Link.(*Tree_Label); ok {
+			return x.Label
+		}
+	}
+	return ""
+}
+
+type isTree_Link interface {
+	isTree_Link()
+}
+
+type Tree_Next struct {
+	Next *Tree `protobuf:"bytes,4,opt,name=next,proto3,oneof"`
+}
+
+type Tree_Label struct {
+	Label string `protobuf:"bytes,5,opt,name=label,proto3,oneof"`
+}
+
+func (*Tree_Next) isTree_Link() {}
+
+func (*Tree_Label) isTree_Link() {}
+
+type Unchecked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unchecked) Reset() {
+	*x = Unchecked{}
+	mi := &file_cycles_cycles_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unchecked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unchecked) ProtoMessage() {}
+
+func (x *Unchecked) ProtoReflect() protoreflect.Message {
+	mi := &file_cycles_cycles_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unchecked.ProtoReflect.Descriptor instead.
+func (*Unchecked) Descriptor() ([]byte, []int) {
+	return file_cycles_cycles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Unchecked) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+var File_cycles_cycles_proto protoreflect.FileDescriptor
+
+const file_cycles_cycles_proto_rawDesc = "" +
+	"\n" +
+	"\x13cycles/cycles.proto\x12\x06cycles\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"\x84\x02\n" +
+	"\x04Tree\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
+	"\bchildren\x18\x02 \x03(\v2\f.cycles.TreeR\bchildren\x121\n" +
+	"\aby_name\x18\x03 \x03(\v2\x18.cycles.Tree.ByNameEntryR\x06byName\x12\"\n" +
+	"\x04next\x18\x04 \x01(\v2\f.cycles.TreeH\x00R\x04next\x12\x16\n" +
+	"\x05label\x18\x05 \x01(\tH\x00R\x05label\x1aG\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\x05value\x18\x02 \x01(\v2\f.cycles.TreeR\x05value:\x028\x01B\x06\n" +
+	"\x04link\"3\n" +
+	"\tUnchecked\x12 \n" +
+	"\x04tree\x18\x01 \x01(\v2\f.cycles.TreeR\x04tree:\x04\xe8\xa6\x1f\x00B\x18\x82\xaa\x1f\x02P\x01Z\x10testproto/cyclesb\x06proto3"
+
+var (
+	file_cycles_cycles_proto_rawDescOnce sync.Once
+	file_cycles_cycles_proto_rawDescData []byte
+)
+
+func file_cycles_cycles_proto_rawDescGZIP() []byte {
+	file_cycles_cycles_proto_rawDescOnce.Do(func() {
+		file_cycles_cycles_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cycles_cycles_proto_rawDesc), len(file_cycles_cycles_proto_rawDesc)))
+	})
+	return file_cycles_cycles_proto_rawDescData
+}
+
+var file_cycles_cycles_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cycles_cycles_proto_goTypes = []any{
+	(*Tree)(nil),      // 0: cycles.Tree
+	(*Unchecked)(nil), // 1: cycles.Unchecked
+	nil,               // 2: cycles.Tree.ByNameEntry
+}
+var file_cycles_cycles_proto_depIdxs = []int32{
+	0, // 0: cycles.Tree.children:type_name -> cycles.Tree
+	2, // 1: cycles.Tree.by_name:type_name -> cycles.Tree.ByNameEntry
+	0, // 2: cycles.Tree.next:type_name -> cycles.Tree
+	0, // 3: cycles.Unchecked.tree:type_name -> cycles.Tree
+	0, // 4: cycles.Tree.ByNameEntry.value:type_name -> cycles.Tree
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cycles_cycles_proto_init() }
+func file_cycles_cycles_proto_init() {
+	if File_cycles_cycles_proto != nil {
+		return
+	}
+	file_cycles_cycles_proto_msgTypes[0].OneofWrappers = []any{
+		(*Tree_Next)(nil),
+		(*Tree_Label)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cycles_cycles_proto_rawDesc), len(file_cycles_cycles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cycles_cycles_proto_goTypes,
+		DependencyIndexes: file_cycles_cycles_proto_depIdxs,
+		MessageInfos:      file_cycles_cycles_proto_msgTypes,
+	}.Build()
+	File_cycles_cycles_proto = out.File
+	file_cycles_cycles_proto_goTypes = nil
+	file_cycles_cycles_proto_depIdxs = nil
+}
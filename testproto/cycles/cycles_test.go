@@ -0,0 +1,107 @@
+package cycles
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+func requireCycle(t *testing.T, err error, depth int) {
+	t.Helper()
+	var cycle *protohelpers.CycleError
+	require.True(t, errors.As(err, &cycle), "unexpected error: %v", err)
+	require.True(t, cycle.Cycle)
+	require.Equal(t, depth, cycle.Depth)
+	require.EqualValues(t, "cycles.Tree", cycle.Message)
+}
+
+func TestCycleCheck(t *testing.T) {
+	t.Run("self", func(t *testing.T) {
+		tree := &Tree{Name: "self"}
+		tree.Children = []*Tree{{}, tree}
+		_, err := tree.MarshalVT()
+		requireCycle(t, err, 1)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		tree := &Tree{}
+		tree.ByName = map[string]*Tree{"child": {Link: &Tree_Next{Next: tree}}}
+		_, err := tree.MarshalVTStrict()
+		requireCycle(t, err, 2)
+	})
+
+	t.Run("oneof", func(t *testing.T) {
+		tree := &Tree{}
+		tree.Link = &Tree_Next{Next: &Tree{Link: &Tree_Next{Next: tree}}}
+		_, err := tree.MarshalToVT(make([]byte, 16))
+		requireCycle(t, err, 2)
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		// Messages held by many parents are not cycles.
+		shared := &Tree{Name: "shared", Link: &Tree_Label{Label: "label"}}
+		tree := &Tree{Children: []*Tree{shared, shared}, ByName: map[string]*Tree{"shared": shared}, Link: &Tree_Next{Next: shared}}
+		data, err := tree.MarshalVT()
+		require.NoError(t, err)
+		expected, err := proto.Marshal(tree)
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	})
+
+	t.Run("sharedDepth", func(t *testing.T) {
+		// A shared tree is checked again at the depth of each of its parents.
+		shared := &Tree{Name: "leaf"}
+		for i := 0; i < protohelpers.MaxMarshalDepth-2; i++ {
+			shared = &Tree{Children: []*Tree{shared}}
+		}
+		deeper := shared
+		for i := 0; i < 5; i++ {
+			deeper = &Tree{Children: []*Tree{deeper}}
+		}
+		tree := &Tree{Children: []*Tree{shared, deeper}}
+		_, err := tree.MarshalVT()
+		var cycle *protohelpers.CycleError
+		require.True(t, errors.As(err, &cycle), "unexpected error: %v", err)
+		require.False(t, cycle.Cycle)
+		require.Equal(t, protohelpers.MaxMarshalDepth+4, cycle.Depth)
+		require.EqualValues(t, "cycles.Tree", cycle.Message)
+
+		tree = &Tree{Children: []*Tree{shared, {Children: []*Tree{shared}}}}
+		require.NoError(t, protohelpers.CheckCycles(tree))
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		tree := &Tree{}
+		for i := 0; i < protohelpers.MaxMarshalDepth; i++ {
+			tree = &Tree{Children: []*Tree{tree}}
+		}
+		_, err := tree.MarshalVT()
+		require.NoError(t, err)
+
+		tree = &Tree{Link: &Tree_Next{Next: tree}}
+		_, err = tree.MarshalVT()
+		var cycle *protohelpers.CycleError
+		require.True(t, errors.As(err, &cycle), "unexpected error: %v", err)
+		require.False(t, cycle.Cycle)
+		require.Equal(t, protohelpers.MaxMarshalDepth+1, cycle.Depth)
+	})
+}
+
+func TestCycleCheckOptOut(t *testing.T) {
+	// Unchecked opts out of the file option: it marshals trees deeper than
+	// MaxMarshalDepth, which Tree refuses to marshal.
+	tree := &Tree{}
+	for i := 0; i <= protohelpers.MaxMarshalDepth; i++ {
+		tree = &Tree{Children: []*Tree{tree}}
+	}
+	_, err := tree.MarshalVT()
+	require.Error(t, err)
+
+	data, err := (&Unchecked{Tree: tree}).MarshalVT()
+	require.NoError(t, err)
+	require.Len(t, data, proto.Size(&Unchecked{Tree: tree}))
+}
@@ -0,0 +1,52 @@
+package dedup
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sameString(a, b string) bool {
+	return unsafe.StringData(a) == unsafe.StringData(b)
+}
+
+func TestDedup(t *testing.T) {
+	original := &Labels{
+		Adjacent: []string{"foo", "foo", "bar", "foo"},
+		All:      []string{"foo", "bar", "foo", "bar"},
+		Plain:    []string{"foo", "foo"},
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Labels{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	require.True(t, sameString(msg.Adjacent[0], msg.Adjacent[1]))
+	require.False(t, sameString(msg.Adjacent[0], msg.Adjacent[3]))
+
+	require.True(t, sameString(msg.All[0], msg.All[2]))
+	require.True(t, sameString(msg.All[1], msg.All[3]))
+	require.False(t, sameString(msg.All[0], msg.All[1]))
+
+	require.False(t, sameString(msg.Plain[0], msg.Plain[1]))
+}
+
+func TestDedupAllocations(t *testing.T) {
+	original := &Labels{}
+	for i := 0; i < 100; i++ {
+		original.All = append(original.All, "label")
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		msg := &Labels{All: make([]string, 0, 100)}
+		if err := msg.UnmarshalVT(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Less(t, allocs, float64(10))
+}
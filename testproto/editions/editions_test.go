@@ -268,3 +268,13 @@ func TestEditionsCompatibilityWithStandardProto(t *testing.T) {
 	require.Equal(t, original.Units, vtDecoded.Units)
 	require.Equal(t, original.Scale, vtDecoded.Scale)
 }
+
+// TestStringFieldUTF8Validation tests that string fields of editions files are validated
+// like proto3 strings, as required by the default features.utf8_validation = VERIFY
+func TestStringFieldUTF8Validation(t *testing.T) {
+	data, err := (&RegularMessage{Name: proto.String("\xff")}).MarshalVT()
+	require.NoError(t, err)
+
+	require.Error(t, (&RegularMessage{}).UnmarshalVT(data))
+	require.Error(t, proto.Unmarshal(data, &RegularMessage{}))
+}
@@ -11,7 +11,10 @@ import (
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
 	math "math"
+	slices "slices"
+	strings "strings"
 	unsafe "unsafe"
 )
 
@@ -51,6 +54,42 @@ func (m *NestedMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// NestedMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func NestedMessageCloneSliceVT(in []*NestedMessage) []*NestedMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*NestedMessage, len(in))
+	clones := make([]NestedMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Id; rhs != nil {
+			tmpVal := *rhs
+			r.Id = &tmpVal
+		}
+		if rhs := m.Name; rhs != nil {
+			tmpVal := *rhs
+			r.Name = &tmpVal
+		}
+		if rhs := m.Data; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.Data = tmpBytes
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *MessageWithLazyField) CloneVT() *MessageWithLazyField {
 	if m == nil {
 		return (*MessageWithLazyField)(nil)
@@ -76,6 +115,38 @@ func (m *MessageWithLazyField) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// MessageWithLazyFieldCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func MessageWithLazyFieldCloneSliceVT(in []*MessageWithLazyField) []*MessageWithLazyField {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MessageWithLazyField, len(in))
+	clones := make([]MessageWithLazyField, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Nested = m.Nested.CloneVT()
+		if rhs := m.Id; rhs != nil {
+			tmpVal := *rhs
+			r.Id = &tmpVal
+		}
+		if rhs := m.Name; rhs != nil {
+			tmpVal := *rhs
+			r.Name = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *RegularMessage) CloneVT() *RegularMessage {
 	if m == nil {
 		return (*RegularMessage)(nil)
@@ -96,11 +167,7 @@ func (m *RegularMessage) CloneVT() *RegularMessage {
 		r.Values = tmpContainer
 	}
 	if rhs := m.Metadata; rhs != nil {
-		tmpContainer := make(map[string]int32, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.Metadata = tmpContainer
+		r.Metadata = maps.Clone(rhs)
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -113,6 +180,46 @@ func (m *RegularMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// RegularMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func RegularMessageCloneSliceVT(in []*RegularMessage) []*RegularMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*RegularMessage, len(in))
+	clones := make([]RegularMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Nested = m.Nested.CloneVT()
+		if rhs := m.Id; rhs != nil {
+			tmpVal := *rhs
+			r.Id = &tmpVal
+		}
+		if rhs := m.Name; rhs != nil {
+			tmpVal := *rhs
+			r.Name = &tmpVal
+		}
+		if rhs := m.Values; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.Values = tmpContainer
+		}
+		if rhs := m.Metadata; rhs != nil {
+			r.Metadata = maps.Clone(rhs)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *ScalarTypes) CloneVT() *ScalarTypes {
 	if m == nil {
 		return (*ScalarTypes)(nil)
@@ -190,6 +297,90 @@ func (m *ScalarTypes) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ScalarTypesCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ScalarTypesCloneSliceVT(in []*ScalarTypes) []*ScalarTypes {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ScalarTypes, len(in))
+	clones := make([]ScalarTypes, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.DoubleField; rhs != nil {
+			tmpVal := *rhs
+			r.DoubleField = &tmpVal
+		}
+		if rhs := m.FloatField; rhs != nil {
+			tmpVal := *rhs
+			r.FloatField = &tmpVal
+		}
+		if rhs := m.Int32Field; rhs != nil {
+			tmpVal := *rhs
+			r.Int32Field = &tmpVal
+		}
+		if rhs := m.Int64Field; rhs != nil {
+			tmpVal := *rhs
+			r.Int64Field = &tmpVal
+		}
+		if rhs := m.Uint32Field; rhs != nil {
+			tmpVal := *rhs
+			r.Uint32Field = &tmpVal
+		}
+		if rhs := m.Uint64Field; rhs != nil {
+			tmpVal := *rhs
+			r.Uint64Field = &tmpVal
+		}
+		if rhs := m.Sint32Field; rhs != nil {
+			tmpVal := *rhs
+			r.Sint32Field = &tmpVal
+		}
+		if rhs := m.Sint64Field; rhs != nil {
+			tmpVal := *rhs
+			r.Sint64Field = &tmpVal
+		}
+		if rhs := m.Fixed32Field; rhs != nil {
+			tmpVal := *rhs
+			r.Fixed32Field = &tmpVal
+		}
+		if rhs := m.Fixed64Field; rhs != nil {
+			tmpVal := *rhs
+			r.Fixed64Field = &tmpVal
+		}
+		if rhs := m.Sfixed32Field; rhs != nil {
+			tmpVal := *rhs
+			r.Sfixed32Field = &tmpVal
+		}
+		if rhs := m.Sfixed64Field; rhs != nil {
+			tmpVal := *rhs
+			r.Sfixed64Field = &tmpVal
+		}
+		if rhs := m.BoolField; rhs != nil {
+			tmpVal := *rhs
+			r.BoolField = &tmpVal
+		}
+		if rhs := m.StringField; rhs != nil {
+			tmpVal := *rhs
+			r.StringField = &tmpVal
+		}
+		if rhs := m.BytesField; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.BytesField = tmpBytes
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *MessageWithEnum) CloneVT() *MessageWithEnum {
 	if m == nil {
 		return (*MessageWithEnum)(nil)
@@ -214,6 +405,37 @@ func (m *MessageWithEnum) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// MessageWithEnumCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func MessageWithEnumCloneSliceVT(in []*MessageWithEnum) []*MessageWithEnum {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MessageWithEnum, len(in))
+	clones := make([]MessageWithEnum, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Id; rhs != nil {
+			tmpVal := *rhs
+			r.Id = &tmpVal
+		}
+		if rhs := m.Status; rhs != nil {
+			tmpVal := *rhs
+			r.Status = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *MessageWithOneof) CloneVT() *MessageWithOneof {
 	if m == nil {
 		return (*MessageWithOneof)(nil)
@@ -239,6 +461,38 @@ func (m *MessageWithOneof) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// MessageWithOneofCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func MessageWithOneofCloneSliceVT(in []*MessageWithOneof) []*MessageWithOneof {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MessageWithOneof, len(in))
+	clones := make([]MessageWithOneof, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Id; rhs != nil {
+			tmpVal := *rhs
+			r.Id = &tmpVal
+		}
+		if m.Choice != nil {
+			r.Choice = m.Choice.(interface {
+				CloneVT() isMessageWithOneof_Choice
+			}).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *MessageWithOneof_StringChoice) CloneVT() isMessageWithOneof_Choice {
 	if m == nil {
 		return (*MessageWithOneof_StringChoice)(nil)
@@ -288,6 +542,35 @@ func (m *ImplicitFieldPresence) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ImplicitFieldPresenceCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ImplicitFieldPresenceCloneSliceVT(in []*ImplicitFieldPresence) []*ImplicitFieldPresence {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ImplicitFieldPresence, len(in))
+	clones := make([]ImplicitFieldPresence, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.CurrencyCode = m.CurrencyCode
+		r.Units = m.Units
+		r.Scale = m.Scale
+		r.IsActive = m.IsActive
+		r.Rate = m.Rate
+		r.Amount = m.Amount
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *ExplicitFieldPresence) CloneVT() *ExplicitFieldPresence {
 	if m == nil {
 		return (*ExplicitFieldPresence)(nil)
@@ -328,6 +611,53 @@ func (m *ExplicitFieldPresence) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// ExplicitFieldPresenceCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func ExplicitFieldPresenceCloneSliceVT(in []*ExplicitFieldPresence) []*ExplicitFieldPresence {
+	if in == nil {
+		return nil
+	}
+	out := make([]*ExplicitFieldPresence, len(in))
+	clones := make([]ExplicitFieldPresence, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.CurrencyCode; rhs != nil {
+			tmpVal := *rhs
+			r.CurrencyCode = &tmpVal
+		}
+		if rhs := m.Units; rhs != nil {
+			tmpVal := *rhs
+			r.Units = &tmpVal
+		}
+		if rhs := m.Scale; rhs != nil {
+			tmpVal := *rhs
+			r.Scale = &tmpVal
+		}
+		if rhs := m.IsActive; rhs != nil {
+			tmpVal := *rhs
+			r.IsActive = &tmpVal
+		}
+		if rhs := m.Rate; rhs != nil {
+			tmpVal := *rhs
+			r.Rate = &tmpVal
+		}
+		if rhs := m.Amount; rhs != nil {
+			tmpVal := *rhs
+			r.Amount = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *NestedMessage) EqualVT(that *NestedMessage) bool {
 	if this == that {
 		return true
@@ -390,15 +720,9 @@ func (this *RegularMessage) EqualVT(that *RegularMessage) bool {
 	if p, q := this.Name, that.Name; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.Values) != len(that.Values) {
+	if !slices.Equal(this.Values, that.Values) {
 		return false
 	}
-	for i, vx := range this.Values {
-		vy := that.Values[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if !this.Nested.EqualVT(that.Nested) {
 		return false
 	}
@@ -1157,13 +1481,13 @@ func (m *ImplicitFieldPresence) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Amount != 0 {
+	if math.Float64bits(float64(m.Amount)) != 0 {
 		i -= 8
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Amount))))
 		i--
 		dAtA[i] = 0x31
 	}
-	if m.Rate != 0 {
+	if math.Float32bits(float32(m.Rate)) != 0 {
 		i -= 4
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rate))))
 		i--
@@ -1775,13 +2099,13 @@ func (m *ImplicitFieldPresence) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Amount != 0 {
+	if math.Float64bits(float64(m.Amount)) != 0 {
 		i -= 8
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Amount))))
 		i--
 		dAtA[i] = 0x31
 	}
-	if m.Rate != 0 {
+	if math.Float32bits(float32(m.Rate)) != 0 {
 		i -= 4
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rate))))
 		i--
@@ -1889,6 +2213,30 @@ func (m *ExplicitFieldPresence) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *NestedMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *MessageWithLazyField) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *RegularMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ScalarTypes) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *MessageWithEnum) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *MessageWithOneof) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ImplicitFieldPresence) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *ExplicitFieldPresence) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *NestedMessage) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -2108,10 +2456,10 @@ func (m *ImplicitFieldPresence) SizeVT() (n int) {
 	if m.IsActive {
 		n += 2
 	}
-	if m.Rate != 0 {
+	if math.Float32bits(float32(m.Rate)) != 0 {
 		n += 5
 	}
-	if m.Amount != 0 {
+	if math.Float64bits(float64(m.Amount)) != 0 {
 		n += 9
 	}
 	n += len(m.unknownFields)
@@ -2226,6 +2574,9 @@ func (m *NestedMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			s := string(dAtA[iNdEx:postIndex])
 			m.Name = &s
 			iNdEx = postIndex
@@ -2364,6 +2715,9 @@ func (m *MessageWithLazyField) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			s := string(dAtA[iNdEx:postIndex])
 			m.Name = &s
 			iNdEx = postIndex
@@ -2504,6 +2858,9 @@ func (m *RegularMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			s := string(dAtA[iNdEx:postIndex])
 			m.Name = &s
 			iNdEx = postIndex
@@ -2562,24 +2919,24 @@ func (m *RegularMessage) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.Values) == 0 {
 					m.Values = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.Values = append(m.Values, v)
+					m.Values = append(m.Values, int64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
@@ -2698,7 +3055,14 @@ func (m *RegularMessage) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -2730,7 +3094,9 @@ func (m *RegularMessage) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			if old, ok := m.Metadata[mapkey]; !ok || old != mapvalue {
+				m.Metadata[strings.Clone(mapkey)] = mapvalue
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3025,6 +3391,9 @@ func (m *ScalarTypes) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			s := string(dAtA[iNdEx:postIndex])
 			m.StringField = &s
 			iNdEx = postIndex
@@ -3254,6 +3623,9 @@ func (m *MessageWithOneof) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			m.Choice = &MessageWithOneof_StringChoice{StringChoice: string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 3:
@@ -3398,6 +3770,9 @@ func (m *ImplicitFieldPresence) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			m.CurrencyCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
@@ -3561,6 +3936,9 @@ func (m *ExplicitFieldPresence) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			s := string(dAtA[iNdEx:postIndex])
 			m.CurrencyCode = &s
 			iNdEx = postIndex
@@ -3750,6 +4128,9 @@ func (m *NestedMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -3889,6 +4270,9 @@ func (m *MessageWithLazyField) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -4033,6 +4417,9 @@ func (m *RegularMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -4095,24 +4482,24 @@ func (m *RegularMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.Values) == 0 {
 					m.Values = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.Values = append(m.Values, v)
+					m.Values = append(m.Values, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
@@ -4231,6 +4618,9 @@ func (m *RegularMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
 					if intStringLenmapkey == 0 {
 						mapkey = ""
 					} else {
@@ -4562,6 +4952,9 @@ func (m *ScalarTypes) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -4792,6 +5185,9 @@ func (m *MessageWithOneof) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -4940,6 +5336,9 @@ func (m *ImplicitFieldPresence) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -5107,6 +5506,9 @@ func (m *ExplicitFieldPresence) UnmarshalVTUnsafe(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			var stringValue string
 			if intStringLen > 0 {
 				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
@@ -0,0 +1,599 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.32.1
+// source: editions/gofeatures.proto
+
+package editions
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enum whose JSON decoding keeps the legacy behaviour of protoc-gen-go
+type LegacyJSONEnum int32
+
+const (
+	LegacyJSONEnum_LEGACY_JSON_ENUM_UNSPECIFIED LegacyJSONEnum = 0
+	LegacyJSONEnum_LEGACY_JSON_ENUM_ONE         LegacyJSONEnum = 1
+)
+
+// Enum value maps for LegacyJSONEnum.
+var (
+	LegacyJSONEnum_name = map[int32]string{
+		0: "LEGACY_JSON_ENUM_UNSPECIFIED",
+		1: "LEGACY_JSON_ENUM_ONE",
+	}
+	LegacyJSONEnum_value = map[string]int32{
+		"LEGACY_JSON_ENUM_UNSPECIFIED": 0,
+		"LEGACY_JSON_ENUM_ONE":         1,
+	}
+)
+
+func (x LegacyJSONEnum) Enum() *LegacyJSONEnum {
+	p := new(LegacyJSONEnum)
+	*p = x
+	return p
+}
+
+func (x LegacyJSONEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LegacyJSONEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_gofeatures_proto_enumTypes[0].Descriptor()
+}
+
+func (LegacyJSONEnum) Type() protoreflect.EnumType {
+	return &file_editions_gofeatures_proto_enumTypes[0]
+}
+
+func (x LegacyJSONEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *LegacyJSONEnum) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = LegacyJSONEnum(num)
+	return nil
+}
+
+// Deprecated: Use LegacyJSONEnum.Descriptor instead.
+func (LegacyJSONEnum) EnumDescriptor() ([]byte, []int) {
+	return file_editions_gofeatures_proto_rawDescGZIP(), []int{0}
+}
+
+// Nested enums are named after their parent message in Go
+type HybridMessage_Kind int32
+
+const (
+	HybridMessage_KIND_UNSPECIFIED HybridMessage_Kind = 0
+	HybridMessage_KIND_FOO         HybridMessage_Kind = 1
+	HybridMessage_KIND_BAR         HybridMessage_Kind = 2
+)
+
+// Enum value maps for HybridMessage_Kind.
+var (
+	HybridMessage_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_FOO",
+		2: "KIND_BAR",
+	}
+	HybridMessage_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_FOO":         1,
+		"KIND_BAR":         2,
+	}
+)
+
+func (x HybridMessage_Kind) Enum() *HybridMessage_Kind {
+	p := new(HybridMessage_Kind)
+	*p = x
+	return p
+}
+
+func (x HybridMessage_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HybridMessage_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_gofeatures_proto_enumTypes[1].Descriptor()
+}
+
+func (HybridMessage_Kind) Type() protoreflect.EnumType {
+	return &file_editions_gofeatures_proto_enumTypes[1]
+}
+
+func (x HybridMessage_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HybridMessage_Kind.Descriptor instead.
+func (HybridMessage_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_editions_gofeatures_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Messages using the hybrid API have both exported fields and opaque-style
+// accessors, so vtprotobuf generates its methods for them
+type HybridMessage struct {
+	state       protoimpl.MessageState        `protogen:"hybrid.v1"`
+	Kind        *HybridMessage_Kind           `protobuf:"varint,1,opt,name=kind,enum=HybridMessage_Kind" json:"kind,omitempty"`
+	Kinds       []HybridMessage_Kind          `protobuf:"varint,2,rep,packed,name=kinds,enum=HybridMessage_Kind" json:"kinds,omitempty"`
+	KindsByName map[string]HybridMessage_Kind `protobuf:"bytes,3,rep,name=kinds_by_name,json=kindsByName" json:"kinds_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=HybridMessage_Kind"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*HybridMessage_Picked
+	//	*HybridMessage_Status
+	Choice        isHybridMessage_Choice `protobuf_oneof:"choice"`
+	Name          *string                `protobuf:"bytes,6,opt,name=name" json:"name,omitempty"`
+	Nested        *NestedMessage         `protobuf:"bytes,7,opt,name=nested" json:"nested,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,8,opt,name=payload" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HybridMessage) Reset() {
+	*x = HybridMessage{}
+	mi := &file_editions_gofeatures_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HybridMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridMessage) ProtoMessage() {}
+
+func (x *HybridMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_gofeatures_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HybridMessage) GetKind() HybridMessage_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return HybridMessage_KIND_UNSPECIFIED
+}
+
+func (x *HybridMessage) GetKinds() []HybridMessage_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *HybridMessage) GetKindsByName() map[string]HybridMessage_Kind {
+	if x != nil {
+		return x.KindsByName
+	}
+	return nil
+}
+
+func (x *HybridMessage) GetChoice() isHybridMessage_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *HybridMessage) GetPicked() HybridMessage_Kind {
+	if x != nil {
+		if x, ok := x.Choice.(*HybridMessage_Picked); ok {
+			return x.Picked
+		}
+	}
+	return HybridMessage_KIND_UNSPECIFIED
+}
+
+func (x *HybridMessage) GetStatus() Status {
+	if x != nil {
+		if x, ok := x.Choice.(*HybridMessage_Status); ok {
+			return x.Status
+		}
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *HybridMessage) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *HybridMessage) GetNested() *NestedMessage {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *HybridMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HybridMessage) SetKind(v HybridMessage_Kind) {
+	x.Kind = &v
+}
+
+func (x *HybridMessage) SetKinds(v []HybridMessage_Kind) {
+	x.Kinds = v
+}
+
+func (x *HybridMessage) SetKindsByName(v map[string]HybridMessage_Kind) {
+	x.KindsByName = v
+}
+
+func (x *HybridMessage) SetPicked(v HybridMessage_Kind) {
+	x.Choice = &HybridMessage_Picked{v}
+}
+
+func (x *HybridMessage) SetStatus(v Status) {
+	x.Choice = &HybridMessage_Status{v}
+}
+
+func (x *HybridMessage) SetName(v string) {
+	x.Name = &v
+}
+
+func (x *HybridMessage) SetNested(v *NestedMessage) {
+	x.Nested = v
+}
+
+func (x *HybridMessage) SetPayload(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.Payload = v
+}
+
+func (x *HybridMessage) HasKind() bool {
+	if x == nil {
+		return false
+	}
+	return x.Kind != nil
+}
+
+func (x *HybridMessage) HasChoice() bool {
+	if x == nil {
+		return false
+	}
+	return x.Choice != nil
+}
+
+func (x *HybridMessage) HasPicked() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Choice.(*HybridMessage_Picked)
+	return ok
+}
+
+func (x *HybridMessage) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Choice.(*HybridMessage_Status)
+	return ok
+}
+
+func (x *HybridMessage) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return x.Name != nil
+}
+
+func (x *HybridMessage) HasNested() bool {
+	if x == nil {
+		return false
+	}
+	return x.Nested != nil
+}
+
+func (x *HybridMessage) HasPayload() bool {
+	if x == nil {
+		return false
+	}
+	return x.Payload != nil
+}
+
+func (x *HybridMessage) ClearKind() {
+	x.Kind = nil
+}
+
+func (x *HybridMessage) ClearChoice() {
+	x.Choice = nil
+}
+
+func (x *HybridMessage) ClearPicked() {
+	if _, ok := x.Choice.(*HybridMessage_Picked); ok {
+		x.Choice = nil
+	}
+}
+
+func (x *HybridMessage) ClearStatus() {
+	if _, ok := x.Choice.(*HybridMessage_Status); ok {
+		x.Choice = nil
+	}
+}
+
+func (x *HybridMessage) ClearName() {
+	x.Name = nil
+}
+
+func (x *HybridMessage) ClearNested() {
+	x.Nested = nil
+}
+
+func (x *HybridMessage) ClearPayload() {
+	x.Payload = nil
+}
+
+const HybridMessage_Choice_not_set_case case_HybridMessage_Choice = 0
+const HybridMessage_Picked_case case_HybridMessage_Choice = 4
+const HybridMessage_Status_case case_HybridMessage_Choice = 5
+
+func (x *HybridMessage) WhichChoice() case_HybridMessage_Choice {
+	if x == nil {
+		return HybridMessage_Choice_not_set_case
+	}
+	switch x.Choice.(type) {
+	case *HybridMessage_Picked:
+		return HybridMessage_Picked_case
+	case *HybridMessage_Status:
+		return HybridMessage_Status_case
+	default:
+		return HybridMessage_Choice_not_set_case
+	}
+}
+
+type HybridMessage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Kind        *HybridMessage_Kind
+	Kinds       []HybridMessage_Kind
+	KindsByName map[string]HybridMessage_Kind
+	// Fields of oneof Choice:
+	Picked *HybridMessage_Kind
+	Status *Status
+	// -- end of Choice
+	Name    *string
+	Nested  *NestedMessage
+	Payload []byte
+}
+
+func (b0 HybridMessage_builder) Build() *HybridMessage {
+	m0 := &HybridMessage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Kind = b.Kind
+	x.Kinds = b.Kinds
+	x.KindsByName = b.KindsByName
+	if b.Picked != nil {
+		x.Choice = &HybridMessage_Picked{*b.Picked}
+	}
+	if b.Status != nil {
+		x.Choice = &HybridMessage_Status{*b.Status}
+	}
+	x.Name = b.Name
+	x.Nested = b.Nested
+	x.Payload = b.Payload
+	return m0
+}
+
+type case_HybridMessage_Choice protoreflect.FieldNumber
+
+func (x case_HybridMessage_Choice) String() string {
+	md := file_editions_gofeatures_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isHybridMessage_Choice interface {
+	isHybridMessage_Choice()
+}
+
+type HybridMessage_Picked struct {
+	Picked HybridMessage_Kind `protobuf:"varint,4,opt,name=picked,enum=HybridMessage_Kind,oneof"`
+}
+
+type HybridMessage_Status struct {
+	Status Status `protobuf:"varint,5,opt,name=status,enum=Status,oneof"`
+}
+
+func (*HybridMessage_Picked) isHybridMessage_Choice() {}
+
+func (*HybridMessage_Status) isHybridMessage_Choice() {}
+
+type MessageWithLegacyJSONEnum struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *LegacyJSONEnum        `protobuf:"varint,1,opt,name=value,enum=LegacyJSONEnum" json:"value,omitempty"`
+	Values        []LegacyJSONEnum       `protobuf:"varint,2,rep,packed,name=values,enum=LegacyJSONEnum" json:"values,omitempty"`
+	Kind          *HybridMessage_Kind    `protobuf:"varint,3,opt,name=kind,enum=HybridMessage_Kind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageWithLegacyJSONEnum) Reset() {
+	*x = MessageWithLegacyJSONEnum{}
+	mi := &file_editions_gofeatures_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageWithLegacyJSONEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageWithLegacyJSONEnum) ProtoMessage() {}
+
+func (x *MessageWithLegacyJSONEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_gofeatures_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageWithLegacyJSONEnum.ProtoReflect.Descriptor instead.
+func (*MessageWithLegacyJSONEnum) Descriptor() ([]byte, []int) {
+	return file_editions_gofeatures_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageWithLegacyJSONEnum) GetValue() LegacyJSONEnum {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return LegacyJSONEnum_LEGACY_JSON_ENUM_UNSPECIFIED
+}
+
+func (x *MessageWithLegacyJSONEnum) GetValues() []LegacyJSONEnum {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *MessageWithLegacyJSONEnum) GetKind() HybridMessage_Kind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return HybridMessage_KIND_UNSPECIFIED
+}
+
+var File_editions_gofeatures_proto protoreflect.FileDescriptor
+
+const file_editions_gofeatures_proto_rawDesc = "" +
+	"\n" +
+	"\x19editions/gofeatures.proto\x1a!google/protobuf/go_features.proto\x1a\x17editions/editions.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"\x92\x04\n" +
+	"\rHybridMessage\x12/\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x13.HybridMessage.KindB\x06\xb2\xa9\x1f\x02\x18\x01R\x04kind\x121\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x13.HybridMessage.KindB\x06\xb2\xa9\x1f\x02\x18\x01R\x05kinds\x12K\n" +
+	"\rkinds_by_name\x18\x03 \x03(\v2\x1f.HybridMessage.KindsByNameEntryB\x06\xb2\xa9\x1f\x02\x18\x01R\vkindsByName\x125\n" +
+	"\x06picked\x18\x04 \x01(\x0e2\x13.HybridMessage.KindB\x06\xb2\xa9\x1f\x02\x18\x01H\x00R\x06picked\x12!\n" +
+	"\x06status\x18\x05 \x01(\x0e2\a.StatusH\x00R\x06status\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12&\n" +
+	"\x06nested\x18\a \x01(\v2\x0e.NestedMessageR\x06nested\x12\x18\n" +
+	"\apayload\x18\b \x01(\fR\apayload\x1aS\n" +
+	"\x10KindsByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x13.HybridMessage.KindR\x05value:\x028\x01\"8\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bKIND_FOO\x10\x01\x12\f\n" +
+	"\bKIND_BAR\x10\x02:\ab\x05\xd2>\x02\x10\x02B\b\n" +
+	"\x06choice\"\x94\x01\n" +
+	"\x19MessageWithLegacyJSONEnum\x12%\n" +
+	"\x05value\x18\x01 \x01(\x0e2\x0f.LegacyJSONEnumR\x05value\x12'\n" +
+	"\x06values\x18\x02 \x03(\x0e2\x0f.LegacyJSONEnumR\x06values\x12'\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x13.HybridMessage.KindR\x04kind*U\n" +
+	"\x0eLegacyJSONEnum\x12 \n" +
+	"\x1cLEGACY_JSON_ENUM_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14LEGACY_JSON_ENUM_ONE\x10\x01\x1a\a:\x05\xd2>\x02\b\x01B\x14Z\x12testproto/editionsb\beditionsp\xe8\a"
+
+var (
+	file_editions_gofeatures_proto_rawDescOnce sync.Once
+	file_editions_gofeatures_proto_rawDescData []byte
+)
+
+func file_editions_gofeatures_proto_rawDescGZIP() []byte {
+	file_editions_gofeatures_proto_rawDescOnce.Do(func() {
+		file_editions_gofeatures_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_editions_gofeatures_proto_rawDesc), len(file_editions_gofeatures_proto_rawDesc)))
+	})
+	return file_editions_gofeatures_proto_rawDescData
+}
+
+var file_editions_gofeatures_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_editions_gofeatures_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_editions_gofeatures_proto_goTypes = []any{
+	(LegacyJSONEnum)(0),               // 0: LegacyJSONEnum
+	(HybridMessage_Kind)(0),           // 1: HybridMessage.Kind
+	(*HybridMessage)(nil),             // 2: HybridMessage
+	(*MessageWithLegacyJSONEnum)(nil), // 3: MessageWithLegacyJSONEnum
+	nil,                               // 4: HybridMessage.KindsByNameEntry
+	(Status)(0),                       // 5: Status
+	(*NestedMessage)(nil),             // 6: NestedMessage
+}
+var file_editions_gofeatures_proto_depIdxs = []int32{
+	1,  // 0: HybridMessage.kind:type_name -> HybridMessage.Kind
+	1,  // 1: HybridMessage.kinds:type_name -> HybridMessage.Kind
+	4,  // 2: HybridMessage.kinds_by_name:type_name -> HybridMessage.KindsByNameEntry
+	1,  // 3: HybridMessage.picked:type_name -> HybridMessage.Kind
+	5,  // 4: HybridMessage.status:type_name -> Status
+	6,  // 5: HybridMessage.nested:type_name -> NestedMessage
+	0,  // 6: MessageWithLegacyJSONEnum.value:type_name -> LegacyJSONEnum
+	0,  // 7: MessageWithLegacyJSONEnum.values:type_name -> LegacyJSONEnum
+	1,  // 8: MessageWithLegacyJSONEnum.kind:type_name -> HybridMessage.Kind
+	1,  // 9: HybridMessage.KindsByNameEntry.value:type_name -> HybridMessage.Kind
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_editions_gofeatures_proto_init() }
+func file_editions_gofeatures_proto_init() {
+	if File_editions_gofeatures_proto != nil {
+		return
+	}
+	file_editions_editions_proto_init()
+	file_editions_gofeatures_proto_msgTypes[0].OneofWrappers = []any{
+		(*HybridMessage_Picked)(nil),
+		(*HybridMessage_Status)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_editions_gofeatures_proto_rawDesc), len(file_editions_gofeatures_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_editions_gofeatures_proto_goTypes,
+		DependencyIndexes: file_editions_gofeatures_proto_depIdxs,
+		EnumInfos:         file_editions_gofeatures_proto_enumTypes,
+		MessageInfos:      file_editions_gofeatures_proto_msgTypes,
+	}.Build()
+	File_editions_gofeatures_proto = out.File
+	file_editions_gofeatures_proto_goTypes = nil
+	file_editions_gofeatures_proto_depIdxs = nil
+}
@@ -0,0 +1,94 @@
+package editions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func newHybridMessage() *HybridMessage {
+	m := &HybridMessage{}
+	m.SetKind(HybridMessage_KIND_FOO)
+	m.SetKinds([]HybridMessage_Kind{HybridMessage_KIND_FOO, HybridMessage_KIND_BAR})
+	m.SetKindsByName(map[string]HybridMessage_Kind{"bar": HybridMessage_KIND_BAR})
+	m.SetPicked(HybridMessage_KIND_BAR)
+	m.SetName("hybrid")
+	m.SetNested(&NestedMessage{Id: proto.Int32(1), Name: proto.String("nested")})
+	return m
+}
+
+// TestHybridMessageRoundTrip tests that the VT methods of messages using the hybrid API
+// agree with the accessors generated by protoc-gen-go
+func TestHybridMessageRoundTrip(t *testing.T) {
+	original := newHybridMessage()
+
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(original), original.SizeVT())
+
+	decoded := &HybridMessage{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, proto.Equal(original, decoded))
+	require.True(t, decoded.HasPicked())
+	require.Equal(t, HybridMessage_KIND_BAR, decoded.GetPicked())
+
+	require.True(t, original.EqualVT(original.CloneVT()))
+
+	decoded.SetStatus(Status_STATUS_ACTIVE)
+	require.False(t, decoded.HasPicked())
+	data, err = decoded.MarshalVT()
+	require.NoError(t, err)
+
+	fromProto := &HybridMessage{}
+	require.NoError(t, proto.Unmarshal(data, fromProto))
+	require.Equal(t, Status_STATUS_ACTIVE, fromProto.GetStatus())
+}
+
+// TestHybridMessageUnsafeBytes tests that the getters of bytes fields return the slice
+// held by the message, which UnmarshalVTUnsafe leaves pointing into the data
+func TestHybridMessageUnsafeBytes(t *testing.T) {
+	original := newHybridMessage()
+	original.SetPayload([]byte("large payload"))
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	decoded := &HybridMessage{}
+	require.NoError(t, decoded.UnmarshalVTUnsafe(data))
+	payload := decoded.GetPayload()
+	require.Equal(t, []byte("large payload"), payload)
+	offset := bytes.Index(data, payload)
+	require.Same(t, &data[offset], &payload[0])
+
+	copied := &HybridMessage{}
+	require.NoError(t, copied.UnmarshalVT(data))
+	require.NotSame(t, &data[offset], &copied.GetPayload()[0])
+}
+
+// TestHybridMessageStrictEnum tests that strict_enum checks nested enums by the Go name
+// protoc-gen-go gives them
+func TestHybridMessageStrictEnum(t *testing.T) {
+	data := protowire.AppendTag(nil, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 42)
+
+	err := (&HybridMessage{}).UnmarshalVT(data)
+	require.ErrorContains(t, err, "invalid value 42 for enum field HybridMessage.kind")
+}
+
+func TestLegacyJSONEnumRoundTrip(t *testing.T) {
+	original := &MessageWithLegacyJSONEnum{
+		Value:  LegacyJSONEnum_LEGACY_JSON_ENUM_ONE.Enum(),
+		Values: []LegacyJSONEnum{LegacyJSONEnum_LEGACY_JSON_ENUM_ONE, LegacyJSONEnum_LEGACY_JSON_ENUM_UNSPECIFIED},
+		Kind:   HybridMessage_KIND_FOO.Enum(),
+	}
+
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	decoded := &MessageWithLegacyJSONEnum{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, proto.Equal(original, decoded))
+	require.True(t, original.EqualVT(decoded))
+}
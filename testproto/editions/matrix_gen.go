@@ -0,0 +1,128 @@
+//go:build ignore
+
+// matrix_gen generates matrix.proto, which declares a message for each combination of
+// the features of edition 2023. Run it with go generate after changing it.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+)
+
+var (
+	fieldPresence         = []string{"EXPLICIT", "IMPLICIT", "LEGACY_REQUIRED"}
+	repeatedFieldEncoding = []string{"PACKED", "EXPANDED"}
+	utf8Validation        = []string{"VERIFY", "NONE"}
+	messageEncoding       = []string{"LENGTH_PREFIXED", "DELIMITED"}
+	enumType              = []string{"OPEN", "CLOSED"}
+)
+
+// camel returns the CamelCase name of a feature value, e.g. LengthPrefixed.
+func camel(value string) string {
+	var out []byte
+	upper := true
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '_':
+			upper = true
+		case upper:
+			out = append(out, c)
+			upper = false
+		default:
+			out = append(out, c-'A'+'a')
+		}
+	}
+	return string(out)
+}
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by matrix_gen.go. DO NOT EDIT.
+
+edition = "2023";
+
+package editions.matrix;
+
+option go_package = "testproto/editions";
+
+message MatrixChild {
+  int32 id = 1;
+  string name = 2;
+}
+
+enum MatrixOpenEnum {
+  option features.enum_type = OPEN;
+  MATRIX_OPEN_ENUM_ZERO = 0;
+  MATRIX_OPEN_ENUM_ONE = 1;
+  MATRIX_OPEN_ENUM_TWO = 2;
+}
+
+enum MatrixClosedEnum {
+  option features.enum_type = CLOSED;
+  MATRIX_CLOSED_ENUM_ZERO = 0;
+  MATRIX_CLOSED_ENUM_ONE = 1;
+  MATRIX_CLOSED_ENUM_TWO = 2;
+}
+`)
+	for _, presence := range fieldPresence {
+		for _, encoding := range repeatedFieldEncoding {
+			for _, utf8 := range utf8Validation {
+				for _, message := range messageEncoding {
+					for _, enum := range enumType {
+						writeMessage(&b, presence, encoding, utf8, message, enum)
+					}
+				}
+			}
+		}
+	}
+	if err := os.WriteFile("matrix.proto", b.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeMessage(b *bytes.Buffer, presence, encoding, utf8, message, enum string) {
+	p := fmt.Sprintf("features.field_presence = %s", presence)
+	r := fmt.Sprintf("features.repeated_field_encoding = %s", encoding)
+	u := fmt.Sprintf("features.utf8_validation = %s", utf8)
+	m := fmt.Sprintf("features.message_encoding = %s", message)
+	enumName := "Matrix" + camel(enum) + "Enum"
+
+	// Closed enums cannot be used with implicit presence, and message fields cannot have
+	// implicit presence: these fields keep the default explicit presence.
+	enumPresence, childPresence := p, p
+	if presence == "IMPLICIT" {
+		childPresence = "features.field_presence = EXPLICIT"
+		if enum == "CLOSED" {
+			enumPresence = childPresence
+		}
+	}
+
+	fmt.Fprintf(b, "\nmessage Matrix%s%s%s%s%s {\n", camel(presence), camel(encoding), camel(utf8), camel(message), camel(enum))
+	fmt.Fprintf(b, "  int32 s_int32 = 1 [%s];\n", p)
+	fmt.Fprintf(b, "  sint64 s_sint64 = 2 [%s];\n", p)
+	fmt.Fprintf(b, "  fixed32 s_fixed32 = 3 [%s];\n", p)
+	fmt.Fprintf(b, "  double s_double = 4 [%s];\n", p)
+	fmt.Fprintf(b, "  bool s_bool = 5 [%s];\n", p)
+	fmt.Fprintf(b, "  bytes s_bytes = 6 [%s];\n", p)
+	fmt.Fprintf(b, "  string s_string = 7 [%s, %s];\n", p, u)
+	fmt.Fprintf(b, "  %s s_enum = 8 [%s];\n", enumName, enumPresence)
+	fmt.Fprintf(b, "  MatrixChild s_child = 9 [%s, %s];\n", childPresence, m)
+	fmt.Fprintf(b, "  repeated int32 r_int32 = 10 [%s];\n", r)
+	fmt.Fprintf(b, "  repeated sint64 r_sint64 = 11 [%s];\n", r)
+	fmt.Fprintf(b, "  repeated double r_double = 12 [%s];\n", r)
+	fmt.Fprintf(b, "  repeated bool r_bool = 13 [%s];\n", r)
+	fmt.Fprintf(b, "  repeated %s r_enum = 14 [%s];\n", enumName, r)
+	fmt.Fprintf(b, "  repeated string r_string = 15 [%s];\n", u)
+	fmt.Fprintf(b, "  repeated bytes r_bytes = 16;\n")
+	fmt.Fprintf(b, "  repeated MatrixChild r_child = 17 [%s];\n", m)
+	fmt.Fprintf(b, "  map<string, string> m_string = 18 [%s];\n", u)
+	fmt.Fprintf(b, "  map<int32, MatrixChild> m_child = 19;\n")
+	fmt.Fprintf(b, "  oneof choice {\n")
+	fmt.Fprintf(b, "    string o_string = 20 [%s];\n", u)
+	fmt.Fprintf(b, "    MatrixChild o_child = 21 [%s];\n", m)
+	fmt.Fprintf(b, "    int64 o_int64 = 22;\n")
+	fmt.Fprintf(b, "  }\n")
+	fmt.Fprintf(b, "}\n")
+}
@@ -0,0 +1,232 @@
+package editions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//go:generate go run matrix_gen.go
+
+type matrixMessage interface {
+	proto.Message
+	MarshalVT() ([]byte, error)
+	UnmarshalVT([]byte) error
+	SizeVT() int
+	CloneMessageVT() proto.Message
+	EqualMessageVT(proto.Message) bool
+}
+
+// matrixValue returns the value of the scalar fields fd in the populated messages.
+func matrixValue(fd protoreflect.FieldDescriptor, zero bool) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(!zero)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if zero {
+			return protoreflect.ValueOfInt32(0)
+		}
+		return protoreflect.ValueOfInt32(-42)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if zero {
+			return protoreflect.ValueOfInt64(0)
+		}
+		return protoreflect.ValueOfInt64(-1 << 40)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if zero {
+			return protoreflect.ValueOfUint32(0)
+		}
+		return protoreflect.ValueOfUint32(42)
+	case protoreflect.DoubleKind:
+		if zero {
+			return protoreflect.ValueOfFloat64(0)
+		}
+		return protoreflect.ValueOfFloat64(-2.25)
+	case protoreflect.StringKind:
+		if zero {
+			return protoreflect.ValueOfString("")
+		}
+		return protoreflect.ValueOfString("héllo")
+	case protoreflect.BytesKind:
+		if zero {
+			return protoreflect.ValueOfBytes([]byte{})
+		}
+		return protoreflect.ValueOfBytes([]byte{0, 1, 2})
+	case protoreflect.EnumKind:
+		if zero {
+			return protoreflect.ValueOfEnum(0)
+		}
+		return protoreflect.ValueOfEnum(fd.Enum().Values().Get(2).Number())
+	}
+	panic("unexpected kind " + fd.Kind().String())
+}
+
+// populateMatrix sets all the fields of m, except the members of its oneofs other than
+// the one of index member, to values of matrixValue, or to zero values if zero is set.
+func populateMatrix(m protoreflect.Message, member int, zero bool) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() && od.Fields().Get(member) != fd {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			mv := m.Mutable(fd).Map()
+			key := matrixValue(fd.MapKey(), zero).MapKey()
+			if fd.MapValue().Message() != nil {
+				value := mv.NewValue()
+				populateMatrix(value.Message(), 0, zero)
+				mv.Set(key, value)
+			} else {
+				mv.Set(key, matrixValue(fd.MapValue(), zero))
+			}
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for j := 0; j < 3; j++ {
+				if fd.Message() != nil {
+					value := list.NewElement()
+					populateMatrix(value.Message(), 0, zero && j == 0)
+					list.Append(value)
+				} else {
+					list.Append(matrixValue(fd, zero && j == 0))
+				}
+			}
+		case fd.Message() != nil:
+			value := m.NewField(fd)
+			populateMatrix(value.Message(), 0, zero)
+			m.Set(fd, value)
+		default:
+			m.Set(fd, matrixValue(fd, zero))
+		}
+	}
+}
+
+// newMatrix returns a new message of type md.
+func newMatrix(t *testing.T, md protoreflect.MessageDescriptor) matrixMessage {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	require.NoError(t, err)
+	return mt.New().Interface().(matrixMessage)
+}
+
+// TestEditionsMatrix round-trips the messages of matrix.proto, which combine all the
+// features of edition 2023, between the generated methods and the proto package.
+func TestEditionsMatrix(t *testing.T) {
+	messages := File_editions_matrix_proto.Messages()
+	var tested int
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.Name() == "MatrixChild" {
+			continue
+		}
+		tested++
+		features := md.Fields().ByName("s_string").Options().(*descriptorpb.FieldOptions).GetFeatures()
+		required := features.GetFieldPresence() == descriptorpb.FeatureSet_LEGACY_REQUIRED
+
+		t.Run(string(md.Name()), func(t *testing.T) {
+			for _, variant := range []struct {
+				name   string
+				member int
+				zero   bool
+				empty  bool
+			}{
+				{name: "string", member: 0},
+				{name: "message", member: 1},
+				{name: "int64", member: 2},
+				{name: "zero", member: 1, zero: true},
+				{name: "empty", empty: true},
+			} {
+				t.Run(variant.name, func(t *testing.T) {
+					msg := newMatrix(t, md)
+					if !variant.empty {
+						populateMatrix(msg.ProtoReflect(), variant.member, variant.zero)
+					}
+
+					data, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(msg)
+					require.NoError(t, err)
+					require.Equal(t, len(data), msg.SizeVT())
+					decoded := newMatrix(t, md)
+					err = decoded.UnmarshalVT(data)
+					protoErr := proto.Unmarshal(data, newMatrix(t, md))
+
+					if variant.empty && required {
+						// Both fail on the missing required fields.
+						_, vtErr := msg.MarshalVT()
+						require.Error(t, vtErr)
+						require.Error(t, err)
+						require.Error(t, protoErr)
+					} else {
+						vtData, vtErr := msg.MarshalVT()
+						require.NoError(t, vtErr)
+						require.Equal(t, data, vtData)
+						require.NoError(t, err)
+						require.NoError(t, protoErr)
+						require.True(t, proto.Equal(msg, decoded), "decoded %v, expected %v", decoded, msg)
+						require.True(t, msg.EqualMessageVT(decoded))
+					}
+					require.True(t, proto.Equal(msg, msg.CloneMessageVT()))
+				})
+			}
+
+			t.Run("utf8", func(t *testing.T) {
+				msg := newMatrix(t, md)
+				populateMatrix(msg.ProtoReflect(), 0, true)
+				msg.ProtoReflect().Set(md.Fields().ByName("s_string"), protoreflect.ValueOfString("\xff"))
+				data, err := msg.MarshalVT()
+				require.NoError(t, err)
+
+				err = newMatrix(t, md).UnmarshalVT(data)
+				protoErr := proto.Unmarshal(data, newMatrix(t, md))
+				if features.GetUtf8Validation() == descriptorpb.FeatureSet_VERIFY {
+					require.Error(t, err)
+					require.Error(t, protoErr)
+				} else {
+					require.NoError(t, err)
+					require.NoError(t, protoErr)
+				}
+			})
+		})
+	}
+	require.Equal(t, 3*2*2*2*2, tested)
+}
+
+// TestEditionsMatrixDelimited checks that the message fields with delimited encoding are
+// encoded as groups, including the repeated fields and the oneof members holding nil.
+func TestEditionsMatrixDelimited(t *testing.T) {
+	child := func(id int32) *MatrixChild {
+		return &MatrixChild{Id: proto.Int32(id), Name: proto.String("child")}
+	}
+	for name, msg := range map[string]*MatrixExplicitPackedVerifyDelimitedOpen{
+		"singular": {SChild: child(1)},
+		"repeated": {RChild: []*MatrixChild{child(1), {}, child(2)}},
+		"oneof":    {Choice: &MatrixExplicitPackedVerifyDelimitedOpen_OChild{OChild: child(3)}},
+		"nilOneof": {Choice: &MatrixExplicitPackedVerifyDelimitedOpen_OChild{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected, err := proto.Marshal(msg)
+			require.NoError(t, err)
+			_, typ, n := protowire.ConsumeTag(expected)
+			require.Greater(t, n, 0)
+			require.Equal(t, protowire.StartGroupType, typ)
+
+			require.Equal(t, len(expected), msg.SizeVT())
+			for method, marshal := range map[string]func() ([]byte, error){
+				"MarshalVT":       msg.MarshalVT,
+				"MarshalVTStrict": msg.MarshalVTStrict,
+			} {
+				data, err := marshal()
+				require.NoError(t, err, method)
+				require.Equal(t, expected, data, method)
+			}
+
+			decoded := &MatrixExplicitPackedVerifyDelimitedOpen{}
+			require.NoError(t, decoded.UnmarshalVT(expected))
+			require.True(t, proto.Equal(msg, decoded), "decoded %v, expected %v", decoded, msg)
+		})
+	}
+}
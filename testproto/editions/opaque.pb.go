@@ -0,0 +1,1706 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.32.1
+// source: editions/opaque.proto
+
+package editions
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpaqueKind int32
+
+const (
+	OpaqueKind_OPAQUE_KIND_UNSPECIFIED OpaqueKind = 0
+	OpaqueKind_OPAQUE_KIND_A           OpaqueKind = 1
+	OpaqueKind_OPAQUE_KIND_B           OpaqueKind = 2
+)
+
+// Enum value maps for OpaqueKind.
+var (
+	OpaqueKind_name = map[int32]string{
+		0: "OPAQUE_KIND_UNSPECIFIED",
+		1: "OPAQUE_KIND_A",
+		2: "OPAQUE_KIND_B",
+	}
+	OpaqueKind_value = map[string]int32{
+		"OPAQUE_KIND_UNSPECIFIED": 0,
+		"OPAQUE_KIND_A":           1,
+		"OPAQUE_KIND_B":           2,
+	}
+)
+
+func (x OpaqueKind) Enum() *OpaqueKind {
+	p := new(OpaqueKind)
+	*p = x
+	return p
+}
+
+func (x OpaqueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpaqueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_opaque_proto_enumTypes[0].Descriptor()
+}
+
+func (OpaqueKind) Type() protoreflect.EnumType {
+	return &file_editions_opaque_proto_enumTypes[0]
+}
+
+func (x OpaqueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Simple message using opaque API
+// vtprotobuf generates its methods through the accessors of the message,
+// since its fields are private
+type OpaqueMessage struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          int32                  `protobuf:"varint,1,opt,name=id"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,2,opt,name=name"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,3,opt,name=data"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OpaqueMessage) Reset() {
+	*x = OpaqueMessage{}
+	mi := &file_editions_opaque_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaqueMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaqueMessage) ProtoMessage() {}
+
+func (x *OpaqueMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_opaque_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OpaqueMessage) GetId() int32 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *OpaqueMessage) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OpaqueMessage) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *OpaqueMessage) SetId(v int32) {
+	x.xxx_hidden_Id = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *OpaqueMessage) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *OpaqueMessage) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *OpaqueMessage) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OpaqueMessage) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *OpaqueMessage) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *OpaqueMessage) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = 0
+}
+
+func (x *OpaqueMessage) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *OpaqueMessage) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Data = nil
+}
+
+type OpaqueMessage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   *int32
+	Name *string
+	Data []byte
+}
+
+func (b0 OpaqueMessage_builder) Build() *OpaqueMessage {
+	m0 := &OpaqueMessage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = *b.Id
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Data = b.Data
+	}
+	return m0
+}
+
+// Opaque message with lazy field
+type OpaqueMessageWithLazy struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id     int32                  `protobuf:"varint,1,opt,name=id"`
+	xxx_hidden_Nested *OpaqueMessage         `protobuf:"bytes,2,opt,name=nested"`
+	// Deprecated: Do not use. This will be deleted in the near future.
+	XXX_lazyUnmarshalInfo  protoimpl.LazyUnmarshalInfo
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OpaqueMessageWithLazy) Reset() {
+	*x = OpaqueMessageWithLazy{}
+	mi := &file_editions_opaque_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaqueMessageWithLazy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaqueMessageWithLazy) ProtoMessage() {}
+
+func (x *OpaqueMessageWithLazy) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_opaque_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OpaqueMessageWithLazy) GetId() int32 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *OpaqueMessageWithLazy) GetNested() *OpaqueMessage {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			if protoimpl.X.AtomicCheckPointerIsNil(&x.xxx_hidden_Nested) {
+				protoimpl.X.UnmarshalField(x, 2)
+			}
+			var rv *OpaqueMessage
+			protoimpl.X.AtomicLoadPointer(protoimpl.Pointer(&x.xxx_hidden_Nested), protoimpl.Pointer(&rv))
+			return rv
+		}
+	}
+	return nil
+}
+
+func (x *OpaqueMessageWithLazy) SetId(v int32) {
+	x.xxx_hidden_Id = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *OpaqueMessageWithLazy) SetNested(v *OpaqueMessage) {
+	protoimpl.X.AtomicSetPointer(&x.xxx_hidden_Nested, v)
+	if v == nil {
+		protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	} else {
+		protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	}
+}
+
+func (x *OpaqueMessageWithLazy) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OpaqueMessageWithLazy) HasNested() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *OpaqueMessageWithLazy) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = 0
+}
+
+func (x *OpaqueMessageWithLazy) ClearNested() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	protoimpl.X.AtomicSetPointer(&x.xxx_hidden_Nested, (*OpaqueMessage)(nil))
+}
+
+type OpaqueMessageWithLazy_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *int32
+	// Lazy field in opaque API - full lazy semantics
+	Nested *OpaqueMessage
+}
+
+func (b0 OpaqueMessageWithLazy_builder) Build() *OpaqueMessageWithLazy {
+	m0 := &OpaqueMessageWithLazy{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = *b.Id
+	}
+	if b.Nested != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Nested = b.Nested
+	}
+	return m0
+}
+
+// Opaque message with fields of every kind of presence
+type OpaqueFields struct {
+	state                   protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_I32          int32                    `protobuf:"varint,1,opt,name=i32"`
+	xxx_hidden_S64          int64                    `protobuf:"zigzag64,2,opt,name=s64"`
+	xxx_hidden_F32          uint32                   `protobuf:"fixed32,3,opt,name=f32"`
+	xxx_hidden_D            float64                  `protobuf:"fixed64,4,opt,name=d"`
+	xxx_hidden_B            bool                     `protobuf:"varint,5,opt,name=b"`
+	xxx_hidden_S            *string                  `protobuf:"bytes,6,opt,name=s"`
+	xxx_hidden_By           []byte                   `protobuf:"bytes,7,opt,name=by"`
+	xxx_hidden_Kind         OpaqueKind               `protobuf:"varint,8,opt,name=kind,enum=OpaqueKind"`
+	xxx_hidden_Msg          *OpaqueMessage           `protobuf:"bytes,9,opt,name=msg"`
+	xxx_hidden_ImplicitI64  int64                    `protobuf:"varint,10,opt,name=implicit_i64,json=implicitI64"`
+	xxx_hidden_ImplicitF    float32                  `protobuf:"fixed32,11,opt,name=implicit_f,json=implicitF"`
+	xxx_hidden_ImplicitS    string                   `protobuf:"bytes,12,opt,name=implicit_s,json=implicitS"`
+	xxx_hidden_ImplicitBy   []byte                   `protobuf:"bytes,13,opt,name=implicit_by,json=implicitBy"`
+	xxx_hidden_ImplicitKind OpaqueKind               `protobuf:"varint,14,opt,name=implicit_kind,json=implicitKind,enum=OpaqueKind"`
+	xxx_hidden_Packed       []int32                  `protobuf:"varint,20,rep,packed,name=packed"`
+	xxx_hidden_PackedFixed  []int64                  `protobuf:"fixed64,21,rep,packed,name=packed_fixed,json=packedFixed"`
+	xxx_hidden_Expanded     []uint32                 `protobuf:"varint,22,rep,name=expanded"`
+	xxx_hidden_Strings      []string                 `protobuf:"bytes,23,rep,name=strings"`
+	xxx_hidden_Blobs        [][]byte                 `protobuf:"bytes,24,rep,name=blobs"`
+	xxx_hidden_Msgs         *[]*OpaqueMessage        `protobuf:"bytes,25,rep,name=msgs"`
+	xxx_hidden_Kinds        []OpaqueKind             `protobuf:"varint,26,rep,packed,name=kinds,enum=OpaqueKind"`
+	xxx_hidden_Counts       map[string]int64         `protobuf:"bytes,30,rep,name=counts" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	xxx_hidden_ById         map[int32]*OpaqueMessage `protobuf:"bytes,31,rep,name=by_id,json=byId" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_BlobsByName  map[string][]byte        `protobuf:"bytes,32,rep,name=blobs_by_name,json=blobsByName" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Choice       isOpaqueFields_Choice    `protobuf_oneof:"choice"`
+	xxx_hidden_Delimited    *OpaqueMessage           `protobuf:"group,50,opt,name=OpaqueMessage,json=delimited"`
+	xxx_hidden_RequiredId   int32                    `protobuf:"varint,51,req,name=required_id,json=requiredId"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *OpaqueFields) Reset() {
+	*x = OpaqueFields{}
+	mi := &file_editions_opaque_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaqueFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaqueFields) ProtoMessage() {}
+
+func (x *OpaqueFields) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_opaque_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OpaqueFields) GetI32() int32 {
+	if x != nil {
+		return x.xxx_hidden_I32
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetS64() int64 {
+	if x != nil {
+		return x.xxx_hidden_S64
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetF32() uint32 {
+	if x != nil {
+		return x.xxx_hidden_F32
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetD() float64 {
+	if x != nil {
+		return x.xxx_hidden_D
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetB() bool {
+	if x != nil {
+		return x.xxx_hidden_B
+	}
+	return false
+}
+
+func (x *OpaqueFields) GetS() string {
+	if x != nil {
+		if x.xxx_hidden_S != nil {
+			return *x.xxx_hidden_S
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OpaqueFields) GetBy() []byte {
+	if x != nil {
+		return x.xxx_hidden_By
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetKind() OpaqueKind {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 7) {
+			return x.xxx_hidden_Kind
+		}
+	}
+	return OpaqueKind_OPAQUE_KIND_UNSPECIFIED
+}
+
+func (x *OpaqueFields) GetMsg() *OpaqueMessage {
+	if x != nil {
+		return x.xxx_hidden_Msg
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetImplicitI64() int64 {
+	if x != nil {
+		return x.xxx_hidden_ImplicitI64
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetImplicitF() float32 {
+	if x != nil {
+		return x.xxx_hidden_ImplicitF
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetImplicitS() string {
+	if x != nil {
+		return x.xxx_hidden_ImplicitS
+	}
+	return ""
+}
+
+func (x *OpaqueFields) GetImplicitBy() []byte {
+	if x != nil {
+		return x.xxx_hidden_ImplicitBy
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetImplicitKind() OpaqueKind {
+	if x != nil {
+		return x.xxx_hidden_ImplicitKind
+	}
+	return OpaqueKind_OPAQUE_KIND_UNSPECIFIED
+}
+
+func (x *OpaqueFields) GetPacked() []int32 {
+	if x != nil {
+		return x.xxx_hidden_Packed
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetPackedFixed() []int64 {
+	if x != nil {
+		return x.xxx_hidden_PackedFixed
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetExpanded() []uint32 {
+	if x != nil {
+		return x.xxx_hidden_Expanded
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetStrings() []string {
+	if x != nil {
+		return x.xxx_hidden_Strings
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetBlobs() [][]byte {
+	if x != nil {
+		return x.xxx_hidden_Blobs
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetMsgs() []*OpaqueMessage {
+	if x != nil {
+		if x.xxx_hidden_Msgs != nil {
+			return *x.xxx_hidden_Msgs
+		}
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetKinds() []OpaqueKind {
+	if x != nil {
+		return x.xxx_hidden_Kinds
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.xxx_hidden_Counts
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetById() map[int32]*OpaqueMessage {
+	if x != nil {
+		return x.xxx_hidden_ById
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetBlobsByName() map[string][]byte {
+	if x != nil {
+		return x.xxx_hidden_BlobsByName
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetChoiceNum() int32 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceNum); ok {
+			return x.ChoiceNum
+		}
+	}
+	return 0
+}
+
+func (x *OpaqueFields) GetChoiceText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceText); ok {
+			return x.ChoiceText
+		}
+	}
+	return ""
+}
+
+func (x *OpaqueFields) GetChoiceData() []byte {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceData); ok {
+			return x.ChoiceData
+		}
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetChoiceMsg() *OpaqueMessage {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceMsg); ok {
+			return x.ChoiceMsg
+		}
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetChoiceKind() OpaqueKind {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceKind); ok {
+			return x.ChoiceKind
+		}
+	}
+	return OpaqueKind_OPAQUE_KIND_UNSPECIFIED
+}
+
+func (x *OpaqueFields) GetDelimited() *OpaqueMessage {
+	if x != nil {
+		return x.xxx_hidden_Delimited
+	}
+	return nil
+}
+
+func (x *OpaqueFields) GetRequiredId() int32 {
+	if x != nil {
+		return x.xxx_hidden_RequiredId
+	}
+	return 0
+}
+
+func (x *OpaqueFields) SetI32(v int32) {
+	x.xxx_hidden_I32 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 27)
+}
+
+func (x *OpaqueFields) SetS64(v int64) {
+	x.xxx_hidden_S64 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 27)
+}
+
+func (x *OpaqueFields) SetF32(v uint32) {
+	x.xxx_hidden_F32 = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 27)
+}
+
+func (x *OpaqueFields) SetD(v float64) {
+	x.xxx_hidden_D = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 27)
+}
+
+func (x *OpaqueFields) SetB(v bool) {
+	x.xxx_hidden_B = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 27)
+}
+
+func (x *OpaqueFields) SetS(v string) {
+	x.xxx_hidden_S = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 27)
+}
+
+func (x *OpaqueFields) SetBy(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_By = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 27)
+}
+
+func (x *OpaqueFields) SetKind(v OpaqueKind) {
+	x.xxx_hidden_Kind = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 27)
+}
+
+func (x *OpaqueFields) SetMsg(v *OpaqueMessage) {
+	x.xxx_hidden_Msg = v
+}
+
+func (x *OpaqueFields) SetImplicitI64(v int64) {
+	x.xxx_hidden_ImplicitI64 = v
+}
+
+func (x *OpaqueFields) SetImplicitF(v float32) {
+	x.xxx_hidden_ImplicitF = v
+}
+
+func (x *OpaqueFields) SetImplicitS(v string) {
+	x.xxx_hidden_ImplicitS = v
+}
+
+func (x *OpaqueFields) SetImplicitBy(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_ImplicitBy = v
+}
+
+func (x *OpaqueFields) SetImplicitKind(v OpaqueKind) {
+	x.xxx_hidden_ImplicitKind = v
+}
+
+func (x *OpaqueFields) SetPacked(v []int32) {
+	x.xxx_hidden_Packed = v
+}
+
+func (x *OpaqueFields) SetPackedFixed(v []int64) {
+	x.xxx_hidden_PackedFixed = v
+}
+
+func (x *OpaqueFields) SetExpanded(v []uint32) {
+	x.xxx_hidden_Expanded = v
+}
+
+func (x *OpaqueFields) SetStrings(v []string) {
+	x.xxx_hidden_Strings = v
+}
+
+func (x *OpaqueFields) SetBlobs(v [][]byte) {
+	x.xxx_hidden_Blobs = v
+}
+
+func (x *OpaqueFields) SetMsgs(v []*OpaqueMessage) {
+	x.xxx_hidden_Msgs = &v
+}
+
+func (x *OpaqueFields) SetKinds(v []OpaqueKind) {
+	x.xxx_hidden_Kinds = v
+}
+
+func (x *OpaqueFields) SetCounts(v map[string]int64) {
+	x.xxx_hidden_Counts = v
+}
+
+func (x *OpaqueFields) SetById(v map[int32]*OpaqueMessage) {
+	x.xxx_hidden_ById = v
+}
+
+func (x *OpaqueFields) SetBlobsByName(v map[string][]byte) {
+	x.xxx_hidden_BlobsByName = v
+}
+
+func (x *OpaqueFields) SetChoiceNum(v int32) {
+	x.xxx_hidden_Choice = &opaqueFields_ChoiceNum{v}
+}
+
+func (x *OpaqueFields) SetChoiceText(v string) {
+	x.xxx_hidden_Choice = &opaqueFields_ChoiceText{v}
+}
+
+func (x *OpaqueFields) SetChoiceData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Choice = &opaqueFields_ChoiceData{v}
+}
+
+func (x *OpaqueFields) SetChoiceMsg(v *OpaqueMessage) {
+	if v == nil {
+		x.xxx_hidden_Choice = nil
+		return
+	}
+	x.xxx_hidden_Choice = &opaqueFields_ChoiceMsg{v}
+}
+
+func (x *OpaqueFields) SetChoiceKind(v OpaqueKind) {
+	x.xxx_hidden_Choice = &opaqueFields_ChoiceKind{v}
+}
+
+func (x *OpaqueFields) SetDelimited(v *OpaqueMessage) {
+	x.xxx_hidden_Delimited = v
+}
+
+func (x *OpaqueFields) SetRequiredId(v int32) {
+	x.xxx_hidden_RequiredId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 26, 27)
+}
+
+func (x *OpaqueFields) HasI32() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OpaqueFields) HasS64() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *OpaqueFields) HasF32() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *OpaqueFields) HasD() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *OpaqueFields) HasB() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *OpaqueFields) HasS() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *OpaqueFields) HasBy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *OpaqueFields) HasKind() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *OpaqueFields) HasMsg() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Msg != nil
+}
+
+func (x *OpaqueFields) HasChoice() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Choice != nil
+}
+
+func (x *OpaqueFields) HasChoiceNum() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceNum)
+	return ok
+}
+
+func (x *OpaqueFields) HasChoiceText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceText)
+	return ok
+}
+
+func (x *OpaqueFields) HasChoiceData() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceData)
+	return ok
+}
+
+func (x *OpaqueFields) HasChoiceMsg() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceMsg)
+	return ok
+}
+
+func (x *OpaqueFields) HasChoiceKind() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceKind)
+	return ok
+}
+
+func (x *OpaqueFields) HasDelimited() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Delimited != nil
+}
+
+func (x *OpaqueFields) HasRequiredId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 26)
+}
+
+func (x *OpaqueFields) ClearI32() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_I32 = 0
+}
+
+func (x *OpaqueFields) ClearS64() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_S64 = 0
+}
+
+func (x *OpaqueFields) ClearF32() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_F32 = 0
+}
+
+func (x *OpaqueFields) ClearD() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_D = 0
+}
+
+func (x *OpaqueFields) ClearB() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_B = false
+}
+
+func (x *OpaqueFields) ClearS() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_S = nil
+}
+
+func (x *OpaqueFields) ClearBy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_By = nil
+}
+
+func (x *OpaqueFields) ClearKind() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Kind = OpaqueKind_OPAQUE_KIND_UNSPECIFIED
+}
+
+func (x *OpaqueFields) ClearMsg() {
+	x.xxx_hidden_Msg = nil
+}
+
+func (x *OpaqueFields) ClearChoice() {
+	x.xxx_hidden_Choice = nil
+}
+
+func (x *OpaqueFields) ClearChoiceNum() {
+	if _, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceNum); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaqueFields) ClearChoiceText() {
+	if _, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceText); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaqueFields) ClearChoiceData() {
+	if _, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceData); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaqueFields) ClearChoiceMsg() {
+	if _, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceMsg); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaqueFields) ClearChoiceKind() {
+	if _, ok := x.xxx_hidden_Choice.(*opaqueFields_ChoiceKind); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaqueFields) ClearDelimited() {
+	x.xxx_hidden_Delimited = nil
+}
+
+func (x *OpaqueFields) ClearRequiredId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 26)
+	x.xxx_hidden_RequiredId = 0
+}
+
+const OpaqueFields_Choice_not_set_case case_OpaqueFields_Choice = 0
+const OpaqueFields_ChoiceNum_case case_OpaqueFields_Choice = 40
+const OpaqueFields_ChoiceText_case case_OpaqueFields_Choice = 41
+const OpaqueFields_ChoiceData_case case_OpaqueFields_Choice = 42
+const OpaqueFields_ChoiceMsg_case case_OpaqueFields_Choice = 43
+const OpaqueFields_ChoiceKind_case case_OpaqueFields_Choice = 44
+
+func (x *OpaqueFields) WhichChoice() case_OpaqueFields_Choice {
+	if x == nil {
+		return OpaqueFields_Choice_not_set_case
+	}
+	switch x.xxx_hidden_Choice.(type) {
+	case *opaqueFields_ChoiceNum:
+		return OpaqueFields_ChoiceNum_case
+	case *opaqueFields_ChoiceText:
+		return OpaqueFields_ChoiceText_case
+	case *opaqueFields_ChoiceData:
+		return OpaqueFields_ChoiceData_case
+	case *opaqueFields_ChoiceMsg:
+		return OpaqueFields_ChoiceMsg_case
+	case *opaqueFields_ChoiceKind:
+		return OpaqueFields_ChoiceKind_case
+	default:
+		return OpaqueFields_Choice_not_set_case
+	}
+}
+
+type OpaqueFields_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Explicit presence
+	I32  *int32
+	S64  *int64
+	F32  *uint32
+	D    *float64
+	B    *bool
+	S    *string
+	By   []byte
+	Kind *OpaqueKind
+	Msg  *OpaqueMessage
+	// Implicit presence
+	ImplicitI64  int64
+	ImplicitF    float32
+	ImplicitS    string
+	ImplicitBy   []byte
+	ImplicitKind OpaqueKind
+	// Repeated
+	Packed      []int32
+	PackedFixed []int64
+	Expanded    []uint32
+	Strings     []string
+	Blobs       [][]byte
+	Msgs        []*OpaqueMessage
+	Kinds       []OpaqueKind
+	// Maps
+	Counts      map[string]int64
+	ById        map[int32]*OpaqueMessage
+	BlobsByName map[string][]byte
+	// Fields of oneof xxx_hidden_Choice:
+	ChoiceNum  *int32
+	ChoiceText *string
+	ChoiceData []byte
+	ChoiceMsg  *OpaqueMessage
+	ChoiceKind *OpaqueKind
+	// -- end of xxx_hidden_Choice
+	Delimited  *OpaqueMessage
+	RequiredId *int32
+}
+
+func (b0 OpaqueFields_builder) Build() *OpaqueFields {
+	m0 := &OpaqueFields{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.I32 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 27)
+		x.xxx_hidden_I32 = *b.I32
+	}
+	if b.S64 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 27)
+		x.xxx_hidden_S64 = *b.S64
+	}
+	if b.F32 != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 27)
+		x.xxx_hidden_F32 = *b.F32
+	}
+	if b.D != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 27)
+		x.xxx_hidden_D = *b.D
+	}
+	if b.B != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 27)
+		x.xxx_hidden_B = *b.B
+	}
+	if b.S != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 27)
+		x.xxx_hidden_S = b.S
+	}
+	if b.By != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 27)
+		x.xxx_hidden_By = b.By
+	}
+	if b.Kind != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 27)
+		x.xxx_hidden_Kind = *b.Kind
+	}
+	x.xxx_hidden_Msg = b.Msg
+	x.xxx_hidden_ImplicitI64 = b.ImplicitI64
+	x.xxx_hidden_ImplicitF = b.ImplicitF
+	x.xxx_hidden_ImplicitS = b.ImplicitS
+	x.xxx_hidden_ImplicitBy = b.ImplicitBy
+	x.xxx_hidden_ImplicitKind = b.ImplicitKind
+	x.xxx_hidden_Packed = b.Packed
+	x.xxx_hidden_PackedFixed = b.PackedFixed
+	x.xxx_hidden_Expanded = b.Expanded
+	x.xxx_hidden_Strings = b.Strings
+	x.xxx_hidden_Blobs = b.Blobs
+	x.xxx_hidden_Msgs = &b.Msgs
+	x.xxx_hidden_Kinds = b.Kinds
+	x.xxx_hidden_Counts = b.Counts
+	x.xxx_hidden_ById = b.ById
+	x.xxx_hidden_BlobsByName = b.BlobsByName
+	if b.ChoiceNum != nil {
+		x.xxx_hidden_Choice = &opaqueFields_ChoiceNum{*b.ChoiceNum}
+	}
+	if b.ChoiceText != nil {
+		x.xxx_hidden_Choice = &opaqueFields_ChoiceText{*b.ChoiceText}
+	}
+	if b.ChoiceData != nil {
+		x.xxx_hidden_Choice = &opaqueFields_ChoiceData{b.ChoiceData}
+	}
+	if b.ChoiceMsg != nil {
+		x.xxx_hidden_Choice = &opaqueFields_ChoiceMsg{b.ChoiceMsg}
+	}
+	if b.ChoiceKind != nil {
+		x.xxx_hidden_Choice = &opaqueFields_ChoiceKind{*b.ChoiceKind}
+	}
+	x.xxx_hidden_Delimited = b.Delimited
+	if b.RequiredId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 26, 27)
+		x.xxx_hidden_RequiredId = *b.RequiredId
+	}
+	return m0
+}
+
+type case_OpaqueFields_Choice protoreflect.FieldNumber
+
+func (x case_OpaqueFields_Choice) String() string {
+	md := file_editions_opaque_proto_msgTypes[2].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isOpaqueFields_Choice interface {
+	isOpaqueFields_Choice()
+}
+
+type opaqueFields_ChoiceNum struct {
+	ChoiceNum int32 `protobuf:"varint,40,opt,name=choice_num,json=choiceNum,oneof"`
+}
+
+type opaqueFields_ChoiceText struct {
+	ChoiceText string `protobuf:"bytes,41,opt,name=choice_text,json=choiceText,oneof"`
+}
+
+type opaqueFields_ChoiceData struct {
+	ChoiceData []byte `protobuf:"bytes,42,opt,name=choice_data,json=choiceData,oneof"`
+}
+
+type opaqueFields_ChoiceMsg struct {
+	ChoiceMsg *OpaqueMessage `protobuf:"bytes,43,opt,name=choice_msg,json=choiceMsg,oneof"`
+}
+
+type opaqueFields_ChoiceKind struct {
+	ChoiceKind OpaqueKind `protobuf:"varint,44,opt,name=choice_kind,json=choiceKind,enum=OpaqueKind,oneof"`
+}
+
+func (*opaqueFields_ChoiceNum) isOpaqueFields_Choice() {}
+
+func (*opaqueFields_ChoiceText) isOpaqueFields_Choice() {}
+
+func (*opaqueFields_ChoiceData) isOpaqueFields_Choice() {}
+
+func (*opaqueFields_ChoiceMsg) isOpaqueFields_Choice() {}
+
+func (*opaqueFields_ChoiceKind) isOpaqueFields_Choice() {}
+
+// Pooled opaque messages, reset through their accessors
+type OpaquePooled struct {
+	state                  protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_Id          int32                         `protobuf:"varint,1,opt,name=id"`
+	xxx_hidden_Children    *[]*OpaquePooledChild         `protobuf:"bytes,2,rep,name=children"`
+	xxx_hidden_ByName      map[string]*OpaquePooledChild `protobuf:"bytes,3,rep,name=by_name,json=byName" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_Child       *OpaquePooledChild            `protobuf:"bytes,4,opt,name=child"`
+	xxx_hidden_Names       []string                      `protobuf:"bytes,5,rep,name=names"`
+	xxx_hidden_Choice      isOpaquePooled_Choice         `protobuf_oneof:"choice"`
+	xxx_hidden_Other       *OpaqueMessage                `protobuf:"bytes,8,opt,name=other"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OpaquePooled) Reset() {
+	*x = OpaquePooled{}
+	mi := &file_editions_opaque_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaquePooled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaquePooled) ProtoMessage() {}
+
+func (x *OpaquePooled) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_opaque_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OpaquePooled) GetId() int32 {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return 0
+}
+
+func (x *OpaquePooled) GetChildren() []*OpaquePooledChild {
+	if x != nil {
+		if x.xxx_hidden_Children != nil {
+			return *x.xxx_hidden_Children
+		}
+	}
+	return nil
+}
+
+func (x *OpaquePooled) GetByName() map[string]*OpaquePooledChild {
+	if x != nil {
+		return x.xxx_hidden_ByName
+	}
+	return nil
+}
+
+func (x *OpaquePooled) GetChild() *OpaquePooledChild {
+	if x != nil {
+		return x.xxx_hidden_Child
+	}
+	return nil
+}
+
+func (x *OpaquePooled) GetNames() []string {
+	if x != nil {
+		return x.xxx_hidden_Names
+	}
+	return nil
+}
+
+func (x *OpaquePooled) GetPicked() *OpaquePooledChild {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaquePooled_Picked); ok {
+			return x.Picked
+		}
+	}
+	return nil
+}
+
+func (x *OpaquePooled) GetText() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Choice.(*opaquePooled_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *OpaquePooled) GetOther() *OpaqueMessage {
+	if x != nil {
+		return x.xxx_hidden_Other
+	}
+	return nil
+}
+
+func (x *OpaquePooled) SetId(v int32) {
+	x.xxx_hidden_Id = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *OpaquePooled) SetChildren(v []*OpaquePooledChild) {
+	x.xxx_hidden_Children = &v
+}
+
+func (x *OpaquePooled) SetByName(v map[string]*OpaquePooledChild) {
+	x.xxx_hidden_ByName = v
+}
+
+func (x *OpaquePooled) SetChild(v *OpaquePooledChild) {
+	x.xxx_hidden_Child = v
+}
+
+func (x *OpaquePooled) SetNames(v []string) {
+	x.xxx_hidden_Names = v
+}
+
+func (x *OpaquePooled) SetPicked(v *OpaquePooledChild) {
+	if v == nil {
+		x.xxx_hidden_Choice = nil
+		return
+	}
+	x.xxx_hidden_Choice = &opaquePooled_Picked{v}
+}
+
+func (x *OpaquePooled) SetText(v string) {
+	x.xxx_hidden_Choice = &opaquePooled_Text{v}
+}
+
+func (x *OpaquePooled) SetOther(v *OpaqueMessage) {
+	x.xxx_hidden_Other = v
+}
+
+func (x *OpaquePooled) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OpaquePooled) HasChild() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Child != nil
+}
+
+func (x *OpaquePooled) HasChoice() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Choice != nil
+}
+
+func (x *OpaquePooled) HasPicked() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaquePooled_Picked)
+	return ok
+}
+
+func (x *OpaquePooled) HasText() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Choice.(*opaquePooled_Text)
+	return ok
+}
+
+func (x *OpaquePooled) HasOther() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Other != nil
+}
+
+func (x *OpaquePooled) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = 0
+}
+
+func (x *OpaquePooled) ClearChild() {
+	x.xxx_hidden_Child = nil
+}
+
+func (x *OpaquePooled) ClearChoice() {
+	x.xxx_hidden_Choice = nil
+}
+
+func (x *OpaquePooled) ClearPicked() {
+	if _, ok := x.xxx_hidden_Choice.(*opaquePooled_Picked); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaquePooled) ClearText() {
+	if _, ok := x.xxx_hidden_Choice.(*opaquePooled_Text); ok {
+		x.xxx_hidden_Choice = nil
+	}
+}
+
+func (x *OpaquePooled) ClearOther() {
+	x.xxx_hidden_Other = nil
+}
+
+const OpaquePooled_Choice_not_set_case case_OpaquePooled_Choice = 0
+const OpaquePooled_Picked_case case_OpaquePooled_Choice = 6
+const OpaquePooled_Text_case case_OpaquePooled_Choice = 7
+
+func (x *OpaquePooled) WhichChoice() case_OpaquePooled_Choice {
+	if x == nil {
+		return OpaquePooled_Choice_not_set_case
+	}
+	switch x.xxx_hidden_Choice.(type) {
+	case *opaquePooled_Picked:
+		return OpaquePooled_Picked_case
+	case *opaquePooled_Text:
+		return OpaquePooled_Text_case
+	default:
+		return OpaquePooled_Choice_not_set_case
+	}
+}
+
+type OpaquePooled_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       *int32
+	Children []*OpaquePooledChild
+	ByName   map[string]*OpaquePooledChild
+	Child    *OpaquePooledChild
+	Names    []string
+	// Fields of oneof xxx_hidden_Choice:
+	Picked *OpaquePooledChild
+	Text   *string
+	// -- end of xxx_hidden_Choice
+	Other *OpaqueMessage
+}
+
+func (b0 OpaquePooled_builder) Build() *OpaquePooled {
+	m0 := &OpaquePooled{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Id = *b.Id
+	}
+	x.xxx_hidden_Children = &b.Children
+	x.xxx_hidden_ByName = b.ByName
+	x.xxx_hidden_Child = b.Child
+	x.xxx_hidden_Names = b.Names
+	if b.Picked != nil {
+		x.xxx_hidden_Choice = &opaquePooled_Picked{b.Picked}
+	}
+	if b.Text != nil {
+		x.xxx_hidden_Choice = &opaquePooled_Text{*b.Text}
+	}
+	x.xxx_hidden_Other = b.Other
+	return m0
+}
+
+type case_OpaquePooled_Choice protoreflect.FieldNumber
+
+func (x case_OpaquePooled_Choice) String() string {
+	md := file_editions_opaque_proto_msgTypes[3].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isOpaquePooled_Choice interface {
+	isOpaquePooled_Choice()
+}
+
+type opaquePooled_Picked struct {
+	Picked *OpaquePooledChild `protobuf:"bytes,6,opt,name=picked,oneof"`
+}
+
+type opaquePooled_Text struct {
+	Text string `protobuf:"bytes,7,opt,name=text,oneof"`
+}
+
+func (*opaquePooled_Picked) isOpaquePooled_Choice() {}
+
+func (*opaquePooled_Text) isOpaquePooled_Choice() {}
+
+type OpaquePooledChild struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OpaquePooledChild) Reset() {
+	*x = OpaquePooledChild{}
+	mi := &file_editions_opaque_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpaquePooledChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpaquePooledChild) ProtoMessage() {}
+
+func (x *OpaquePooledChild) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_opaque_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OpaquePooledChild) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *OpaquePooledChild) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *OpaquePooledChild) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *OpaquePooledChild) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+type OpaquePooledChild_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name *string
+}
+
+func (b0 OpaquePooledChild_builder) Build() *OpaquePooledChild {
+	m0 := &OpaquePooledChild{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+var File_editions_opaque_proto protoreflect.FileDescriptor
+
+const file_editions_opaque_proto_rawDesc = "" +
+	"\n" +
+	"\x15editions/opaque.proto\x1a!google/protobuf/go_features.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"G\n" +
+	"\rOpaqueMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"S\n" +
+	"\x15OpaqueMessageWithLazy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
+	"\x06nested\x18\x02 \x01(\v2\x0e.OpaqueMessageB\x02(\x01R\x06nested\"\x85\n" +
+	"\n" +
+	"\fOpaqueFields\x12\x10\n" +
+	"\x03i32\x18\x01 \x01(\x05R\x03i32\x12\x10\n" +
+	"\x03s64\x18\x02 \x01(\x12R\x03s64\x12\x10\n" +
+	"\x03f32\x18\x03 \x01(\aR\x03f32\x12\f\n" +
+	"\x01d\x18\x04 \x01(\x01R\x01d\x12\f\n" +
+	"\x01b\x18\x05 \x01(\bR\x01b\x12\f\n" +
+	"\x01s\x18\x06 \x01(\tR\x01s\x12\x0e\n" +
+	"\x02by\x18\a \x01(\fR\x02by\x12\x1f\n" +
+	"\x04kind\x18\b \x01(\x0e2\v.OpaqueKindR\x04kind\x12 \n" +
+	"\x03msg\x18\t \x01(\v2\x0e.OpaqueMessageR\x03msg\x12(\n" +
+	"\fimplicit_i64\x18\n" +
+	" \x01(\x03B\x05\xaa\x01\x02\b\x02R\vimplicitI64\x12$\n" +
+	"\n" +
+	"implicit_f\x18\v \x01(\x02B\x05\xaa\x01\x02\b\x02R\timplicitF\x12$\n" +
+	"\n" +
+	"implicit_s\x18\f \x01(\tB\x05\xaa\x01\x02\b\x02R\timplicitS\x12&\n" +
+	"\vimplicit_by\x18\r \x01(\fB\x05\xaa\x01\x02\b\x02R\n" +
+	"implicitBy\x127\n" +
+	"\rimplicit_kind\x18\x0e \x01(\x0e2\v.OpaqueKindB\x05\xaa\x01\x02\b\x02R\fimplicitKind\x12\x16\n" +
+	"\x06packed\x18\x14 \x03(\x05R\x06packed\x12!\n" +
+	"\fpacked_fixed\x18\x15 \x03(\x10R\vpackedFixed\x12!\n" +
+	"\bexpanded\x18\x16 \x03(\rB\x05\xaa\x01\x02\x18\x02R\bexpanded\x12\x18\n" +
+	"\astrings\x18\x17 \x03(\tR\astrings\x12\x14\n" +
+	"\x05blobs\x18\x18 \x03(\fR\x05blobs\x12\"\n" +
+	"\x04msgs\x18\x19 \x03(\v2\x0e.OpaqueMessageR\x04msgs\x12!\n" +
+	"\x05kinds\x18\x1a \x03(\x0e2\v.OpaqueKindR\x05kinds\x121\n" +
+	"\x06counts\x18\x1e \x03(\v2\x19.OpaqueFields.CountsEntryR\x06counts\x12,\n" +
+	"\x05by_id\x18\x1f \x03(\v2\x17.OpaqueFields.ByIdEntryR\x04byId\x12B\n" +
+	"\rblobs_by_name\x18  \x03(\v2\x1e.OpaqueFields.BlobsByNameEntryR\vblobsByName\x12\x1f\n" +
+	"\n" +
+	"choice_num\x18( \x01(\x05H\x00R\tchoiceNum\x12!\n" +
+	"\vchoice_text\x18) \x01(\tH\x00R\n" +
+	"choiceText\x12!\n" +
+	"\vchoice_data\x18* \x01(\fH\x00R\n" +
+	"choiceData\x12/\n" +
+	"\n" +
+	"choice_msg\x18+ \x01(\v2\x0e.OpaqueMessageH\x00R\tchoiceMsg\x12.\n" +
+	"\vchoice_kind\x18, \x01(\x0e2\v.OpaqueKindH\x00R\n" +
+	"choiceKind\x123\n" +
+	"\tdelimited\x182 \x01(\v2\x0e.OpaqueMessageB\x05\xaa\x01\x02(\x02R\tdelimited\x12&\n" +
+	"\vrequired_id\x183 \x01(\x05B\x05\xaa\x01\x02\b\x03R\n" +
+	"requiredId\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aG\n" +
+	"\tByIdEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.OpaqueMessageR\x05value:\x028\x01\x1a>\n" +
+	"\x10BlobsByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01B\b\n" +
+	"\x06choice\"\x8b\x03\n" +
+	"\fOpaquePooled\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12.\n" +
+	"\bchildren\x18\x02 \x03(\v2\x12.OpaquePooledChildR\bchildren\x122\n" +
+	"\aby_name\x18\x03 \x03(\v2\x19.OpaquePooled.ByNameEntryR\x06byName\x12(\n" +
+	"\x05child\x18\x04 \x01(\v2\x12.OpaquePooledChildR\x05child\x12\x14\n" +
+	"\x05names\x18\x05 \x03(\tR\x05names\x12,\n" +
+	"\x06picked\x18\x06 \x01(\v2\x12.OpaquePooledChildH\x00R\x06picked\x12\x14\n" +
+	"\x04text\x18\a \x01(\tH\x00R\x04text\x12$\n" +
+	"\x05other\x18\b \x01(\v2\x0e.OpaqueMessageR\x05other\x1aM\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.OpaquePooledChildR\x05value:\x028\x01:\x04\xa8\xa6\x1f\x01B\b\n" +
+	"\x06choice\"-\n" +
+	"\x11OpaquePooledChild\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name:\x04\xa8\xa6\x1f\x01*O\n" +
+	"\n" +
+	"OpaqueKind\x12\x1b\n" +
+	"\x17OPAQUE_KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rOPAQUE_KIND_A\x10\x01\x12\x11\n" +
+	"\rOPAQUE_KIND_B\x10\x02B\x1cZ\x12testproto/editions\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_editions_opaque_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_editions_opaque_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_editions_opaque_proto_goTypes = []any{
+	(OpaqueKind)(0),               // 0: OpaqueKind
+	(*OpaqueMessage)(nil),         // 1: OpaqueMessage
+	(*OpaqueMessageWithLazy)(nil), // 2: OpaqueMessageWithLazy
+	(*OpaqueFields)(nil),          // 3: OpaqueFields
+	(*OpaquePooled)(nil),          // 4: OpaquePooled
+	(*OpaquePooledChild)(nil),     // 5: OpaquePooledChild
+	nil,                           // 6: OpaqueFields.CountsEntry
+	nil,                           // 7: OpaqueFields.ByIdEntry
+	nil,                           // 8: OpaqueFields.BlobsByNameEntry
+	nil,                           // 9: OpaquePooled.ByNameEntry
+}
+var file_editions_opaque_proto_depIdxs = []int32{
+	1,  // 0: OpaqueMessageWithLazy.nested:type_name -> OpaqueMessage
+	0,  // 1: OpaqueFields.kind:type_name -> OpaqueKind
+	1,  // 2: OpaqueFields.msg:type_name -> OpaqueMessage
+	0,  // 3: OpaqueFields.implicit_kind:type_name -> OpaqueKind
+	1,  // 4: OpaqueFields.msgs:type_name -> OpaqueMessage
+	0,  // 5: OpaqueFields.kinds:type_name -> OpaqueKind
+	6,  // 6: OpaqueFields.counts:type_name -> OpaqueFields.CountsEntry
+	7,  // 7: OpaqueFields.by_id:type_name -> OpaqueFields.ByIdEntry
+	8,  // 8: OpaqueFields.blobs_by_name:type_name -> OpaqueFields.BlobsByNameEntry
+	1,  // 9: OpaqueFields.choice_msg:type_name -> OpaqueMessage
+	0,  // 10: OpaqueFields.choice_kind:type_name -> OpaqueKind
+	1,  // 11: OpaqueFields.delimited:type_name -> OpaqueMessage
+	5,  // 12: OpaquePooled.children:type_name -> OpaquePooledChild
+	9,  // 13: OpaquePooled.by_name:type_name -> OpaquePooled.ByNameEntry
+	5,  // 14: OpaquePooled.child:type_name -> OpaquePooledChild
+	5,  // 15: OpaquePooled.picked:type_name -> OpaquePooledChild
+	1,  // 16: OpaquePooled.other:type_name -> OpaqueMessage
+	1,  // 17: OpaqueFields.ByIdEntry.value:type_name -> OpaqueMessage
+	5,  // 18: OpaquePooled.ByNameEntry.value:type_name -> OpaquePooledChild
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_editions_opaque_proto_init() }
+func file_editions_opaque_proto_init() {
+	if File_editions_opaque_proto != nil {
+		return
+	}
+	file_editions_opaque_proto_msgTypes[2].OneofWrappers = []any{
+		(*opaqueFields_ChoiceNum)(nil),
+		(*opaqueFields_ChoiceText)(nil),
+		(*opaqueFields_ChoiceData)(nil),
+		(*opaqueFields_ChoiceMsg)(nil),
+		(*opaqueFields_ChoiceKind)(nil),
+	}
+	file_editions_opaque_proto_msgTypes[3].OneofWrappers = []any{
+		(*opaquePooled_Picked)(nil),
+		(*opaquePooled_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_editions_opaque_proto_rawDesc), len(file_editions_opaque_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_editions_opaque_proto_goTypes,
+		DependencyIndexes: file_editions_opaque_proto_depIdxs,
+		EnumInfos:         file_editions_opaque_proto_enumTypes,
+		MessageInfos:      file_editions_opaque_proto_msgTypes,
+	}.Build()
+	File_editions_opaque_proto = out.File
+	file_editions_opaque_proto_goTypes = nil
+	file_editions_opaque_proto_depIdxs = nil
+}
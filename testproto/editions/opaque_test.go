@@ -0,0 +1,257 @@
+package editions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func newOpaqueFields() *OpaqueFields {
+	return OpaqueFields_builder{
+		I32:          proto.Int32(-1),
+		S64:          proto.Int64(-2),
+		F32:          proto.Uint32(3),
+		D:            proto.Float64(4.5),
+		B:            proto.Bool(false),
+		S:            proto.String(""),
+		By:           []byte{},
+		Kind:         OpaqueKind_OPAQUE_KIND_UNSPECIFIED.Enum(),
+		Msg:          OpaqueMessage_builder{Id: proto.Int32(1), Name: proto.String("msg")}.Build(),
+		ImplicitI64:  64,
+		ImplicitF:    1.5,
+		ImplicitS:    "implicit",
+		ImplicitBy:   []byte("by"),
+		ImplicitKind: OpaqueKind_OPAQUE_KIND_B,
+		Packed:       []int32{1, -2, 300},
+		PackedFixed:  []int64{-1, 2},
+		Expanded:     []uint32{7, 8},
+		Strings:      []string{"a", "", "c"},
+		Blobs:        [][]byte{[]byte("x"), {}},
+		Msgs:         []*OpaqueMessage{OpaqueMessage_builder{Id: proto.Int32(2)}.Build(), {}},
+		Kinds:        []OpaqueKind{OpaqueKind_OPAQUE_KIND_A, 7},
+		Counts:       map[string]int64{"a": 1, "b": 0},
+		ById:         map[int32]*OpaqueMessage{1: OpaqueMessage_builder{Data: []byte("d")}.Build()},
+		BlobsByName:  map[string][]byte{"blob": []byte("blob")},
+		ChoiceMsg:    OpaqueMessage_builder{Name: proto.String("choice")}.Build(),
+		Delimited:    OpaqueMessage_builder{Id: proto.Int32(3)}.Build(),
+		RequiredId:   proto.Int32(0),
+	}.Build()
+}
+
+// TestOpaqueRoundTrip tests that the VT methods of messages using the opaque API, which
+// go through their accessors, agree with the protobuf runtime
+func TestOpaqueRoundTrip(t *testing.T) {
+	choices := map[string]func(*OpaqueFields){
+		"msg":  func(*OpaqueFields) {},
+		"num":  func(m *OpaqueFields) { m.SetChoiceNum(0) },
+		"text": func(m *OpaqueFields) { m.SetChoiceText("text") },
+		"data": func(m *OpaqueFields) { m.SetChoiceData(nil) },
+		"kind": func(m *OpaqueFields) { m.SetChoiceKind(OpaqueKind_OPAQUE_KIND_A) },
+		"none": func(m *OpaqueFields) { m.ClearChoice() },
+	}
+	for name, set := range choices {
+		t.Run(name, func(t *testing.T) {
+			original := newOpaqueFields()
+			set(original)
+
+			data, err := original.MarshalVT()
+			require.NoError(t, err)
+			require.Equal(t, proto.Size(original), original.SizeVT())
+			require.Len(t, data, original.SizeVT())
+
+			expected := &OpaqueFields{}
+			require.NoError(t, proto.Unmarshal(data, expected))
+			require.True(t, proto.Equal(original, expected))
+
+			decoded := &OpaqueFields{}
+			require.NoError(t, decoded.UnmarshalVT(data))
+			require.True(t, proto.Equal(original, decoded))
+			require.True(t, original.EqualVT(decoded))
+
+			deterministic, err := original.MarshalVTDeterministic()
+			require.NoError(t, err)
+			canonical, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+			require.NoError(t, err)
+			require.Equal(t, canonical, deterministic)
+
+			clone := original.CloneVT()
+			require.True(t, proto.Equal(original, clone))
+			require.True(t, original.EqualVT(clone))
+		})
+	}
+}
+
+func TestOpaqueEqual(t *testing.T) {
+	for name, change := range map[string]func(*OpaqueFields){
+		"explicitZero": func(m *OpaqueFields) { m.ClearI32() },
+		"explicitBool": func(m *OpaqueFields) { m.ClearB() },
+		"explicitByte": func(m *OpaqueFields) { m.ClearBy() },
+		"implicit":     func(m *OpaqueFields) { m.SetImplicitS("") },
+		"message":      func(m *OpaqueFields) { m.GetMsg().SetId(2) },
+		"packed":       func(m *OpaqueFields) { m.SetPacked(m.GetPacked()[1:]) },
+		"map":          func(m *OpaqueFields) { m.GetCounts()["c"] = 3 },
+		"oneofCase":    func(m *OpaqueFields) { m.SetChoiceText("choice") },
+		"oneofValue":   func(m *OpaqueFields) { m.GetChoiceMsg().SetName("other") },
+		"delimited":    func(m *OpaqueFields) { m.ClearDelimited() },
+	} {
+		m := newOpaqueFields()
+		change(m)
+		require.False(t, newOpaqueFields().EqualVT(m), name)
+		require.False(t, m.EqualVT(newOpaqueFields()), name)
+	}
+
+	// The clones do not share the values of the original.
+	original := newOpaqueFields()
+	clone := original.CloneVT()
+	clone.GetMsg().SetId(7)
+	clone.GetMsgs()[0].SetId(7)
+	clone.GetCounts()["a"] = 7
+	clone.GetImplicitBy()[0] = 'X'
+	require.True(t, newOpaqueFields().EqualVT(original))
+}
+
+func TestOpaqueUnmarshal(t *testing.T) {
+	// The fields of messages are merged, the last value of scalars wins and the elements
+	// of repeated fields are appended.
+	first, err := OpaqueFields_builder{
+		I32:        proto.Int32(1),
+		Packed:     []int32{1},
+		Msg:        OpaqueMessage_builder{Id: proto.Int32(1)}.Build(),
+		RequiredId: proto.Int32(1),
+	}.Build().MarshalVT()
+	require.NoError(t, err)
+	second, err := OpaqueFields_builder{
+		I32:        proto.Int32(2),
+		Packed:     []int32{2},
+		Msg:        OpaqueMessage_builder{Name: proto.String("name")}.Build(),
+		RequiredId: proto.Int32(1),
+	}.Build().MarshalVT()
+	require.NoError(t, err)
+
+	decoded := &OpaqueFields{}
+	require.NoError(t, decoded.UnmarshalVT(append(first, second...)))
+	expected := &OpaqueFields{}
+	require.NoError(t, proto.Unmarshal(append(first, second...), expected))
+	require.True(t, proto.Equal(expected, decoded))
+	require.Equal(t, int32(2), decoded.GetI32())
+	require.Equal(t, []int32{1, 2}, decoded.GetPacked())
+	require.Equal(t, int32(1), decoded.GetMsg().GetId())
+	require.Equal(t, "name", decoded.GetMsg().GetName())
+
+	// Repeated scalars decode whether they are packed or not.
+	var data []byte
+	for _, v := range []uint64{1, 2} {
+		data = protowire.AppendTag(data, 20, protowire.VarintType)
+		data = protowire.AppendVarint(data, v)
+	}
+	data = protowire.AppendTag(data, 22, protowire.BytesType)
+	data = protowire.AppendBytes(data, protowire.AppendVarint(protowire.AppendVarint(nil, 3), 4))
+	data = protowire.AppendTag(data, 51, protowire.VarintType)
+	data = protowire.AppendVarint(data, 0)
+	decoded = &OpaqueFields{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.Equal(t, []int32{1, 2}, decoded.GetPacked())
+	require.Equal(t, []uint32{3, 4}, decoded.GetExpanded())
+
+	// Unknown fields are kept.
+	data = protowire.AppendTag(data, 100, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	decoded = &OpaqueFields{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.Equal(t, protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 1), []byte(decoded.ProtoReflect().GetUnknown()))
+
+	err = (&OpaqueFields{}).UnmarshalVT(nil)
+	require.ErrorContains(t, err, "required field required_id not set")
+
+	_, err = (&OpaqueFields{}).MarshalVTStrict()
+	require.Error(t, err)
+}
+
+func TestOpaqueLazy(t *testing.T) {
+	original := OpaqueMessageWithLazy_builder{
+		Id:     proto.Int32(1),
+		Nested: OpaqueMessage_builder{Id: proto.Int32(2), Name: proto.String("nested")}.Build(),
+	}.Build()
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(original), original.SizeVT())
+
+	// Messages decoded by the protobuf runtime hold their lazy fields undecoded.
+	lazy := &OpaqueMessageWithLazy{}
+	require.NoError(t, proto.Unmarshal(data, lazy))
+	again, err := lazy.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, data, again)
+	require.True(t, lazy.EqualVT(original))
+	require.True(t, lazy.CloneVT().EqualVT(original))
+
+	decoded := &OpaqueMessageWithLazy{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, proto.Equal(original, decoded))
+}
+
+// freelist is a deterministic pool backend.
+type freelist struct {
+	free []any
+}
+
+func (f *freelist) Get() any {
+	if len(f.free) == 0 {
+		return nil
+	}
+	v := f.free[len(f.free)-1]
+	f.free = f.free[:len(f.free)-1]
+	return v
+}
+
+func (f *freelist) Put(v any) { f.free = append(f.free, v) }
+
+func TestOpaquePool(t *testing.T) {
+	children := &freelist{}
+	(*OpaquePooledChild)(nil).SetVTPoolBackend(children)
+	defer (*OpaquePooledChild)(nil).SetVTPoolBackend(nil)
+
+	original := OpaquePooled_builder{
+		Id:       proto.Int32(1),
+		Children: []*OpaquePooledChild{OpaquePooledChild_builder{Name: proto.String("a")}.Build()},
+		ByName:   map[string]*OpaquePooledChild{"b": OpaquePooledChild_builder{Name: proto.String("b")}.Build()},
+		Child:    OpaquePooledChild_builder{Name: proto.String("c")}.Build(),
+		Names:    []string{"d"},
+		Picked:   OpaquePooledChild_builder{Name: proto.String("e")}.Build(),
+		Other:    OpaqueMessage_builder{Id: proto.Int32(2)}.Build(),
+	}.Build()
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	m := OpaquePooledFromVTPool()
+	require.NoError(t, m.UnmarshalVT(data))
+	require.True(t, proto.Equal(original, m))
+	element, names := m.GetChildren()[0], m.GetNames()
+
+	// The presence of the fields is cleared, the lists and maps are kept empty, and the
+	// pooled messages go back to their pool.
+	m.ResetVT()
+	require.True(t, proto.Equal(&OpaquePooled{}, m))
+	require.False(t, m.HasId())
+	require.False(t, m.HasChild())
+	require.False(t, m.HasChoice())
+	require.False(t, m.HasOther())
+	require.Empty(t, m.GetChildren())
+	require.NotNil(t, m.GetByName())
+	require.Len(t, children.free, 3)
+	require.Empty(t, element.GetName())
+
+	// Unmarshal reuses the elements of lists, and takes the other messages from their pools.
+	require.NoError(t, m.UnmarshalVT(data))
+	require.True(t, proto.Equal(original, m))
+	require.Same(t, element, m.GetChildren()[0])
+	require.Same(t, &names[:1][0], &m.GetNames()[0])
+	require.Empty(t, children.free)
+
+	m.ReleaseMapVT()
+	require.Empty(t, m.GetByName())
+	require.Len(t, children.free, 1)
+	m.ReturnToVTPool()
+}
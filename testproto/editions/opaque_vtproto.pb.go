@@ -0,0 +1,8919 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: editions/opaque.proto
+
+package editions
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	maps "maps"
+	math "math"
+	slices "slices"
+	sort "sort"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *OpaqueMessage) CloneVT() *OpaqueMessage {
+	if m == nil {
+		return (*OpaqueMessage)(nil)
+	}
+	r := new(OpaqueMessage)
+	if m.HasId() {
+		r.SetId(m.GetId())
+	}
+	if m.HasName() {
+		r.SetName(m.GetName())
+	}
+	if rhs := m.GetData(); rhs != nil {
+		var tmp []byte
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		tmp = tmpBytes
+		r.SetData(tmp)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *OpaqueMessage) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// OpaqueMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func OpaqueMessageCloneSliceVT(in []*OpaqueMessage) []*OpaqueMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OpaqueMessage, len(in))
+	clones := make([]OpaqueMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.HasId() {
+			r.SetId(m.GetId())
+		}
+		if m.HasName() {
+			r.SetName(m.GetName())
+		}
+		if rhs := m.GetData(); rhs != nil {
+			var tmp []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmp = tmpBytes
+			r.SetData(tmp)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *OpaqueMessageWithLazy) CloneVT() *OpaqueMessageWithLazy {
+	if m == nil {
+		return (*OpaqueMessageWithLazy)(nil)
+	}
+	r := new(OpaqueMessageWithLazy)
+	if m.HasId() {
+		r.SetId(m.GetId())
+	}
+	if rhs := m.GetNested(); rhs != nil {
+		var tmp *OpaqueMessage
+		tmp = rhs.CloneVT()
+		r.SetNested(tmp)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *OpaqueMessageWithLazy) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// OpaqueMessageWithLazyCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func OpaqueMessageWithLazyCloneSliceVT(in []*OpaqueMessageWithLazy) []*OpaqueMessageWithLazy {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OpaqueMessageWithLazy, len(in))
+	clones := make([]OpaqueMessageWithLazy, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.HasId() {
+			r.SetId(m.GetId())
+		}
+		if rhs := m.GetNested(); rhs != nil {
+			var tmp *OpaqueMessage
+			tmp = rhs.CloneVT()
+			r.SetNested(tmp)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *OpaqueFields) CloneVT() *OpaqueFields {
+	if m == nil {
+		return (*OpaqueFields)(nil)
+	}
+	r := new(OpaqueFields)
+	if m.HasI32() {
+		r.SetI32(m.GetI32())
+	}
+	if m.HasS64() {
+		r.SetS64(m.GetS64())
+	}
+	if m.HasF32() {
+		r.SetF32(m.GetF32())
+	}
+	if m.HasD() {
+		r.SetD(m.GetD())
+	}
+	if m.HasB() {
+		r.SetB(m.GetB())
+	}
+	if m.HasS() {
+		r.SetS(m.GetS())
+	}
+	if rhs := m.GetBy(); rhs != nil {
+		var tmp []byte
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		tmp = tmpBytes
+		r.SetBy(tmp)
+	}
+	if m.HasKind() {
+		r.SetKind(m.GetKind())
+	}
+	if rhs := m.GetMsg(); rhs != nil {
+		var tmp *OpaqueMessage
+		tmp = rhs.CloneVT()
+		r.SetMsg(tmp)
+	}
+	r.SetImplicitI64(m.GetImplicitI64())
+	r.SetImplicitF(m.GetImplicitF())
+	r.SetImplicitS(m.GetImplicitS())
+	if rhs := m.GetImplicitBy(); rhs != nil {
+		var tmp []byte
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		tmp = tmpBytes
+		r.SetImplicitBy(tmp)
+	}
+	r.SetImplicitKind(m.GetImplicitKind())
+	if rhs := m.GetPacked(); rhs != nil {
+		var tmp []int32
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetPacked(tmp)
+	}
+	if rhs := m.GetPackedFixed(); rhs != nil {
+		var tmp []int64
+		tmpContainer := make([]int64, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetPackedFixed(tmp)
+	}
+	if rhs := m.GetExpanded(); rhs != nil {
+		var tmp []uint32
+		tmpContainer := make([]uint32, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetExpanded(tmp)
+	}
+	if rhs := m.GetStrings(); rhs != nil {
+		var tmp []string
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetStrings(tmp)
+	}
+	if rhs := m.GetBlobs(); rhs != nil {
+		var tmp [][]byte
+		tmpContainer := make([][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		tmp = tmpContainer
+		r.SetBlobs(tmp)
+	}
+	if rhs := m.GetMsgs(); rhs != nil {
+		var tmp []*OpaqueMessage
+		tmpContainer := make([]*OpaqueMessage, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		tmp = tmpContainer
+		r.SetMsgs(tmp)
+	}
+	if rhs := m.GetKinds(); rhs != nil {
+		var tmp []OpaqueKind
+		tmpContainer := make([]OpaqueKind, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetKinds(tmp)
+	}
+	if rhs := m.GetCounts(); rhs != nil {
+		var tmp map[string]int64
+		tmp = maps.Clone(rhs)
+		r.SetCounts(tmp)
+	}
+	if rhs := m.GetById(); rhs != nil {
+		var tmp map[int32]*OpaqueMessage
+		tmpContainer := make(map[int32]*OpaqueMessage, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		tmp = tmpContainer
+		r.SetById(tmp)
+	}
+	if rhs := m.GetBlobsByName(); rhs != nil {
+		var tmp map[string][]byte
+		tmpContainer := make(map[string][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		tmp = tmpContainer
+		r.SetBlobsByName(tmp)
+	}
+	switch m.WhichChoice() {
+	case OpaqueFields_ChoiceNum_case:
+		r.SetChoiceNum(m.GetChoiceNum())
+	case OpaqueFields_ChoiceText_case:
+		r.SetChoiceText(m.GetChoiceText())
+	case OpaqueFields_ChoiceData_case:
+		rhs := m.GetChoiceData()
+		var tmp []byte
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		tmp = tmpBytes
+		r.SetChoiceData(tmp)
+	case OpaqueFields_ChoiceMsg_case:
+		rhs := m.GetChoiceMsg()
+		var tmp *OpaqueMessage
+		tmp = rhs.CloneVT()
+		r.SetChoiceMsg(tmp)
+	case OpaqueFields_ChoiceKind_case:
+		r.SetChoiceKind(m.GetChoiceKind())
+	}
+	if rhs := m.GetDelimited(); rhs != nil {
+		var tmp *OpaqueMessage
+		tmp = rhs.CloneVT()
+		r.SetDelimited(tmp)
+	}
+	if m.HasRequiredId() {
+		r.SetRequiredId(m.GetRequiredId())
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *OpaqueFields) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// OpaqueFieldsCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func OpaqueFieldsCloneSliceVT(in []*OpaqueFields) []*OpaqueFields {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OpaqueFields, len(in))
+	clones := make([]OpaqueFields, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.HasI32() {
+			r.SetI32(m.GetI32())
+		}
+		if m.HasS64() {
+			r.SetS64(m.GetS64())
+		}
+		if m.HasF32() {
+			r.SetF32(m.GetF32())
+		}
+		if m.HasD() {
+			r.SetD(m.GetD())
+		}
+		if m.HasB() {
+			r.SetB(m.GetB())
+		}
+		if m.HasS() {
+			r.SetS(m.GetS())
+		}
+		if rhs := m.GetBy(); rhs != nil {
+			var tmp []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmp = tmpBytes
+			r.SetBy(tmp)
+		}
+		if m.HasKind() {
+			r.SetKind(m.GetKind())
+		}
+		if rhs := m.GetMsg(); rhs != nil {
+			var tmp *OpaqueMessage
+			tmp = rhs.CloneVT()
+			r.SetMsg(tmp)
+		}
+		r.SetImplicitI64(m.GetImplicitI64())
+		r.SetImplicitF(m.GetImplicitF())
+		r.SetImplicitS(m.GetImplicitS())
+		if rhs := m.GetImplicitBy(); rhs != nil {
+			var tmp []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmp = tmpBytes
+			r.SetImplicitBy(tmp)
+		}
+		r.SetImplicitKind(m.GetImplicitKind())
+		if rhs := m.GetPacked(); rhs != nil {
+			var tmp []int32
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetPacked(tmp)
+		}
+		if rhs := m.GetPackedFixed(); rhs != nil {
+			var tmp []int64
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetPackedFixed(tmp)
+		}
+		if rhs := m.GetExpanded(); rhs != nil {
+			var tmp []uint32
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetExpanded(tmp)
+		}
+		if rhs := m.GetStrings(); rhs != nil {
+			var tmp []string
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetStrings(tmp)
+		}
+		if rhs := m.GetBlobs(); rhs != nil {
+			var tmp [][]byte
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			tmp = tmpContainer
+			r.SetBlobs(tmp)
+		}
+		if rhs := m.GetMsgs(); rhs != nil {
+			var tmp []*OpaqueMessage
+			tmpContainer := make([]*OpaqueMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			tmp = tmpContainer
+			r.SetMsgs(tmp)
+		}
+		if rhs := m.GetKinds(); rhs != nil {
+			var tmp []OpaqueKind
+			tmpContainer := make([]OpaqueKind, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetKinds(tmp)
+		}
+		if rhs := m.GetCounts(); rhs != nil {
+			var tmp map[string]int64
+			tmp = maps.Clone(rhs)
+			r.SetCounts(tmp)
+		}
+		if rhs := m.GetById(); rhs != nil {
+			var tmp map[int32]*OpaqueMessage
+			tmpContainer := make(map[int32]*OpaqueMessage, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			tmp = tmpContainer
+			r.SetById(tmp)
+		}
+		if rhs := m.GetBlobsByName(); rhs != nil {
+			var tmp map[string][]byte
+			tmpContainer := make(map[string][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			tmp = tmpContainer
+			r.SetBlobsByName(tmp)
+		}
+		switch m.WhichChoice() {
+		case OpaqueFields_ChoiceNum_case:
+			r.SetChoiceNum(m.GetChoiceNum())
+		case OpaqueFields_ChoiceText_case:
+			r.SetChoiceText(m.GetChoiceText())
+		case OpaqueFields_ChoiceData_case:
+			rhs := m.GetChoiceData()
+			var tmp []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmp = tmpBytes
+			r.SetChoiceData(tmp)
+		case OpaqueFields_ChoiceMsg_case:
+			rhs := m.GetChoiceMsg()
+			var tmp *OpaqueMessage
+			tmp = rhs.CloneVT()
+			r.SetChoiceMsg(tmp)
+		case OpaqueFields_ChoiceKind_case:
+			r.SetChoiceKind(m.GetChoiceKind())
+		}
+		if rhs := m.GetDelimited(); rhs != nil {
+			var tmp *OpaqueMessage
+			tmp = rhs.CloneVT()
+			r.SetDelimited(tmp)
+		}
+		if m.HasRequiredId() {
+			r.SetRequiredId(m.GetRequiredId())
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *OpaquePooled) CloneVT() *OpaquePooled {
+	if m == nil {
+		return (*OpaquePooled)(nil)
+	}
+	r := OpaquePooledFromVTPool()
+	if m.HasId() {
+		r.SetId(m.GetId())
+	}
+	if rhs := m.GetChildren(); rhs != nil {
+		var tmp []*OpaquePooledChild
+		tmpContainer := make([]*OpaquePooledChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		tmp = tmpContainer
+		r.SetChildren(tmp)
+	}
+	if rhs := m.GetByName(); rhs != nil {
+		var tmp map[string]*OpaquePooledChild
+		tmpContainer := make(map[string]*OpaquePooledChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		tmp = tmpContainer
+		r.SetByName(tmp)
+	}
+	if rhs := m.GetChild(); rhs != nil {
+		var tmp *OpaquePooledChild
+		tmp = rhs.CloneVT()
+		r.SetChild(tmp)
+	}
+	if rhs := m.GetNames(); rhs != nil {
+		var tmp []string
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		tmp = tmpContainer
+		r.SetNames(tmp)
+	}
+	switch m.WhichChoice() {
+	case OpaquePooled_Picked_case:
+		rhs := m.GetPicked()
+		var tmp *OpaquePooledChild
+		tmp = rhs.CloneVT()
+		r.SetPicked(tmp)
+	case OpaquePooled_Text_case:
+		r.SetText(m.GetText())
+	}
+	if rhs := m.GetOther(); rhs != nil {
+		var tmp *OpaqueMessage
+		tmp = rhs.CloneVT()
+		r.SetOther(tmp)
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *OpaquePooled) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// OpaquePooledCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OpaquePooled.
+func OpaquePooledCloneSliceVT(in []*OpaquePooled) []*OpaquePooled {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OpaquePooled, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OpaquePooledFromVTPool()
+		if m.HasId() {
+			r.SetId(m.GetId())
+		}
+		if rhs := m.GetChildren(); rhs != nil {
+			var tmp []*OpaquePooledChild
+			tmpContainer := make([]*OpaquePooledChild, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			tmp = tmpContainer
+			r.SetChildren(tmp)
+		}
+		if rhs := m.GetByName(); rhs != nil {
+			var tmp map[string]*OpaquePooledChild
+			tmpContainer := make(map[string]*OpaquePooledChild, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			tmp = tmpContainer
+			r.SetByName(tmp)
+		}
+		if rhs := m.GetChild(); rhs != nil {
+			var tmp *OpaquePooledChild
+			tmp = rhs.CloneVT()
+			r.SetChild(tmp)
+		}
+		if rhs := m.GetNames(); rhs != nil {
+			var tmp []string
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			tmp = tmpContainer
+			r.SetNames(tmp)
+		}
+		switch m.WhichChoice() {
+		case OpaquePooled_Picked_case:
+			rhs := m.GetPicked()
+			var tmp *OpaquePooledChild
+			tmp = rhs.CloneVT()
+			r.SetPicked(tmp)
+		case OpaquePooled_Text_case:
+			r.SetText(m.GetText())
+		}
+		if rhs := m.GetOther(); rhs != nil {
+			var tmp *OpaqueMessage
+			tmp = rhs.CloneVT()
+			r.SetOther(tmp)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *OpaquePooledChild) CloneVT() *OpaquePooledChild {
+	if m == nil {
+		return (*OpaquePooledChild)(nil)
+	}
+	r := OpaquePooledChildFromVTPool()
+	if m.HasName() {
+		r.SetName(m.GetName())
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *OpaquePooledChild) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// OpaquePooledChildCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OpaquePooledChild.
+func OpaquePooledChildCloneSliceVT(in []*OpaquePooledChild) []*OpaquePooledChild {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OpaquePooledChild, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OpaquePooledChildFromVTPool()
+		if m.HasName() {
+			r.SetName(m.GetName())
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (this *OpaqueMessage) EqualVT(that *OpaqueMessage) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.HasId() != that.HasId() {
+		return false
+	}
+	if this.GetId() != that.GetId() {
+		return false
+	}
+	if this.HasName() != that.HasName() {
+		return false
+	}
+	if this.GetName() != that.GetName() {
+		return false
+	}
+	if this.HasData() != that.HasData() {
+		return false
+	}
+	if string(this.GetData()) != string(that.GetData()) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OpaqueMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OpaqueMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *OpaqueMessageWithLazy) EqualVT(that *OpaqueMessageWithLazy) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.HasId() != that.HasId() {
+		return false
+	}
+	if this.GetId() != that.GetId() {
+		return false
+	}
+	if !this.GetNested().EqualVT(that.GetNested()) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OpaqueMessageWithLazy) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OpaqueMessageWithLazy)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *OpaqueFields) EqualVT(that *OpaqueFields) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.WhichChoice() != that.WhichChoice() {
+		return false
+	}
+	switch this.WhichChoice() {
+	case OpaqueFields_ChoiceNum_case:
+		if this.GetChoiceNum() != that.GetChoiceNum() {
+			return false
+		}
+	case OpaqueFields_ChoiceText_case:
+		if this.GetChoiceText() != that.GetChoiceText() {
+			return false
+		}
+	case OpaqueFields_ChoiceData_case:
+		if string(this.GetChoiceData()) != string(that.GetChoiceData()) {
+			return false
+		}
+	case OpaqueFields_ChoiceMsg_case:
+		if p, q := this.GetChoiceMsg(), that.GetChoiceMsg(); p != q {
+			if p == nil {
+				p = &OpaqueMessage{}
+			}
+			if q == nil {
+				q = &OpaqueMessage{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	case OpaqueFields_ChoiceKind_case:
+		if this.GetChoiceKind() != that.GetChoiceKind() {
+			return false
+		}
+	}
+	if this.HasI32() != that.HasI32() {
+		return false
+	}
+	if this.GetI32() != that.GetI32() {
+		return false
+	}
+	if this.HasS64() != that.HasS64() {
+		return false
+	}
+	if this.GetS64() != that.GetS64() {
+		return false
+	}
+	if this.HasF32() != that.HasF32() {
+		return false
+	}
+	if this.GetF32() != that.GetF32() {
+		return false
+	}
+	if this.HasD() != that.HasD() {
+		return false
+	}
+	if this.GetD() != that.GetD() {
+		return false
+	}
+	if this.HasB() != that.HasB() {
+		return false
+	}
+	if this.GetB() != that.GetB() {
+		return false
+	}
+	if this.HasS() != that.HasS() {
+		return false
+	}
+	if this.GetS() != that.GetS() {
+		return false
+	}
+	if this.HasBy() != that.HasBy() {
+		return false
+	}
+	if string(this.GetBy()) != string(that.GetBy()) {
+		return false
+	}
+	if this.HasKind() != that.HasKind() {
+		return false
+	}
+	if this.GetKind() != that.GetKind() {
+		return false
+	}
+	if !this.GetMsg().EqualVT(that.GetMsg()) {
+		return false
+	}
+	if this.GetImplicitI64() != that.GetImplicitI64() {
+		return false
+	}
+	if this.GetImplicitF() != that.GetImplicitF() {
+		return false
+	}
+	if this.GetImplicitS() != that.GetImplicitS() {
+		return false
+	}
+	if string(this.GetImplicitBy()) != string(that.GetImplicitBy()) {
+		return false
+	}
+	if this.GetImplicitKind() != that.GetImplicitKind() {
+		return false
+	}
+	if !slices.Equal(this.GetPacked(), that.GetPacked()) {
+		return false
+	}
+	if !slices.Equal(this.GetPackedFixed(), that.GetPackedFixed()) {
+		return false
+	}
+	if !slices.Equal(this.GetExpanded(), that.GetExpanded()) {
+		return false
+	}
+	if !slices.Equal(this.GetStrings(), that.GetStrings()) {
+		return false
+	}
+	if len(this.GetBlobs()) != len(that.GetBlobs()) {
+		return false
+	}
+	for i, vx := range this.GetBlobs() {
+		vy := that.GetBlobs()[i]
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
+	if len(this.GetMsgs()) != len(that.GetMsgs()) {
+		return false
+	}
+	for i, vx := range this.GetMsgs() {
+		vy := that.GetMsgs()[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &OpaqueMessage{}
+			}
+			if q == nil {
+				q = &OpaqueMessage{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if !slices.Equal(this.GetKinds(), that.GetKinds()) {
+		return false
+	}
+	if len(this.GetCounts()) != len(that.GetCounts()) {
+		return false
+	}
+	for i, vx := range this.GetCounts() {
+		vy, ok := that.GetCounts()[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.GetById()) != len(that.GetById()) {
+		return false
+	}
+	for i, vx := range this.GetById() {
+		vy, ok := that.GetById()[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &OpaqueMessage{}
+			}
+			if q == nil {
+				q = &OpaqueMessage{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.GetBlobsByName()) != len(that.GetBlobsByName()) {
+		return false
+	}
+	for i, vx := range this.GetBlobsByName() {
+		vy, ok := that.GetBlobsByName()[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
+	if !this.GetDelimited().EqualVT(that.GetDelimited()) {
+		return false
+	}
+	if this.HasRequiredId() != that.HasRequiredId() {
+		return false
+	}
+	if this.GetRequiredId() != that.GetRequiredId() {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OpaqueFields) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OpaqueFields)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *OpaquePooled) EqualVT(that *OpaquePooled) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.WhichChoice() != that.WhichChoice() {
+		return false
+	}
+	switch this.WhichChoice() {
+	case OpaquePooled_Picked_case:
+		if p, q := this.GetPicked(), that.GetPicked(); p != q {
+			if p == nil {
+				p = &OpaquePooledChild{}
+			}
+			if q == nil {
+				q = &OpaquePooledChild{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	case OpaquePooled_Text_case:
+		if this.GetText() != that.GetText() {
+			return false
+		}
+	}
+	if this.HasId() != that.HasId() {
+		return false
+	}
+	if this.GetId() != that.GetId() {
+		return false
+	}
+	if len(this.GetChildren()) != len(that.GetChildren()) {
+		return false
+	}
+	for i, vx := range this.GetChildren() {
+		vy := that.GetChildren()[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &OpaquePooledChild{}
+			}
+			if q == nil {
+				q = &OpaquePooledChild{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.GetByName()) != len(that.GetByName()) {
+		return false
+	}
+	for i, vx := range this.GetByName() {
+		vy, ok := that.GetByName()[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &OpaquePooledChild{}
+			}
+			if q == nil {
+				q = &OpaquePooledChild{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if !this.GetChild().EqualVT(that.GetChild()) {
+		return false
+	}
+	if !slices.Equal(this.GetNames(), that.GetNames()) {
+		return false
+	}
+	if !this.GetOther().EqualVT(that.GetOther()) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OpaquePooled) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OpaquePooled)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *OpaquePooledChild) EqualVT(that *OpaquePooledChild) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.HasName() != that.HasName() {
+		return false
+	}
+	if this.GetName() != that.GetName() {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OpaquePooledChild) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OpaquePooledChild)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (m *OpaqueMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OpaqueMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasData() {
+		i -= len(m.GetData())
+		copy(dAtA[i:], m.GetData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetData())))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasNested() {
+		size, err := m.GetNested().MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueFields) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueFields) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OpaqueFields) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch m.WhichChoice() {
+	case OpaqueFields_ChoiceNum_case:
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceNum()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	case OpaqueFields_ChoiceText_case:
+		i -= len(m.GetChoiceText())
+		copy(dAtA[i:], m.GetChoiceText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceText())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	case OpaqueFields_ChoiceData_case:
+		i -= len(m.GetChoiceData())
+		copy(dAtA[i:], m.GetChoiceData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceData())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	case OpaqueFields_ChoiceMsg_case:
+		if m.HasChoiceMsg() {
+			size, err := m.GetChoiceMsg().MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	case OpaqueFields_ChoiceKind_case:
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceKind()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if !m.HasRequiredId() {
+		return 0, fmt.Errorf("proto: required field required_id not set")
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetRequiredId()))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HasDelimited() {
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x94
+		size, err := m.GetDelimited().MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x93
+	}
+	if len(m.GetBlobsByName()) > 0 {
+		for k := range m.GetBlobsByName() {
+			v := m.GetBlobsByName()[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GetById()) > 0 {
+		for k := range m.GetById() {
+			v := m.GetById()[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.GetCounts()) > 0 {
+		for k := range m.GetCounts() {
+			v := m.GetCounts()[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.GetKinds()) > 0 {
+		var pksize2 int
+		for _, num := range m.GetKinds() {
+			pksize2 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.GetKinds() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.GetMsgs()) > 0 {
+		for iNdEx := len(m.GetMsgs()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetMsgs()[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.GetBlobs()) > 0 {
+		for iNdEx := len(m.GetBlobs()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetBlobs()[iNdEx])
+			copy(dAtA[i:], m.GetBlobs()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBlobs()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.GetStrings()) > 0 {
+		for iNdEx := len(m.GetStrings()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetStrings()[iNdEx])
+			copy(dAtA[i:], m.GetStrings()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetStrings()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.GetExpanded()) > 0 {
+		for iNdEx := len(m.GetExpanded()) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetExpanded()[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+	}
+	if len(m.GetPackedFixed()) > 0 {
+		for iNdEx := len(m.GetPackedFixed()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GetPackedFixed()[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetPackedFixed())*8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.GetPacked()) > 0 {
+		var pksize4 int
+		for _, num := range m.GetPacked() {
+			pksize4 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize4
+		j3 := i
+		for _, num1 := range m.GetPacked() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA[j3] = uint8(num)
+			j3++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.GetImplicitKind() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitKind()))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.GetImplicitBy()) > 0 {
+		i -= len(m.GetImplicitBy())
+		copy(dAtA[i:], m.GetImplicitBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitBy())))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.GetImplicitS()) > 0 {
+		i -= len(m.GetImplicitS())
+		copy(dAtA[i:], m.GetImplicitS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitS())))
+		i--
+		dAtA[i] = 0x62
+	}
+	if math.Float32bits(float32(m.GetImplicitF())) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GetImplicitF()))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.GetImplicitI64() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitI64()))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HasMsg() {
+		size, err := m.GetMsg().MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HasKind() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetKind()))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HasBy() {
+		i -= len(m.GetBy())
+		copy(dAtA[i:], m.GetBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBy())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasS() {
+		i -= len(m.GetS())
+		copy(dAtA[i:], m.GetS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetS())))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasB() {
+		i--
+		if m.GetB() {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasD() {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GetD()))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.HasF32() {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GetF32()))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.HasS64() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(m.GetS64())<<1)^uint64((m.GetS64()>>63))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasI32() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetI32()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooled) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooled) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OpaquePooled) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch m.WhichChoice() {
+	case OpaquePooled_Picked_case:
+		if m.HasPicked() {
+			size, err := m.GetPicked().MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x32
+		}
+	case OpaquePooled_Text_case:
+		i -= len(m.GetText())
+		copy(dAtA[i:], m.GetText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetText())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasOther() {
+		size, err := m.GetOther().MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.GetNames()) > 0 {
+		for iNdEx := len(m.GetNames()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetNames()[iNdEx])
+			copy(dAtA[i:], m.GetNames()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetNames()[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HasChild() {
+		size, err := m.GetChild().MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GetByName()) > 0 {
+		for k := range m.GetByName() {
+			v := m.GetByName()[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GetChildren()) > 0 {
+		for iNdEx := len(m.GetChildren()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetChildren()[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooledChild) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooledChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *OpaquePooledChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessage) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessage) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *OpaqueMessage) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasData() {
+		i -= len(m.GetData())
+		copy(dAtA[i:], m.GetData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetData())))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasNested() {
+		size, err := m.GetNested().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueFields) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueFields) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *OpaqueFields) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch m.WhichChoice() {
+	case OpaqueFields_ChoiceNum_case:
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceNum()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	case OpaqueFields_ChoiceText_case:
+		i -= len(m.GetChoiceText())
+		copy(dAtA[i:], m.GetChoiceText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceText())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	case OpaqueFields_ChoiceData_case:
+		i -= len(m.GetChoiceData())
+		copy(dAtA[i:], m.GetChoiceData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceData())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	case OpaqueFields_ChoiceMsg_case:
+		if m.HasChoiceMsg() {
+			size, err := m.GetChoiceMsg().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	case OpaqueFields_ChoiceKind_case:
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceKind()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if !m.HasRequiredId() {
+		return 0, fmt.Errorf("proto: required field required_id not set")
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetRequiredId()))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HasDelimited() {
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x94
+		size, err := m.GetDelimited().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x93
+	}
+	if len(m.GetBlobsByName()) > 0 {
+		keysForBlobsByName := make([]string, 0, len(m.GetBlobsByName()))
+		for k := range m.GetBlobsByName() {
+			keysForBlobsByName = append(keysForBlobsByName, string(k))
+		}
+		sort.Slice(keysForBlobsByName, func(i, j int) bool {
+			return keysForBlobsByName[i] < keysForBlobsByName[j]
+		})
+		for iNdEx := len(keysForBlobsByName) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.GetBlobsByName()[string(keysForBlobsByName[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForBlobsByName[iNdEx])
+			copy(dAtA[i:], keysForBlobsByName[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForBlobsByName[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GetById()) > 0 {
+		keysForById := make([]int32, 0, len(m.GetById()))
+		for k := range m.GetById() {
+			keysForById = append(keysForById, int32(k))
+		}
+		sort.Slice(keysForById, func(i, j int) bool {
+			return keysForById[i] < keysForById[j]
+		})
+		for iNdEx := len(keysForById) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.GetById()[int32(keysForById[iNdEx])]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(keysForById[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.GetCounts()) > 0 {
+		keysForCounts := make([]string, 0, len(m.GetCounts()))
+		for k := range m.GetCounts() {
+			keysForCounts = append(keysForCounts, string(k))
+		}
+		sort.Slice(keysForCounts, func(i, j int) bool {
+			return keysForCounts[i] < keysForCounts[j]
+		})
+		for iNdEx := len(keysForCounts) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.GetCounts()[string(keysForCounts[iNdEx])]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForCounts[iNdEx])
+			copy(dAtA[i:], keysForCounts[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForCounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.GetKinds()) > 0 {
+		var pksize2 int
+		for _, num := range m.GetKinds() {
+			pksize2 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.GetKinds() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.GetMsgs()) > 0 {
+		for iNdEx := len(m.GetMsgs()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetMsgs()[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.GetBlobs()) > 0 {
+		for iNdEx := len(m.GetBlobs()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetBlobs()[iNdEx])
+			copy(dAtA[i:], m.GetBlobs()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBlobs()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.GetStrings()) > 0 {
+		for iNdEx := len(m.GetStrings()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetStrings()[iNdEx])
+			copy(dAtA[i:], m.GetStrings()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetStrings()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.GetExpanded()) > 0 {
+		for iNdEx := len(m.GetExpanded()) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetExpanded()[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+	}
+	if len(m.GetPackedFixed()) > 0 {
+		for iNdEx := len(m.GetPackedFixed()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GetPackedFixed()[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetPackedFixed())*8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.GetPacked()) > 0 {
+		var pksize4 int
+		for _, num := range m.GetPacked() {
+			pksize4 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize4
+		j3 := i
+		for _, num1 := range m.GetPacked() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA[j3] = uint8(num)
+			j3++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.GetImplicitKind() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitKind()))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.GetImplicitBy()) > 0 {
+		i -= len(m.GetImplicitBy())
+		copy(dAtA[i:], m.GetImplicitBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitBy())))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.GetImplicitS()) > 0 {
+		i -= len(m.GetImplicitS())
+		copy(dAtA[i:], m.GetImplicitS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitS())))
+		i--
+		dAtA[i] = 0x62
+	}
+	if math.Float32bits(float32(m.GetImplicitF())) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GetImplicitF()))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.GetImplicitI64() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitI64()))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HasMsg() {
+		size, err := m.GetMsg().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HasKind() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetKind()))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HasBy() {
+		i -= len(m.GetBy())
+		copy(dAtA[i:], m.GetBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBy())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasS() {
+		i -= len(m.GetS())
+		copy(dAtA[i:], m.GetS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetS())))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasB() {
+		i--
+		if m.GetB() {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasD() {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GetD()))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.HasF32() {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GetF32()))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.HasS64() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(m.GetS64())<<1)^uint64((m.GetS64()>>63))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasI32() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetI32()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooled) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooled) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *OpaquePooled) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	switch m.WhichChoice() {
+	case OpaquePooled_Picked_case:
+		if m.HasPicked() {
+			size, err := m.GetPicked().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x32
+		}
+	case OpaquePooled_Text_case:
+		i -= len(m.GetText())
+		copy(dAtA[i:], m.GetText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetText())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasOther() {
+		size, err := m.GetOther().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.GetNames()) > 0 {
+		for iNdEx := len(m.GetNames()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetNames()[iNdEx])
+			copy(dAtA[i:], m.GetNames()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetNames()[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HasChild() {
+		size, err := m.GetChild().MarshalToSizedBufferVTDeterministic(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GetByName()) > 0 {
+		keysForByName := make([]string, 0, len(m.GetByName()))
+		for k := range m.GetByName() {
+			keysForByName = append(keysForByName, string(k))
+		}
+		sort.Slice(keysForByName, func(i, j int) bool {
+			return keysForByName[i] < keysForByName[j]
+		})
+		for iNdEx := len(keysForByName) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.GetByName()[string(keysForByName[iNdEx])]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForByName[iNdEx])
+			copy(dAtA[i:], keysForByName[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(keysForByName[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GetChildren()) > 0 {
+		for iNdEx := len(m.GetChildren()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetChildren()[iNdEx].MarshalToSizedBufferVTDeterministic(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooledChild) MarshalVTDeterministic() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooledChild) MarshalToVTDeterministic(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTDeterministic(dAtA[:size])
+}
+
+func (m *OpaquePooledChild) MarshalToSizedBufferVTDeterministic(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OpaqueMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasData() {
+		i -= len(m.GetData())
+		copy(dAtA[i:], m.GetData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetData())))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OpaqueMessageWithLazy) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasNested() {
+		size, err := m.GetNested().MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueFields) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaqueFields) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OpaqueFields) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if !m.HasRequiredId() {
+		return 0, fmt.Errorf("proto: required field required_id not set")
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetRequiredId()))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HasDelimited() {
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x94
+		size, err := m.GetDelimited().MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x93
+	}
+	if m.WhichChoice() == OpaqueFields_ChoiceKind_case {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceKind()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.WhichChoice() == OpaqueFields_ChoiceMsg_case {
+		if m.HasChoiceMsg() {
+			size, err := m.GetChoiceMsg().MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.WhichChoice() == OpaqueFields_ChoiceData_case {
+		i -= len(m.GetChoiceData())
+		copy(dAtA[i:], m.GetChoiceData())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceData())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.WhichChoice() == OpaqueFields_ChoiceText_case {
+		i -= len(m.GetChoiceText())
+		copy(dAtA[i:], m.GetChoiceText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetChoiceText())))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.WhichChoice() == OpaqueFields_ChoiceNum_case {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetChoiceNum()))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.GetBlobsByName()) > 0 {
+		for k := range m.GetBlobsByName() {
+			v := m.GetBlobsByName()[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.GetById()) > 0 {
+		for k := range m.GetById() {
+			v := m.GetById()[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.GetCounts()) > 0 {
+		for k := range m.GetCounts() {
+			v := m.GetCounts()[k]
+			baseI := i
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.GetKinds()) > 0 {
+		var pksize2 int
+		for _, num := range m.GetKinds() {
+			pksize2 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.GetKinds() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.GetMsgs()) > 0 {
+		for iNdEx := len(m.GetMsgs()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetMsgs()[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.GetBlobs()) > 0 {
+		for iNdEx := len(m.GetBlobs()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetBlobs()[iNdEx])
+			copy(dAtA[i:], m.GetBlobs()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBlobs()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.GetStrings()) > 0 {
+		for iNdEx := len(m.GetStrings()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetStrings()[iNdEx])
+			copy(dAtA[i:], m.GetStrings()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetStrings()[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.GetExpanded()) > 0 {
+		for iNdEx := len(m.GetExpanded()) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetExpanded()[iNdEx]))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+	}
+	if len(m.GetPackedFixed()) > 0 {
+		for iNdEx := len(m.GetPackedFixed()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.GetPackedFixed()[iNdEx]))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetPackedFixed())*8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.GetPacked()) > 0 {
+		var pksize4 int
+		for _, num := range m.GetPacked() {
+			pksize4 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize4
+		j3 := i
+		for _, num1 := range m.GetPacked() {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA[j3] = uint8(num)
+			j3++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.GetImplicitKind() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitKind()))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.GetImplicitBy()) > 0 {
+		i -= len(m.GetImplicitBy())
+		copy(dAtA[i:], m.GetImplicitBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitBy())))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.GetImplicitS()) > 0 {
+		i -= len(m.GetImplicitS())
+		copy(dAtA[i:], m.GetImplicitS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetImplicitS())))
+		i--
+		dAtA[i] = 0x62
+	}
+	if math.Float32bits(float32(m.GetImplicitF())) != 0 {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GetImplicitF()))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.GetImplicitI64() != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetImplicitI64()))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HasMsg() {
+		size, err := m.GetMsg().MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HasKind() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetKind()))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HasBy() {
+		i -= len(m.GetBy())
+		copy(dAtA[i:], m.GetBy())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetBy())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasS() {
+		i -= len(m.GetS())
+		copy(dAtA[i:], m.GetS())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetS())))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HasB() {
+		i--
+		if m.GetB() {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HasD() {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GetD()))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.HasF32() {
+		i -= 4
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GetF32()))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.HasS64() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64((uint64(m.GetS64())<<1)^uint64((m.GetS64()>>63))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasI32() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetI32()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooled) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooled) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OpaquePooled) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasOther() {
+		size, err := m.GetOther().MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WhichChoice() == OpaquePooled_Text_case {
+		i -= len(m.GetText())
+		copy(dAtA[i:], m.GetText())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetText())))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WhichChoice() == OpaquePooled_Picked_case {
+		if m.HasPicked() {
+			size, err := m.GetPicked().MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		} else {
+			i = protohelpers.EncodeVarint(dAtA, i, 0)
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GetNames()) > 0 {
+		for iNdEx := len(m.GetNames()) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GetNames()[iNdEx])
+			copy(dAtA[i:], m.GetNames()[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetNames()[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HasChild() {
+		size, err := m.GetChild().MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GetByName()) > 0 {
+		for k := range m.GetByName() {
+			v := m.GetByName()[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GetChildren()) > 0 {
+		for iNdEx := len(m.GetChildren()) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.GetChildren()[iNdEx].MarshalToSizedBufferVTStrict(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HasId() {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.GetId()))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaquePooledChild) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpaquePooledChild) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OpaquePooledChild) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.HasName() {
+		i -= len(m.GetName())
+		copy(dAtA[i:], m.GetName())
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.GetName())))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpaqueMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OpaqueMessageWithLazy) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OpaqueFields) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetI32(v)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S64", wireType)
+			}
+			var v int64
+			var vtemp uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			vtemp = (vtemp >> 1) ^ uint64((int64(vtemp&1)<<63)>>63)
+			v = int64(vtemp)
+			m.SetS64(v)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SetF32(v)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			var v float64
+			var vtemp uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v = math.Float64frombits(vtemp)
+			m.SetD(v)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v bool
+			var vtemp int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = bool(vtemp != 0)
+			m.SetB(v)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetS(v)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetBy(v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetKind(v)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetMsg(v)
+			}
+			if err := v.MergeFromWireVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitI64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitI64(v)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitF", wireType)
+			}
+			var v float32
+			var vtemp uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v = math.Float32frombits(vtemp)
+			m.SetImplicitF(v)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitS", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetImplicitS(v)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBy", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetImplicitBy(v)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitKind(v)
+		case 20:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetPacked(append(m.GetPacked(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPacked()
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetPacked(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packed", wireType)
+			}
+		case 21:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.SetPackedFixed(append(m.GetPackedFixed(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPackedFixed()
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					list = append(list, v)
+				}
+				m.SetPackedFixed(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetExpanded(append(m.GetExpanded(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetExpanded()
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetExpanded(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expanded", wireType)
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetStrings(append(m.GetStrings(), v))
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetBlobs(append(m.GetBlobs(), v))
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OpaqueMessage{}
+			if err := v.MergeFromWireVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SetMsgs(append(m.GetMsgs(), v))
+			iNdEx = postIndex
+		case 26:
+			if wireType == 0 {
+				var v OpaqueKind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OpaqueKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetKinds(append(m.GetKinds(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetKinds()
+				for iNdEx < postIndex {
+					var v OpaqueKind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OpaqueKind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetKinds(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetCounts()
+			if entries == nil {
+				entries = make(map[string]int64)
+				m.SetCounts(entries)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ById", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetById()
+			if entries == nil {
+				entries = make(map[int32]*OpaqueMessage)
+				m.SetById(entries)
+			}
+			var mapkey int32
+			var mapvalue *OpaqueMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OpaqueMessage{}
+					if err := mapvalue.MergeFromWireVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobsByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetBlobsByName()
+			if entries == nil {
+				entries = make(map[string][]byte)
+				m.SetBlobsByName(entries)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceNum", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceNum(v)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceText", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetChoiceText(v)
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceData", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetChoiceData(v)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetChoiceMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetChoiceMsg(v)
+			}
+			if err := v.MergeFromWireVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceKind(v)
+		case 50:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimited", wireType)
+			}
+			groupStart := iNdEx
+			for {
+				maybeGroupEnd := iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				groupWireType := int(groupFieldWire & 0x7)
+				if groupWireType == 4 {
+					v := m.GetDelimited()
+					if v == nil {
+						v = &OpaqueMessage{}
+						m.SetDelimited(v)
+					}
+					if err := v.MergeFromWireVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
+						return err
+					}
+					break
+				}
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				iNdEx = maybeGroupEnd + skippy
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetRequiredId(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if !m.HasRequiredId() {
+		return fmt.Errorf("proto: required field required_id not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaquePooled) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OpaquePooledChild) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+
+// ReleaseMapVT returns the message values of the maps of m to their pools, and
+// clears the maps. The values must not be used once they are released.
+func (m *OpaquePooled) ReleaseMapVT() {
+	if m == nil {
+		return
+	}
+	for _, mm := range m.GetByName() {
+		mm.ReturnToVTPool()
+	}
+	clear(m.GetByName())
+}
+
+func (m *OpaquePooled) ResetVT() {
+	if m != nil {
+		for _, mm := range m.GetChildren() {
+			mm.ResetVT()
+		}
+		f0 := m.GetChildren()[:0]
+		for _, mm := range m.GetByName() {
+			mm.ReturnToVTPool()
+		}
+		clear(m.GetByName())
+		f1 := m.GetByName()
+		m.GetChild().ReturnToVTPool()
+		clear(m.GetNames())
+		f2 := m.GetNames()[:0]
+		m.GetPicked().ReturnToVTPool()
+		*m = OpaquePooled{}
+		m.SetChildren(f0)
+		m.SetByName(f1)
+		m.SetNames(f2)
+	}
+}
+
+var vtprotoPool_OpaquePooled vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &OpaquePooled{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by OpaquePooledFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OpaquePooled) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OpaquePooled{} }}
+	}
+	vtprotoPool_OpaquePooled = b
+}
+func (m *OpaquePooled) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_OpaquePooled.Put(m)
+	}
+}
+func OpaquePooledFromVTPool() *OpaquePooled {
+	if m, ok := vtprotoPool_OpaquePooled.Get().(*OpaquePooled); ok {
+		return m
+	}
+	return &OpaquePooled{}
+}
+func (m *OpaquePooledChild) ResetVT() {
+	if m != nil {
+		*m = OpaquePooledChild{}
+	}
+}
+
+var vtprotoPool_OpaquePooledChild vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &OpaquePooledChild{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by OpaquePooledChildFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OpaquePooledChild) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OpaquePooledChild{} }}
+	}
+	vtprotoPool_OpaquePooledChild = b
+}
+func (m *OpaquePooledChild) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_OpaquePooledChild.Put(m)
+	}
+}
+func OpaquePooledChildFromVTPool() *OpaquePooledChild {
+	if m, ok := vtprotoPool_OpaquePooledChild.Get().(*OpaquePooledChild); ok {
+		return m
+	}
+	return &OpaquePooledChild{}
+}
+func (m *OpaqueMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasId() {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetId()))
+	}
+	if m.HasName() {
+		l = len(m.GetName())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.HasData() {
+		l = len(m.GetData())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OpaqueMessageWithLazy) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasId() {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetId()))
+	}
+	if m.HasNested() {
+		l = m.GetNested().SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OpaqueFields) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasI32() {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetI32()))
+	}
+	if m.HasS64() {
+		n += 1 + protohelpers.SizeOfZigzag(uint64(m.GetS64()))
+	}
+	if m.HasF32() {
+		n += 5
+	}
+	if m.HasD() {
+		n += 9
+	}
+	if m.HasB() {
+		n += 2
+	}
+	if m.HasS() {
+		l = len(m.GetS())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.HasBy() {
+		l = len(m.GetBy())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.HasKind() {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetKind()))
+	}
+	if m.HasMsg() {
+		l = m.GetMsg().SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.GetImplicitI64() != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetImplicitI64()))
+	}
+	if math.Float32bits(float32(m.GetImplicitF())) != 0 {
+		n += 5
+	}
+	l = len(m.GetImplicitS())
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	l = len(m.GetImplicitBy())
+	if l > 0 {
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.GetImplicitKind() != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetImplicitKind()))
+	}
+	if len(m.GetPacked()) > 0 {
+		l = 0
+		for _, e := range m.GetPacked() {
+			l += protohelpers.SizeOfVarint(uint64(e))
+		}
+		n += 2 + protohelpers.SizeOfVarint(uint64(l)) + l
+	}
+	if len(m.GetPackedFixed()) > 0 {
+		n += 2 + protohelpers.SizeOfVarint(uint64(len(m.GetPackedFixed())*8)) + len(m.GetPackedFixed())*8
+	}
+	if len(m.GetExpanded()) > 0 {
+		for _, e := range m.GetExpanded() {
+			n += 2 + protohelpers.SizeOfVarint(uint64(e))
+		}
+	}
+	if len(m.GetStrings()) > 0 {
+		for _, s := range m.GetStrings() {
+			l = len(s)
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.GetBlobs()) > 0 {
+		for _, b := range m.GetBlobs() {
+			l = len(b)
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.GetMsgs()) > 0 {
+		for _, e := range m.GetMsgs() {
+			l = e.SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.GetKinds()) > 0 {
+		l = 0
+		for _, e := range m.GetKinds() {
+			l += protohelpers.SizeOfVarint(uint64(e))
+		}
+		n += 2 + protohelpers.SizeOfVarint(uint64(l)) + l
+	}
+	if len(m.GetCounts()) > 0 {
+		for k, v := range m.GetCounts() {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + protohelpers.SizeOfVarint(uint64(len(k))) + 1 + protohelpers.SizeOfVarint(uint64(v))
+			n += mapEntrySize + 2 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
+		}
+	}
+	if len(m.GetById()) > 0 {
+		for k, v := range m.GetById() {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + protohelpers.SizeOfVarint(uint64(l))
+			mapEntrySize := 1 + protohelpers.SizeOfVarint(uint64(k)) + l
+			n += mapEntrySize + 2 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
+		}
+	}
+	if len(m.GetBlobsByName()) > 0 {
+		for k, v := range m.GetBlobsByName() {
+			_ = k
+			_ = v
+			l = 1 + len(v) + protohelpers.SizeOfVarint(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + protohelpers.SizeOfVarint(uint64(len(k))) + l
+			n += mapEntrySize + 2 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
+		}
+	}
+	switch m.WhichChoice() {
+	case OpaqueFields_ChoiceNum_case:
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.GetChoiceNum()))
+	case OpaqueFields_ChoiceText_case:
+		l = len(m.GetChoiceText())
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	case OpaqueFields_ChoiceData_case:
+		l = len(m.GetChoiceData())
+		n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+	case OpaqueFields_ChoiceMsg_case:
+		if m.HasChoiceMsg() {
+			l = m.GetChoiceMsg().SizeVT()
+			n += 2 + l + protohelpers.SizeOfVarint(uint64(l))
+		} else {
+			n += 3
+		}
+	case OpaqueFields_ChoiceKind_case:
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.GetChoiceKind()))
+	}
+	if m.HasDelimited() {
+		l = m.GetDelimited().SizeVT()
+		n += l + 4
+	}
+	if m.HasRequiredId() {
+		n += 2 + protohelpers.SizeOfVarint(uint64(m.GetRequiredId()))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OpaquePooled) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasId() {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.GetId()))
+	}
+	if len(m.GetChildren()) > 0 {
+		for _, e := range m.GetChildren() {
+			l = e.SizeVT()
+			n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	if len(m.GetByName()) > 0 {
+		for k, v := range m.GetByName() {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + protohelpers.SizeOfVarint(uint64(l))
+			mapEntrySize := 1 + len(k) + protohelpers.SizeOfVarint(uint64(len(k))) + l
+			n += mapEntrySize + 1 + protohelpers.SizeOfVarint(uint64(mapEntrySize))
+		}
+	}
+	if m.HasChild() {
+		l = m.GetChild().SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if len(m.GetNames()) > 0 {
+		for _, s := range m.GetNames() {
+			l = len(s)
+			n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+		}
+	}
+	switch m.WhichChoice() {
+	case OpaquePooled_Picked_case:
+		if m.HasPicked() {
+			l = m.GetPicked().SizeVT()
+			n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+		} else {
+			n += 2
+		}
+	case OpaquePooled_Text_case:
+		l = len(m.GetText())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	if m.HasOther() {
+		l = m.GetOther().SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OpaquePooledChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasName() {
+		l = len(m.GetName())
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OpaqueMessage) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetName(v)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetData(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaqueMessageWithLazy) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueMessageWithLazy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueMessageWithLazy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetNested()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetNested(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaqueFields) UnmarshalVT(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetI32(v)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S64", wireType)
+			}
+			var v int64
+			var vtemp uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			vtemp = (vtemp >> 1) ^ uint64((int64(vtemp&1)<<63)>>63)
+			v = int64(vtemp)
+			m.SetS64(v)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SetF32(v)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			var v float64
+			var vtemp uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v = math.Float64frombits(vtemp)
+			m.SetD(v)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v bool
+			var vtemp int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = bool(vtemp != 0)
+			m.SetB(v)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetS(v)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetBy(v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetKind(v)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetMsg(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitI64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitI64(v)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitF", wireType)
+			}
+			var v float32
+			var vtemp uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v = math.Float32frombits(vtemp)
+			m.SetImplicitF(v)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitS", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetImplicitS(v)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBy", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetImplicitBy(v)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitKind(v)
+		case 20:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetPacked(append(m.GetPacked(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPacked()
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetPacked(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packed", wireType)
+			}
+		case 21:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.SetPackedFixed(append(m.GetPackedFixed(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPackedFixed()
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					list = append(list, v)
+				}
+				m.SetPackedFixed(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetExpanded(append(m.GetExpanded(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetExpanded()
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetExpanded(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expanded", wireType)
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetStrings(append(m.GetStrings(), v))
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetBlobs(append(m.GetBlobs(), v))
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OpaqueMessage{}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SetMsgs(append(m.GetMsgs(), v))
+			iNdEx = postIndex
+		case 26:
+			if wireType == 0 {
+				var v OpaqueKind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OpaqueKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetKinds(append(m.GetKinds(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetKinds()
+				for iNdEx < postIndex {
+					var v OpaqueKind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OpaqueKind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetKinds(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetCounts()
+			if entries == nil {
+				entries = make(map[string]int64)
+				m.SetCounts(entries)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ById", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetById()
+			if entries == nil {
+				entries = make(map[int32]*OpaqueMessage)
+				m.SetById(entries)
+			}
+			var mapkey int32
+			var mapvalue *OpaqueMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OpaqueMessage{}
+					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobsByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetBlobsByName()
+			if entries == nil {
+				entries = make(map[string][]byte)
+				m.SetBlobsByName(entries)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceNum", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceNum(v)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceText", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetChoiceText(v)
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceData", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = make([]byte, mapbyteLen)
+			copy(v, dAtA[iNdEx:postbytesIndex])
+			iNdEx = postbytesIndex
+			m.SetChoiceData(v)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetChoiceMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetChoiceMsg(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceKind(v)
+		case 50:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimited", wireType)
+			}
+			groupStart := iNdEx
+			for {
+				maybeGroupEnd := iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				groupWireType := int(groupFieldWire & 0x7)
+				if groupWireType == 4 {
+					v := m.GetDelimited()
+					if v == nil {
+						v = &OpaqueMessage{}
+						m.SetDelimited(v)
+					}
+					if err := v.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
+						return err
+					}
+					break
+				}
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				iNdEx = maybeGroupEnd + skippy
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetRequiredId(v)
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_id not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaquePooled) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaquePooled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaquePooled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			list := m.GetChildren()
+			var v *OpaquePooledChild
+			if len(list) < cap(list) {
+				v = list[:len(list)+1][len(list)]
+			}
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+			}
+			m.SetChildren(append(list, v))
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetByName()
+			if entries == nil {
+				entries = make(map[string]*OpaquePooledChild)
+				m.SetByName(entries)
+			}
+			var mapkey string
+			var mapvalue *OpaquePooledChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = OpaquePooledChildFromVTPool()
+					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetChild()
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+				m.SetChild(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetNames(append(m.GetNames(), v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Picked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetPicked()
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+				m.SetPicked(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetText(v)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetOther()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetOther(v)
+			}
+			if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaquePooledChild) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaquePooledChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaquePooledChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			v = string(dAtA[iNdEx:postStringIndexv])
+			iNdEx = postStringIndexv
+			m.SetName(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaqueMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetName(v)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = dAtA[iNdEx:postbytesIndex]
+			iNdEx = postbytesIndex
+			m.SetData(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaqueMessageWithLazy) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueMessageWithLazy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueMessageWithLazy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetNested()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetNested(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaqueFields) UnmarshalVTUnsafe(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaqueFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaqueFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetI32(v)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S64", wireType)
+			}
+			var v int64
+			var vtemp uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			vtemp = (vtemp >> 1) ^ uint64((int64(vtemp&1)<<63)>>63)
+			v = int64(vtemp)
+			m.SetS64(v)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.SetF32(v)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			var v float64
+			var vtemp uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v = math.Float64frombits(vtemp)
+			m.SetD(v)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v bool
+			var vtemp int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				vtemp |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = bool(vtemp != 0)
+			m.SetB(v)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetS(v)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field By", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = dAtA[iNdEx:postbytesIndex]
+			iNdEx = postbytesIndex
+			m.SetBy(v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetKind(v)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetMsg(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitI64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitI64(v)
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitF", wireType)
+			}
+			var v float32
+			var vtemp uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			vtemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v = math.Float32frombits(vtemp)
+			m.SetImplicitF(v)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitS", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetImplicitS(v)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBy", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = dAtA[iNdEx:postbytesIndex]
+			iNdEx = postbytesIndex
+			m.SetImplicitBy(v)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetImplicitKind(v)
+		case 20:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetPacked(append(m.GetPacked(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPacked()
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetPacked(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packed", wireType)
+			}
+		case 21:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.SetPackedFixed(append(m.GetPackedFixed(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetPackedFixed()
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					list = append(list, v)
+				}
+				m.SetPackedFixed(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetExpanded(append(m.GetExpanded(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetExpanded()
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetExpanded(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expanded", wireType)
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetStrings(append(m.GetStrings(), v))
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = dAtA[iNdEx:postbytesIndex]
+			iNdEx = postbytesIndex
+			m.SetBlobs(append(m.GetBlobs(), v))
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &OpaqueMessage{}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.SetMsgs(append(m.GetMsgs(), v))
+			iNdEx = postIndex
+		case 26:
+			if wireType == 0 {
+				var v OpaqueKind
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OpaqueKind(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SetKinds(append(m.GetKinds(), v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				list := m.GetKinds()
+				for iNdEx < postIndex {
+					var v OpaqueKind
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OpaqueKind(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					list = append(list, v)
+				}
+				m.SetKinds(list)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetCounts()
+			if entries == nil {
+				entries = make(map[string]int64)
+				m.SetCounts(entries)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ById", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetById()
+			if entries == nil {
+				entries = make(map[int32]*OpaqueMessage)
+				m.SetById(entries)
+			}
+			var mapkey int32
+			var mapvalue *OpaqueMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OpaqueMessage{}
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobsByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetBlobsByName()
+			if entries == nil {
+				entries = make(map[string][]byte)
+				m.SetBlobsByName(entries)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = dAtA[iNdEx:postbytesIndex]
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceNum", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceNum(v)
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceText", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetChoiceText(v)
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceData", wireType)
+			}
+			var v []byte
+			var mapbyteLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapbyteLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intMapbyteLen := int(mapbyteLen)
+			if intMapbyteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postbytesIndex := iNdEx + intMapbyteLen
+			if postbytesIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postbytesIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = dAtA[iNdEx:postbytesIndex]
+			iNdEx = postbytesIndex
+			m.SetChoiceData(v)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetChoiceMsg()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetChoiceMsg(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChoiceKind", wireType)
+			}
+			var v OpaqueKind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpaqueKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetChoiceKind(v)
+		case 50:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimited", wireType)
+			}
+			groupStart := iNdEx
+			for {
+				maybeGroupEnd := iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				groupWireType := int(groupFieldWire & 0x7)
+				if groupWireType == 4 {
+					v := m.GetDelimited()
+					if v == nil {
+						v = &OpaqueMessage{}
+						m.SetDelimited(v)
+					}
+					if err := v.UnmarshalVTUnsafe(dAtA[groupStart:maybeGroupEnd]); err != nil {
+						return err
+					}
+					break
+				}
+				skippy, err := protohelpers.Skip(dAtA[maybeGroupEnd:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (maybeGroupEnd+skippy) < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				iNdEx = maybeGroupEnd + skippy
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetRequiredId(v)
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return fmt.Errorf("proto: required field required_id not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaquePooled) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaquePooled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaquePooled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetId(v)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			list := m.GetChildren()
+			var v *OpaquePooledChild
+			if len(list) < cap(list) {
+				v = list[:len(list)+1][len(list)]
+			}
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+			}
+			m.SetChildren(append(list, v))
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			entries := m.GetByName()
+			if entries == nil {
+				entries = make(map[string]*OpaquePooledChild)
+				m.SetByName(entries)
+			}
+			var mapkey string
+			var mapvalue *OpaquePooledChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
+						return err
+					}
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protohelpers.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = OpaquePooledChildFromVTPool()
+					if err := mapvalue.UnmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return protohelpers.ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			entries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetChild()
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+				m.SetChild(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetNames(append(m.GetNames(), v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Picked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetPicked()
+			if v == nil {
+				v = OpaquePooledChildFromVTPool()
+				m.SetPicked(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetText(v)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := m.GetOther()
+			if v == nil {
+				v = &OpaqueMessage{}
+				m.SetOther(v)
+			}
+			if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpaquePooledChild) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpaquePooledChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpaquePooledChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var v string
+			var stringLenv uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenv := int(stringLenv)
+			if intStringLenv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postStringIndexv := iNdEx + intStringLenv
+			if postStringIndexv < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postStringIndexv > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexv]); err != nil {
+				return err
+			}
+			if intStringLenv == 0 {
+				v = ""
+			} else {
+				v = unsafe.String(&dAtA[iNdEx], intStringLenv)
+			}
+			iNdEx = postStringIndexv
+			m.SetName(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package editions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestStrippedEnumPrefixRoundTrip tests that the VT methods of messages holding enums
+// with strip_enum_prefix use the Go names protoc-gen-go gives to their values
+func TestStrippedEnumPrefixRoundTrip(t *testing.T) {
+	original := &MessageWithStrippedEnums{
+		Shade:  Shade_LIGHT,
+		Shades: []Shade{Shade_DARK, Shade_UNSPECIFIED},
+		Tones:  map[string]Tone{"warm": Tone_WARM, "cold": Tone_TONE_COLD},
+		Finish: MessageWithStrippedEnums_GLOSS.Enum(),
+		Choice: &MessageWithStrippedEnums_Tone{Tone: Tone_COLD},
+	}
+	require.Equal(t, Shade_DARK, original.GetShadeWithDefault())
+
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(original), original.SizeVT())
+
+	decoded := &MessageWithStrippedEnums{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	require.True(t, proto.Equal(original, decoded))
+
+	data, err = proto.Marshal(original)
+	require.NoError(t, err)
+	decoded = &MessageWithStrippedEnums{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, original.EqualVT(decoded))
+	require.Equal(t, Tone_TONE_COLD, decoded.GetTone())
+	require.Equal(t, Shade_DARK, decoded.GetShadeWithDefault())
+
+	clone := original.CloneVT()
+	require.True(t, original.EqualVT(clone))
+	clone.Choice = &MessageWithStrippedEnums_Picked{Picked: MessageWithStrippedEnums_MATTE}
+	require.False(t, original.EqualVT(clone))
+}
+
+// TestStrippedEnumPrefixStrictEnum tests that strict_enum checks the enums with
+// strip_enum_prefix, nested or not, by the Go names protoc-gen-go gives them
+func TestStrippedEnumPrefixStrictEnum(t *testing.T) {
+	for num, name := range map[protowire.Number]string{1: "shade", 5: "finish", 6: "tone"} {
+		data := protowire.AppendTag(nil, num, protowire.VarintType)
+		data = protowire.AppendVarint(data, 42)
+
+		err := (&MessageWithStrippedEnums{}).UnmarshalVT(data)
+		require.ErrorContains(t, err, "invalid value 42 for enum field MessageWithStrippedEnums."+name)
+	}
+}
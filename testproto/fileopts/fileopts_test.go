@@ -0,0 +1,62 @@
+package fileopts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func unknownField() []byte {
+	data := protowire.AppendTag(nil, 100, protowire.VarintType)
+	return protowire.AppendVarint(data, 1)
+}
+
+func TestFileOptions(t *testing.T) {
+	msg := PooledFromVTPool()
+	msg.Name = "pooled"
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	msg.ReturnToVTPool()
+
+	decoded := PooledFromVTPool()
+	require.NoError(t, decoded.UnmarshalVT(append(data, unknownField()...)))
+	require.Equal(t, "pooled", decoded.Name)
+	require.Empty(t, decoded.unknownFields)
+
+	// The features set in the file options replace the default ones.
+	_, ok := any(decoded).(vtproto.Equaler)
+	require.False(t, ok)
+}
+
+func TestMessageOptionsOverrideFileOptions(t *testing.T) {
+	_, ok := any(&NotPooled{}).(vtproto.Pooler)
+	require.False(t, ok)
+
+	decoded := &NotPooled{}
+	require.NoError(t, decoded.UnmarshalVT(unknownField()))
+	require.Equal(t, unknownField(), decoded.unknownFields)
+}
+
+func TestNarrowedFeaturesHolder(t *testing.T) {
+	msg := &Holder{
+		Pooled: &Pooled{Name: "pooled", Children: []*Pooled{{Name: "child"}}},
+		Items:  []*NotPooled{{Name: "a"}, {Name: "b"}},
+		ByName: map[string]*Pooled{"c": {Name: "c"}},
+		Choice: &Holder_Picked{Picked: &NotPooled{Name: "d"}},
+	}
+
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	got := &Holder{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, msg.EqualVT(got))
+
+	clone := msg.CloneVT()
+	require.True(t, msg.EqualVT(clone))
+	clone.Pooled.Name = "changed"
+	require.False(t, msg.EqualVT(clone))
+	require.Equal(t, "pooled", msg.Pooled.Name)
+}
@@ -0,0 +1,132 @@
+// Package fuzz holds the fuzz targets of the generated decoders, which check that
+// UnmarshalVT agrees with proto.Unmarshal on arbitrary input. Each target is a separate
+// function so that OSS-Fuzz builds it as its own fuzzer, see oss-fuzz.sh.
+package fuzz
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/testproto/editions"
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+	"github.com/planetscale/vtprotobuf/testproto/proto2"
+	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+	"github.com/planetscale/vtprotobuf/testproto/wkt"
+	"github.com/planetscale/vtprotobuf/vtquick"
+)
+
+type vtMessage interface {
+	proto.Message
+	MarshalVT() ([]byte, error)
+	UnmarshalVT([]byte) error
+	UnmarshalVTUnsafe([]byte) error
+}
+
+// corpus is the directory the seed corpus of each target is written to, in a directory
+// named after the target, for the fuzzing engines that do not run the seeds added with
+// testing.F.Add, like OSS-Fuzz.
+var corpus = flag.String("corpus", "", "directory to write the seed corpus of the fuzz targets to")
+
+// seeds is the number of random messages of each type marshaled into the seed corpus.
+const seeds = 16
+
+// fuzzUnmarshal fuzzes the decoders of messages of type T. The seed corpus holds the
+// encodings of messages filled by vtquick, so that the fuzzer starts from valid input.
+//
+// UnmarshalVT is stricter than proto.Unmarshal on wire types, which it rejects instead
+// of keeping them as unknown fields, and more lenient on field numbers, which it does not
+// validate, so the decoded messages are only compared when both succeed. They are compared
+// once marshaled by MarshalVT and decoded again by proto.Unmarshal, which re-encodes the
+// tags of unknown fields. The known types drop their unknown fields, which are discarded
+// before comparing when unknown is set.
+func fuzzUnmarshal[T vtMessage](f *testing.F, unknown bool) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < seeds; i++ {
+		data, err := vtquick.New[T](r, 2*i).MarshalVT()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+		if *corpus != "" {
+			dir := filepath.Join(*corpus, f.Name())
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				f.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("seed%d", i)), data, 0o644); err != nil {
+				f.Fatal(err)
+			}
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		newMessage := func() T {
+			var zero T
+			return zero.ProtoReflect().Type().New().Interface().(T)
+		}
+		got, want := newMessage(), newMessage()
+		if err := got.UnmarshalVT(data); err != nil || proto.Unmarshal(data, want) != nil {
+			return
+		}
+		encoded, err := got.MarshalVT()
+		if err != nil {
+			t.Fatalf("MarshalVT of %v returned %v", got, err)
+		}
+		decoded := newMessage()
+		if err := proto.Unmarshal(encoded, decoded); err != nil {
+			t.Fatalf("proto.Unmarshal of MarshalVT output %x returned %v", encoded, err)
+		}
+		if unknown {
+			discardUnknown(decoded.ProtoReflect())
+			discardUnknown(want.ProtoReflect())
+		}
+		if !proto.Equal(decoded, want) {
+			t.Fatalf("UnmarshalVT(%x) decoded %v, proto.Unmarshal decoded %v", data, decoded, want)
+		}
+
+		unsafe := newMessage()
+		if err := unsafe.UnmarshalVTUnsafe(data); err != nil || !proto.Equal(unsafe, got) {
+			t.Fatalf("UnmarshalVTUnsafe(%x) decoded %v, %v, UnmarshalVT decoded %v", data, unsafe, err, got)
+		}
+		again := newMessage()
+		if err := again.UnmarshalVT(encoded); err != nil || !proto.Equal(again, got) {
+			t.Fatalf("UnmarshalVT of MarshalVT output %x decoded %v, %v", encoded, again, err)
+		}
+	})
+}
+
+// discardUnknown clears the unknown fields of m and of the messages it holds.
+func discardUnknown(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				discardUnknown(v.List().Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				discardUnknown(v.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			discardUnknown(v.Message())
+		}
+		return true
+	})
+	if len(m.GetUnknown()) > 0 {
+		m.SetUnknown(nil)
+	}
+}
+
+func FuzzProto2Int64(f *testing.F)    { fuzzUnmarshal[*proto2.Int64Message](f, false) }
+func FuzzProto2String(f *testing.F)   { fuzzUnmarshal[*proto2.StringMessage](f, false) }
+func FuzzProto2Enum(f *testing.F)     { fuzzUnmarshal[*proto2.EnumMessage](f, false) }
+func FuzzProto3Optional(f *testing.F) { fuzzUnmarshal[*proto3opt.OptionalFieldInProto3](f, false) }
+func FuzzOneof(f *testing.F)          { fuzzUnmarshal[*pool.OneofTest](f, false) }
+func FuzzWellKnownTypes(f *testing.F) { fuzzUnmarshal[*wkt.MessageWithWKT](f, true) }
+func FuzzEditions(f *testing.F)       { fuzzUnmarshal[*editions.ScalarTypes](f, false) }
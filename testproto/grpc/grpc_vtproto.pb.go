@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
 	inner "github.com/planetscale/vtprotobuf/testproto/grpc/inner"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -41,6 +42,27 @@ func (m *LocalTestMessageRequest) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// LocalTestMessageRequestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of LocalTestMessageRequest.
+func LocalTestMessageRequestCloneSliceVT(in []*LocalTestMessageRequest) []*LocalTestMessageRequest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*LocalTestMessageRequest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := LocalTestMessageRequestFromVTPool()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *LocalTestMessageResponse) CloneVT() *LocalTestMessageResponse {
 	if m == nil {
 		return (*LocalTestMessageResponse)(nil)
@@ -57,6 +79,27 @@ func (m *LocalTestMessageResponse) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// LocalTestMessageResponseCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of LocalTestMessageResponse.
+func LocalTestMessageResponseCloneSliceVT(in []*LocalTestMessageResponse) []*LocalTestMessageResponse {
+	if in == nil {
+		return nil
+	}
+	out := make([]*LocalTestMessageResponse, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := LocalTestMessageResponseFromVTPool()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *LocalTestMessageRequest) EqualVT(that *LocalTestMessageRequest) bool {
 	if this == that {
 		return true
@@ -399,16 +442,31 @@ func (m *LocalTestMessageResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-var vtprotoPool_LocalTestMessageRequest = sync.Pool{
+func (m *LocalTestMessageRequest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *LocalTestMessageResponse) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *LocalTestMessageRequest) ResetVT() {
+	if m != nil {
+		*m = LocalTestMessageRequest{}
+	}
+}
+
+var vtprotoPool_LocalTestMessageRequest vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &LocalTestMessageRequest{}
 	},
 }
 
-func (m *LocalTestMessageRequest) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by LocalTestMessageRequestFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*LocalTestMessageRequest) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &LocalTestMessageRequest{} }}
 	}
+	vtprotoPool_LocalTestMessageRequest = b
 }
 func (m *LocalTestMessageRequest) ReturnToVTPool() {
 	if m != nil {
@@ -417,19 +475,30 @@ func (m *LocalTestMessageRequest) ReturnToVTPool() {
 	}
 }
 func LocalTestMessageRequestFromVTPool() *LocalTestMessageRequest {
-	return vtprotoPool_LocalTestMessageRequest.Get().(*LocalTestMessageRequest)
+	if m, ok := vtprotoPool_LocalTestMessageRequest.Get().(*LocalTestMessageRequest); ok {
+		return m
+	}
+	return &LocalTestMessageRequest{}
+}
+func (m *LocalTestMessageResponse) ResetVT() {
+	if m != nil {
+		*m = LocalTestMessageResponse{}
+	}
 }
 
-var vtprotoPool_LocalTestMessageResponse = sync.Pool{
+var vtprotoPool_LocalTestMessageResponse vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &LocalTestMessageResponse{}
 	},
 }
 
-func (m *LocalTestMessageResponse) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by LocalTestMessageResponseFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*LocalTestMessageResponse) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &LocalTestMessageResponse{} }}
 	}
+	vtprotoPool_LocalTestMessageResponse = b
 }
 func (m *LocalTestMessageResponse) ReturnToVTPool() {
 	if m != nil {
@@ -438,7 +507,10 @@ func (m *LocalTestMessageResponse) ReturnToVTPool() {
 	}
 }
 func LocalTestMessageResponseFromVTPool() *LocalTestMessageResponse {
-	return vtprotoPool_LocalTestMessageResponse.Get().(*LocalTestMessageResponse)
+	if m, ok := vtprotoPool_LocalTestMessageResponse.Get().(*LocalTestMessageResponse); ok {
+		return m
+	}
+	return &LocalTestMessageResponse{}
 }
 func (m *LocalTestMessageRequest) SizeVT() (n int) {
 	if m == nil {
@@ -7,6 +7,7 @@ package inner
 import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
@@ -36,6 +37,27 @@ func (m *TestMessageRequest) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestMessageRequestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of TestMessageRequest.
+func TestMessageRequestCloneSliceVT(in []*TestMessageRequest) []*TestMessageRequest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestMessageRequest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := TestMessageRequestFromVTPool()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *TestMessageResponse) CloneVT() *TestMessageResponse {
 	if m == nil {
 		return (*TestMessageResponse)(nil)
@@ -52,6 +74,27 @@ func (m *TestMessageResponse) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// TestMessageResponseCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of TestMessageResponse.
+func TestMessageResponseCloneSliceVT(in []*TestMessageResponse) []*TestMessageResponse {
+	if in == nil {
+		return nil
+	}
+	out := make([]*TestMessageResponse, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := TestMessageResponseFromVTPool()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *TestMessageRequest) EqualVT(that *TestMessageRequest) bool {
 	if this == that {
 		return true
@@ -216,16 +259,31 @@ func (m *TestMessageResponse) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-var vtprotoPool_TestMessageRequest = sync.Pool{
+func (m *TestMessageRequest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestMessageResponse) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *TestMessageRequest) ResetVT() {
+	if m != nil {
+		*m = TestMessageRequest{}
+	}
+}
+
+var vtprotoPool_TestMessageRequest vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &TestMessageRequest{}
 	},
 }
 
-func (m *TestMessageRequest) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by TestMessageRequestFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*TestMessageRequest) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &TestMessageRequest{} }}
 	}
+	vtprotoPool_TestMessageRequest = b
 }
 func (m *TestMessageRequest) ReturnToVTPool() {
 	if m != nil {
@@ -234,19 +292,30 @@ func (m *TestMessageRequest) ReturnToVTPool() {
 	}
 }
 func TestMessageRequestFromVTPool() *TestMessageRequest {
-	return vtprotoPool_TestMessageRequest.Get().(*TestMessageRequest)
+	if m, ok := vtprotoPool_TestMessageRequest.Get().(*TestMessageRequest); ok {
+		return m
+	}
+	return &TestMessageRequest{}
+}
+func (m *TestMessageResponse) ResetVT() {
+	if m != nil {
+		*m = TestMessageResponse{}
+	}
 }
 
-var vtprotoPool_TestMessageResponse = sync.Pool{
+var vtprotoPool_TestMessageResponse vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &TestMessageResponse{}
 	},
 }
 
-func (m *TestMessageResponse) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by TestMessageResponseFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*TestMessageResponse) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &TestMessageResponse{} }}
 	}
+	vtprotoPool_TestMessageResponse = b
 }
 func (m *TestMessageResponse) ReturnToVTPool() {
 	if m != nil {
@@ -255,7 +324,10 @@ func (m *TestMessageResponse) ReturnToVTPool() {
 	}
 }
 func TestMessageResponseFromVTPool() *TestMessageResponse {
-	return vtprotoPool_TestMessageResponse.Get().(*TestMessageResponse)
+	if m, ok := vtprotoPool_TestMessageResponse.Get().(*TestMessageResponse); ok {
+		return m
+	}
+	return &TestMessageResponse{}
 }
 func (m *TestMessageRequest) SizeVT() (n int) {
 	if m == nil {
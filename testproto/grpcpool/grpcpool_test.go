@@ -0,0 +1,132 @@
+package grpcpool
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/planetscale/vtprotobuf/vtpool"
+)
+
+// countingBackend counts the messages returned to the pool.
+type countingBackend struct {
+	mu   sync.Mutex
+	puts int
+}
+
+func (b *countingBackend) Get() any { return nil }
+
+func (b *countingBackend) Put(any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.puts++
+}
+
+func (b *countingBackend) count() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := b.puts
+	b.puts = 0
+	return n
+}
+
+type echoServer struct {
+	UnimplementedEchoServer
+}
+
+func (echoServer) Unary(_ context.Context, in *Request) (*Reply, error) {
+	return &Reply{Name: in.Name}, nil
+}
+
+func (echoServer) ServerStream(in *Request, stream Echo_ServerStreamServer) error {
+	for i := 0; i < 2; i++ {
+		reply := ReplyFromVTPool()
+		reply.Name = in.Name
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (echoServer) ClientStream(stream Echo_ClientStreamServer) error {
+	var names string
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&Reply{Name: names})
+		}
+		if err != nil {
+			return err
+		}
+		names += in.Name
+		in.ReturnToVTPool()
+	}
+}
+
+func newClient(t *testing.T) EchoClient {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterEchoServer(server, echoServer{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return NewEchoClient(conn)
+}
+
+func TestStubsReturnMessagesToPool(t *testing.T) {
+	requests, replies := &countingBackend{}, &countingBackend{}
+	vtpool.SetBackend[Request](requests)
+	vtpool.SetBackend[Reply](replies)
+	t.Cleanup(func() {
+		vtpool.SetBackend[Request](nil)
+		vtpool.SetBackend[Reply](nil)
+	})
+	client := newClient(t)
+	ctx := context.Background()
+
+	reply, err := client.Unary(ctx, &Request{Name: "unary"})
+	require.NoError(t, err)
+	require.Equal(t, "unary", reply.Name)
+	require.Equal(t, 1, requests.count())
+	// The client owns the replies it receives.
+	require.Equal(t, 0, replies.count())
+
+	stream, err := client.ServerStream(ctx, &Request{Name: "stream"})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		reply, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, "stream", reply.Name)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+	require.Equal(t, 1, requests.count())
+	require.Equal(t, 2, replies.count())
+
+	upload, err := client.ClientStream(ctx)
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b"} {
+		m := RequestFromVTPool()
+		m.Name = name
+		require.NoError(t, upload.Send(m))
+	}
+	reply, err = upload.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, "ab", reply.Name)
+	// Both the client and the server return the requests to the pool.
+	require.Equal(t, 4, requests.count())
+	require.Equal(t, 1, replies.count())
+}
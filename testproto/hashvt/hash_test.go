@@ -0,0 +1,130 @@
+package hashvt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/planetscale/vtprotobuf/vthash"
+	"github.com/planetscale/vtprotobuf/vtintern"
+)
+
+func newItem() *Item {
+	return &Item{
+		Name:     "item",
+		Scalars:  &Scalars{D: 1.5, I64: -3, S: "s", By: []byte("by"), Kind: Kind_KIND_A},
+		Values:   []int64{1, 2, 3},
+		Children: []*Scalars{{U32: 1}, {U64: 2}},
+		Counts:   map[string]int64{"a": 1, "b": 2, "c": 3},
+		ById:     map[int32]*Scalars{1: {B: true}, 2: {F: 2.5}},
+		Flags:    map[bool]Kind{true: Kind_KIND_A, false: Kind_KIND_UNSPECIFIED},
+		Choice:   &Item_Text{Text: "text"},
+		Created:  &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 5},
+		Blobs:    [][]byte{[]byte("x"), nil},
+	}
+}
+
+func TestHashEqual(t *testing.T) {
+	item := newItem()
+	h := item.HashVT(0)
+	require.Equal(t, h, item.CloneVT().HashVT(0))
+
+	// Maps are hashed whatever the order of their entries.
+	reordered := newItem()
+	reordered.Counts = make(map[string]int64)
+	for _, k := range []string{"c", "a", "b"} {
+		reordered.Counts[k] = item.Counts[k]
+	}
+	require.Equal(t, h, reordered.HashVT(0))
+
+	for name, pair := range map[string][2]*Item{
+		"empty":        {{}, {Values: []int64{}, Counts: map[string]int64{}}},
+		"zero":         {{}, {Name: "", Scalars: nil}},
+		"negativeZero": {{Scalars: &Scalars{D: math.Copysign(0, -1), F: float32(math.Copysign(0, -1))}}, {Scalars: &Scalars{}}},
+		"emptyBlob":    {{Blobs: [][]byte{nil}}, {Blobs: [][]byte{{}}}},
+		"mapValue":     {{ById: map[int32]*Scalars{1: nil}}, {ById: map[int32]*Scalars{1: {}}}},
+	} {
+		require.True(t, pair[0].EqualVT(pair[1]), name)
+		require.Equal(t, pair[0].HashVT(7), pair[1].HashVT(7), name)
+	}
+
+	require.Equal(t, uint64(7), (*Item)(nil).HashVT(7))
+	require.Equal(t, uint64(7), (&Item{}).HashVT(7))
+}
+
+func TestHashDifferent(t *testing.T) {
+	i, d := int32(0), 0.0
+	for name, pair := range map[string][2]*Item{
+		"explicitZero": {{Scalars: &Scalars{}}, {Scalars: &Scalars{OptI32: &i}}},
+		"explicitKind": {{Scalars: &Scalars{OptI32: &i}}, {Scalars: &Scalars{OptD: &d}}},
+		"emptyMessage": {{}, {Scalars: &Scalars{}}},
+		"oneof":        {{Choice: &Item_Num{Num: 0}}, {}},
+		"oneofMember":  {{Choice: &Item_Text{Text: "a"}}, {Name: "a"}},
+		"swap":         {{Values: []int64{1, 2}}, {Values: []int64{2, 1}}},
+		"split":        {{Blobs: [][]byte{[]byte("ab")}}, {Blobs: [][]byte{[]byte("a"), []byte("b")}}},
+		"mapEntries":   {{Counts: map[string]int64{"a": 1, "b": 2}}, {Counts: map[string]int64{"a": 2, "b": 1}}},
+		"mapKey":       {{Counts: map[string]int64{"a": 1}}, {Counts: map[string]int64{"b": 1}}},
+		"children":     {{Children: []*Scalars{nil}}, {}},
+		"timestamp":    {{Created: &timestamppb.Timestamp{Seconds: 1}}, {Created: &timestamppb.Timestamp{Nanos: 1}}},
+	} {
+		require.False(t, pair[0].EqualVT(pair[1]), name)
+		require.NotEqual(t, pair[0].HashVT(0), pair[1].HashVT(0), name)
+	}
+
+	item := newItem()
+	require.NotEqual(t, item.HashVT(0), item.HashVT(1))
+}
+
+func TestHashStable(t *testing.T) {
+	// The hashes do not depend on the process, and may be stored.
+	require.Equal(t, uint64(0x2d1bc25977aff39f), newItem().HashVT(0))
+}
+
+func TestHashReflection(t *testing.T) {
+	attributes, err := structpb.NewStruct(map[string]any{"a": 1, "b": []any{"x", true}, "c": nil})
+	require.NoError(t, err)
+	item := &Item{Attributes: attributes}
+	h := item.HashVT(0)
+
+	same, err := structpb.NewStruct(map[string]any{"c": nil, "b": []any{"x", true}, "a": 1})
+	require.NoError(t, err)
+	require.Equal(t, h, (&Item{Attributes: same}).HashVT(0))
+
+	other, err := structpb.NewStruct(map[string]any{"a": 1, "b": []any{true, "x"}, "c": nil})
+	require.NoError(t, err)
+	require.NotEqual(t, h, (&Item{Attributes: other}).HashVT(0))
+
+	// Messages with HashVT are hashed with it by vthash.Message.
+	another := newItem()
+	require.Equal(t, another.HashVT(3), vthash.Message(3, another))
+}
+
+func TestHashUnknownFields(t *testing.T) {
+	data, err := (&Untagged{Name: "a", Tag: "b"}).MarshalVT()
+	require.NoError(t, err)
+	tagged := &Tagged{}
+	require.NoError(t, tagged.UnmarshalVT(data))
+	require.NotEqual(t, (&Tagged{Name: "a"}).HashVT(0), tagged.HashVT(0))
+
+	// Item is generated without hash-unknown-fields.
+	data = protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 1)
+	item := &Item{}
+	require.NoError(t, item.UnmarshalVT(data))
+	require.NotEmpty(t, item.unknownFields)
+	require.Equal(t, (&Item{}).HashVT(0), item.HashVT(0))
+}
+
+func TestHashIntern(t *testing.T) {
+	items := vtintern.New[Item]()
+	first, err := items.Intern(newItem())
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		shared, err := items.Intern(newItem())
+		require.NoError(t, err)
+		require.Same(t, first, shared)
+	}
+}
@@ -33,6 +33,26 @@ func (m *IgnoreUnknownFieldsExtension) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// IgnoreUnknownFieldsExtensionCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func IgnoreUnknownFieldsExtensionCloneSliceVT(in []*IgnoreUnknownFieldsExtension) []*IgnoreUnknownFieldsExtension {
+	if in == nil {
+		return nil
+	}
+	out := make([]*IgnoreUnknownFieldsExtension, len(in))
+	clones := make([]IgnoreUnknownFieldsExtension, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Foo = m.Foo
+		out[i] = r
+	}
+	return out
+}
+
 func (this *IgnoreUnknownFieldsExtension) EqualVT(that *IgnoreUnknownFieldsExtension) bool {
 	if this == that {
 		return true
@@ -124,6 +144,9 @@ func (m *IgnoreUnknownFieldsExtension) MarshalToSizedBufferVTStrict(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *IgnoreUnknownFieldsExtension) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *IgnoreUnknownFieldsExtension) SizeVT() (n int) {
 	if m == nil {
 		return 0
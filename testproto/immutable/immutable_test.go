@@ -0,0 +1,61 @@
+package immutable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneSharesImmutableBytes(t *testing.T) {
+	original := &Blob{
+		Name:    "blob",
+		Payload: []byte("payload"),
+		Chunks:  [][]byte{[]byte("first"), []byte("second")},
+		Parts:   map[string][]byte{"part": []byte("part")},
+		Inline:  &Blob_Data{Data: []byte("data")},
+		Copied:  []byte("copied"),
+	}
+	clone := original.CloneVT()
+	require.True(t, original.EqualVT(clone))
+
+	require.Same(t, &original.Payload[0], &clone.Payload[0])
+	require.Same(t, &original.Chunks[1][0], &clone.Chunks[1][0])
+	require.Same(t, &original.Parts["part"][0], &clone.Parts["part"][0])
+	require.Same(t, &original.GetData()[0], &clone.GetData()[0])
+	require.NotSame(t, &original.Copied[0], &clone.Copied[0])
+
+	// The containers of the fields are still copied.
+	clone.Chunks[0] = nil
+	clone.Parts["other"] = nil
+	require.Equal(t, []byte("first"), original.Chunks[0])
+	require.Len(t, original.Parts, 1)
+}
+
+func TestCloneSlice(t *testing.T) {
+	require.Nil(t, BlobCloneSliceVT(nil))
+
+	in := []*Blob{
+		{Name: "first", Payload: []byte("payload"), Copied: []byte("copied")},
+		nil,
+		{Name: "second", Chunks: [][]byte{[]byte("chunk")}, Inline: &Blob_Data{Data: []byte("data")}},
+	}
+	out := BlobCloneSliceVT(in)
+	require.Len(t, out, len(in))
+	require.Nil(t, out[1])
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		require.NotSame(t, m, out[i])
+		require.True(t, m.EqualVT(out[i]))
+	}
+
+	// The options of the fields apply like for CloneVT.
+	require.Same(t, &in[0].Payload[0], &out[0].Payload[0])
+	require.NotSame(t, &in[0].Copied[0], &out[0].Copied[0])
+
+	out[2].Chunks[0] = nil
+	out[2].Inline = nil
+	require.Equal(t, []byte("chunk"), in[2].Chunks[0])
+	require.Equal(t, []byte("data"), in[2].GetData())
+}
@@ -0,0 +1,190 @@
+package jsonvt
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/vtjson"
+)
+
+// requireProtoJSON checks that MarshalJSONVT returns the encoding of protojson.Marshal
+// without whitespace, or fails like it.
+func requireProtoJSON(t *testing.T, m interface {
+	proto.Message
+	vtjson.Marshaler
+}) {
+	t.Helper()
+	want, wantErr := protojson.Marshal(m)
+	got, err := m.MarshalJSONVT()
+	if wantErr != nil {
+		require.Error(t, err, "protojson failed with %v", wantErr)
+		return
+	}
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, json.Compact(&buf, want))
+	require.Equal(t, buf.String(), string(got))
+}
+
+func TestScalars(t *testing.T) {
+	requireProtoJSON(t, &Scalars{})
+	requireProtoJSON(t, (*Scalars)(nil))
+	requireProtoJSON(t, &Scalars{
+		D: 1.5, F: 0.1, I32: math.MinInt32, I64: math.MinInt64, U32: math.MaxUint32, U64: math.MaxUint64,
+		S32: -1, S64: math.MaxInt64, Fx32: 7, Fx64: 8, Sfx32: -9, Sfx64: -10,
+		B: true, S: "quote\" backslash\\ \b\f\n\r\t\x00\x1f <>&  ünïcode 😀", By: []byte{0, 1, 2, 0xff},
+		Color: Color_GREEN, Renamed: 3,
+	})
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1), 1e-7, 1e21, 1e20, 123456789.125, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		requireProtoJSON(t, &Scalars{D: f, F: float32(f)})
+	}
+	zero, empty, unspecified := int32(0), "", Color_COLOR_UNSPECIFIED
+	requireProtoJSON(t, &Scalars{OptI32: &zero, OptS: &empty, OptColor: &unspecified})
+	requireProtoJSON(t, &Scalars{Color: 42})
+
+	_, err := (&Scalars{S: "\xff"}).MarshalJSONVT()
+	require.ErrorIs(t, err, vtjson.ErrInvalidUTF8)
+	requireProtoJSON(t, &Scalars{S: "\xff"})
+}
+
+func TestCollections(t *testing.T) {
+	requireProtoJSON(t, &Collections{})
+	requireProtoJSON(t, &Collections{
+		I64S:    []int64{1, -2, math.MaxInt64},
+		Strings: []string{"a", "", "c"},
+		Colors:  []Color{Color_RED, 7},
+		Items:   []*Scalars{{I32: 1}, nil, {}},
+		Doubles: []float64{0, math.NaN(), -1.25},
+		Blobs:   [][]byte{nil, []byte("blob")},
+		Labels:  map[string]string{"b": "2", "a": "1", "é": "3", "": "empty"},
+		ById:    map[int32]*Scalars{10: {S: "ten"}, -1: nil, 2: {}},
+		Flags:   map[bool]int64{true: 1, false: 0},
+		ColorsById: map[uint64]Color{
+			math.MaxUint64: Color_GREEN,
+			0:              Color_COLOR_UNSPECIFIED,
+		},
+		BlobsById: map[int64][]byte{-5: {1}, 5: nil},
+	})
+	requireProtoJSON(t, &Collections{Flags: map[bool]int64{true: 1}})
+	requireProtoJSON(t, &Collections{Labels: map[string]string{"\xff": ""}})
+}
+
+func TestOneofs(t *testing.T) {
+	requireProtoJSON(t, &Oneofs{})
+	requireProtoJSON(t, &Oneofs{Value: &Oneofs_Num{}})
+	requireProtoJSON(t, &Oneofs{Before: "b", Value: &Oneofs_Text{Text: ""}, After: "a"})
+	requireProtoJSON(t, &Oneofs{Value: &Oneofs_Msg{Msg: &Scalars{U64: 1}}, After: "a"})
+	requireProtoJSON(t, &Oneofs{Value: &Oneofs_Color{Color: Color_RED}})
+}
+
+func TestWellKnown(t *testing.T) {
+	requireProtoJSON(t, &WellKnown{})
+	st, err := structpb.NewStruct(map[string]any{"z": 1, "a": []any{"x", true, nil}, "m": map[string]any{"k": 1.5}})
+	require.NoError(t, err)
+	packed, err := anypb.New(&Scalars{S: "inner"})
+	require.NoError(t, err)
+	requireProtoJSON(t, &WellKnown{
+		Ts:    timestamppb.New(timestamppb.Now().AsTime()),
+		Dur:   durationpb.New(-1500000),
+		I64:   wrapperspb.Int64(math.MinInt64),
+		Str:   wrapperspb.String("s"),
+		B:     wrapperspb.Bool(false),
+		D:     wrapperspb.Double(math.Inf(1)),
+		By:    wrapperspb.Bytes([]byte("b")),
+		F:     wrapperspb.Float(0.5),
+		U64:   wrapperspb.UInt64(math.MaxUint64),
+		I32:   wrapperspb.Int32(-3),
+		U32:   wrapperspb.UInt32(3),
+		St:    st,
+		Val:   structpb.NewStringValue("v"),
+		List:  &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1)}},
+		Any:   packed,
+		Mask:  &fieldmaskpb.FieldMask{Paths: []string{"a.b_c", "d"}},
+		Empty: &emptypb.Empty{},
+		Times: []*timestamppb.Timestamp{{}, {Seconds: 1, Nanos: 1000}, {Seconds: -1, Nanos: 120000000}, nil},
+		Durs:  map[string]*durationpb.Duration{"a": {Seconds: 3}, "b": {Nanos: -1}, "c": nil},
+		Null:  structpb.NullValue_NULL_VALUE,
+		Ints:  []*wrapperspb.Int32Value{{Value: 1}, nil},
+	})
+	for _, m := range []*WellKnown{
+		{Ts: &timestamppb.Timestamp{Seconds: 253402300800}},
+		{Ts: &timestamppb.Timestamp{Nanos: -1}},
+		{Dur: &durationpb.Duration{Seconds: 1, Nanos: -1}},
+		{Dur: &durationpb.Duration{Seconds: 315576000001}},
+		{Str: wrapperspb.String("\xff")},
+	} {
+		_, err := m.MarshalJSONVT()
+		require.Error(t, err)
+		requireProtoJSON(t, m)
+	}
+}
+
+func TestNested(t *testing.T) {
+	requireProtoJSON(t, &Nested{
+		Scalars:  &Scalars{I32: 1},
+		Child:    &Nested{Child: &Nested{Scalars: &Scalars{}}},
+		Children: map[string]*Nested{"a": {}, "b": {Children: map[string]*Nested{"c": nil}}},
+	})
+	requireProtoJSON(t, &Empty{})
+
+	b, err := (&Nested{Scalars: &Scalars{B: true}}).AppendJSONVT([]byte("prefix"))
+	require.NoError(t, err)
+	require.Equal(t, `prefix{"scalars":{"b":true}}`, string(b))
+}
+
+func TestLegacy(t *testing.T) {
+	requireProtoJSON(t, &Legacy{})
+	_, err := (&Legacy{}).MarshalJSONVT()
+	require.EqualError(t, err, "proto: required field jsonvt.Legacy.id not set")
+
+	m := &Legacy{
+		Id:    proto.String(""),
+		Count: proto.Int64(0),
+		Data:  []byte{},
+		Info:  &Legacy_Info{Note: proto.String("n")},
+		Kind:  Legacy_KIND_UNKNOWN.Enum(),
+		Ratio: proto.Float64(math.NaN()),
+	}
+	requireProtoJSON(t, m)
+	requireProtoJSON(t, &Legacy{Id: proto.String("id"), Info: &Legacy_Info{}})
+
+	proto.SetExtension(m, E_Tag, "tag")
+	requireProtoJSON(t, m)
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	m := &Collections{
+		I64S:   []int64{1, 2, 3},
+		Items:  []*Scalars{{I32: 1, S: "one", D: 1.5}, {I64: 2, S: "two", Color: Color_RED}},
+		Labels: map[string]string{"a": "1", "b": "2"},
+	}
+	b.Run("protojson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := protojson.Marshal(m); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("vtproto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := m.MarshalJSONVT(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
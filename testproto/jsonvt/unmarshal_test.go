@@ -0,0 +1,173 @@
+package jsonvt
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/planetscale/vtprotobuf/vtjson"
+)
+
+// requireUnmarshal checks that UnmarshalJSONVT decodes each of the inputs into a message
+// of the type of m like protojson.Unmarshal, or fails like it, with and without
+// discarding the unknown fields.
+func requireUnmarshal(t *testing.T, m proto.Message, inputs ...string) {
+	t.Helper()
+	for _, discard := range []bool{false, true} {
+		for _, input := range inputs {
+			want := m.ProtoReflect().New().Interface()
+			wantErr := protojson.UnmarshalOptions{DiscardUnknown: discard}.Unmarshal([]byte(input), want)
+			got := m.ProtoReflect().New().Interface()
+			err := vtjson.UnmarshalOptions{DiscardUnknown: discard}.Unmarshal([]byte(input), got)
+			if wantErr != nil {
+				require.Error(t, err, "%s: protojson failed with %v", input, wantErr)
+				continue
+			}
+			require.NoError(t, err, input)
+			require.Empty(t, cmpDiff(want, got), input)
+		}
+	}
+}
+
+func cmpDiff(want, got proto.Message) string {
+	if proto.Equal(want, got) {
+		return ""
+	}
+	// proto.Equal never equates NaNs.
+	if protojson.Format(want) == protojson.Format(got) {
+		return ""
+	}
+	return protojson.Format(want) + " != " + protojson.Format(got)
+}
+
+func TestUnmarshalScalars(t *testing.T) {
+	requireUnmarshal(t, &Scalars{},
+		`{}`, ` { } `, `null`, `[]`, `{`, `{"i32":1,}`, `{"i32":1}x`, `{"i32" 1}`, `{,}`, `{"i32":1 "i64":2}`,
+		`{"d":1.5,"f":0.1,"i32":-2147483648,"i64":"-9223372036854775808","u32":4294967295,"u64":"18446744073709551615"}`,
+		`{"s32":-1,"s64":"9223372036854775807","fx32":7,"fx64":"8","sfx32":-9,"sfx64":"-10","b":true,"s":"x","by":"AAEC/w==","color":"GREEN"}`,
+		`{"i32":"1"}`, `{"i32":" 1"}`, `{"i32":"1 "}`, `{"i32":1.0}`, `{"i32":1e2}`, `{"i32":"1e2"}`, `{"i32":1.5}`, `{"i32":100e-2}`, `{"i32":-0}`,
+		`{"i32":2147483648}`, `{"u32":-1}`, `{"i32":01}`, `{"i32":+1}`, `{"i32":1e999999999999}`, `{"i32":true}`, `{"i32":null}`, `{"i32":"x"}`, `{"i32":""}`,
+		`{"i64":9223372036854775807}`, `{"u64":18446744073709551616}`, `{"u64":"1.8446744073709551615e19"}`, `{"i64":1e19}`,
+		`{"d":"NaN"}`, `{"d":"Infinity"}`, `{"f":"-Infinity"}`, `{"d":"1.5"}`, `{"d":1e400}`, `{"f":1e39}`, `{"d":-0}`, `{"d":"nan"}`, `{"d":1.}`,
+		`{"b":"true"}`, `{"b":1}`, `{"b":truex}`, `{"b":false}`,
+		`{"s":"quote\" backslash\\ \/ \b\f\n\r\t \u0000\u001f é 😀 ünïcode"}`, `{"s":"\ud83d"}`, `{"s":"\ud83dx"}`, `{"s":"\x"}`, `{"s":"\u12"}`,
+		"{\"s\":\"\xff\"}", "{\"s\":\"a\nb\"}", `{"s":1}`, `{"s":"unterminated}`,
+		`{"by":"AAEC_w"}`, `{"by":"AAEC-w=="}`, `{"by":""}`, `{"by":"!"}`, `{"by":"AAE"}`,
+		`{"color":1}`, `{"color":42}`, `{"color":"BLUE"}`, `{"color":"1"}`, `{"color":1.0}`, `{"color":null}`,
+		`{"optI32":0,"optS":"","optColor":"COLOR_UNSPECIFIED"}`, `{"opt_i32":null}`, `{"optColor":"BLUE"}`,
+		`{"other\tname":3}`, `{"renamed":3}`, `{"other\u0009name":3}`,
+		`{"i32":1,"i32":2}`, `{"i32":1,"i32":null}`, `{"opt_i32":1,"optI32":2}`, `{"unknown":{"a":[1,"x",null,true,{}]}}`, `{"unknown":[}`, `{"unknown":tru}`,
+	)
+}
+
+func TestUnmarshalCollections(t *testing.T) {
+	requireUnmarshal(t, &Collections{},
+		`{"i64s":[1,"-2",9223372036854775807],"strings":["a","","c"],"colors":["RED",7,"BLUE"],"items":[{"i32":1},{}],"doubles":[0,"NaN",-1.25],"blobs":["","YmxvYg=="]}`,
+		`{"labels":{"b":"2","a":"1","é":"3","":"empty"},"byId":{"10":{"s":"ten"},"-1":{}},"flags":{"true":"1","false":0},"colorsById":{"18446744073709551615":"GREEN","0":0},"blobsById":{"-5":"AQ=="}}`,
+		`{"i64s":null,"labels":null}`, `{"i64s":[null]}`, `{"items":[null]}`, `{"i64s":{}}`, `{"i64s":[1,]}`, `{"i64s":[1}`, `{"labels":[]}`, `{"i64s":[],"labels":{}}`,
+		`{"labels":{"a":"1","a":"2"}}`, `{"labels":{"a":null}}`, `{"byId":{"x":{}}}`, `{"byId":{"01":{}}}`, `{"byId":{"1":null}}`, `{"byId":{"1":{},"+1":{}}}`,
+		`{"flags":{"TRUE":1}}`, `{"colorsById":{"-1":0}}`, `{"colorsById":{"1":"BLUE","1":0}}`, `{"items":[{"i32":1,"i32":1}]}`,
+	)
+}
+
+func TestUnmarshalOneofs(t *testing.T) {
+	requireUnmarshal(t, &Oneofs{},
+		`{"num":0}`, `{"before":"b","text":"","after":"a"}`, `{"msg":{"u64":"1"},"after":"a"}`, `{"color":"RED"}`, `{"color":"BLUE"}`,
+		`{"num":1,"text":"x"}`, `{"num":null,"text":"x"}`, `{"color":"BLUE","num":1}`, `{"msg":null}`, `{"msg":{},"msg":{}}`,
+	)
+}
+
+func TestUnmarshalWellKnown(t *testing.T) {
+	requireUnmarshal(t, &WellKnown{},
+		`{"ts":"2024-01-02T03:04:05.123456789Z","dur":"-1.5s","i64":"-9223372036854775808","str":"s","b":false,"d":"Infinity","by":"Yg==","f":0.5,"u64":"18446744073709551615","i32":-3,"u32":3}`,
+		`{"st":{"z":1,"a":["x",true,null],"m":{"k":1.5}},"val":"v","list":[1],"any":{"@type":"type.googleapis.com/jsonvt.Scalars","s":"inner"},"mask":"a.bC,d","empty":{}}`,
+		`{"times":["1970-01-01T00:00:00Z","1970-01-01T00:00:01.000001Z","1969-12-31T23:59:59.120Z"],"durs":{"a":"3s","b":"-0.000000001s"},"null":null,"ints":[1,0]}`,
+		`{"ts":"2024-01-02T03:04:05+01:00"}`, `{"ts":"2024-01-02T03:04:05.1234567891Z"}`, `{"ts":"10000-01-01T00:00:00Z"}`, `{"ts":"0000-01-01T00:00:00Z"}`, `{"ts":"2024-01-02"}`, `{"ts":1}`, `{"ts":null}`,
+		`{"dur":"1s"}`, `{"dur":"1.s"}`, `{"dur":".5s"}`, `{"dur":"+1s"}`, `{"dur":"-.1s"}`, `{"dur":"01s"}`, `{"dur":"1.0000000001s"}`, `{"dur":"315576000001s"}`, `{"dur":"-315576000000s"}`, `{"dur":"1"}`, `{"dur":"s"}`, `{"dur":"-s"}`, `{"dur":".s"}`, `{"dur":"99999999999999999999s"}`,
+		`{"i64":null}`, `{"i64":{"value":1}}`, `{"str":1}`, `{"empty":{"a":1}}`, `{"empty":[]}`, `{"empty":null}`,
+		`{"val":null}`, `{"val":{"a":[1]}}`, `{"st":null}`, `{"st":1}`, `{"any":{}}`, `{"any":{"@type":"type.googleapis.com/jsonvt.Unknown"}}`, `{"mask":"a_b"}`,
+		`{"null":"NULL_VALUE"}`, `{"null":0}`, `{"null":1}`, `{"null":"X"}`, `{"times":[null]}`, `{"durs":{"a":null}}`, `{"ints":[null]}`,
+		`{"any":{"@type":"type.googleapis.com/jsonvt.Scalars","unknown":1}}`,
+	)
+}
+
+func TestUnmarshalNested(t *testing.T) {
+	requireUnmarshal(t, &Nested{},
+		`{"scalars":{"i32":1},"child":{"child":{"scalars":{}}},"children":{"a":{},"b":{"children":{"c":{}}}}}`,
+		`{"child":{"unknown":1}}`, `{"child":{"child":{"child":null}}}`,
+		strings.Repeat(`{"child":`, 10001)+`{}`+strings.Repeat(`}`, 10001),
+	)
+	requireUnmarshal(t, &Empty{}, `{}`, `{"a":1}`, `{"a":1,"b":[]}`, `[]`)
+
+	m := &Nested{Scalars: &Scalars{I32: 1}}
+	require.NoError(t, m.UnmarshalJSONVT([]byte(`{"child":{}}`)))
+	require.True(t, proto.Equal(&Nested{Child: &Nested{}}, m))
+}
+
+func TestUnmarshalLegacy(t *testing.T) {
+	requireUnmarshal(t, &Legacy{},
+		`{}`, `{"id":""}`, `{"id":null}`, `{"id":"a","count":"0","data":"","Info":{"note":"n"},"kind":"KIND_UNKNOWN","ratio":"NaN"}`,
+		`{"id":"a","info":{}}`, `{"id":"a","kind":"KIND_B"}`, `{"id":"a","[jsonvt.tag]":"tag"}`, `{"[jsonvt.tag]":"tag","id":"a"}`,
+		`{"id":"a","[jsonvt.unknown]":1}`, `{"id":"a","[jsonvt.tag]":1}`,
+	)
+	err := (&Legacy{}).UnmarshalJSONVT([]byte(`{}`))
+	require.EqualError(t, err, "proto: required field jsonvt.Legacy.id not set")
+
+	m := &Legacy{}
+	require.NoError(t, m.UnmarshalJSONVT([]byte(`{"count":"1","[jsonvt.tag]":"tag","id":"a"}`)))
+	require.Equal(t, "tag", proto.GetExtension(m, E_Tag))
+	require.Equal(t, int64(1), m.GetCount())
+}
+
+func TestUnmarshalRoundTrip(t *testing.T) {
+	st, err := structpb.NewStruct(map[string]any{"k": []any{1.5, "x"}})
+	require.NoError(t, err)
+	for _, m := range []interface {
+		proto.Message
+		vtjson.Marshaler
+		vtjson.Unmarshaler
+	}{
+		&Scalars{D: math.MaxFloat64, F: math.SmallestNonzeroFloat32, I64: math.MinInt64, U64: math.MaxUint64, S: "s ", By: []byte{0xff}, Color: 42},
+		&Collections{Labels: map[string]string{"a\"": "b"}, ById: map[int32]*Scalars{math.MinInt32: {}}, Flags: map[bool]int64{true: -1}},
+		&WellKnown{St: st, Val: structpb.NewNullValue(), Null: structpb.NullValue_NULL_VALUE},
+		&Nested{Children: map[string]*Nested{"a": {Child: &Nested{}}}},
+	} {
+		data, err := m.MarshalJSONVT()
+		require.NoError(t, err)
+		got := m.ProtoReflect().New().Interface().(vtjson.Unmarshaler)
+		require.NoError(t, got.UnmarshalJSONVT(data))
+		require.Empty(t, cmpDiff(m, got.(proto.Message)), string(data))
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	data, err := (&Collections{
+		I64S:   []int64{1, 2, 3},
+		Items:  []*Scalars{{I32: 1, S: "one", D: 1.5}, {I64: 2, S: "two", Color: Color_RED}},
+		Labels: map[string]string{"a": "1", "b": "2"},
+	}).MarshalJSONVT()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("protojson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := protojson.Unmarshal(data, &Collections{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("vtproto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := (&Collections{}).UnmarshalJSONVT(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
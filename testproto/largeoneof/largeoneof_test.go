@@ -0,0 +1,92 @@
+package largeoneof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// events returns an Event for each of the fields of its body.
+func events() []*Event {
+	var events []*Event
+	fields := (&Event{}).ProtoReflect().Descriptor().Oneofs().ByName("body").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		msg := &Event{Timestamp: int64(i)}
+		fd := fields.Get(i)
+		switch fd.Kind() {
+		case protoreflect.MessageKind:
+			msg.ProtoReflect().Set(fd, protoreflect.ValueOfMessage((&Payload{Id: int64(i), Name: "payload"}).ProtoReflect()))
+		case protoreflect.StringKind:
+			msg.ProtoReflect().Set(fd, protoreflect.ValueOfString("text"))
+		default:
+			msg.ProtoReflect().Set(fd, protoreflect.ValueOfInt64(int64(i)))
+		}
+		events = append(events, msg)
+	}
+	return events
+}
+
+func TestLargeOneof(t *testing.T) {
+	for _, msg := range events() {
+		upstream, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, proto.Size(msg), msg.SizeVT())
+
+		for _, marshal := range []func() ([]byte, error){msg.MarshalVT, msg.MarshalVTStrict} {
+			vt, err := marshal()
+			require.NoError(t, err)
+			require.Equal(t, upstream, vt)
+		}
+
+		got := &Event{}
+		require.NoError(t, got.UnmarshalVT(upstream))
+		require.True(t, msg.EqualVT(got))
+	}
+}
+
+// BenchmarkLargeOneof measures the size, the marshalling and the unmarshalling of the
+// fields of a large oneof.
+func BenchmarkLargeOneof(b *testing.B) {
+	events := events()
+	buf := make([]byte, 64)
+	var encoded [][]byte
+	for _, msg := range events {
+		data, err := msg.MarshalVT()
+		require.NoError(b, err)
+		encoded = append(encoded, data)
+	}
+	b.Run("size", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, msg := range events {
+				msg.SizeVT()
+			}
+		}
+	})
+	b.Run("marshal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, msg := range events {
+				msg.MarshalToSizedBufferVT(buf[:msg.SizeVT()])
+			}
+		}
+	})
+	b.Run("marshal_strict", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, msg := range events {
+				msg.MarshalToSizedBufferVTStrict(buf[:msg.SizeVT()])
+			}
+		}
+	})
+	b.Run("unmarshal", func(b *testing.B) {
+		var msg Event
+		for i := 0; i < b.N; i++ {
+			for _, data := range encoded {
+				msg.Reset()
+				if err := msg.UnmarshalVT(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: marshalbuffer/marshalbuffer.proto
+
+package marshalbuffer
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Payload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Values        []int64                `protobuf:"varint,3,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_marshalbuffer_marshalbuffer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_marshalbuffer_marshalbuffer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_marshalbuffer_marshalbuffer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Payload) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_marshalbuffer_marshalbuffer_proto protoreflect.FileDescriptor
+
+const file_marshalbuffer_marshalbuffer_proto_rawDesc = "" +
+	"\n" +
+	"!marshalbuffer/marshalbuffer.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"O\n" +
+	"\aPayload\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\x03R\x06values:\x04Ȧ\x1f\x01B\x19Z\x17testproto/marshalbufferb\x06proto3"
+
+var (
+	file_marshalbuffer_marshalbuffer_proto_rawDescOnce sync.Once
+	file_marshalbuffer_marshalbuffer_proto_rawDescData []byte
+)
+
+func file_marshalbuffer_marshalbuffer_proto_rawDescGZIP() []byte {
+	file_marshalbuffer_marshalbuffer_proto_rawDescOnce.Do(func() {
+		file_marshalbuffer_marshalbuffer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_marshalbuffer_marshalbuffer_proto_rawDesc), len(file_marshalbuffer_marshalbuffer_proto_rawDesc)))
+	})
+	return file_marshalbuffer_marshalbuffer_proto_rawDescData
+}
+
+var file_marshalbuffer_marshalbuffer_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_marshalbuffer_marshalbuffer_proto_goTypes = []any{
+	(*Payload)(nil), // 0: Payload
+}
+var file_marshalbuffer_marshalbuffer_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_marshalbuffer_marshalbuffer_proto_init() }
+func file_marshalbuffer_marshalbuffer_proto_init() {
+	if File_marshalbuffer_marshalbuffer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_marshalbuffer_marshalbuffer_proto_rawDesc), len(file_marshalbuffer_marshalbuffer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_marshalbuffer_marshalbuffer_proto_goTypes,
+		DependencyIndexes: file_marshalbuffer_marshalbuffer_proto_depIdxs,
+		MessageInfos:      file_marshalbuffer_marshalbuffer_proto_msgTypes,
+	}.Build()
+	File_marshalbuffer_marshalbuffer_proto = out.File
+	file_marshalbuffer_marshalbuffer_proto_goTypes = nil
+	file_marshalbuffer_marshalbuffer_proto_depIdxs = nil
+}
@@ -0,0 +1,35 @@
+package marshalbuffer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalVTBuffer(t *testing.T) {
+	msg := &Payload{Name: "payload", Data: []byte{1, 2, 3}, Values: []int64{1, 300, -1}}
+	expected, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	buf, err := msg.MarshalVTBuffer()
+	require.NoError(t, err)
+	require.Equal(t, expected, buf.Bytes())
+	data := buf.Copy()
+	buf.Release()
+	require.Equal(t, expected, data)
+
+	// Once warmed up, marshaling into a pooled buffer does not allocate.
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, err := msg.MarshalVTBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Release()
+	})
+	require.Zero(t, allocs)
+
+	buf, err = (*Payload)(nil).MarshalVTBuffer()
+	require.NoError(t, err)
+	require.Nil(t, buf)
+	buf.Release()
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: maxcount/maxcount.proto
+
+package maxcount
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BoundedLists struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packed        []int32                `protobuf:"varint,1,rep,packed,name=packed,proto3" json:"packed,omitempty"`
+	Fixed         []uint64               `protobuf:"fixed64,2,rep,packed,name=fixed,proto3" json:"fixed,omitempty"`
+	Names         []string               `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	Unbounded     []int32                `protobuf:"varint,4,rep,packed,name=unbounded,proto3" json:"unbounded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundedLists) Reset() {
+	*x = BoundedLists{}
+	mi := &file_maxcount_maxcount_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundedLists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundedLists) ProtoMessage() {}
+
+func (x *BoundedLists) ProtoReflect() protoreflect.Message {
+	mi := &file_maxcount_maxcount_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundedLists.ProtoReflect.Descriptor instead.
+func (*BoundedLists) Descriptor() ([]byte, []int) {
+	return file_maxcount_maxcount_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BoundedLists) GetPacked() []int32 {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+func (x *BoundedLists) GetFixed() []uint64 {
+	if x != nil {
+		return x.Fixed
+	}
+	return nil
+}
+
+func (x *BoundedLists) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BoundedLists) GetUnbounded() []int32 {
+	if x != nil {
+		return x.Unbounded
+	}
+	return nil
+}
+
+var File_maxcount_maxcount_proto protoreflect.FileDescriptor
+
+const file_maxcount_maxcount_proto_rawDesc = "" +
+	"\n" +
+	"\x17maxcount/maxcount.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"\x88\x01\n" +
+	"\fBoundedLists\x12\x1e\n" +
+	"\x06packed\x18\x01 \x03(\x05B\x06\xb2\xa9\x1f\x02\x10\x04R\x06packed\x12\x1c\n" +
+	"\x05fixed\x18\x02 \x03(\x06B\x06\xb2\xa9\x1f\x02\x10\x02R\x05fixed\x12\x1c\n" +
+	"\x05names\x18\x03 \x03(\tB\x06\xb2\xa9\x1f\x02\x10\x03R\x05names\x12\x1c\n" +
+	"\tunbounded\x18\x04 \x03(\x05R\tunboundedB\x14Z\x12testproto/maxcountb\x06proto3"
+
+var (
+	file_maxcount_maxcount_proto_rawDescOnce sync.Once
+	file_maxcount_maxcount_proto_rawDescData []byte
+)
+
+func file_maxcount_maxcount_proto_rawDescGZIP() []byte {
+	file_maxcount_maxcount_proto_rawDescOnce.Do(func() {
+		file_maxcount_maxcount_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_maxcount_maxcount_proto_rawDesc), len(file_maxcount_maxcount_proto_rawDesc)))
+	})
+	return file_maxcount_maxcount_proto_rawDescData
+}
+
+var file_maxcount_maxcount_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_maxcount_maxcount_proto_goTypes = []any{
+	(*BoundedLists)(nil), // 0: BoundedLists
+}
+var file_maxcount_maxcount_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_maxcount_maxcount_proto_init() }
+func file_maxcount_maxcount_proto_init() {
+	if File_maxcount_maxcount_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_maxcount_maxcount_proto_rawDesc), len(file_maxcount_maxcount_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_maxcount_maxcount_proto_goTypes,
+		DependencyIndexes: file_maxcount_maxcount_proto_depIdxs,
+		MessageInfos:      file_maxcount_maxcount_proto_msgTypes,
+	}.Build()
+	File_maxcount_maxcount_proto = out.File
+	file_maxcount_maxcount_proto_goTypes = nil
+	file_maxcount_maxcount_proto_depIdxs = nil
+}
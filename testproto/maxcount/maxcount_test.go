@@ -0,0 +1,70 @@
+package maxcount
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestMaxCountWithinLimit(t *testing.T) {
+	msg := &BoundedLists{
+		Packed: []int32{1, 2, 3, 4},
+		Fixed:  []uint64{1, 2},
+		Names:  []string{"a", "b", "c"},
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	got := &BoundedLists{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, msg.EqualVT(got))
+	require.Equal(t, 4, cap(got.Packed))
+	require.Equal(t, 2, cap(got.Fixed))
+	require.Equal(t, 3, cap(got.Names))
+}
+
+func TestMaxCountExceeded(t *testing.T) {
+	for name, msg := range map[string]*BoundedLists{
+		"packed": {Packed: []int32{1, 2, 3, 4, 5}},
+		"fixed":  {Fixed: []uint64{1, 2, 3}},
+		"names":  {Names: []string{"a", "b", "c", "d"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := msg.MarshalVT()
+			require.NoError(t, err)
+			require.ErrorContains(t, (&BoundedLists{}).UnmarshalVT(data), "exceeds max_count")
+		})
+	}
+}
+
+func TestMaxCountUnpacked(t *testing.T) {
+	// Unpacked encoding of the packed field must be bounded as well.
+	var data []byte
+	for i := 0; i < 5; i++ {
+		data = protowire.AppendTag(data, 1, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(i))
+	}
+	got := &BoundedLists{}
+	require.NoError(t, got.UnmarshalVT(data[:8]))
+	require.Equal(t, []int32{0, 1, 2, 3}, got.Packed)
+	require.ErrorContains(t, (&BoundedLists{}).UnmarshalVT(data), "exceeds max_count")
+}
+
+func TestMaxCountMerge(t *testing.T) {
+	// The limit applies to the merged result, not to a single packed run.
+	part, err := (&BoundedLists{Packed: []int32{1, 2, 3}}).MarshalVT()
+	require.NoError(t, err)
+	got := &BoundedLists{}
+	require.NoError(t, got.UnmarshalVT(part))
+	require.ErrorContains(t, got.UnmarshalVT(part), "exceeds max_count")
+}
+
+func TestUnboundedList(t *testing.T) {
+	msg := &BoundedLists{Unbounded: make([]int32, 100)}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	got := &BoundedLists{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.Len(t, got.Unbounded, 100)
+}
@@ -0,0 +1,83 @@
+package maxlen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+func TestMaxLenWithinLimit(t *testing.T) {
+	msg := &Bounded{
+		Name:      "12345678",
+		Data:      []byte("1234"),
+		Tags:      []string{"a", "abc"},
+		Blobs:     map[string][]byte{"12345": []byte("12345")},
+		Value:     &Bounded_Text{Text: "12"},
+		Unbounded: strings.Repeat("x", 1000),
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	got := &Bounded{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, msg.EqualVT(got))
+	require.NoError(t, (&Bounded{}).UnmarshalVTUnsafe(data))
+}
+
+func TestMaxLenExceeded(t *testing.T) {
+	for name, tt := range map[string]struct {
+		msg   *Bounded
+		field string
+		len   int
+		max   uint64
+	}{
+		"name":      {&Bounded{Name: "123456789"}, "Bounded.name", 9, 8},
+		"data":      {&Bounded{Data: []byte("12345")}, "Bounded.data", 5, 4},
+		"tags":      {&Bounded{Tags: []string{"a", "abcd"}}, "Bounded.tags", 4, 3},
+		"map key":   {&Bounded{Blobs: map[string][]byte{"123456": nil}}, "Bounded.blobs", 6, 5},
+		"map value": {&Bounded{Blobs: map[string][]byte{"k": []byte("123456")}}, "Bounded.blobs", 6, 5},
+		"oneof":     {&Bounded{Value: &Bounded_Raw{Raw: []byte("123")}}, "Bounded.raw", 3, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := tt.msg.MarshalVT()
+			require.NoError(t, err)
+			for _, unmarshal := range []func([]byte) error{(&Bounded{}).UnmarshalVT, (&Bounded{}).UnmarshalVTUnsafe} {
+				var lerr *protohelpers.LengthError
+				require.ErrorAs(t, unmarshal(data), &lerr)
+				require.Equal(t, &protohelpers.LengthError{Field: protoreflect.FullName(tt.field), Len: tt.len, Max: tt.max}, lerr)
+			}
+		})
+	}
+}
+
+func TestMaxLenFlag(t *testing.T) {
+	long := strings.Repeat("x", 17)
+	msg := &Global{
+		Name:      strings.Repeat("x", 16),
+		Short:     "1234",
+		Unbounded: []byte(strings.Repeat("x", 1000)),
+		Labels:    map[string]string{"k": strings.Repeat("x", 16)},
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, (&Global{}).UnmarshalVT(data))
+
+	for name, msg := range map[string]*Global{
+		"name":   {Name: long},
+		"data":   {Data: []byte(long)},
+		"short":  {Short: "12345"},
+		"labels": {Labels: map[string]string{"k": long}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := msg.MarshalVT()
+			require.NoError(t, err)
+			var lerr *protohelpers.LengthError
+			require.ErrorAs(t, (&Global{}).UnmarshalVT(data), &lerr)
+			require.Contains(t, lerr.Error(), "proto: field Global.")
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package membuffer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemBufferAliasesData(t *testing.T) {
+	original := &Blob{
+		Name:    "blob",
+		Payload: []byte("payload"),
+		Chunks:  [][]byte{[]byte("first"), []byte("second")},
+		Inline:  &Blob_Data{Data: []byte("data")},
+		Copied:  []byte("copied"),
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Blob{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	clear(data)
+	require.Equal(t, "blob", msg.Name)
+	require.Equal(t, []byte("copied"), msg.Copied)
+	require.Equal(t, make([]byte, len("payload")), msg.Payload)
+	require.Equal(t, make([]byte, len("first")), msg.Chunks[0])
+	require.Equal(t, make([]byte, len("data")), msg.GetData())
+}
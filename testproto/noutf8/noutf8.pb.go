@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: noutf8/noutf8.proto
+
+package noutf8
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Trusted struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags   []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Trusted_Text
+	Value         isTrusted_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trusted) Reset() {
+	*x = Trusted{}
+	mi := &file_noutf8_noutf8_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trusted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trusted) ProtoMessage() {}
+
+func (x *Trusted) ProtoReflect() protoreflect.Message {
+	mi := &file_noutf8_noutf8_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trusted.ProtoReflect.Descriptor instead.
+func (*Trusted) Descriptor() ([]byte, []int) {
+	return file_noutf8_noutf8_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Trusted) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Trusted) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Trusted) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Trusted) GetValue() isTrusted_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Trusted) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*Trusted_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+type isTrusted_Value interface {
+	isTrusted_Value()
+}
+
+type Trusted_Text struct {
+	Text string `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+func (*Trusted_Text) isTrusted_Value() {}
+
+var File_noutf8_noutf8_proto protoreflect.FileDescriptor
+
+const file_noutf8_noutf8_proto_rawDesc = "" +
+	"\n" +
+	"\x13noutf8/noutf8.proto\"\xb9\x01\n" +
+	"\aTrusted\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12,\n" +
+	"\x06labels\x18\x03 \x03(\v2\x14.Trusted.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x04text\x18\x04 \x01(\tH\x00R\x04text\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05valueB\x12Z\x10testproto/noutf8b\x06proto3"
+
+var (
+	file_noutf8_noutf8_proto_rawDescOnce sync.Once
+	file_noutf8_noutf8_proto_rawDescData []byte
+)
+
+func file_noutf8_noutf8_proto_rawDescGZIP() []byte {
+	file_noutf8_noutf8_proto_rawDescOnce.Do(func() {
+		file_noutf8_noutf8_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_noutf8_noutf8_proto_rawDesc), len(file_noutf8_noutf8_proto_rawDesc)))
+	})
+	return file_noutf8_noutf8_proto_rawDescData
+}
+
+var file_noutf8_noutf8_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_noutf8_noutf8_proto_goTypes = []any{
+	(*Trusted)(nil), // 0: Trusted
+	nil,             // 1: Trusted.LabelsEntry
+}
+var file_noutf8_noutf8_proto_depIdxs = []int32{
+	1, // 0: Trusted.labels:type_name -> Trusted.LabelsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_noutf8_noutf8_proto_init() }
+func file_noutf8_noutf8_proto_init() {
+	if File_noutf8_noutf8_proto != nil {
+		return
+	}
+	file_noutf8_noutf8_proto_msgTypes[0].OneofWrappers = []any{
+		(*Trusted_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_noutf8_noutf8_proto_rawDesc), len(file_noutf8_noutf8_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noutf8_noutf8_proto_goTypes,
+		DependencyIndexes: file_noutf8_noutf8_proto_depIdxs,
+		MessageInfos:      file_noutf8_noutf8_proto_msgTypes,
+	}.Build()
+	File_noutf8_noutf8_proto = out.File
+	file_noutf8_noutf8_proto_goTypes = nil
+	file_noutf8_noutf8_proto_depIdxs = nil
+}
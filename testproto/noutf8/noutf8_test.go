@@ -0,0 +1,28 @@
+package noutf8
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUTF8ValidationDisabled(t *testing.T) {
+	invalid := "\xff\xfe"
+	original := &Trusted{
+		Name:   invalid,
+		Tags:   []string{invalid},
+		Labels: map[string]string{invalid: invalid},
+		Value:  &Trusted_Text{Text: invalid},
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	// The proto package enforces UTF-8 for proto3 strings.
+	require.Error(t, proto.Unmarshal(data, &Trusted{}))
+
+	msg := &Trusted{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+	require.NoError(t, (&Trusted{}).UnmarshalVTUnsafe(data))
+}
@@ -0,0 +1,45 @@
+package nowkt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// TestDisabledWellKnownTypes tests that the VT methods of messages with well-known
+// types fields fall back to the proto package when the types/known helpers are disabled
+func TestDisabledWellKnownTypes(t *testing.T) {
+	dur := durationpb.New(4*time.Hour + 2*time.Second)
+	anyVal, err := anypb.New(dur)
+	require.NoError(t, err)
+	value, err := structpb.NewValue(map[string]any{"a": 1.0, "b": []any{"c"}})
+	require.NoError(t, err)
+
+	m := &MessageWithWKT{
+		Any:         anyVal,
+		Duration:    dur,
+		Timestamp:   timestamppb.Now(),
+		StringValue: wrapperspb.String("string"),
+		ValueValue:  value,
+	}
+
+	vtProtoBytes, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(m), m.SizeVT())
+
+	decoded := &MessageWithWKT{}
+	require.NoError(t, decoded.UnmarshalVT(vtProtoBytes))
+	require.True(t, proto.Equal(m, decoded))
+	require.True(t, m.EqualVT(decoded))
+
+	clone := m.CloneVT()
+	require.True(t, proto.Equal(m, clone))
+	require.NotSame(t, m.Duration, clone.Duration)
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: only/only.proto
+
+package only
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Target struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Dep    *Dep                   `protobuf:"bytes,1,opt,name=dep,proto3" json:"dep,omitempty"`
+	Values map[string]*MapValue   `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Target_Picked
+	Choice        isTarget_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_only_only_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Target) GetDep() *Dep {
+	if x != nil {
+		return x.Dep
+	}
+	return nil
+}
+
+func (x *Target) GetValues() map[string]*MapValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Target) GetChoice() isTarget_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Target) GetPicked() *OneofValue {
+	if x != nil {
+		if x, ok := x.Choice.(*Target_Picked); ok {
+			return x.Picked
+		}
+	}
+	return nil
+}
+
+type isTarget_Choice interface {
+	isTarget_Choice()
+}
+
+type Target_Picked struct {
+	Picked *OneofValue `protobuf:"bytes,3,opt,name=picked,proto3,oneof"`
+}
+
+func (*Target_Picked) isTarget_Choice() {}
+
+type Dep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dep) Reset() {
+	*x = Dep{}
+	mi := &file_only_only_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dep) ProtoMessage() {}
+
+func (x *Dep) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dep.ProtoReflect.Descriptor instead.
+func (*Dep) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Dep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MapValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapValue) Reset() {
+	*x = MapValue{}
+	mi := &file_only_only_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapValue) ProtoMessage() {}
+
+func (x *MapValue) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapValue.ProtoReflect.Descriptor instead.
+func (*MapValue) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MapValue) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type OneofValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Set           bool                   `protobuf:"varint,1,opt,name=set,proto3" json:"set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneofValue) Reset() {
+	*x = OneofValue{}
+	mi := &file_only_only_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneofValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneofValue) ProtoMessage() {}
+
+func (x *OneofValue) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneofValue.ProtoReflect.Descriptor instead.
+func (*OneofValue) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OneofValue) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+type Unrelated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dep           *Dep                   `protobuf:"bytes,1,opt,name=dep,proto3" json:"dep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unrelated) Reset() {
+	*x = Unrelated{}
+	mi := &file_only_only_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unrelated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unrelated) ProtoMessage() {}
+
+func (x *Unrelated) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unrelated.ProtoReflect.Descriptor instead.
+func (*Unrelated) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Unrelated) GetDep() *Dep {
+	if x != nil {
+		return x.Dep
+	}
+	return nil
+}
+
+// Nested messages are only selected when they are used
+type Dep_Unused struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dep_Unused) Reset() {
+	*x = Dep_Unused{}
+	mi := &file_only_only_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dep_Unused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dep_Unused) ProtoMessage() {}
+
+func (x *Dep_Unused) ProtoReflect() protoreflect.Message {
+	mi := &file_only_only_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dep_Unused.ProtoReflect.Descriptor instead.
+func (*Dep_Unused) Descriptor() ([]byte, []int) {
+	return file_only_only_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Dep_Unused) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_only_only_proto protoreflect.FileDescriptor
+
+const file_only_only_proto_rawDesc = "" +
+	"\n" +
+	"\x0fonly/only.proto\x12\x04only\"\xd8\x01\n" +
+	"\x06Target\x12\x1b\n" +
+	"\x03dep\x18\x01 \x01(\v2\t.only.DepR\x03dep\x120\n" +
+	"\x06values\x18\x02 \x03(\v2\x18.only.Target.ValuesEntryR\x06values\x12*\n" +
+	"\x06picked\x18\x03 \x01(\v2\x10.only.OneofValueH\x00R\x06picked\x1aI\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.only.MapValueR\x05value:\x028\x01B\b\n" +
+	"\x06choice\"7\n" +
+	"\x03Dep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x1a\x1c\n" +
+	"\x06Unused\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\" \n" +
+	"\bMapValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"\x1e\n" +
+	"\n" +
+	"OneofValue\x12\x10\n" +
+	"\x03set\x18\x01 \x01(\bR\x03set\"(\n" +
+	"\tUnrelated\x12\x1b\n" +
+	"\x03dep\x18\x01 \x01(\v2\t.only.DepR\x03depB\x10Z\x0etestproto/onlyb\x06proto3"
+
+var (
+	file_only_only_proto_rawDescOnce sync.Once
+	file_only_only_proto_rawDescData []byte
+)
+
+func file_only_only_proto_rawDescGZIP() []byte {
+	file_only_only_proto_rawDescOnce.Do(func() {
+		file_only_only_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_only_only_proto_rawDesc), len(file_only_only_proto_rawDesc)))
+	})
+	return file_only_only_proto_rawDescData
+}
+
+var file_only_only_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_only_only_proto_goTypes = []any{
+	(*Target)(nil),     // 0: only.Target
+	(*Dep)(nil),        // 1: only.Dep
+	(*MapValue)(nil),   // 2: only.MapValue
+	(*OneofValue)(nil), // 3: only.OneofValue
+	(*Unrelated)(nil),  // 4: only.Unrelated
+	nil,                // 5: only.Target.ValuesEntry
+	(*Dep_Unused)(nil), // 6: only.Dep.Unused
+}
+var file_only_only_proto_depIdxs = []int32{
+	1, // 0: only.Target.dep:type_name -> only.Dep
+	5, // 1: only.Target.values:type_name -> only.Target.ValuesEntry
+	3, // 2: only.Target.picked:type_name -> only.OneofValue
+	1, // 3: only.Unrelated.dep:type_name -> only.Dep
+	2, // 4: only.Target.ValuesEntry.value:type_name -> only.MapValue
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_only_only_proto_init() }
+func file_only_only_proto_init() {
+	if File_only_only_proto != nil {
+		return
+	}
+	file_only_only_proto_msgTypes[0].OneofWrappers = []any{
+		(*Target_Picked)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_only_only_proto_rawDesc), len(file_only_only_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_only_only_proto_goTypes,
+		DependencyIndexes: file_only_only_proto_depIdxs,
+		MessageInfos:      file_only_only_proto_msgTypes,
+	}.Build()
+	File_only_only_proto = out.File
+	file_only_only_proto_goTypes = nil
+	file_only_only_proto_depIdxs = nil
+}
@@ -0,0 +1,33 @@
+package only
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func TestOnlySelectedMessages(t *testing.T) {
+	msg := &Target{
+		Dep:    &Dep{Name: "dep"},
+		Values: map[string]*MapValue{"a": {Value: 1}},
+		Choice: &Target_Picked{Picked: &OneofValue{Set: true}},
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	decoded := &Target{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, proto.Equal(msg, decoded))
+
+	for _, m := range []any{&Dep{}, &MapValue{}, &OneofValue{}} {
+		_, ok := m.(vtproto.Message)
+		require.True(t, ok, "%T", m)
+	}
+	for _, m := range []any{&Unrelated{}, &Dep_Unused{}} {
+		_, ok := m.(vtproto.Message)
+		require.False(t, ok, "%T", m)
+	}
+}
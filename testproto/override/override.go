@@ -0,0 +1,4 @@
+package override
+
+// hotSizeCalls counts the calls to the SizeVT method of Hot generated from hot_size.tmpl.
+var hotSizeCalls int
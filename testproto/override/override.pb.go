@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: override/override.proto
+
+package override
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Hot uses the SizeVT method of hot_size.tmpl
+type Hot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []int64                `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hot) Reset() {
+	*x = Hot{}
+	mi := &file_override_override_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hot) ProtoMessage() {}
+
+func (x *Hot) ProtoReflect() protoreflect.Message {
+	mi := &file_override_override_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hot.ProtoReflect.Descriptor instead.
+func (*Hot) Descriptor() ([]byte, []int) {
+	return file_override_override_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Hot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hot) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Plain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hot           *Hot                   `protobuf:"bytes,2,opt,name=hot,proto3" json:"hot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plain) Reset() {
+	*x = Plain{}
+	mi := &file_override_override_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plain) ProtoMessage() {}
+
+func (x *Plain) ProtoReflect() protoreflect.Message {
+	mi := &file_override_override_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plain.ProtoReflect.Descriptor instead.
+func (*Plain) Descriptor() ([]byte, []int) {
+	return file_override_override_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Plain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plain) GetHot() *Hot {
+	if x != nil {
+		return x.Hot
+	}
+	return nil
+}
+
+var File_override_override_proto protoreflect.FileDescriptor
+
+const file_override_override_proto_rawDesc = "" +
+	"\n" +
+	"\x17override/override.proto\x12\boverride\"1\n" +
+	"\x03Hot\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x03R\x06values\"<\n" +
+	"\x05Plain\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\x03hot\x18\x02 \x01(\v2\r.override.HotR\x03hotB\x14Z\x12testproto/overrideb\x06proto3"
+
+var (
+	file_override_override_proto_rawDescOnce sync.Once
+	file_override_override_proto_rawDescData []byte
+)
+
+func file_override_override_proto_rawDescGZIP() []byte {
+	file_override_override_proto_rawDescOnce.Do(func() {
+		file_override_override_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_override_override_proto_rawDesc), len(file_override_override_proto_rawDesc)))
+	})
+	return file_override_override_proto_rawDescData
+}
+
+var file_override_override_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_override_override_proto_goTypes = []any{
+	(*Hot)(nil),   // 0: override.Hot
+	(*Plain)(nil), // 1: override.Plain
+}
+var file_override_override_proto_depIdxs = []int32{
+	0, // 0: override.Plain.hot:type_name -> override.Hot
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_override_override_proto_init() }
+func file_override_override_proto_init() {
+	if File_override_override_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_override_override_proto_rawDesc), len(file_override_override_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_override_override_proto_goTypes,
+		DependencyIndexes: file_override_override_proto_depIdxs,
+		MessageInfos:      file_override_override_proto_msgTypes,
+	}.Build()
+	File_override_override_proto = out.File
+	file_override_override_proto_goTypes = nil
+	file_override_override_proto_depIdxs = nil
+}
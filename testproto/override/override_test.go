@@ -0,0 +1,22 @@
+package override
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTemplateOverride(t *testing.T) {
+	msg := &Plain{Name: "plain", Hot: &Hot{Name: "hot", Values: []int64{1, -1, 300}}}
+
+	calls := hotSizeCalls
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Greater(t, hotSizeCalls, calls)
+	require.Equal(t, proto.Size(msg), msg.SizeVT())
+
+	decoded := &Plain{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	require.True(t, msg.EqualVT(decoded))
+}
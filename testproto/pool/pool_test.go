@@ -231,3 +231,140 @@ func Test_Pool_Oneof_Bytes_Capacity(t *testing.T) {
 	// Verify capacity was preserved end-to-end (reused from pool after unmarshal)
 	assert.Equal(t, 1024, cap(oneof2.Test4), "capacity should be reused from pool after unmarshal")
 }
+
+func Test_Pool_Multiple_Oneof_Bytes(t *testing.T) {
+	msg := MultiOneofBytesTestFromVTPool()
+	msg.First = &MultiOneofBytesTest_FirstBytes{FirstBytes: make([]byte, 16)}
+	msg.Second = &MultiOneofBytesTest_SecondInt{SecondInt: 1}
+	msg.Third = &MultiOneofBytesTest_ThirdBytes{ThirdBytes: make([]byte, 32)}
+
+	msg.ResetVT()
+
+	// Every oneof holding bytes keeps its wrapper with an empty slice, the others are cleared.
+	first, ok := msg.First.(*MultiOneofBytesTest_FirstBytes)
+	require.True(t, ok)
+	assert.Equal(t, 0, len(first.FirstBytes))
+	assert.Equal(t, 16, cap(first.FirstBytes))
+	assert.Nil(t, msg.Second)
+	third, ok := msg.Third.(*MultiOneofBytesTest_ThirdBytes)
+	require.True(t, ok)
+	assert.Equal(t, 32, cap(third.ThirdBytes))
+	msg.ReturnToVTPool()
+}
+
+func Test_Pool_ResetVT_Reflection(t *testing.T) {
+	msg := &MemoryPoolExtension{Foo1: "foo", Foo2: 42}
+	// Store the message info in the state of the message before resetting it.
+	require.True(t, msg.ProtoReflect().IsValid())
+	msg.ResetVT()
+
+	require.True(t, proto.Equal(&MemoryPoolExtension{}, msg))
+	require.Equal(t, "MemoryPoolExtension", string(msg.ProtoReflect().Descriptor().FullName()))
+
+	data, err := proto.Marshal(&MemoryPoolExtension{Foo1: "bar"})
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(data, msg))
+	require.Equal(t, "bar", msg.Foo1)
+}
+
+func Test_Pool_CloneSliceVT(t *testing.T) {
+	in := []*MemoryPoolExtension{
+		{Foo1: "foo", Foo2: 1, Foo3: &OptionalMessage{}},
+		nil,
+		{Foo1: "bar"},
+	}
+	out := MemoryPoolExtensionCloneSliceVT(in)
+	require.Len(t, out, len(in))
+	require.Nil(t, out[1])
+	require.True(t, in[0].EqualVT(out[0]))
+	require.True(t, in[2].EqualVT(out[2]))
+	require.NotSame(t, in[0].Foo3, out[0].Foo3)
+
+	// The clones come from the pool and can be returned to it.
+	out[0].ReturnToVTPool()
+	out[2].ReturnToVTPool()
+	require.Equal(t, "foo", in[0].Foo1)
+}
+
+// freelist is a deterministic pool backend.
+type freelist struct {
+	free []any
+}
+
+func (f *freelist) Get() any {
+	if len(f.free) == 0 {
+		return nil
+	}
+	v := f.free[len(f.free)-1]
+	f.free = f.free[:len(f.free)-1]
+	return v
+}
+
+func (f *freelist) Put(v any) { f.free = append(f.free, v) }
+
+func Test_Pool_Map_Reuse(t *testing.T) {
+	values := &freelist{}
+	(*MapValue)(nil).SetVTPoolBackend(values)
+	defer (*MapValue)(nil).SetVTPoolBackend(nil)
+
+	data, err := (&MapTest{
+		Labels:   map[string]string{"a": "b"},
+		Blobs:    map[int32][]byte{1: []byte("blob")},
+		Values:   map[string]*MapValue{"v": {Name: "value", Ids: []int64{1, 2}}},
+		Elements: map[string]*MapElement{"e": {A: 1}},
+	}).MarshalVT()
+	require.NoError(t, err)
+
+	m := &MapTest{}
+	require.NoError(t, m.UnmarshalVT(data))
+	labels, value := m.Labels, m.Values["v"]
+
+	// The maps are kept empty, and the pooled values go back to their pool.
+	m.ResetVT()
+	require.True(t, m.EqualVT(&MapTest{}))
+	require.Empty(t, m.Labels)
+	require.NotNil(t, m.Labels)
+	require.NotNil(t, m.Blobs)
+	require.NotNil(t, m.Values)
+	require.NotNil(t, m.Elements)
+	require.Equal(t, []any{value}, values.free)
+	require.Empty(t, value.Name)
+
+	// Unmarshal fills the same maps, with values taken from the pool.
+	require.NoError(t, m.UnmarshalVT(data))
+	m.Labels["c"] = "d"
+	require.Equal(t, "d", labels["c"])
+	require.Same(t, value, m.Values["v"])
+	require.Equal(t, []int64{1, 2}, value.Ids)
+	require.Empty(t, values.free)
+}
+
+func Test_Pool_Map_Release(t *testing.T) {
+	values := &freelist{}
+	(*MapValue)(nil).SetVTPoolBackend(values)
+	defer (*MapValue)(nil).SetVTPoolBackend(nil)
+
+	data, err := (&MapHolder{
+		Values:   map[string]*MapValue{"v": {Name: "value"}},
+		Elements: map[string]*MapElement{"e": {A: 1}},
+	}).MarshalVT()
+	require.NoError(t, err)
+
+	// MapHolder is not pooled, but the values of its map are.
+	m := &MapHolder{}
+	require.NoError(t, m.UnmarshalVT(data))
+	value := m.Values["v"]
+	require.Equal(t, "value", value.Name)
+
+	m.ReleaseMapVT()
+	require.Empty(t, m.Values)
+	require.Len(t, m.Elements, 1)
+	require.Equal(t, []any{value}, values.free)
+	require.Empty(t, value.Name)
+
+	require.NoError(t, m.UnmarshalVT(data))
+	require.Same(t, value, m.Values["v"])
+	require.Empty(t, values.free)
+
+	(*MapHolder)(nil).ReleaseMapVT()
+}
@@ -7,6 +7,8 @@ package pool
 import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtfreeze "github.com/planetscale/vtprotobuf/vtfreeze"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
@@ -37,6 +39,27 @@ func (m *OptionalMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OptionalMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OptionalMessage.
+func OptionalMessageCloneSliceVT(in []*OptionalMessage) []*OptionalMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OptionalMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OptionalMessageFromVTPool()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *MemoryPoolExtension) CloneVT() *MemoryPoolExtension {
 	if m == nil {
 		return (*MemoryPoolExtension)(nil)
@@ -56,6 +79,30 @@ func (m *MemoryPoolExtension) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// MemoryPoolExtensionCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of MemoryPoolExtension.
+func MemoryPoolExtensionCloneSliceVT(in []*MemoryPoolExtension) []*MemoryPoolExtension {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MemoryPoolExtension, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := MemoryPoolExtensionFromVTPool()
+		r.Foo1 = m.Foo1
+		r.Foo2 = m.Foo2
+		r.Foo3 = m.Foo3.CloneVT()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *OptionalMessage) EqualVT(that *OptionalMessage) bool {
 	if this == that {
 		return true
@@ -97,6 +144,14 @@ func (this *MemoryPoolExtension) EqualMessageVT(thatMsg proto.Message) bool {
 	}
 	return this.EqualVT(that)
 }
+func (m *OptionalMessage) FreezeVT() {
+	vtfreeze.Freeze(m)
+}
+
+func (m *MemoryPoolExtension) FreezeVT() {
+	vtfreeze.Freeze(m)
+}
+
 func (m *OptionalMessage) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -119,6 +174,9 @@ func (m *OptionalMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
+	if vtfreeze.Enabled {
+		vtfreeze.Check(m)
+	}
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -152,6 +210,9 @@ func (m *MemoryPoolExtension) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
+	if vtfreeze.Enabled {
+		vtfreeze.Check(m)
+	}
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -207,6 +268,9 @@ func (m *OptionalMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	if m == nil {
 		return 0, nil
 	}
+	if vtfreeze.Enabled {
+		vtfreeze.Check(m)
+	}
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -240,6 +304,9 @@ func (m *MemoryPoolExtension) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 	if m == nil {
 		return 0, nil
 	}
+	if vtfreeze.Enabled {
+		vtfreeze.Check(m)
+	}
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -273,16 +340,34 @@ func (m *MemoryPoolExtension) MarshalToSizedBufferVTStrict(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-var vtprotoPool_OptionalMessage = sync.Pool{
+func (m *OptionalMessage) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *MemoryPoolExtension) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OptionalMessage) ResetVT() {
+	if m != nil {
+		if vtfreeze.Enabled {
+			vtfreeze.Thaw(m)
+		}
+		*m = OptionalMessage{}
+	}
+}
+
+var vtprotoPool_OptionalMessage vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &OptionalMessage{}
 	},
 }
 
-func (m *OptionalMessage) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by OptionalMessageFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OptionalMessage) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OptionalMessage{} }}
 	}
+	vtprotoPool_OptionalMessage = b
 }
 func (m *OptionalMessage) ReturnToVTPool() {
 	if m != nil {
@@ -291,20 +376,34 @@ func (m *OptionalMessage) ReturnToVTPool() {
 	}
 }
 func OptionalMessageFromVTPool() *OptionalMessage {
-	return vtprotoPool_OptionalMessage.Get().(*OptionalMessage)
+	if m, ok := vtprotoPool_OptionalMessage.Get().(*OptionalMessage); ok {
+		return m
+	}
+	return &OptionalMessage{}
+}
+func (m *MemoryPoolExtension) ResetVT() {
+	if m != nil {
+		if vtfreeze.Enabled {
+			vtfreeze.Thaw(m)
+		}
+		m.Foo3.ReturnToVTPool()
+		*m = MemoryPoolExtension{}
+	}
 }
 
-var vtprotoPool_MemoryPoolExtension = sync.Pool{
+var vtprotoPool_MemoryPoolExtension vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &MemoryPoolExtension{}
 	},
 }
 
-func (m *MemoryPoolExtension) ResetVT() {
-	if m != nil {
-		m.Foo3.ReturnToVTPool()
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by MemoryPoolExtensionFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*MemoryPoolExtension) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &MemoryPoolExtension{} }}
 	}
+	vtprotoPool_MemoryPoolExtension = b
 }
 func (m *MemoryPoolExtension) ReturnToVTPool() {
 	if m != nil {
@@ -313,7 +412,10 @@ func (m *MemoryPoolExtension) ReturnToVTPool() {
 	}
 }
 func MemoryPoolExtensionFromVTPool() *MemoryPoolExtension {
-	return vtprotoPool_MemoryPoolExtension.Get().(*MemoryPoolExtension)
+	if m, ok := vtprotoPool_MemoryPoolExtension.Get().(*MemoryPoolExtension); ok {
+		return m
+	}
+	return &MemoryPoolExtension{}
 }
 func (m *OptionalMessage) SizeVT() (n int) {
 	if m == nil {
@@ -136,6 +136,164 @@ func (*OneofTest_Test3_) isOneofTest_Test() {}
 
 func (*OneofTest_Test4) isOneofTest_Test() {}
 
+type MultiOneofBytesTest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to First:
+	//
+	//	*MultiOneofBytesTest_FirstBytes
+	//	*MultiOneofBytesTest_FirstString
+	First isMultiOneofBytesTest_First `protobuf_oneof:"first"`
+	// Types that are valid to be assigned to Second:
+	//
+	//	*MultiOneofBytesTest_SecondInt
+	//	*MultiOneofBytesTest_SecondBytes
+	Second isMultiOneofBytesTest_Second `protobuf_oneof:"second"`
+	// Types that are valid to be assigned to Third:
+	//
+	//	*MultiOneofBytesTest_ThirdBytes
+	Third         isMultiOneofBytesTest_Third `protobuf_oneof:"third"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiOneofBytesTest) Reset() {
+	*x = MultiOneofBytesTest{}
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiOneofBytesTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiOneofBytesTest) ProtoMessage() {}
+
+func (x *MultiOneofBytesTest) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiOneofBytesTest.ProtoReflect.Descriptor instead.
+func (*MultiOneofBytesTest) Descriptor() ([]byte, []int) {
+	return file_pool_pool_with_oneof_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MultiOneofBytesTest) GetFirst() isMultiOneofBytesTest_First {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *MultiOneofBytesTest) GetFirstBytes() []byte {
+	if x != nil {
+		if x, ok := x.First.(*MultiOneofBytesTest_FirstBytes); ok {
+			return x.FirstBytes
+		}
+	}
+	return nil
+}
+
+func (x *MultiOneofBytesTest) GetFirstString() string {
+	if x != nil {
+		if x, ok := x.First.(*MultiOneofBytesTest_FirstString); ok {
+			return x.FirstString
+		}
+	}
+	return ""
+}
+
+func (x *MultiOneofBytesTest) GetSecond() isMultiOneofBytesTest_Second {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *MultiOneofBytesTest) GetSecondInt() int32 {
+	if x != nil {
+		if x, ok := x.Second.(*MultiOneofBytesTest_SecondInt); ok {
+			return x.SecondInt
+		}
+	}
+	return 0
+}
+
+func (x *MultiOneofBytesTest) GetSecondBytes() []byte {
+	if x != nil {
+		if x, ok := x.Second.(*MultiOneofBytesTest_SecondBytes); ok {
+			return x.SecondBytes
+		}
+	}
+	return nil
+}
+
+func (x *MultiOneofBytesTest) GetThird() isMultiOneofBytesTest_Third {
+	if x != nil {
+		return x.Third
+	}
+	return nil
+}
+
+func (x *MultiOneofBytesTest) GetThirdBytes() []byte {
+	if x != nil {
+		if x, ok := x.Third.(*MultiOneofBytesTest_ThirdBytes); ok {
+			return x.ThirdBytes
+		}
+	}
+	return nil
+}
+
+type isMultiOneofBytesTest_First interface {
+	isMultiOneofBytesTest_First()
+}
+
+type MultiOneofBytesTest_FirstBytes struct {
+	FirstBytes []byte `protobuf:"bytes,1,opt,name=first_bytes,json=firstBytes,proto3,oneof"`
+}
+
+type MultiOneofBytesTest_FirstString struct {
+	FirstString string `protobuf:"bytes,2,opt,name=first_string,json=firstString,proto3,oneof"`
+}
+
+func (*MultiOneofBytesTest_FirstBytes) isMultiOneofBytesTest_First() {}
+
+func (*MultiOneofBytesTest_FirstString) isMultiOneofBytesTest_First() {}
+
+type isMultiOneofBytesTest_Second interface {
+	isMultiOneofBytesTest_Second()
+}
+
+type MultiOneofBytesTest_SecondInt struct {
+	SecondInt int32 `protobuf:"varint,3,opt,name=second_int,json=secondInt,proto3,oneof"`
+}
+
+type MultiOneofBytesTest_SecondBytes struct {
+	SecondBytes []byte `protobuf:"bytes,4,opt,name=second_bytes,json=secondBytes,proto3,oneof"`
+}
+
+func (*MultiOneofBytesTest_SecondInt) isMultiOneofBytesTest_Second() {}
+
+func (*MultiOneofBytesTest_SecondBytes) isMultiOneofBytesTest_Second() {}
+
+type isMultiOneofBytesTest_Third interface {
+	isMultiOneofBytesTest_Third()
+}
+
+type MultiOneofBytesTest_ThirdBytes struct {
+	ThirdBytes []byte `protobuf:"bytes,5,opt,name=third_bytes,json=thirdBytes,proto3,oneof"`
+}
+
+func (*MultiOneofBytesTest_ThirdBytes) isMultiOneofBytesTest_Third() {}
+
 type OneofTest_Test1 struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	A             int64                  `protobuf:"varint,1,opt,name=a,proto3" json:"a,omitempty"`
@@ -145,7 +303,7 @@ type OneofTest_Test1 struct {
 
 func (x *OneofTest_Test1) Reset() {
 	*x = OneofTest_Test1{}
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[1]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -157,7 +315,7 @@ func (x *OneofTest_Test1) String() string {
 func (*OneofTest_Test1) ProtoMessage() {}
 
 func (x *OneofTest_Test1) ProtoReflect() protoreflect.Message {
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[1]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,7 +347,7 @@ type OneofTest_Test2 struct {
 
 func (x *OneofTest_Test2) Reset() {
 	*x = OneofTest_Test2{}
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[2]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +359,7 @@ func (x *OneofTest_Test2) String() string {
 func (*OneofTest_Test2) ProtoMessage() {}
 
 func (x *OneofTest_Test2) ProtoReflect() protoreflect.Message {
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[2]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +391,7 @@ type OneofTest_Test3 struct {
 
 func (x *OneofTest_Test3) Reset() {
 	*x = OneofTest_Test3{}
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[3]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +403,7 @@ func (x *OneofTest_Test3) String() string {
 func (*OneofTest_Test3) ProtoMessage() {}
 
 func (x *OneofTest_Test3) ProtoReflect() protoreflect.Message {
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[3]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +435,7 @@ type OneofTest_Test3_Element2 struct {
 
 func (x *OneofTest_Test3_Element2) Reset() {
 	*x = OneofTest_Test3_Element2{}
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[4]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +447,7 @@ func (x *OneofTest_Test3_Element2) String() string {
 func (*OneofTest_Test3_Element2) ProtoMessage() {}
 
 func (x *OneofTest_Test3_Element2) ProtoReflect() protoreflect.Message {
-	mi := &file_pool_pool_with_oneof_proto_msgTypes[4]
+	mi := &file_pool_pool_with_oneof_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +488,19 @@ const file_pool_pool_with_oneof_proto_rawDesc = "" +
 	"\x01c\x18\x01 \x01(\v2\x19.OneofTest.Test3.Element2R\x01c\x1a\x1e\n" +
 	"\bElement2\x12\f\n" +
 	"\x01d\x18\x01 \x01(\x03R\x01d:\x04\xa8\xa6\x1f\x01:\x04\xa8\xa6\x1f\x01:\x04\xa8\xa6\x1f\x01B\x06\n" +
-	"\x04testB\x10Z\x0etestproto/poolb\x06proto3"
+	"\x04test\"\xe8\x01\n" +
+	"\x13MultiOneofBytesTest\x12!\n" +
+	"\vfirst_bytes\x18\x01 \x01(\fH\x00R\n" +
+	"firstBytes\x12#\n" +
+	"\ffirst_string\x18\x02 \x01(\tH\x00R\vfirstString\x12\x1f\n" +
+	"\n" +
+	"second_int\x18\x03 \x01(\x05H\x01R\tsecondInt\x12#\n" +
+	"\fsecond_bytes\x18\x04 \x01(\fH\x01R\vsecondBytes\x12!\n" +
+	"\vthird_bytes\x18\x05 \x01(\fH\x02R\n" +
+	"thirdBytes:\x04\xa8\xa6\x1f\x01B\a\n" +
+	"\x05firstB\b\n" +
+	"\x06secondB\a\n" +
+	"\x05thirdB\x10Z\x0etestproto/poolb\x06proto3"
 
 var (
 	file_pool_pool_with_oneof_proto_rawDescOnce sync.Once
@@ -344,19 +514,20 @@ func file_pool_pool_with_oneof_proto_rawDescGZIP() []byte {
 	return file_pool_pool_with_oneof_proto_rawDescData
 }
 
-var file_pool_pool_with_oneof_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pool_pool_with_oneof_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pool_pool_with_oneof_proto_goTypes = []any{
 	(*OneofTest)(nil),                // 0: OneofTest
-	(*OneofTest_Test1)(nil),          // 1: OneofTest.Test1
-	(*OneofTest_Test2)(nil),          // 2: OneofTest.Test2
-	(*OneofTest_Test3)(nil),          // 3: OneofTest.Test3
-	(*OneofTest_Test3_Element2)(nil), // 4: OneofTest.Test3.Element2
+	(*MultiOneofBytesTest)(nil),      // 1: MultiOneofBytesTest
+	(*OneofTest_Test1)(nil),          // 2: OneofTest.Test1
+	(*OneofTest_Test2)(nil),          // 3: OneofTest.Test2
+	(*OneofTest_Test3)(nil),          // 4: OneofTest.Test3
+	(*OneofTest_Test3_Element2)(nil), // 5: OneofTest.Test3.Element2
 }
 var file_pool_pool_with_oneof_proto_depIdxs = []int32{
-	1, // 0: OneofTest.test1:type_name -> OneofTest.Test1
-	2, // 1: OneofTest.test2:type_name -> OneofTest.Test2
-	3, // 2: OneofTest.test3:type_name -> OneofTest.Test3
-	4, // 3: OneofTest.Test3.c:type_name -> OneofTest.Test3.Element2
+	2, // 0: OneofTest.test1:type_name -> OneofTest.Test1
+	3, // 1: OneofTest.test2:type_name -> OneofTest.Test2
+	4, // 2: OneofTest.test3:type_name -> OneofTest.Test3
+	5, // 3: OneofTest.Test3.c:type_name -> OneofTest.Test3.Element2
 	4, // [4:4] is the sub-list for method output_type
 	4, // [4:4] is the sub-list for method input_type
 	4, // [4:4] is the sub-list for extension type_name
@@ -375,13 +546,20 @@ func file_pool_pool_with_oneof_proto_init() {
 		(*OneofTest_Test3_)(nil),
 		(*OneofTest_Test4)(nil),
 	}
+	file_pool_pool_with_oneof_proto_msgTypes[1].OneofWrappers = []any{
+		(*MultiOneofBytesTest_FirstBytes)(nil),
+		(*MultiOneofBytesTest_FirstString)(nil),
+		(*MultiOneofBytesTest_SecondInt)(nil),
+		(*MultiOneofBytesTest_SecondBytes)(nil),
+		(*MultiOneofBytesTest_ThirdBytes)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pool_pool_with_oneof_proto_rawDesc), len(file_pool_pool_with_oneof_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,9 +7,11 @@ package pool
 import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	slices "slices"
 	sync "sync"
 	unsafe "unsafe"
 )
@@ -38,6 +40,28 @@ func (m *OneofTest_Test1) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneofTest_Test1CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OneofTest_Test1.
+func OneofTest_Test1CloneSliceVT(in []*OneofTest_Test1) []*OneofTest_Test1 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneofTest_Test1, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OneofTest_Test1FromVTPool()
+		r.A = m.A
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneofTest_Test2) CloneVT() *OneofTest_Test2 {
 	if m == nil {
 		return (*OneofTest_Test2)(nil)
@@ -59,6 +83,32 @@ func (m *OneofTest_Test2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneofTest_Test2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OneofTest_Test2.
+func OneofTest_Test2CloneSliceVT(in []*OneofTest_Test2) []*OneofTest_Test2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneofTest_Test2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OneofTest_Test2FromVTPool()
+		if rhs := m.B; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.B = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneofTest_Test3_Element2) CloneVT() *OneofTest_Test3_Element2 {
 	if m == nil {
 		return (*OneofTest_Test3_Element2)(nil)
@@ -76,6 +126,28 @@ func (m *OneofTest_Test3_Element2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneofTest_Test3_Element2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OneofTest_Test3_Element2.
+func OneofTest_Test3_Element2CloneSliceVT(in []*OneofTest_Test3_Element2) []*OneofTest_Test3_Element2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneofTest_Test3_Element2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OneofTest_Test3_Element2FromVTPool()
+		r.D = m.D
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneofTest_Test3) CloneVT() *OneofTest_Test3 {
 	if m == nil {
 		return (*OneofTest_Test3)(nil)
@@ -93,6 +165,28 @@ func (m *OneofTest_Test3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneofTest_Test3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OneofTest_Test3.
+func OneofTest_Test3CloneSliceVT(in []*OneofTest_Test3) []*OneofTest_Test3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneofTest_Test3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OneofTest_Test3FromVTPool()
+		r.C = m.C.CloneVT()
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneofTest) CloneVT() *OneofTest {
 	if m == nil {
 		return (*OneofTest)(nil)
@@ -112,6 +206,30 @@ func (m *OneofTest) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OneofTestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of OneofTest.
+func OneofTestCloneSliceVT(in []*OneofTest) []*OneofTest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OneofTest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := OneofTestFromVTPool()
+		if m.Test != nil {
+			r.Test = m.Test.(interface{ CloneVT() isOneofTest_Test }).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *OneofTest_Test1_) CloneVT() isOneofTest_Test {
 	if m == nil {
 		return (*OneofTest_Test1_)(nil)
@@ -152,6 +270,130 @@ func (m *OneofTest_Test4) CloneVT() isOneofTest_Test {
 	return r
 }
 
+func (m *MultiOneofBytesTest) CloneVT() *MultiOneofBytesTest {
+	if m == nil {
+		return (*MultiOneofBytesTest)(nil)
+	}
+	r := MultiOneofBytesTestFromVTPool()
+	if m.First != nil {
+		r.First = m.First.(interface {
+			CloneVT() isMultiOneofBytesTest_First
+		}).CloneVT()
+	}
+	if m.Second != nil {
+		r.Second = m.Second.(interface {
+			CloneVT() isMultiOneofBytesTest_Second
+		}).CloneVT()
+	}
+	if m.Third != nil {
+		r.Third = m.Third.(interface {
+			CloneVT() isMultiOneofBytesTest_Third
+		}).CloneVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MultiOneofBytesTest) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// MultiOneofBytesTestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of MultiOneofBytesTest.
+func MultiOneofBytesTestCloneSliceVT(in []*MultiOneofBytesTest) []*MultiOneofBytesTest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MultiOneofBytesTest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := MultiOneofBytesTestFromVTPool()
+		if m.First != nil {
+			r.First = m.First.(interface {
+				CloneVT() isMultiOneofBytesTest_First
+			}).CloneVT()
+		}
+		if m.Second != nil {
+			r.Second = m.Second.(interface {
+				CloneVT() isMultiOneofBytesTest_Second
+			}).CloneVT()
+		}
+		if m.Third != nil {
+			r.Third = m.Third.(interface {
+				CloneVT() isMultiOneofBytesTest_Third
+			}).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *MultiOneofBytesTest_FirstBytes) CloneVT() isMultiOneofBytesTest_First {
+	if m == nil {
+		return (*MultiOneofBytesTest_FirstBytes)(nil)
+	}
+	r := new(MultiOneofBytesTest_FirstBytes)
+	if rhs := m.FirstBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.FirstBytes = tmpBytes
+	}
+	return r
+}
+
+func (m *MultiOneofBytesTest_FirstString) CloneVT() isMultiOneofBytesTest_First {
+	if m == nil {
+		return (*MultiOneofBytesTest_FirstString)(nil)
+	}
+	r := new(MultiOneofBytesTest_FirstString)
+	r.FirstString = m.FirstString
+	return r
+}
+
+func (m *MultiOneofBytesTest_SecondInt) CloneVT() isMultiOneofBytesTest_Second {
+	if m == nil {
+		return (*MultiOneofBytesTest_SecondInt)(nil)
+	}
+	r := new(MultiOneofBytesTest_SecondInt)
+	r.SecondInt = m.SecondInt
+	return r
+}
+
+func (m *MultiOneofBytesTest_SecondBytes) CloneVT() isMultiOneofBytesTest_Second {
+	if m == nil {
+		return (*MultiOneofBytesTest_SecondBytes)(nil)
+	}
+	r := new(MultiOneofBytesTest_SecondBytes)
+	if rhs := m.SecondBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.SecondBytes = tmpBytes
+	}
+	return r
+}
+
+func (m *MultiOneofBytesTest_ThirdBytes) CloneVT() isMultiOneofBytesTest_Third {
+	if m == nil {
+		return (*MultiOneofBytesTest_ThirdBytes)(nil)
+	}
+	r := new(MultiOneofBytesTest_ThirdBytes)
+	if rhs := m.ThirdBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.ThirdBytes = tmpBytes
+	}
+	return r
+}
+
 func (this *OneofTest_Test1) EqualVT(that *OneofTest_Test1) bool {
 	if this == that {
 		return true
@@ -177,15 +419,9 @@ func (this *OneofTest_Test2) EqualVT(that *OneofTest_Test2) bool {
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.B) != len(that.B) {
+	if !slices.Equal(this.B, that.B) {
 		return false
 	}
-	for i, vx := range this.B {
-		vy := that.B[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -352,6 +588,143 @@ func (this *OneofTest_Test4) EqualVT(thatIface isOneofTest_Test) bool {
 	return true
 }
 
+func (this *MultiOneofBytesTest) EqualVT(that *MultiOneofBytesTest) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.First == nil && that.First != nil {
+		return false
+	} else if this.First != nil {
+		if that.First == nil {
+			return false
+		}
+		if !this.First.(interface {
+			EqualVT(isMultiOneofBytesTest_First) bool
+		}).EqualVT(that.First) {
+			return false
+		}
+	}
+	if this.Second == nil && that.Second != nil {
+		return false
+	} else if this.Second != nil {
+		if that.Second == nil {
+			return false
+		}
+		if !this.Second.(interface {
+			EqualVT(isMultiOneofBytesTest_Second) bool
+		}).EqualVT(that.Second) {
+			return false
+		}
+	}
+	if this.Third == nil && that.Third != nil {
+		return false
+	} else if this.Third != nil {
+		if that.Third == nil {
+			return false
+		}
+		if !this.Third.(interface {
+			EqualVT(isMultiOneofBytesTest_Third) bool
+		}).EqualVT(that.Third) {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MultiOneofBytesTest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MultiOneofBytesTest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *MultiOneofBytesTest_FirstBytes) EqualVT(thatIface isMultiOneofBytesTest_First) bool {
+	that, ok := thatIface.(*MultiOneofBytesTest_FirstBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.FirstBytes) != string(that.FirstBytes) {
+		return false
+	}
+	return true
+}
+
+func (this *MultiOneofBytesTest_FirstString) EqualVT(thatIface isMultiOneofBytesTest_First) bool {
+	that, ok := thatIface.(*MultiOneofBytesTest_FirstString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.FirstString != that.FirstString {
+		return false
+	}
+	return true
+}
+
+func (this *MultiOneofBytesTest_SecondInt) EqualVT(thatIface isMultiOneofBytesTest_Second) bool {
+	that, ok := thatIface.(*MultiOneofBytesTest_SecondInt)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.SecondInt != that.SecondInt {
+		return false
+	}
+	return true
+}
+
+func (this *MultiOneofBytesTest_SecondBytes) EqualVT(thatIface isMultiOneofBytesTest_Second) bool {
+	that, ok := thatIface.(*MultiOneofBytesTest_SecondBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.SecondBytes) != string(that.SecondBytes) {
+		return false
+	}
+	return true
+}
+
+func (this *MultiOneofBytesTest_ThirdBytes) EqualVT(thatIface isMultiOneofBytesTest_Third) bool {
+	that, ok := thatIface.(*MultiOneofBytesTest_ThirdBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.ThirdBytes) != string(that.ThirdBytes) {
+		return false
+	}
+	return true
+}
+
 func (m *OneofTest_Test1) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -638,25 +1011,25 @@ func (m *OneofTest_Test4) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
-func (m *OneofTest_Test1) MarshalVTStrict() (dAtA []byte, err error) {
+func (m *MultiOneofBytesTest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *OneofTest_Test1) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *MultiOneofBytesTest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *OneofTest_Test1) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+func (m *MultiOneofBytesTest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -668,43 +1041,171 @@ func (m *OneofTest_Test1) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.A != 0 {
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.A))
-		i--
-		dAtA[i] = 0x8
+	if vtmsg, ok := m.Third.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if vtmsg, ok := m.Second.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if vtmsg, ok := m.First.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OneofTest_Test2) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
+func (m *MultiOneofBytesTest_FirstBytes) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *OneofTest_Test2) MarshalToVTStrict(dAtA []byte) (int, error) {
+func (m *MultiOneofBytesTest_FirstBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.FirstBytes)
+	copy(dAtA[i:], m.FirstBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.FirstBytes)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_FirstString) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *OneofTest_Test2) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
+func (m *MultiOneofBytesTest_FirstString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	i -= len(m.FirstString)
+	copy(dAtA[i:], m.FirstString)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.FirstString)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_SecondInt) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_SecondInt) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SecondInt))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_SecondBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_SecondBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SecondBytes)
+	copy(dAtA[i:], m.SecondBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SecondBytes)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_ThirdBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_ThirdBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ThirdBytes)
+	copy(dAtA[i:], m.ThirdBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.ThirdBytes)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *OneofTest_Test1) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OneofTest_Test1) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OneofTest_Test1) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.A != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.A))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OneofTest_Test2) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OneofTest_Test2) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *OneofTest_Test2) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
 	if len(m.B) > 0 {
 		for iNdEx := len(m.B) - 1; iNdEx >= 0; iNdEx-- {
@@ -943,17 +1444,179 @@ func (m *OneofTest_Test4) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	dAtA[i] = 0x22
 	return len(dAtA) - i, nil
 }
+func (m *MultiOneofBytesTest) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
-var vtprotoPool_OneofTest_Test1 = sync.Pool{
+func (m *MultiOneofBytesTest) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if msg, ok := m.Third.(*MultiOneofBytesTest_ThirdBytes); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Second.(*MultiOneofBytesTest_SecondBytes); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.Second.(*MultiOneofBytesTest_SecondInt); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.First.(*MultiOneofBytesTest_FirstString); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if msg, ok := m.First.(*MultiOneofBytesTest_FirstBytes); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiOneofBytesTest_FirstBytes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_FirstBytes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.FirstBytes)
+	copy(dAtA[i:], m.FirstBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.FirstBytes)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_FirstString) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_FirstString) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.FirstString)
+	copy(dAtA[i:], m.FirstString)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.FirstString)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_SecondInt) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_SecondInt) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(m.SecondInt))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_SecondBytes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_SecondBytes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SecondBytes)
+	copy(dAtA[i:], m.SecondBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.SecondBytes)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *MultiOneofBytesTest_ThirdBytes) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *MultiOneofBytesTest_ThirdBytes) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ThirdBytes)
+	copy(dAtA[i:], m.ThirdBytes)
+	i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.ThirdBytes)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *OneofTest_Test1) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneofTest_Test2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneofTest_Test3_Element2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneofTest_Test3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneofTest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *MultiOneofBytesTest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *OneofTest_Test1) ResetVT() {
+	if m != nil {
+		*m = OneofTest_Test1{}
+	}
+}
+
+var vtprotoPool_OneofTest_Test1 vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &OneofTest_Test1{}
 	},
 }
 
-func (m *OneofTest_Test1) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by OneofTest_Test1FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OneofTest_Test1) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OneofTest_Test1{} }}
 	}
+	vtprotoPool_OneofTest_Test1 = b
 }
 func (m *OneofTest_Test1) ReturnToVTPool() {
 	if m != nil {
@@ -962,22 +1625,33 @@ func (m *OneofTest_Test1) ReturnToVTPool() {
 	}
 }
 func OneofTest_Test1FromVTPool() *OneofTest_Test1 {
-	return vtprotoPool_OneofTest_Test1.Get().(*OneofTest_Test1)
+	if m, ok := vtprotoPool_OneofTest_Test1.Get().(*OneofTest_Test1); ok {
+		return m
+	}
+	return &OneofTest_Test1{}
+}
+func (m *OneofTest_Test2) ResetVT() {
+	if m != nil {
+		clear(m.B)
+		f0 := m.B[:0]
+		*m = OneofTest_Test2{}
+		m.B = f0
+	}
 }
 
-var vtprotoPool_OneofTest_Test2 = sync.Pool{
+var vtprotoPool_OneofTest_Test2 vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &OneofTest_Test2{}
 	},
 }
 
-func (m *OneofTest_Test2) ResetVT() {
-	if m != nil {
-		clear(m.B)
-		f0 := m.B[:0]
-		m.Reset()
-		m.B = f0
+// SetVTPoolBackend replaces the pool used by OneofTest_Test2FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OneofTest_Test2) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OneofTest_Test2{} }}
 	}
+	vtprotoPool_OneofTest_Test2 = b
 }
 func (m *OneofTest_Test2) ReturnToVTPool() {
 	if m != nil {
@@ -986,19 +1660,30 @@ func (m *OneofTest_Test2) ReturnToVTPool() {
 	}
 }
 func OneofTest_Test2FromVTPool() *OneofTest_Test2 {
-	return vtprotoPool_OneofTest_Test2.Get().(*OneofTest_Test2)
+	if m, ok := vtprotoPool_OneofTest_Test2.Get().(*OneofTest_Test2); ok {
+		return m
+	}
+	return &OneofTest_Test2{}
+}
+func (m *OneofTest_Test3_Element2) ResetVT() {
+	if m != nil {
+		*m = OneofTest_Test3_Element2{}
+	}
 }
 
-var vtprotoPool_OneofTest_Test3_Element2 = sync.Pool{
+var vtprotoPool_OneofTest_Test3_Element2 vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &OneofTest_Test3_Element2{}
 	},
 }
 
-func (m *OneofTest_Test3_Element2) ResetVT() {
-	if m != nil {
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by OneofTest_Test3_Element2FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OneofTest_Test3_Element2) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OneofTest_Test3_Element2{} }}
 	}
+	vtprotoPool_OneofTest_Test3_Element2 = b
 }
 func (m *OneofTest_Test3_Element2) ReturnToVTPool() {
 	if m != nil {
@@ -1007,20 +1692,31 @@ func (m *OneofTest_Test3_Element2) ReturnToVTPool() {
 	}
 }
 func OneofTest_Test3_Element2FromVTPool() *OneofTest_Test3_Element2 {
-	return vtprotoPool_OneofTest_Test3_Element2.Get().(*OneofTest_Test3_Element2)
+	if m, ok := vtprotoPool_OneofTest_Test3_Element2.Get().(*OneofTest_Test3_Element2); ok {
+		return m
+	}
+	return &OneofTest_Test3_Element2{}
+}
+func (m *OneofTest_Test3) ResetVT() {
+	if m != nil {
+		m.C.ReturnToVTPool()
+		*m = OneofTest_Test3{}
+	}
 }
 
-var vtprotoPool_OneofTest_Test3 = sync.Pool{
+var vtprotoPool_OneofTest_Test3 vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &OneofTest_Test3{}
 	},
 }
 
-func (m *OneofTest_Test3) ResetVT() {
-	if m != nil {
-		m.C.ReturnToVTPool()
-		m.Reset()
+// SetVTPoolBackend replaces the pool used by OneofTest_Test3FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OneofTest_Test3) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OneofTest_Test3{} }}
 	}
+	vtprotoPool_OneofTest_Test3 = b
 }
 func (m *OneofTest_Test3) ReturnToVTPool() {
 	if m != nil {
@@ -1029,15 +1725,11 @@ func (m *OneofTest_Test3) ReturnToVTPool() {
 	}
 }
 func OneofTest_Test3FromVTPool() *OneofTest_Test3 {
-	return vtprotoPool_OneofTest_Test3.Get().(*OneofTest_Test3)
-}
-
-var vtprotoPool_OneofTest = sync.Pool{
-	New: func() interface{} {
-		return &OneofTest{}
-	},
+	if m, ok := vtprotoPool_OneofTest_Test3.Get().(*OneofTest_Test3); ok {
+		return m
+	}
+	return &OneofTest_Test3{}
 }
-
 func (m *OneofTest) ResetVT() {
 	if m != nil {
 		if oneof, ok := m.Test.(*OneofTest_Test1_); ok {
@@ -1055,18 +1747,89 @@ func (m *OneofTest) ResetVT() {
 			c.Test4 = c.Test4[:0]
 			savedTest = c
 		}
-		m.Reset()
+		*m = OneofTest{}
 		m.Test = savedTest
 	}
 }
-func (m *OneofTest) ReturnToVTPool() {
+
+var vtprotoPool_OneofTest vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &OneofTest{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by OneofTestFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*OneofTest) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &OneofTest{} }}
+	}
+	vtprotoPool_OneofTest = b
+}
+func (m *OneofTest) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_OneofTest.Put(m)
+	}
+}
+func OneofTestFromVTPool() *OneofTest {
+	if m, ok := vtprotoPool_OneofTest.Get().(*OneofTest); ok {
+		return m
+	}
+	return &OneofTest{}
+}
+func (m *MultiOneofBytesTest) ResetVT() {
+	if m != nil {
+		var savedFirst isMultiOneofBytesTest_First
+		switch c := m.First.(type) {
+		case *MultiOneofBytesTest_FirstBytes:
+			c.FirstBytes = c.FirstBytes[:0]
+			savedFirst = c
+		}
+		var savedSecond isMultiOneofBytesTest_Second
+		switch c := m.Second.(type) {
+		case *MultiOneofBytesTest_SecondBytes:
+			c.SecondBytes = c.SecondBytes[:0]
+			savedSecond = c
+		}
+		var savedThird isMultiOneofBytesTest_Third
+		switch c := m.Third.(type) {
+		case *MultiOneofBytesTest_ThirdBytes:
+			c.ThirdBytes = c.ThirdBytes[:0]
+			savedThird = c
+		}
+		*m = MultiOneofBytesTest{}
+		m.First = savedFirst
+		m.Second = savedSecond
+		m.Third = savedThird
+	}
+}
+
+var vtprotoPool_MultiOneofBytesTest vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &MultiOneofBytesTest{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by MultiOneofBytesTestFromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*MultiOneofBytesTest) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &MultiOneofBytesTest{} }}
+	}
+	vtprotoPool_MultiOneofBytesTest = b
+}
+func (m *MultiOneofBytesTest) ReturnToVTPool() {
 	if m != nil {
 		m.ResetVT()
-		vtprotoPool_OneofTest.Put(m)
+		vtprotoPool_MultiOneofBytesTest.Put(m)
 	}
 }
-func OneofTestFromVTPool() *OneofTest {
-	return vtprotoPool_OneofTest.Get().(*OneofTest)
+func MultiOneofBytesTestFromVTPool() *MultiOneofBytesTest {
+	if m, ok := vtprotoPool_MultiOneofBytesTest.Get().(*MultiOneofBytesTest); ok {
+		return m
+	}
+	return &MultiOneofBytesTest{}
 }
 func (m *OneofTest_Test1) SizeVT() (n int) {
 	if m == nil {
@@ -1189,6 +1952,74 @@ func (m *OneofTest_Test4) SizeVT() (n int) {
 	n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	return n
 }
+func (m *MultiOneofBytesTest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.First.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if vtmsg, ok := m.Second.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if vtmsg, ok := m.Third.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MultiOneofBytesTest_FirstBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstBytes)
+	n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	return n
+}
+func (m *MultiOneofBytesTest_FirstString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstString)
+	n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	return n
+}
+func (m *MultiOneofBytesTest_SecondInt) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + protohelpers.SizeOfVarint(uint64(m.SecondInt))
+	return n
+}
+func (m *MultiOneofBytesTest_SecondBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecondBytes)
+	n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	return n
+}
+func (m *MultiOneofBytesTest_ThirdBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThirdBytes)
+	n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	return n
+}
 func (m *OneofTest_Test1) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1574,9 +2405,216 @@ func (m *OneofTest) UnmarshalVT(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Test2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Test2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Test.(*OneofTest_Test2_); ok {
+				if err := oneof.Test2.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := OneofTest_Test2FromVTPool()
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Test = &OneofTest_Test2_{Test2: v}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Test3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Test.(*OneofTest_Test3_); ok {
+				if err := oneof.Test3.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := OneofTest_Test3FromVTPool()
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Test = &OneofTest_Test3_{Test3: v}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Test4", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Test.(*OneofTest_Test4); ok {
+				oneof.Test4 = append(oneof.Test4[:0], dAtA[iNdEx:postIndex]...)
+			} else {
+				v := make([]byte, postIndex-iNdEx)
+				copy(v, dAtA[iNdEx:postIndex])
+				m.Test = &OneofTest_Test4{Test4: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiOneofBytesTest) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiOneofBytesTest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiOneofBytesTest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.First.(*MultiOneofBytesTest_FirstBytes); ok {
+				oneof.FirstBytes = append(oneof.FirstBytes[:0], dAtA[iNdEx:postIndex]...)
+			} else {
+				v := make([]byte, postIndex-iNdEx)
+				copy(v, dAtA[iNdEx:postIndex])
+				m.First = &MultiOneofBytesTest_FirstBytes{FirstBytes: v}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstString", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -1586,38 +2624,52 @@ func (m *OneofTest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.Test.(*OneofTest_Test2_); ok {
-				if err := oneof.Test2.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := OneofTest_Test2FromVTPool()
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.Test = &OneofTest_Test2_{Test2: v}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.First = &MultiOneofBytesTest_FirstString{FirstString: string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondInt", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Second = &MultiOneofBytesTest_SecondInt{SecondInt: v}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Test3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protohelpers.ErrIntOverflow
@@ -1627,36 +2679,32 @@ func (m *OneofTest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return protohelpers.ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return protohelpers.ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.Test.(*OneofTest_Test3_); ok {
-				if err := oneof.Test3.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
+			if oneof, ok := m.Second.(*MultiOneofBytesTest_SecondBytes); ok {
+				oneof.SecondBytes = append(oneof.SecondBytes[:0], dAtA[iNdEx:postIndex]...)
 			} else {
-				v := OneofTest_Test3FromVTPool()
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.Test = &OneofTest_Test3_{Test3: v}
+				v := make([]byte, postIndex-iNdEx)
+				copy(v, dAtA[iNdEx:postIndex])
+				m.Second = &MultiOneofBytesTest_SecondBytes{SecondBytes: v}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Test4", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThirdBytes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,12 +2731,12 @@ func (m *OneofTest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.Test.(*OneofTest_Test4); ok {
-				oneof.Test4 = append(oneof.Test4[:0], dAtA[iNdEx:postIndex]...)
+			if oneof, ok := m.Third.(*MultiOneofBytesTest_ThirdBytes); ok {
+				oneof.ThirdBytes = append(oneof.ThirdBytes[:0], dAtA[iNdEx:postIndex]...)
 			} else {
 				v := make([]byte, postIndex-iNdEx)
 				copy(v, dAtA[iNdEx:postIndex])
-				m.Test = &OneofTest_Test4{Test4: v}
+				m.Third = &MultiOneofBytesTest_ThirdBytes{ThirdBytes: v}
 			}
 			iNdEx = postIndex
 		default:
@@ -2236,3 +3284,209 @@ func (m *OneofTest) UnmarshalVTUnsafe(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiOneofBytesTest) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiOneofBytesTest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiOneofBytesTest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := dAtA[iNdEx:postIndex]
+			m.First = &MultiOneofBytesTest_FirstBytes{FirstBytes: v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.First = &MultiOneofBytesTest_FirstString{FirstString: stringValue}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondInt", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Second = &MultiOneofBytesTest_SecondInt{SecondInt: v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := dAtA[iNdEx:postIndex]
+			m.Second = &MultiOneofBytesTest_SecondBytes{SecondBytes: v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThirdBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := dAtA[iNdEx:postIndex]
+			m.Third = &MultiOneofBytesTest_ThirdBytes{ThirdBytes: v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
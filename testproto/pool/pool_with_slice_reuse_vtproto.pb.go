@@ -7,9 +7,12 @@ package pool
 import (
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtpool "github.com/planetscale/vtprotobuf/vtpool"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
+	slices "slices"
 	sync "sync"
 	unsafe "unsafe"
 )
@@ -42,6 +45,32 @@ func (m *Test1) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Test1CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of Test1.
+func Test1CloneSliceVT(in []*Test1) []*Test1 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Test1, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := Test1FromVTPool()
+		if rhs := m.Sl; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.Sl = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Test2) CloneVT() *Test2 {
 	if m == nil {
 		return (*Test2)(nil)
@@ -65,6 +94,34 @@ func (m *Test2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Test2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of Test2.
+func Test2CloneSliceVT(in []*Test2) []*Test2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Test2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := Test2FromVTPool()
+		if rhs := m.Sl; rhs != nil {
+			tmpContainer := make([]*Slice2, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			r.Sl = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Slice2) CloneVT() *Slice2 {
 	if m == nil {
 		return (*Slice2)(nil)
@@ -74,11 +131,7 @@ func (m *Slice2) CloneVT() *Slice2 {
 	r.E = m.E
 	r.F = m.F
 	if rhs := m.A; rhs != nil {
-		tmpContainer := make(map[int64]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.A = tmpContainer
+		r.A = maps.Clone(rhs)
 	}
 	if rhs := m.B; rhs != nil {
 		tmpVal := *rhs
@@ -100,6 +153,44 @@ func (m *Slice2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Slice2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Slice2CloneSliceVT(in []*Slice2) []*Slice2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Slice2, len(in))
+	clones := make([]Slice2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.D = m.D.CloneVT()
+		r.E = m.E
+		r.F = m.F
+		if rhs := m.A; rhs != nil {
+			r.A = maps.Clone(rhs)
+		}
+		if rhs := m.B; rhs != nil {
+			tmpVal := *rhs
+			r.B = &tmpVal
+		}
+		if rhs := m.C; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.C = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Element2) CloneVT() *Element2 {
 	if m == nil {
 		return (*Element2)(nil)
@@ -117,6 +208,30 @@ func (m *Element2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Element2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Element2CloneSliceVT(in []*Element2) []*Element2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Element2, len(in))
+	clones := make([]Element2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.A = m.A
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Test3) CloneVT() *Test3 {
 	if m == nil {
 		return (*Test3)(nil)
@@ -142,21 +257,45 @@ func (m *Test3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Test3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are taken from the pool of Test3.
+func Test3CloneSliceVT(in []*Test3) []*Test3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Test3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := Test3FromVTPool()
+		if rhs := m.Sl; rhs != nil {
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.Sl = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *Test1) EqualVT(that *Test1) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Sl) != len(that.Sl) {
+	if !slices.Equal(this.Sl, that.Sl) {
 		return false
 	}
-	for i, vx := range this.Sl {
-		vy := that.Sl[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -221,15 +360,9 @@ func (this *Slice2) EqualVT(that *Slice2) bool {
 	if p, q := this.B, that.B; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.C) != len(that.C) {
+	if !slices.Equal(this.C, that.C) {
 		return false
 	}
-	for i, vx := range this.C {
-		vy := that.C[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if !this.D.EqualVT(that.D) {
 		return false
 	}
@@ -795,20 +928,44 @@ func (m *Test3) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-var vtprotoPool_Test1 = sync.Pool{
-	New: func() interface{} {
-		return &Test1{}
-	},
+func (m *Test1) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *Test2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *Slice2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *Element2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *Test3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
 }
-
 func (m *Test1) ResetVT() {
 	if m != nil {
 		clear(m.Sl)
 		f0 := m.Sl[:0]
-		m.Reset()
+		*m = Test1{}
 		m.Sl = f0
 	}
 }
+
+var vtprotoPool_Test1 vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &Test1{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by Test1FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*Test1) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &Test1{} }}
+	}
+	vtprotoPool_Test1 = b
+}
 func (m *Test1) ReturnToVTPool() {
 	if m != nil {
 		m.ResetVT()
@@ -816,25 +973,36 @@ func (m *Test1) ReturnToVTPool() {
 	}
 }
 func Test1FromVTPool() *Test1 {
-	return vtprotoPool_Test1.Get().(*Test1)
-}
-
-var vtprotoPool_Test2 = sync.Pool{
-	New: func() interface{} {
-		return &Test2{}
-	},
+	if m, ok := vtprotoPool_Test1.Get().(*Test1); ok {
+		return m
+	}
+	return &Test1{}
 }
-
 func (m *Test2) ResetVT() {
 	if m != nil {
 		for _, mm := range m.Sl {
 			mm.Reset()
 		}
 		f0 := m.Sl[:0]
-		m.Reset()
+		*m = Test2{}
 		m.Sl = f0
 	}
 }
+
+var vtprotoPool_Test2 vtpool.Backend = &sync.Pool{
+	New: func() interface{} {
+		return &Test2{}
+	},
+}
+
+// SetVTPoolBackend replaces the pool used by Test2FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*Test2) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &Test2{} }}
+	}
+	vtprotoPool_Test2 = b
+}
 func (m *Test2) ReturnToVTPool() {
 	if m != nil {
 		m.ResetVT()
@@ -842,22 +1010,33 @@ func (m *Test2) ReturnToVTPool() {
 	}
 }
 func Test2FromVTPool() *Test2 {
-	return vtprotoPool_Test2.Get().(*Test2)
+	if m, ok := vtprotoPool_Test2.Get().(*Test2); ok {
+		return m
+	}
+	return &Test2{}
+}
+func (m *Test3) ResetVT() {
+	if m != nil {
+		clear(m.Sl)
+		f0 := m.Sl[:0]
+		*m = Test3{}
+		m.Sl = f0
+	}
 }
 
-var vtprotoPool_Test3 = sync.Pool{
+var vtprotoPool_Test3 vtpool.Backend = &sync.Pool{
 	New: func() interface{} {
 		return &Test3{}
 	},
 }
 
-func (m *Test3) ResetVT() {
-	if m != nil {
-		clear(m.Sl)
-		f0 := m.Sl[:0]
-		m.Reset()
-		m.Sl = f0
+// SetVTPoolBackend replaces the pool used by Test3FromVTPool and ReturnToVTPool,
+// or restores the default sync.Pool if b is nil. It must be called before using the pool.
+func (*Test3) SetVTPoolBackend(b vtpool.Backend) {
+	if b == nil {
+		b = &sync.Pool{New: func() interface{} { return &Test3{} }}
 	}
+	vtprotoPool_Test3 = b
 }
 func (m *Test3) ReturnToVTPool() {
 	if m != nil {
@@ -866,7 +1045,10 @@ func (m *Test3) ReturnToVTPool() {
 	}
 }
 func Test3FromVTPool() *Test3 {
-	return vtprotoPool_Test3.Get().(*Test3)
+	if m, ok := vtprotoPool_Test3.Get().(*Test3); ok {
+		return m
+	}
+	return &Test3{}
 }
 func (m *Test1) SizeVT() (n int) {
 	if m == nil {
@@ -0,0 +1,66 @@
+package pooledbytes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPooledBytes(t *testing.T) {
+	trailer := []byte("end")
+	original := &Frame{
+		Name:    "frame",
+		Payload: bytes.Repeat([]byte{1}, 100),
+		Chunks:  [][]byte{[]byte("a"), bytes.Repeat([]byte{2}, 1000)},
+		Trailer: trailer,
+		Kept:    []byte("kept"),
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := FrameFromVTPool()
+	defer msg.ReturnToVTPool()
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	// The data is held in buffers of the size class of each value.
+	require.Equal(t, 128, cap(msg.Payload))
+	require.Equal(t, 64, cap(msg.Chunks[0]))
+	require.Equal(t, 1024, cap(msg.Chunks[1]))
+	require.Equal(t, 64, cap(msg.Trailer))
+
+	// Decoding data of the same size class reuses the buffer.
+	payload := msg.Payload
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Same(t, &payload[0], &msg.Payload[0])
+
+	// Smaller data does not keep a larger buffer.
+	small, err := (&Frame{Payload: []byte("small")}).MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, msg.UnmarshalVT(small))
+	require.Equal(t, []byte("small"), msg.Payload)
+	require.Equal(t, 64, cap(msg.Payload))
+
+	clear(data)
+	clear(small)
+	require.Equal(t, []byte("small"), msg.Payload)
+
+	msg.ResetVT()
+	require.Nil(t, msg.Payload)
+	require.Nil(t, msg.Trailer)
+	require.Empty(t, msg.Chunks)
+}
+
+func TestPooledBytesUnsafe(t *testing.T) {
+	original := &Frame{Payload: []byte("payload"), Kept: []byte("kept")}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Frame{}
+	require.NoError(t, msg.UnmarshalVTUnsafe(data))
+	clear(data)
+	// Pooled fields are copied even by UnmarshalVTUnsafe.
+	require.Equal(t, []byte("payload"), msg.Payload)
+	require.Equal(t, make([]byte, 4), msg.Kept)
+}
@@ -0,0 +1,16 @@
+//go:build vtpool
+
+package pooltag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolingEnabled(t *testing.T) {
+	m := PooledFromVTPool()
+	m.Name = "name"
+	m.ReturnToVTPool()
+	require.Empty(t, m.Name)
+}
@@ -0,0 +1,26 @@
+//go:build !vtpool
+
+package pooltag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolingDisabled(t *testing.T) {
+	m := PooledFromVTPool()
+	m.Name = "name"
+	m.ReturnToVTPool()
+	require.Equal(t, "name", m.Name)
+
+	require.NotSame(t, m, PooledFromVTPool())
+
+	data, err := (&Pooled{Name: "parent", Children: []*Pooled{{Name: "child"}}}).MarshalVT()
+	require.NoError(t, err)
+	decoded := PooledFromVTPool()
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.Equal(t, "child", decoded.Children[0].Name)
+	decoded.ResetVT()
+	require.Empty(t, decoded.Name)
+}
@@ -0,0 +1,68 @@
+package presence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExplicitPresenceZeroValues(t *testing.T) {
+	staged := &Staged{}
+	data, err := staged.MarshalVT()
+	require.NoError(t, err)
+	require.Len(t, data, staged.SizeVT())
+
+	// The readers of the optional fields see the zero values as set, except for the
+	// field without the option.
+	migrated := &Migrated{}
+	require.NoError(t, proto.Unmarshal(data, migrated))
+	require.True(t, proto.Equal(&Migrated{
+		Count:   proto.Int32(0),
+		Offset:  proto.Int64(0),
+		Mask:    proto.Uint32(0),
+		Ratio:   proto.Float64(0),
+		Weight:  proto.Float32(0),
+		Enabled: proto.Bool(false),
+		Name:    proto.String(""),
+		Data:    []byte{},
+		Level:   Level_LEVEL_UNSPECIFIED.Enum(),
+	}, migrated), "unexpected %v", migrated)
+
+	// The data is the one protoc-gen-go produces for the optional fields once set.
+	expected, err := proto.Marshal(migrated)
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	decoded := &Staged{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, staged.EqualVT(decoded))
+}
+
+func TestExplicitPresenceValues(t *testing.T) {
+	staged := &Staged{
+		Count:   -1,
+		Offset:  -1 << 40,
+		Mask:    42,
+		Ratio:   0.5,
+		Weight:  -2,
+		Enabled: true,
+		Name:    "name",
+		Data:    []byte("data"),
+		Level:   Level_LEVEL_HIGH,
+		Other:   7,
+	}
+	data, err := staged.MarshalVT()
+	require.NoError(t, err)
+	require.Len(t, data, staged.SizeVT())
+
+	migrated := &Migrated{}
+	require.NoError(t, migrated.UnmarshalVT(data))
+	expected, err := migrated.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	decoded := &Staged{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	require.True(t, proto.Equal(staged, decoded))
+}
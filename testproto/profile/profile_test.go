@@ -0,0 +1,65 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/testproto/profile/full"
+	wire "github.com/planetscale/vtprotobuf/testproto/profile/wirepb"
+)
+
+type wireMessage interface {
+	MarshalVT() ([]byte, error)
+	UnmarshalVT([]byte) error
+	SizeVT() int
+}
+
+func TestWireProfile(t *testing.T) {
+	var msg any = &wire.WireMessage{Name: "wire", Values: []int64{1, 2}}
+	require.Implements(t, (*wireMessage)(nil), msg)
+
+	_, hasClone := msg.(interface{ CloneVT() *wire.WireMessage })
+	require.False(t, hasClone)
+	_, hasEqual := msg.(interface{ EqualVT(*wire.WireMessage) bool })
+	require.False(t, hasEqual)
+}
+
+func TestFullProfile(t *testing.T) {
+	var msg any = &full.FullMessage{Name: "full"}
+	require.Implements(t, (*wireMessage)(nil), msg)
+
+	_, hasClone := msg.(interface{ CloneVT() *full.FullMessage })
+	require.True(t, hasClone)
+	_, hasEqual := msg.(interface{ EqualVT(*full.FullMessage) bool })
+	require.True(t, hasEqual)
+}
+
+func TestMixedProfiles(t *testing.T) {
+	msg := &full.FullMessage{
+		Name:    "full",
+		Wire:    &wire.WireMessage{Name: "wire", Values: []int64{1}},
+		Wires:   []*wire.WireMessage{{Name: "a"}, {Name: "b"}},
+		WireMap: map[string]*wire.WireMessage{"c": {Name: "c"}},
+		Choice:  &full.FullMessage_WireChoice{WireChoice: &wire.WireMessage{Name: "d"}},
+	}
+
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	expected, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(data))
+
+	got := full.FullMessageFromVTPool()
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, proto.Equal(msg, got))
+	require.True(t, msg.EqualVT(got))
+
+	clone := msg.CloneVT()
+	require.True(t, proto.Equal(msg, clone))
+	clone.Wire.Name = "changed"
+	require.Equal(t, "wire", msg.Wire.Name)
+
+	got.ReturnToVTPool()
+}
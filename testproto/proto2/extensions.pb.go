@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: proto2/extensions.proto
+
+package proto2
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExtensionLevel int32
+
+const (
+	ExtensionLevel_EXTENSION_LEVEL_LOW  ExtensionLevel = 1
+	ExtensionLevel_EXTENSION_LEVEL_HIGH ExtensionLevel = 2
+)
+
+// Enum value maps for ExtensionLevel.
+var (
+	ExtensionLevel_name = map[int32]string{
+		1: "EXTENSION_LEVEL_LOW",
+		2: "EXTENSION_LEVEL_HIGH",
+	}
+	ExtensionLevel_value = map[string]int32{
+		"EXTENSION_LEVEL_LOW":  1,
+		"EXTENSION_LEVEL_HIGH": 2,
+	}
+)
+
+func (x ExtensionLevel) Enum() *ExtensionLevel {
+	p := new(ExtensionLevel)
+	*p = x
+	return p
+}
+
+func (x ExtensionLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExtensionLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto2_extensions_proto_enumTypes[0].Descriptor()
+}
+
+func (ExtensionLevel) Type() protoreflect.EnumType {
+	return &file_proto2_extensions_proto_enumTypes[0]
+}
+
+func (x ExtensionLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ExtensionLevel) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ExtensionLevel(num)
+	return nil
+}
+
+// Deprecated: Use ExtensionLevel.Descriptor instead.
+func (ExtensionLevel) EnumDescriptor() ([]byte, []int) {
+	return file_proto2_extensions_proto_rawDescGZIP(), []int{0}
+}
+
+type Extendable struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Extendable) Reset() {
+	*x = Extendable{}
+	mi := &file_proto2_extensions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Extendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extendable) ProtoMessage() {}
+
+func (x *Extendable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_extensions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extendable.ProtoReflect.Descriptor instead.
+func (*Extendable) Descriptor() ([]byte, []int) {
+	return file_proto2_extensions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Extendable) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ExtensionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionPayload) Reset() {
+	*x = ExtensionPayload{}
+	mi := &file_proto2_extensions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionPayload) ProtoMessage() {}
+
+func (x *ExtensionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_extensions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionPayload.ProtoReflect.Descriptor instead.
+func (*ExtensionPayload) Descriptor() ([]byte, []int) {
+	return file_proto2_extensions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExtensionPayload) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+type ExtensionScope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtensionScope) Reset() {
+	*x = ExtensionScope{}
+	mi := &file_proto2_extensions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtensionScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionScope) ProtoMessage() {}
+
+func (x *ExtensionScope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_extensions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionScope.ProtoReflect.Descriptor instead.
+func (*ExtensionScope) Descriptor() ([]byte, []int) {
+	return file_proto2_extensions_proto_rawDescGZIP(), []int{2}
+}
+
+var file_proto2_extensions_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         51234,
+		Name:          "ext_label",
+		Tag:           "bytes,51234,opt,name=ext_label",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "ext_int32",
+		Tag:           "varint,100,opt,name=ext_int32",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]byte)(nil),
+		Field:         101,
+		Name:          "ext_bytes",
+		Tag:           "bytes,101,opt,name=ext_bytes",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*ExtensionPayload)(nil),
+		Field:         102,
+		Name:          "ext_message",
+		Tag:           "bytes,102,opt,name=ext_message",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]*ExtensionPayload)(nil),
+		Field:         103,
+		Name:          "ext_messages",
+		Tag:           "bytes,103,rep,name=ext_messages",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         104,
+		Name:          "ext_string",
+		Tag:           "bytes,104,opt,name=ext_string,def=default",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*ExtensionLevel)(nil),
+		Field:         105,
+		Name:          "ext_level",
+		Tag:           "varint,105,opt,name=ext_level,enum=ExtensionLevel",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*float64)(nil),
+		Field:         106,
+		Name:          "ext_double",
+		Tag:           "fixed64,106,opt,name=ext_double,def=inf",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int64)(nil),
+		Field:         107,
+		Name:          "ext_sint64",
+		Tag:           "zigzag64,107,opt,name=ext_sint64,def=-7",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         108,
+		Name:          "ext_bool",
+		Tag:           "varint,108,opt,name=ext_bool",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*uint32)(nil),
+		Field:         109,
+		Name:          "ext_plain",
+		Tag:           "fixed32,109,opt,name=ext_plain",
+		Filename:      "proto2/extensions.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*float32)(nil),
+		Field:         110,
+		Name:          "ExtensionScope.nested_float",
+		Tag:           "fixed32,110,opt,name=nested_float,def=1.5",
+		Filename:      "proto2/extensions.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional string ext_label = 51234;
+	E_ExtLabel = &file_proto2_extensions_proto_extTypes[0]
+)
+
+// Extension fields to Extendable.
+var (
+	// optional int32 ext_int32 = 100;
+	E_ExtInt32 = &file_proto2_extensions_proto_extTypes[1]
+	// optional bytes ext_bytes = 101;
+	E_ExtBytes = &file_proto2_extensions_proto_extTypes[2]
+	// optional ExtensionPayload ext_message = 102;
+	E_ExtMessage = &file_proto2_extensions_proto_extTypes[3]
+	// repeated ExtensionPayload ext_messages = 103;
+	E_ExtMessages = &file_proto2_extensions_proto_extTypes[4]
+	// optional string ext_string = 104;
+	E_ExtString = &file_proto2_extensions_proto_extTypes[5]
+	// optional ExtensionLevel ext_level = 105;
+	E_ExtLevel = &file_proto2_extensions_proto_extTypes[6]
+	// optional double ext_double = 106;
+	E_ExtDouble = &file_proto2_extensions_proto_extTypes[7]
+	// optional sint64 ext_sint64 = 107;
+	E_ExtSint64 = &file_proto2_extensions_proto_extTypes[8]
+	// optional bool ext_bool = 108;
+	E_ExtBool = &file_proto2_extensions_proto_extTypes[9]
+	// optional fixed32 ext_plain = 109;
+	E_ExtPlain = &file_proto2_extensions_proto_extTypes[10]
+	// optional float nested_float = 110;
+	E_ExtensionScope_NestedFloat = &file_proto2_extensions_proto_extTypes[11]
+)
+
+var File_proto2_extensions_proto protoreflect.FileDescriptor
+
+const file_proto2_extensions_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto2/extensions.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\x1a google/protobuf/descriptor.proto\"7\n" +
+	"\n" +
+	"Extendable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name*\x05\bd\x10\xc8\x01:\x0e\x92\x82\x19\n" +
+	"extendable\"(\n" +
+	"\x10ExtensionPayload\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"M\n" +
+	"\x0eExtensionScope2;\n" +
+	"\fnested_float\x12\v.Extendable\x18n \x01(\x02:\x031.5B\x06\xb2\xa9\x1f\x02X\x01R\vnestedFloat*C\n" +
+	"\x0eExtensionLevel\x12\x17\n" +
+	"\x13EXTENSION_LEVEL_LOW\x10\x01\x12\x18\n" +
+	"\x14EXTENSION_LEVEL_HIGH\x10\x02:F\n" +
+	"\text_label\x12\x1f.google.protobuf.MessageOptions\x18\xa2\x90\x03 \x01(\tB\x06\xb2\xa9\x1f\x02X\x01R\bextLabel:0\n" +
+	"\text_int32\x12\v.Extendable\x18d \x01(\x05B\x06\xb2\xa9\x1f\x02X\x01R\bextInt32:0\n" +
+	"\text_bytes\x12\v.Extendable\x18e \x01(\fB\x06\xb2\xa9\x1f\x02X\x01R\bextBytes:G\n" +
+	"\vext_message\x12\v.Extendable\x18f \x01(\v2\x11.ExtensionPayloadB\x06\xb2\xa9\x1f\x02X\x01R\n" +
+	"extMessage:A\n" +
+	"\fext_messages\x12\v.Extendable\x18g \x03(\v2\x11.ExtensionPayloadR\vextMessages:;\n" +
+	"\n" +
+	"ext_string\x12\v.Extendable\x18h \x01(\t:\adefaultB\x06\xb2\xa9\x1f\x02X\x01R\textString:A\n" +
+	"\text_level\x12\v.Extendable\x18i \x01(\x0e2\x0f.ExtensionLevelB\x06\xb2\xa9\x1f\x02X\x01R\bextLevel:7\n" +
+	"\n" +
+	"ext_double\x12\v.Extendable\x18j \x01(\x01:\x03infB\x06\xb2\xa9\x1f\x02X\x01R\textDouble:6\n" +
+	"\n" +
+	"ext_sint64\x12\v.Extendable\x18k \x01(\x12:\x02-7B\x06\xb2\xa9\x1f\x02X\x01R\textSint64:.\n" +
+	"\bext_bool\x12\v.Extendable\x18l \x01(\bB\x06\xb2\xa9\x1f\x02X\x01R\aextBool:(\n" +
+	"\text_plain\x12\v.Extendable\x18m \x01(\aR\bextPlainB\x12Z\x10testproto/proto2"
+
+var (
+	file_proto2_extensions_proto_rawDescOnce sync.Once
+	file_proto2_extensions_proto_rawDescData []byte
+)
+
+func file_proto2_extensions_proto_rawDescGZIP() []byte {
+	file_proto2_extensions_proto_rawDescOnce.Do(func() {
+		file_proto2_extensions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto2_extensions_proto_rawDesc), len(file_proto2_extensions_proto_rawDesc)))
+	})
+	return file_proto2_extensions_proto_rawDescData
+}
+
+var file_proto2_extensions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto2_extensions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto2_extensions_proto_goTypes = []any{
+	(ExtensionLevel)(0),                 // 0: ExtensionLevel
+	(*Extendable)(nil),                  // 1: Extendable
+	(*ExtensionPayload)(nil),            // 2: ExtensionPayload
+	(*ExtensionScope)(nil),              // 3: ExtensionScope
+	(*descriptorpb.MessageOptions)(nil), // 4: google.protobuf.MessageOptions
+}
+var file_proto2_extensions_proto_depIdxs = []int32{
+	4,  // 0: ext_label:extendee -> google.protobuf.MessageOptions
+	1,  // 1: ext_int32:extendee -> Extendable
+	1,  // 2: ext_bytes:extendee -> Extendable
+	1,  // 3: ext_message:extendee -> Extendable
+	1,  // 4: ext_messages:extendee -> Extendable
+	1,  // 5: ext_string:extendee -> Extendable
+	1,  // 6: ext_level:extendee -> Extendable
+	1,  // 7: ext_double:extendee -> Extendable
+	1,  // 8: ext_sint64:extendee -> Extendable
+	1,  // 9: ext_bool:extendee -> Extendable
+	1,  // 10: ext_plain:extendee -> Extendable
+	1,  // 11: ExtensionScope.nested_float:extendee -> Extendable
+	2,  // 12: ext_message:type_name -> ExtensionPayload
+	2,  // 13: ext_messages:type_name -> ExtensionPayload
+	0,  // 14: ext_level:type_name -> ExtensionLevel
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	12, // [12:15] is the sub-list for extension type_name
+	0,  // [0:12] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto2_extensions_proto_init() }
+func file_proto2_extensions_proto_init() {
+	if File_proto2_extensions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto2_extensions_proto_rawDesc), len(file_proto2_extensions_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 12,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto2_extensions_proto_goTypes,
+		DependencyIndexes: file_proto2_extensions_proto_depIdxs,
+		EnumInfos:         file_proto2_extensions_proto_enumTypes,
+		MessageInfos:      file_proto2_extensions_proto_msgTypes,
+		ExtensionInfos:    file_proto2_extensions_proto_extTypes,
+	}.Build()
+	File_proto2_extensions_proto = out.File
+	file_proto2_extensions_proto_goTypes = nil
+	file_proto2_extensions_proto_depIdxs = nil
+}
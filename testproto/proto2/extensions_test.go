@@ -0,0 +1,153 @@
+package proto2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestCloneVTExtensions(t *testing.T) {
+	msg := &Extendable{Name: proto.String("name")}
+	proto.SetExtension(msg, E_ExtInt32, int32(42))
+	proto.SetExtension(msg, E_ExtBytes, []byte("bytes"))
+	proto.SetExtension(msg, E_ExtMessage, &ExtensionPayload{Value: proto.String("single")})
+	proto.SetExtension(msg, E_ExtMessages, []*ExtensionPayload{
+		{Value: proto.String("a")},
+		{Value: proto.String("b")},
+	})
+
+	clone := msg.CloneVT()
+	require.True(t, proto.Equal(msg, clone))
+
+	// The clone must not alias any extension value of the source.
+	require.NotSame(t, proto.GetExtension(msg, E_ExtMessage), proto.GetExtension(clone, E_ExtMessage))
+	proto.GetExtension(clone, E_ExtMessage).(*ExtensionPayload).Value = proto.String("changed")
+	proto.GetExtension(clone, E_ExtMessages).([]*ExtensionPayload)[0].Value = proto.String("changed")
+	proto.GetExtension(clone, E_ExtBytes).([]byte)[0] = 'B'
+	require.Equal(t, "single", proto.GetExtension(msg, E_ExtMessage).(*ExtensionPayload).GetValue())
+	require.Equal(t, "a", proto.GetExtension(msg, E_ExtMessages).([]*ExtensionPayload)[0].GetValue())
+	require.Equal(t, []byte("bytes"), proto.GetExtension(msg, E_ExtBytes))
+}
+
+func TestCloneVTExtensionsFromWire(t *testing.T) {
+	src := &Extendable{}
+	proto.SetExtension(src, E_ExtMessage, &ExtensionPayload{Value: proto.String("wire")})
+	data, err := proto.Marshal(src)
+	require.NoError(t, err)
+
+	msg := &Extendable{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	clone := msg.CloneVT()
+	require.True(t, proto.Equal(msg, clone))
+	require.Equal(t, "wire", proto.GetExtension(clone, E_ExtMessage).(*ExtensionPayload).GetValue())
+}
+
+func TestCloneVTWithoutExtensions(t *testing.T) {
+	msg := &Extendable{Name: proto.String("name")}
+	clone := msg.CloneVT()
+	require.True(t, proto.Equal(msg, clone))
+	require.False(t, proto.HasExtension(clone, E_ExtInt32))
+}
+
+func TestUnmarshalVTExtensionAfterFields(t *testing.T) {
+	// Decoding an extension must keep the fields decoded before it.
+	data, err := (&Extendable{Name: proto.String("name")}).MarshalVT()
+	require.NoError(t, err)
+	ext := &Extendable{}
+	proto.SetExtension(ext, E_ExtInt32, int32(42))
+	extData, err := proto.Marshal(ext)
+	require.NoError(t, err)
+
+	msg := &Extendable{}
+	require.NoError(t, msg.UnmarshalVT(append(data, extData...)))
+	require.Equal(t, "name", msg.GetName())
+	require.Equal(t, int32(42), proto.GetExtension(msg, E_ExtInt32))
+}
+
+func TestUnmarshalVTExtensionTwice(t *testing.T) {
+	// The same extensions decoded again are merged into the ones decoded before them,
+	// like proto.Unmarshal does.
+	var data []byte
+	for _, value := range []string{"first", "second"} {
+		ext := &Extendable{}
+		proto.SetExtension(ext, E_ExtMessages, []*ExtensionPayload{{Value: proto.String(value)}})
+		if value == "first" {
+			proto.SetExtension(ext, E_ExtMessage, &ExtensionPayload{Value: proto.String(value)})
+		} else {
+			proto.SetExtension(ext, E_ExtMessage, &ExtensionPayload{})
+		}
+		extData, err := proto.Marshal(ext)
+		require.NoError(t, err)
+		data = append(data, extData...)
+	}
+
+	expected := &Extendable{}
+	require.NoError(t, proto.Unmarshal(data, expected))
+	msg := &Extendable{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, proto.Equal(expected, msg), "decoded %v, expected %v", msg, expected)
+	require.Len(t, proto.GetExtension(msg, E_ExtMessages), 2)
+	require.Equal(t, "first", proto.GetExtension(msg, E_ExtMessage).(*ExtensionPayload).GetValue())
+}
+
+func TestFastAccessors(t *testing.T) {
+	check := func(t *testing.T, msg *Extendable) {
+		t.Helper()
+		require.Equal(t, proto.GetExtension(msg, E_ExtInt32), GetExtInt32VT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtBytes), GetExtBytesVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtMessage), GetExtMessageVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtString), GetExtStringVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtLevel), GetExtLevelVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtDouble), GetExtDoubleVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtSint64), GetExtSint64VT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtBool), GetExtBoolVT(msg))
+		require.Equal(t, proto.GetExtension(msg, E_ExtensionScope_NestedFloat), GetExtensionScope_NestedFloatVT(msg))
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		check(t, nil)
+		check(t, &Extendable{})
+		require.Equal(t, "default", GetExtStringVT(nil))
+		require.Equal(t, ExtensionLevel_EXTENSION_LEVEL_LOW, GetExtLevelVT(&Extendable{}))
+		require.Equal(t, float32(1.5), GetExtensionScope_NestedFloatVT(&Extendable{}))
+	})
+
+	msg := &Extendable{}
+	proto.SetExtension(msg, E_ExtInt32, int32(-42))
+	proto.SetExtension(msg, E_ExtBytes, []byte("bytes"))
+	proto.SetExtension(msg, E_ExtMessage, &ExtensionPayload{Value: proto.String("payload")})
+	proto.SetExtension(msg, E_ExtString, "")
+	proto.SetExtension(msg, E_ExtLevel, ExtensionLevel_EXTENSION_LEVEL_HIGH)
+	proto.SetExtension(msg, E_ExtDouble, 0.25)
+	proto.SetExtension(msg, E_ExtSint64, int64(-1<<40))
+	proto.SetExtension(msg, E_ExtBool, true)
+	proto.SetExtension(msg, E_ExtensionScope_NestedFloat, float32(-2))
+	t.Run("set", func(t *testing.T) {
+		check(t, msg)
+		require.Equal(t, "", GetExtStringVT(msg))
+	})
+
+	t.Run("decoded", func(t *testing.T) {
+		data, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		decoded := &Extendable{}
+		require.NoError(t, decoded.UnmarshalVT(data))
+		check(t, decoded)
+		require.Equal(t, "payload", GetExtMessageVT(decoded).GetValue())
+
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = GetExtInt32VT(decoded)
+			_ = GetExtMessageVT(decoded)
+			_ = GetExtStringVT(decoded)
+		})
+		require.Zero(t, allocs)
+	})
+
+	t.Run("other package", func(t *testing.T) {
+		opts := (&Extendable{}).ProtoReflect().Descriptor().Options().(*descriptorpb.MessageOptions)
+		require.Equal(t, "extendable", GetExtLabelVT(opts))
+		require.Equal(t, "", GetExtLabelVT(nil))
+	})
+}
@@ -0,0 +1,119 @@
+package proto2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOneofGroups(t *testing.T) {
+	for name, msg := range map[string]*OneofGroups{
+		"empty": {},
+		"picked": {Id: proto.Int32(1), Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{
+			Name:   proto.String("name"),
+			Values: []int64{1, -1},
+			Inner:  &Inner{Value: proto.String("inner")},
+		}}},
+		"empty picked": {Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{}}},
+		"nested group": {Choice: &OneofGroups_Other_{Other: &OneofGroups_Other{
+			Flag:   proto.Bool(true),
+			Nested: &OneofGroups_Other_Deep_{Deep: &OneofGroups_Other_Deep{Label: proto.String("deep")}},
+		}}},
+		"nested string": {Choice: &OneofGroups_Other_{Other: &OneofGroups_Other{Nested: &OneofGroups_Other_Text{Text: "text"}}}},
+		"plain":         {Choice: &OneofGroups_Plain{Plain: "plain"}, Single: &OneofGroups_Single{Value: proto.Int32(2)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := msg.MarshalVT()
+			require.NoError(t, err)
+			expected, err := proto.Marshal(msg)
+			require.NoError(t, err)
+			require.Equal(t, expected, data)
+			require.Equal(t, len(data), msg.SizeVT())
+
+			decoded := &OneofGroups{}
+			require.NoError(t, decoded.UnmarshalVT(data))
+			require.True(t, proto.Equal(msg, decoded), "decoded %v, expected %v", decoded, msg)
+			require.True(t, msg.EqualVT(decoded))
+
+			clone := msg.CloneVT()
+			require.True(t, proto.Equal(msg, clone))
+			require.True(t, msg.EqualVT(clone))
+		})
+	}
+}
+
+func TestGroups(t *testing.T) {
+	// A group is decoded by finding its end group tag among the fields it holds, which
+	// must be skipped whatever their wire type.
+	single := func(fields ...[]byte) []byte {
+		data := protowire.AppendTag(nil, 12, protowire.StartGroupType)
+		for _, f := range fields {
+			data = append(data, f...)
+		}
+		return protowire.AppendTag(data, 12, protowire.EndGroupType)
+	}
+	value := protowire.AppendVarint(protowire.AppendTag(nil, 13, protowire.VarintType), 7)
+	unknown := protowire.AppendString(protowire.AppendTag(nil, 100, protowire.BytesType), "unknown")
+	unknownGroup := protowire.AppendTag(protowire.AppendTag(nil, 101, protowire.StartGroupType), 101, protowire.EndGroupType)
+	id := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)
+
+	for name, data := range map[string][]byte{
+		"empty":              single(),
+		"value":              single(value),
+		"unknown fields":     single(unknown, value, unknownGroup),
+		"followed by fields": append(single(value), id...),
+		"merged":             append(single(value), single(unknown)...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected := &OneofGroups{}
+			require.NoError(t, proto.Unmarshal(data, expected))
+			decoded := &OneofGroups{}
+			require.NoError(t, decoded.UnmarshalVT(data))
+			require.True(t, proto.Equal(expected, decoded), "decoded %v, expected %v", decoded, expected)
+			require.NotNil(t, decoded.Single)
+		})
+	}
+}
+
+func TestOneofGroupsMerge(t *testing.T) {
+	// A group decoded again into the same member of the oneof is merged into it, and a
+	// group of another member replaces it, like the proto package does.
+	first, err := (&OneofGroups{Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{Name: proto.String("name"), Values: []int64{1}}}}).MarshalVT()
+	require.NoError(t, err)
+	second, err := (&OneofGroups{Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{Values: []int64{2}}}}).MarshalVT()
+	require.NoError(t, err)
+	other, err := (&OneofGroups{Choice: &OneofGroups_Other_{Other: &OneofGroups_Other{Flag: proto.Bool(true)}}}).MarshalVT()
+	require.NoError(t, err)
+
+	for _, data := range [][]byte{append(first, second...), append(append(first, other...), second...)} {
+		decoded := &OneofGroups{}
+		require.NoError(t, decoded.UnmarshalVT(data))
+		expected := &OneofGroups{}
+		require.NoError(t, proto.Unmarshal(data, expected))
+		require.True(t, proto.Equal(expected, decoded), "decoded %v, expected %v", decoded, expected)
+	}
+
+	differs := &OneofGroups{Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{Name: proto.String("other")}}}
+	require.False(t, differs.EqualVT(&OneofGroups{Choice: &OneofGroups_Picked_{Picked: &OneofGroups_Picked{Name: proto.String("name")}}}))
+	require.False(t, differs.EqualVT(&OneofGroups{Choice: &OneofGroups_Other_{Other: &OneofGroups_Other{}}}))
+}
+
+func TestOneofGroupsUnknownFields(t *testing.T) {
+	// Unknown fields inside a group are kept by the message of the group.
+	data := protowire.AppendTag(nil, 2, protowire.StartGroupType)
+	data = protowire.AppendTag(data, 100, protowire.VarintType)
+	data = protowire.AppendVarint(data, 7)
+	data = protowire.AppendTag(data, 2, protowire.EndGroupType)
+
+	decoded := &OneofGroups{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.NotEmpty(t, decoded.GetPicked().ProtoReflect().GetUnknown())
+	reencoded, err := decoded.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, data, reencoded)
+
+	// A group that is not terminated is rejected.
+	require.Error(t, (&OneofGroups{}).UnmarshalVT(data[:len(data)-1]))
+}
@@ -0,0 +1,62 @@
+package proto2
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+type vtMessage interface {
+	proto.Message
+	MarshalVT() ([]byte, error)
+	UnmarshalVT([]byte) error
+}
+
+func TestPackedVarints(t *testing.T) {
+	for name, msg := range map[string]vtMessage{
+		"int32":  &Int32Message{RequiredField: proto.Int32(0), PackedField: []int32{0, 1, -1, 127, 128, math.MaxInt32, math.MinInt32}},
+		"int64":  &Int64Message{RequiredField: proto.Int64(0), PackedField: []int64{0, 1, -1, 127, 128, math.MaxInt64, math.MinInt64}},
+		"uint32": &Uint32Message{RequiredField: proto.Uint32(0), PackedField: []uint32{0, 1, 127, 128, math.MaxUint32}},
+		"uint64": &Uint64Message{RequiredField: proto.Uint64(0), PackedField: []uint64{0, 1, 127, 128, math.MaxUint64}},
+		"sint32": &Sint32Message{RequiredField: proto.Int32(0), PackedField: []int32{0, 1, -1, 63, -64, 64, math.MaxInt32, math.MinInt32}},
+		"sint64": &Sint64Message{RequiredField: proto.Int64(0), PackedField: []int64{0, 1, -1, 63, -64, 64, math.MaxInt64, math.MinInt64}},
+		"bool":   &BoolMessage{RequiredField: proto.Bool(false), PackedField: []bool{true, false, true}},
+		"enum":   &EnumMessage{RequiredField: EnumMessage_TEN.Enum(), PackedField: []EnumMessage_Num{EnumMessage_SEVEN, EnumMessage_TEN, 200}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := msg.MarshalVT()
+			require.NoError(t, err)
+
+			got := msg.ProtoReflect().Type().New().Interface().(vtMessage)
+			require.NoError(t, got.UnmarshalVT(data))
+			require.True(t, proto.Equal(msg, got))
+
+			// Truncating the last varint of the packed field must be reported.
+			require.ErrorContains(t, got.UnmarshalVT(data[:len(data)-1]), "unexpected EOF")
+		})
+	}
+}
+
+func TestPackedVarintsOverflow(t *testing.T) {
+	packed := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}
+	data := protowire.AppendTag(nil, 4, protowire.BytesType)
+	data = protowire.AppendBytes(data, packed)
+
+	require.ErrorContains(t, (&Int64Message{}).UnmarshalVT(data), "integer overflow")
+}
+
+func TestMergeFromWireRequired(t *testing.T) {
+	// The delta does not set the required field, which the message already has.
+	delta, err := (&Int64Message{RequiredField: proto.Int64(1), OptionalField: proto.Int64(5), RepeatedField: []int64{3}}).MarshalVT()
+	require.NoError(t, err)
+	delta = delta[2:]
+	require.ErrorContains(t, (&Int64Message{}).UnmarshalVT(delta), "required field")
+	require.ErrorContains(t, (&Int64Message{}).MergeFromWireVT(delta), "required field")
+
+	msg := &Int64Message{RequiredField: proto.Int64(7), RepeatedField: []int64{1, 2}}
+	require.NoError(t, msg.MergeFromWireVT(delta))
+	require.True(t, proto.Equal(&Int64Message{RequiredField: proto.Int64(7), OptionalField: proto.Int64(5), RepeatedField: []int64{1, 2, 3}}, msg))
+}
@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
 	math "math"
+	slices "slices"
 	unsafe "unsafe"
 )
 
@@ -56,6 +57,47 @@ func (m *DoubleMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// DoubleMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func DoubleMessageCloneSliceVT(in []*DoubleMessage) []*DoubleMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*DoubleMessage, len(in))
+	clones := make([]DoubleMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *FloatMessage) CloneVT() *FloatMessage {
 	if m == nil {
 		return (*FloatMessage)(nil)
@@ -90,6 +132,47 @@ func (m *FloatMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// FloatMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func FloatMessageCloneSliceVT(in []*FloatMessage) []*FloatMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*FloatMessage, len(in))
+	clones := make([]FloatMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Int32Message) CloneVT() *Int32Message {
 	if m == nil {
 		return (*Int32Message)(nil)
@@ -124,6 +207,47 @@ func (m *Int32Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Int32MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Int32MessageCloneSliceVT(in []*Int32Message) []*Int32Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Int32Message, len(in))
+	clones := make([]Int32Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Int64Message) CloneVT() *Int64Message {
 	if m == nil {
 		return (*Int64Message)(nil)
@@ -158,6 +282,47 @@ func (m *Int64Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Int64MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Int64MessageCloneSliceVT(in []*Int64Message) []*Int64Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Int64Message, len(in))
+	clones := make([]Int64Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Uint32Message) CloneVT() *Uint32Message {
 	if m == nil {
 		return (*Uint32Message)(nil)
@@ -192,6 +357,47 @@ func (m *Uint32Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Uint32MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Uint32MessageCloneSliceVT(in []*Uint32Message) []*Uint32Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Uint32Message, len(in))
+	clones := make([]Uint32Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Uint64Message) CloneVT() *Uint64Message {
 	if m == nil {
 		return (*Uint64Message)(nil)
@@ -226,6 +432,47 @@ func (m *Uint64Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Uint64MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Uint64MessageCloneSliceVT(in []*Uint64Message) []*Uint64Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Uint64Message, len(in))
+	clones := make([]Uint64Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Sint32Message) CloneVT() *Sint32Message {
 	if m == nil {
 		return (*Sint32Message)(nil)
@@ -260,6 +507,47 @@ func (m *Sint32Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Sint32MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Sint32MessageCloneSliceVT(in []*Sint32Message) []*Sint32Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Sint32Message, len(in))
+	clones := make([]Sint32Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Sint64Message) CloneVT() *Sint64Message {
 	if m == nil {
 		return (*Sint64Message)(nil)
@@ -294,6 +582,47 @@ func (m *Sint64Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Sint64MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Sint64MessageCloneSliceVT(in []*Sint64Message) []*Sint64Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Sint64Message, len(in))
+	clones := make([]Sint64Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Fixed32Message) CloneVT() *Fixed32Message {
 	if m == nil {
 		return (*Fixed32Message)(nil)
@@ -328,6 +657,47 @@ func (m *Fixed32Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Fixed32MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Fixed32MessageCloneSliceVT(in []*Fixed32Message) []*Fixed32Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Fixed32Message, len(in))
+	clones := make([]Fixed32Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]uint32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Fixed64Message) CloneVT() *Fixed64Message {
 	if m == nil {
 		return (*Fixed64Message)(nil)
@@ -362,6 +732,47 @@ func (m *Fixed64Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Fixed64MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Fixed64MessageCloneSliceVT(in []*Fixed64Message) []*Fixed64Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Fixed64Message, len(in))
+	clones := make([]Fixed64Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]uint64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Sfixed32Message) CloneVT() *Sfixed32Message {
 	if m == nil {
 		return (*Sfixed32Message)(nil)
@@ -396,6 +807,47 @@ func (m *Sfixed32Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Sfixed32MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Sfixed32MessageCloneSliceVT(in []*Sfixed32Message) []*Sfixed32Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Sfixed32Message, len(in))
+	clones := make([]Sfixed32Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *Sfixed64Message) CloneVT() *Sfixed64Message {
 	if m == nil {
 		return (*Sfixed64Message)(nil)
@@ -430,6 +882,47 @@ func (m *Sfixed64Message) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// Sfixed64MessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func Sfixed64MessageCloneSliceVT(in []*Sfixed64Message) []*Sfixed64Message {
+	if in == nil {
+		return nil
+	}
+	out := make([]*Sfixed64Message, len(in))
+	clones := make([]Sfixed64Message, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]int64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *BoolMessage) CloneVT() *BoolMessage {
 	if m == nil {
 		return (*BoolMessage)(nil)
@@ -464,6 +957,47 @@ func (m *BoolMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// BoolMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func BoolMessageCloneSliceVT(in []*BoolMessage) []*BoolMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*BoolMessage, len(in))
+	clones := make([]BoolMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]bool, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *StringMessage) CloneVT() *StringMessage {
 	if m == nil {
 		return (*StringMessage)(nil)
@@ -493,6 +1027,42 @@ func (m *StringMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// StringMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func StringMessageCloneSliceVT(in []*StringMessage) []*StringMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*StringMessage, len(in))
+	clones := make([]StringMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *BytesMessage) CloneVT() *BytesMessage {
 	if m == nil {
 		return (*BytesMessage)(nil)
@@ -528,6 +1098,48 @@ func (m *BytesMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// BytesMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func BytesMessageCloneSliceVT(in []*BytesMessage) []*BytesMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*BytesMessage, len(in))
+	clones := make([]BytesMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.RequiredField = tmpBytes
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.OptionalField = tmpBytes
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.RepeatedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *EnumMessage) CloneVT() *EnumMessage {
 	if m == nil {
 		return (*EnumMessage)(nil)
@@ -562,6 +1174,47 @@ func (m *EnumMessage) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// EnumMessageCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func EnumMessageCloneSliceVT(in []*EnumMessage) []*EnumMessage {
+	if in == nil {
+		return nil
+	}
+	out := make([]*EnumMessage, len(in))
+	clones := make([]EnumMessage, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.RequiredField; rhs != nil {
+			tmpVal := *rhs
+			r.RequiredField = &tmpVal
+		}
+		if rhs := m.OptionalField; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalField = &tmpVal
+		}
+		if rhs := m.RepeatedField; rhs != nil {
+			tmpContainer := make([]EnumMessage_Num, len(rhs))
+			copy(tmpContainer, rhs)
+			r.RepeatedField = tmpContainer
+		}
+		if rhs := m.PackedField; rhs != nil {
+			tmpContainer := make([]EnumMessage_Num, len(rhs))
+			copy(tmpContainer, rhs)
+			r.PackedField = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
 	if this == that {
 		return true
@@ -574,24 +1227,12 @@ func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -614,24 +1255,12 @@ func (this *FloatMessage) EqualVT(that *FloatMessage) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -654,24 +1283,12 @@ func (this *Int32Message) EqualVT(that *Int32Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -694,24 +1311,12 @@ func (this *Int64Message) EqualVT(that *Int64Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -734,24 +1339,12 @@ func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -774,24 +1367,12 @@ func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -814,24 +1395,12 @@ func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -854,24 +1423,12 @@ func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -894,24 +1451,12 @@ func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -934,24 +1479,12 @@ func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -974,24 +1507,12 @@ func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1014,24 +1535,12 @@ func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1054,24 +1563,12 @@ func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1094,15 +1591,9 @@ func (this *StringMessage) EqualVT(that *StringMessage) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1156,24 +1647,12 @@ func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
 	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
+	if !slices.Equal(this.RepeatedField, that.RepeatedField) {
 		return false
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.PackedField) != len(that.PackedField) {
+	if !slices.Equal(this.PackedField, that.PackedField) {
 		return false
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -3156,224 +3635,3578 @@ func (m *BoolMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 			} else {
 				dAtA[i] = 0
 			}
-			i--
-			dAtA[i] = 0x18
-		}
-	}
-	if m.OptionalField != nil {
-		i--
-		if *m.OptionalField {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i--
-		if *m.RequiredField {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StringMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *StringMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *StringMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedField[iNdEx])
-			copy(dAtA[i:], m.RepeatedField[iNdEx])
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.OptionalField != nil {
-		i -= len(*m.OptionalField)
-		copy(dAtA[i:], *m.OptionalField)
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalField)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= len(*m.RequiredField)
-		copy(dAtA[i:], *m.RequiredField)
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.RequiredField)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BytesMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BytesMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
-
-func (m *BytesMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedField[iNdEx])
-			copy(dAtA[i:], m.RepeatedField[iNdEx])
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i--
+		if *m.OptionalField {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i--
+		if *m.RequiredField {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StringMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *StringMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedField[iNdEx])
+			copy(dAtA[i:], m.RepeatedField[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i -= len(*m.OptionalField)
+		copy(dAtA[i:], *m.OptionalField)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.OptionalField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= len(*m.RequiredField)
+		copy(dAtA[i:], *m.RequiredField)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(*m.RequiredField)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytesMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BytesMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *BytesMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedField[iNdEx])
+			copy(dAtA[i:], m.RepeatedField[iNdEx])
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i -= len(m.OptionalField)
+		copy(dAtA[i:], m.OptionalField)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= len(m.RequiredField)
+		copy(dAtA[i:], m.RequiredField)
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RequiredField)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumMessage) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnumMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *EnumMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += protohelpers.SizeOfVarint(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num1 := range m.PackedField {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			i--
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.RequiredField = &v2
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FloatMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FloatMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FloatMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.RequiredField = &v2
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int32Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int64Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint32Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint64Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint32Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint64Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.RequiredField = &v2
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.RepeatedField = append(m.RepeatedField, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PackedField = append(m.PackedField, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed32Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RequiredField = &v
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed64Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequiredField = &v
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed32Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RequiredField = &v
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed64Message) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequiredField = &v
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.RequiredField = &b
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalField = &b
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]bool, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.RepeatedField = append(m.RepeatedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]bool, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					m.PackedField = append(m.PackedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RequiredField = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.OptionalField = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedField = append(m.RepeatedField, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BytesMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BytesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BytesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredField = append(m.RequiredField[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequiredField == nil {
+				m.RequiredField = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalField = append(m.OptionalField[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalField == nil {
+				m.OptionalField = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedField = append(m.RepeatedField, make([]byte, postIndex-iNdEx))
+			copy(m.RepeatedField[len(m.RepeatedField)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if m.RequiredField == nil {
+		return fmt.Errorf("proto: required field required_field not set")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnumMessage) MergeFromWireVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v EnumMessage_Num
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= EnumMessage_Num(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v EnumMessage_Num
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= EnumMessage_Num(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EnumMessage_Num(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					e := EnumMessage_Num(v)
+					m.RepeatedField = append(m.RepeatedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EnumMessage_Num(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
+							return protohelpers.ErrIntOverflow
+						}
+						continue
+					}
+					e := EnumMessage_Num(v)
+					m.PackedField = append(m.PackedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.OptionalField != nil {
-		i -= len(m.OptionalField)
-		copy(dAtA[i:], m.OptionalField)
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.OptionalField)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= len(m.RequiredField)
-		copy(dAtA[i:], m.RequiredField)
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.RequiredField)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EnumMessage) MarshalVTStrict() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
-	if err != nil {
-		return nil, err
+		return fmt.Errorf("proto: required field required_field not set")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EnumMessage) MarshalToVTStrict(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
-}
 
-func (m *EnumMessage) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += protohelpers.SizeOfVarint(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num1 := range m.PackedField {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
-		}
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = protohelpers.EncodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
-	}
-	if m.OptionalField != nil {
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = protohelpers.EncodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-
 func (m *DoubleMessage) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -4274,24 +8107,24 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -4350,24 +8183,24 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -4522,24 +8355,24 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -4598,24 +8431,24 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -4770,24 +8603,24 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -4846,24 +8679,24 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -5018,24 +8851,24 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -5094,24 +8927,24 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, uint64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -5269,25 +9102,24 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -5347,25 +9179,24 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -5525,25 +9356,24 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedField = append(m.RepeatedField, int64(v))
+					m.RepeatedField = append(m.RepeatedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -5603,25 +9433,24 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedField = append(m.PackedField, int64(v))
+					m.PackedField = append(m.PackedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -6500,24 +10329,24 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, bool(v != 0))
+					m.RepeatedField = append(m.RepeatedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -6570,24 +10399,24 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, bool(v != 0))
+					m.PackedField = append(m.PackedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -7045,24 +10874,25 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v EnumMessage_Num
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= EnumMessage_Num(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					e := EnumMessage_Num(v)
+					m.RepeatedField = append(m.RepeatedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -7114,24 +10944,25 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v EnumMessage_Num
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= EnumMessage_Num(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					e := EnumMessage_Num(v)
+					m.PackedField = append(m.PackedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -7256,6 +11087,9 @@ func (m *DoubleMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.RepeatedField = append(m.RepeatedField, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -7310,6 +11144,9 @@ func (m *DoubleMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]float64, 0, elementCount)
 				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.PackedField = append(m.PackedField, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint64
 					if (iNdEx + 8) > l {
@@ -7444,6 +11281,9 @@ func (m *FloatMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.RepeatedField = append(m.RepeatedField, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -7498,6 +11338,9 @@ func (m *FloatMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]float32, 0, elementCount)
 				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.PackedField = append(m.PackedField, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
 				for iNdEx < postIndex {
 					var v uint32
 					if (iNdEx + 4) > l {
@@ -7662,24 +11505,24 @@ func (m *Int32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -7738,24 +11581,24 @@ func (m *Int32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int32(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -7910,24 +11753,24 @@ func (m *Int64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -7986,24 +11829,24 @@ func (m *Int64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -8158,24 +12001,24 @@ func (m *Uint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -8234,24 +12077,24 @@ func (m *Uint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]uint32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, uint32(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -8406,24 +12249,24 @@ func (m *Uint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, uint64(v))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -8482,24 +12325,24 @@ func (m *Uint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]uint64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, uint64(v))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -8657,25 +12500,24 @@ func (m *Sint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.RepeatedField = append(m.RepeatedField, v)
+					m.RepeatedField = append(m.RepeatedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -8735,25 +12577,24 @@ func (m *Sint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-					m.PackedField = append(m.PackedField, v)
+					m.PackedField = append(m.PackedField, int32(uint32(v)>>1)^-int32(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -8913,25 +12754,24 @@ func (m *Sint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.RepeatedField = append(m.RepeatedField, int64(v))
+					m.RepeatedField = append(m.RepeatedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -8991,25 +12831,24 @@ func (m *Sint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]int64, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
-					m.PackedField = append(m.PackedField, int64(v))
+					m.PackedField = append(m.PackedField, int64(v>>1)^-int64(v&1))
+					v, shift = 0, 0
 				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -9888,24 +13727,24 @@ func (m *BoolMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, bool(v != 0))
+					m.RepeatedField = append(m.RepeatedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -9958,24 +13797,24 @@ func (m *BoolMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]bool, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, bool(v != 0))
+					m.PackedField = append(m.PackedField, v != 0)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
@@ -10438,24 +14277,25 @@ func (m *EnumMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.RepeatedField) == 0 {
 					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v EnumMessage_Num
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= EnumMessage_Num(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.RepeatedField = append(m.RepeatedField, v)
+					e := EnumMessage_Num(v)
+					m.RepeatedField = append(m.RepeatedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
 			}
@@ -10507,24 +14347,25 @@ func (m *EnumMessage) UnmarshalVTUnsafe(dAtA []byte) error {
 				if elementCount != 0 && len(m.PackedField) == 0 {
 					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v EnumMessage_Num
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
+				var v uint64
+				var shift uint
+				for _, b := range dAtA[iNdEx:postIndex] {
+					v |= uint64(b&0x7F) << shift
+					if b >= 0x80 {
+						shift += 7
+						if shift >= 70 {
 							return protohelpers.ErrIntOverflow
 						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= EnumMessage_Num(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+						continue
 					}
-					m.PackedField = append(m.PackedField, v)
+					e := EnumMessage_Num(v)
+					m.PackedField = append(m.PackedField, e)
+					v, shift = 0, 0
+				}
+				if shift != 0 {
+					return io.ErrUnexpectedEOF
 				}
+				iNdEx = postIndex
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
 			}
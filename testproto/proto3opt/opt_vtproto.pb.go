@@ -103,6 +103,94 @@ func (m *OptionalFieldInProto3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// OptionalFieldInProto3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func OptionalFieldInProto3CloneSliceVT(in []*OptionalFieldInProto3) []*OptionalFieldInProto3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*OptionalFieldInProto3, len(in))
+	clones := make([]OptionalFieldInProto3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.OptionalInt32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalInt32 = &tmpVal
+		}
+		if rhs := m.OptionalInt64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalInt64 = &tmpVal
+		}
+		if rhs := m.OptionalUint32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalUint32 = &tmpVal
+		}
+		if rhs := m.OptionalUint64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalUint64 = &tmpVal
+		}
+		if rhs := m.OptionalSint32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSint32 = &tmpVal
+		}
+		if rhs := m.OptionalSint64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSint64 = &tmpVal
+		}
+		if rhs := m.OptionalFixed32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFixed32 = &tmpVal
+		}
+		if rhs := m.OptionalFixed64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFixed64 = &tmpVal
+		}
+		if rhs := m.OptionalSfixed32; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSfixed32 = &tmpVal
+		}
+		if rhs := m.OptionalSfixed64; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalSfixed64 = &tmpVal
+		}
+		if rhs := m.OptionalFloat; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalFloat = &tmpVal
+		}
+		if rhs := m.OptionalDouble; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalDouble = &tmpVal
+		}
+		if rhs := m.OptionalBool; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalBool = &tmpVal
+		}
+		if rhs := m.OptionalString; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalString = &tmpVal
+		}
+		if rhs := m.OptionalBytes; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.OptionalBytes = tmpBytes
+		}
+		if rhs := m.OptionalEnum; rhs != nil {
+			tmpVal := *rhs
+			r.OptionalEnum = &tmpVal
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *OptionalFieldInProto3) EqualVT(that *OptionalFieldInProto3) bool {
 	if this == that {
 		return true
@@ -427,6 +515,9 @@ func (m *OptionalFieldInProto3) MarshalToSizedBufferVTStrict(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *OptionalFieldInProto3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *OptionalFieldInProto3) SizeVT() (n int) {
 	if m == nil {
 		return 0
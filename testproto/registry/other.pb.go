@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: registry/other.proto
+
+package registry
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	apipb "google.golang.org/protobuf/types/known/apipb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Other lives in the same Go package as Container and embeds the same external
+// message, so both files declare their own vtregistry handle for it.
+type Other struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Api           *apipb.Api             `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Other) Reset() {
+	*x = Other{}
+	mi := &file_registry_other_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Other) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Other) ProtoMessage() {}
+
+func (x *Other) ProtoReflect() protoreflect.Message {
+	mi := &file_registry_other_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Other.ProtoReflect.Descriptor instead.
+func (*Other) Descriptor() ([]byte, []int) {
+	return file_registry_other_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Other) GetApi() *apipb.Api {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+var File_registry_other_proto protoreflect.FileDescriptor
+
+const file_registry_other_proto_rawDesc = "" +
+	"\n" +
+	"\x14registry/other.proto\x12\bregistry\x1a\x19google/protobuf/api.proto\"/\n" +
+	"\x05Other\x12&\n" +
+	"\x03api\x18\x01 \x01(\v2\x14.google.protobuf.ApiR\x03apiB\x14Z\x12testproto/registryb\x06proto3"
+
+var (
+	file_registry_other_proto_rawDescOnce sync.Once
+	file_registry_other_proto_rawDescData []byte
+)
+
+func file_registry_other_proto_rawDescGZIP() []byte {
+	file_registry_other_proto_rawDescOnce.Do(func() {
+		file_registry_other_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_registry_other_proto_rawDesc), len(file_registry_other_proto_rawDesc)))
+	})
+	return file_registry_other_proto_rawDescData
+}
+
+var file_registry_other_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_registry_other_proto_goTypes = []any{
+	(*Other)(nil),     // 0: registry.Other
+	(*apipb.Api)(nil), // 1: google.protobuf.Api
+}
+var file_registry_other_proto_depIdxs = []int32{
+	1, // 0: registry.Other.api:type_name -> google.protobuf.Api
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_registry_other_proto_init() }
+func file_registry_other_proto_init() {
+	if File_registry_other_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_registry_other_proto_rawDesc), len(file_registry_other_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_registry_other_proto_goTypes,
+		DependencyIndexes: file_registry_other_proto_depIdxs,
+		MessageInfos:      file_registry_other_proto_msgTypes,
+	}.Build()
+	File_registry_other_proto = out.File
+	file_registry_other_proto_goTypes = nil
+	file_registry_other_proto_depIdxs = nil
+}
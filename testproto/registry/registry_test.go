@@ -0,0 +1,122 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/apipb"
+
+	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+	"github.com/planetscale/vtprotobuf/vtregistry"
+)
+
+func TestRegistryRoundTrip(t *testing.T) {
+	msg := &Container{
+		Single: &proto3opt.OptionalFieldInProto3{OptionalString: proto.String("single")},
+		List: []*proto3opt.OptionalFieldInProto3{
+			{OptionalInt32: proto.Int32(1)},
+			{OptionalBool: proto.Bool(true)},
+		},
+		ByName: map[string]*proto3opt.OptionalFieldInProto3{
+			"a": {OptionalBytes: []byte("a")},
+		},
+		Choice: &Container_Picked{Picked: &proto3opt.OptionalFieldInProto3{OptionalDouble: proto.Float64(1.5)}},
+		Api:    &apipb.Api{Name: "api", Version: "v1"},
+		Local:  &Local{Name: "local"},
+	}
+
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(msg), msg.SizeVT())
+
+	got := &Container{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, proto.Equal(msg, got))
+	require.True(t, msg.EqualVT(got))
+
+	got.Api.Version = "v2"
+	require.False(t, msg.EqualVT(got))
+
+	clone := msg.CloneVT()
+	require.True(t, clone.EqualVT(msg))
+	require.NotSame(t, msg.Single, clone.Single)
+	require.NotSame(t, msg.Api, clone.Api)
+}
+
+func TestRegistryLookup(t *testing.T) {
+	caps, ok := vtregistry.Lookup("registry.Container")
+	require.True(t, ok)
+	require.NotNil(t, caps.SizeVT)
+	require.NotNil(t, caps.MarshalToSizedBufferVT)
+	require.NotNil(t, caps.UnmarshalVT)
+	require.NotNil(t, caps.CloneVT)
+	require.NotNil(t, caps.EqualVT)
+
+	msg := &Container{Local: &Local{Name: "local"}}
+	require.Equal(t, msg.SizeVT(), caps.SizeVT(msg))
+	require.True(t, caps.EqualVT(msg, caps.CloneVT(msg)))
+
+	// Messages without VT helpers are not registered and have no capabilities but
+	// their constructor.
+	_, ok = vtregistry.Lookup("google.protobuf.Api")
+	require.False(t, ok)
+	caps = vtregistry.CapabilitiesOf((*apipb.Api)(nil))
+	require.Zero(t, caps.Flags())
+	require.IsType(t, &apipb.Api{}, caps.New())
+}
+
+func TestRegistryByName(t *testing.T) {
+	caps, ok := vtregistry.LookupURL("type.googleapis.com/registry.Local")
+	require.True(t, ok)
+	require.Equal(t, vtregistry.HasSizeVT|vtregistry.HasMarshalToSizedBufferVT|vtregistry.HasMarshalToSizedBufferVTStrict|
+		vtregistry.HasMarshalToSizedBufferVTDeterministic|vtregistry.HasUnmarshalVT|vtregistry.HasUnmarshalVTUnsafe|
+		vtregistry.HasCloneVT|vtregistry.HasEqualVT, caps.Flags())
+
+	msg, ok := vtregistry.New("registry.Local")
+	require.True(t, ok)
+	require.IsType(t, &Local{}, msg)
+	_, ok = vtregistry.New("registry.Missing")
+	require.False(t, ok)
+
+	data, err := (&Local{Name: "local"}).MarshalVT()
+	require.NoError(t, err)
+	msg, err = vtregistry.Unmarshal("registry.Local", data)
+	require.NoError(t, err)
+	require.True(t, (&Local{Name: "local"}).EqualVT(msg.(*Local)))
+	_, err = vtregistry.Unmarshal("registry.Missing", data)
+	require.Error(t, err)
+
+	var names []string
+	vtregistry.Range(func(name protoreflect.FullName, _ vtregistry.Capabilities) bool {
+		names = append(names, string(name))
+		return true
+	})
+	require.Subset(t, names, []string{"registry.Container", "registry.Local", "registry.Other"})
+}
+
+func TestRegistrySamePackage(t *testing.T) {
+	msg := &Other{Api: &apipb.Api{Name: "other"}}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	got := &Other{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, msg.EqualVT(got))
+}
+
+func TestRegistryPooled(t *testing.T) {
+	caps, ok := vtregistry.Lookup("registry.Pooled")
+	require.True(t, ok)
+	require.NotZero(t, caps.Flags()&vtregistry.HasFromVTPool)
+	require.NotZero(t, caps.Flags()&vtregistry.HasReturnToVTPool)
+
+	m := caps.FromVTPool().(*Pooled)
+	m.Name = "pooled"
+	caps.ReturnToVTPool(m)
+
+	caps, ok = vtregistry.Lookup("registry.Local")
+	require.True(t, ok)
+	require.Zero(t, caps.Flags()&(vtregistry.HasFromVTPool|vtregistry.HasReturnToVTPool))
+}
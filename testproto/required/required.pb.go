@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: required/required.proto
+
+package required
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Leaf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int32                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Leaf) Reset() {
+	*x = Leaf{}
+	mi := &file_required_required_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Leaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leaf) ProtoMessage() {}
+
+func (x *Leaf) ProtoReflect() protoreflect.Message {
+	mi := &file_required_required_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leaf.ProtoReflect.Descriptor instead.
+func (*Leaf) Descriptor() ([]byte, []int) {
+	return file_required_required_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Leaf) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Leaf) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// Tree reaches required fields through all the kinds of message fields.
+type Tree struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   *string                `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Leaf   *Leaf                  `protobuf:"bytes,2,opt,name=leaf" json:"leaf,omitempty"`
+	Leaves []*Leaf                `protobuf:"bytes,3,rep,name=leaves" json:"leaves,omitempty"`
+	ByName map[string]*Leaf       `protobuf:"bytes,4,rep,name=by_name,json=byName" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Tree_Picked
+	//	*Tree_Text
+	Choice          isTree_Choice `protobuf_oneof:"choice"`
+	Node            *Tree_Node    `protobuf:"group,7,opt,name=Node,json=node" json:"node,omitempty"`
+	extensionFields protoimpl.ExtensionFields
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	mi := &file_required_required_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_required_required_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_required_required_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Tree) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Tree) GetLeaf() *Leaf {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *Tree) GetLeaves() []*Leaf {
+	if x != nil {
+		return x.Leaves
+	}
+	return nil
+}
+
+func (x *Tree) GetByName() map[string]*Leaf {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *Tree) GetChoice() isTree_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Tree) GetPicked() *Leaf {
+	if x != nil {
+		if x, ok := x.Choice.(*Tree_Picked); ok {
+			return x.Picked
+		}
+	}
+	return nil
+}
+
+func (x *Tree) GetText() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Tree_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Tree) GetNode() *Tree_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type isTree_Choice interface {
+	isTree_Choice()
+}
+
+type Tree_Picked struct {
+	Picked *Leaf `protobuf:"bytes,5,opt,name=picked,oneof"`
+}
+
+type Tree_Text struct {
+	Text string `protobuf:"bytes,6,opt,name=text,oneof"`
+}
+
+func (*Tree_Picked) isTree_Choice() {}
+
+func (*Tree_Text) isTree_Choice() {}
+
+// Plain has neither required fields nor extensions.
+type Plain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int32                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Children      map[string]*Plain      `protobuf:"bytes,2,rep,name=children" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plain) Reset() {
+	*x = Plain{}
+	mi := &file_required_required_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plain) ProtoMessage() {}
+
+func (x *Plain) ProtoReflect() protoreflect.Message {
+	mi := &file_required_required_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plain.ProtoReflect.Descriptor instead.
+func (*Plain) Descriptor() ([]byte, []int) {
+	return file_required_required_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Plain) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Plain) GetChildren() map[string]*Plain {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Tree_Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *int32                 `protobuf:"varint,8,req,name=value" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tree_Node) Reset() {
+	*x = Tree_Node{}
+	mi := &file_required_required_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree_Node) ProtoMessage() {}
+
+func (x *Tree_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_required_required_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree_Node.ProtoReflect.Descriptor instead.
+func (*Tree_Node) Descriptor() ([]byte, []int) {
+	return file_required_required_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Tree_Node) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+var file_required_required_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Tree)(nil),
+		ExtensionType: (*Leaf)(nil),
+		Field:         100,
+		Name:          "ext_leaf",
+		Tag:           "bytes,100,opt,name=ext_leaf",
+		Filename:      "required/required.proto",
+	},
+}
+
+// Extension fields to Tree.
+var (
+	// optional Leaf ext_leaf = 100;
+	E_ExtLeaf = &file_required_required_proto_extTypes[0]
+)
+
+var File_required_required_proto protoreflect.FileDescriptor
+
+const file_required_required_proto_rawDesc = "" +
+	"\n" +
+	"\x17required/required.proto\"*\n" +
+	"\x04Leaf\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc8\x02\n" +
+	"\x04Tree\x12\x12\n" +
+	"\x04name\x18\x01 \x02(\tR\x04name\x12\x19\n" +
+	"\x04leaf\x18\x02 \x01(\v2\x05.LeafR\x04leaf\x12\x1d\n" +
+	"\x06leaves\x18\x03 \x03(\v2\x05.LeafR\x06leaves\x12*\n" +
+	"\aby_name\x18\x04 \x03(\v2\x11.Tree.ByNameEntryR\x06byName\x12\x1f\n" +
+	"\x06picked\x18\x05 \x01(\v2\x05.LeafH\x00R\x06picked\x12\x14\n" +
+	"\x04text\x18\x06 \x01(\tH\x00R\x04text\x12\x1e\n" +
+	"\x04node\x18\a \x01(\n" +
+	"2\n" +
+	".Tree.NodeR\x04node\x1a@\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\x05value\x18\x02 \x01(\v2\x05.LeafR\x05value:\x028\x01\x1a\x1c\n" +
+	"\x04Node\x12\x14\n" +
+	"\x05value\x18\b \x02(\x05R\x05value*\x05\bd\x10\xc8\x01B\b\n" +
+	"\x06choice\"\x8e\x01\n" +
+	"\x05Plain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x120\n" +
+	"\bchildren\x18\x02 \x03(\v2\x14.Plain.ChildrenEntryR\bchildren\x1aC\n" +
+	"\rChildrenEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\x05value\x18\x02 \x01(\v2\x06.PlainR\x05value:\x028\x01:'\n" +
+	"\bext_leaf\x12\x05.Tree\x18d \x01(\v2\x05.LeafR\aextLeafB\x14Z\x12testproto/required"
+
+var (
+	file_required_required_proto_rawDescOnce sync.Once
+	file_required_required_proto_rawDescData []byte
+)
+
+func file_required_required_proto_rawDescGZIP() []byte {
+	file_required_required_proto_rawDescOnce.Do(func() {
+		file_required_required_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_required_required_proto_rawDesc), len(file_required_required_proto_rawDesc)))
+	})
+	return file_required_required_proto_rawDescData
+}
+
+var file_required_required_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_required_required_proto_goTypes = []any{
+	(*Leaf)(nil),      // 0: Leaf
+	(*Tree)(nil),      // 1: Tree
+	(*Plain)(nil),     // 2: Plain
+	nil,               // 3: Tree.ByNameEntry
+	(*Tree_Node)(nil), // 4: Tree.Node
+	nil,               // 5: Plain.ChildrenEntry
+}
+var file_required_required_proto_depIdxs = []int32{
+	0,  // 0: Tree.leaf:type_name -> Leaf
+	0,  // 1: Tree.leaves:type_name -> Leaf
+	3,  // 2: Tree.by_name:type_name -> Tree.ByNameEntry
+	0,  // 3: Tree.picked:type_name -> Leaf
+	4,  // 4: Tree.node:type_name -> Tree.Node
+	5,  // 5: Plain.children:type_name -> Plain.ChildrenEntry
+	0,  // 6: Tree.ByNameEntry.value:type_name -> Leaf
+	2,  // 7: Plain.ChildrenEntry.value:type_name -> Plain
+	1,  // 8: ext_leaf:extendee -> Tree
+	0,  // 9: ext_leaf:type_name -> Leaf
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	9,  // [9:10] is the sub-list for extension type_name
+	8,  // [8:9] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_required_required_proto_init() }
+func file_required_required_proto_init() {
+	if File_required_required_proto != nil {
+		return
+	}
+	file_required_required_proto_msgTypes[1].OneofWrappers = []any{
+		(*Tree_Picked)(nil),
+		(*Tree_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_required_required_proto_rawDesc), len(file_required_required_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_required_required_proto_goTypes,
+		DependencyIndexes: file_required_required_proto_depIdxs,
+		MessageInfos:      file_required_required_proto_msgTypes,
+		ExtensionInfos:    file_required_required_proto_extTypes,
+	}.Build()
+	File_required_required_proto = out.File
+	file_required_required_proto_goTypes = nil
+	file_required_required_proto_depIdxs = nil
+}
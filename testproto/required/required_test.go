@@ -0,0 +1,123 @@
+package required
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func leaf(id int32) *Leaf {
+	return &Leaf{Id: proto.Int32(id)}
+}
+
+// entry returns the encoding of an entry of the by_name map of Tree, without value when
+// value is nil.
+func entry(key string, value []byte) []byte {
+	e := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), key)
+	if value != nil {
+		e = protowire.AppendBytes(protowire.AppendTag(e, 2, protowire.BytesType), value)
+	}
+	return protowire.AppendBytes(protowire.AppendTag(nil, 4, protowire.BytesType), e)
+}
+
+func TestUnmarshalVTStrict(t *testing.T) {
+	complete := &Tree{
+		Name:   proto.String("tree"),
+		Leaf:   leaf(1),
+		Leaves: []*Leaf{leaf(2)},
+		ByName: map[string]*Leaf{"a": leaf(3)},
+		Choice: &Tree_Picked{Picked: leaf(4)},
+		Node:   &Tree_Node{Value: proto.Int32(5)},
+	}
+	proto.SetExtension(complete, E_ExtLeaf, leaf(6))
+	data, err := proto.Marshal(complete)
+	require.NoError(t, err)
+	name := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "tree")
+	extension := func(value []byte) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, 100, protowire.BytesType), value)
+	}
+	empty := []byte{}
+
+	for _, tc := range []struct {
+		name string
+		data []byte
+		// partial is set when UnmarshalVT accepts the data, unlike proto.Unmarshal.
+		partial bool
+	}{
+		{"complete", data, false},
+		{"name", nil, false},
+		{"leaf", append(protowire.AppendBytes(protowire.AppendTag(nil, 2, protowire.BytesType), empty), name...), false},
+		{"leaves", append(protowire.AppendBytes(protowire.AppendTag(nil, 3, protowire.BytesType), empty), name...), false},
+		{"mapValue", append(entry("a", empty), name...), false},
+		{"mapEntry", append(entry("a", nil), name...), true},
+		{"oneof", append(protowire.AppendBytes(protowire.AppendTag(nil, 5, protowire.BytesType), empty), name...), false},
+		{"group", append(protowire.AppendTag(protowire.AppendTag(nil, 7, protowire.StartGroupType), 7, protowire.EndGroupType), name...), false},
+		{"extension", append(extension(empty), name...), true},
+		{"extensionBeforeName", append(extension(protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 1)), name...), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := proto.Unmarshal(tc.data, &Tree{})
+			err := (&Tree{}).UnmarshalVTStrict(tc.data)
+			require.Equal(t, expected == nil, err == nil, "proto.Unmarshal: %v, UnmarshalVTStrict: %v", expected, err)
+			if err != nil {
+				require.ErrorContains(t, err, "required field")
+			}
+
+			err = (&Tree{}).UnmarshalVT(tc.data)
+			if tc.partial {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, expected == nil, err == nil, "proto.Unmarshal: %v, UnmarshalVT: %v", expected, err)
+			}
+		})
+	}
+
+	decoded := &Tree{}
+	require.NoError(t, decoded.UnmarshalVTStrict(data))
+	require.True(t, proto.Equal(complete, decoded))
+
+	plain := &Plain{Id: proto.Int32(1), Children: map[string]*Plain{"a": {}}}
+	data, err = plain.MarshalVT()
+	require.NoError(t, err)
+	decodedPlain := &Plain{}
+	require.NoError(t, decodedPlain.UnmarshalVTStrict(data))
+	require.True(t, proto.Equal(plain, decodedPlain))
+}
+
+func TestMarshalVTStrictRequired(t *testing.T) {
+	for name, tree := range map[string]*Tree{
+		"complete": {Name: proto.String("tree"), Leaf: leaf(1)},
+		"name":     {},
+		"leaf":     {Name: proto.String("tree"), Leaf: &Leaf{}},
+		"leaves":   {Name: proto.String("tree"), Leaves: []*Leaf{{}}},
+		"mapValue": {Name: proto.String("tree"), ByName: map[string]*Leaf{"a": {}}},
+		"oneof":    {Name: proto.String("tree"), Choice: &Tree_Picked{Picked: &Leaf{}}},
+		"group":    {Name: proto.String("tree"), Node: &Tree_Node{}},
+	} {
+		_, expected := proto.Marshal(tree)
+		for method, marshal := range map[string]func() ([]byte, error){
+			"MarshalVT":              tree.MarshalVT,
+			"MarshalVTStrict":        tree.MarshalVTStrict,
+			"MarshalVTDeterministic": tree.MarshalVTDeterministic,
+		} {
+			_, err := marshal()
+			require.Equal(t, expected == nil, err == nil, "%s: proto.Marshal: %v, %s: %v", name, expected, method, err)
+		}
+	}
+
+	// The nil map values and oneof members are encoded as empty messages, whose required
+	// fields are only checked by MarshalVTStrict.
+	for name, tree := range map[string]*Tree{
+		"nilMapValue": {Name: proto.String("tree"), ByName: map[string]*Leaf{"a": nil}},
+		"nilOneof":    {Name: proto.String("tree"), Choice: &Tree_Picked{}},
+	} {
+		_, err := proto.Marshal(tree)
+		require.Error(t, err, name)
+		_, err = tree.MarshalVTStrict()
+		require.Error(t, err, name)
+		_, err = tree.MarshalVT()
+		require.NoError(t, err, name)
+	}
+}
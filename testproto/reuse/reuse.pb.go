@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: reuse/reuse.proto
+
+package reuse
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []int64                `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Child         *Item                  `protobuf:"bytes,3,opt,name=child,proto3" json:"child,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_reuse_reuse_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_reuse_reuse_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_reuse_reuse_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Item) GetChild() *Item {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+type Batch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	mi := &file_reuse_reuse_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_reuse_reuse_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_reuse_reuse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Batch) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PlainBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlainBatch) Reset() {
+	*x = PlainBatch{}
+	mi := &file_reuse_reuse_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlainBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainBatch) ProtoMessage() {}
+
+func (x *PlainBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_reuse_reuse_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainBatch.ProtoReflect.Descriptor instead.
+func (*PlainBatch) Descriptor() ([]byte, []int) {
+	return file_reuse_reuse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlainBatch) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Strings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label *string                `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Tags  []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Strings_Text
+	//	*Strings_Number
+	Value         isStrings_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Strings) Reset() {
+	*x = Strings{}
+	mi := &file_reuse_reuse_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Strings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strings) ProtoMessage() {}
+
+func (x *Strings) ProtoReflect() protoreflect.Message {
+	mi := &file_reuse_reuse_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strings.ProtoReflect.Descriptor instead.
+func (*Strings) Descriptor() ([]byte, []int) {
+	return file_reuse_reuse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Strings) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Strings) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *Strings) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Strings) GetValue() isStrings_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Strings) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*Strings_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Strings) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*Strings_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+type isStrings_Value interface {
+	isStrings_Value()
+}
+
+type Strings_Text struct {
+	Text string `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type Strings_Number struct {
+	Number int64 `protobuf:"varint,5,opt,name=number,proto3,oneof"`
+}
+
+func (*Strings_Text) isStrings_Value() {}
+
+func (*Strings_Number) isStrings_Value() {}
+
+type Maps struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        map[string]string      `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Counters      map[string]int64       `protobuf:"bytes,2,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Ratios        map[string]float64     `protobuf:"bytes,3,rep,name=ratios,proto3" json:"ratios,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Items         map[string]*Item       `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Maps) Reset() {
+	*x = Maps{}
+	mi := &file_reuse_reuse_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Maps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maps) ProtoMessage() {}
+
+func (x *Maps) ProtoReflect() protoreflect.Message {
+	mi := &file_reuse_reuse_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maps.ProtoReflect.Descriptor instead.
+func (*Maps) Descriptor() ([]byte, []int) {
+	return file_reuse_reuse_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Maps) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Maps) GetCounters() map[string]int64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *Maps) GetRatios() map[string]float64 {
+	if x != nil {
+		return x.Ratios
+	}
+	return nil
+}
+
+func (x *Maps) GetItems() map[string]*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_reuse_reuse_proto protoreflect.FileDescriptor
+
+const file_reuse_reuse_proto_rawDesc = "" +
+	"\n" +
+	"\x11reuse/reuse.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"O\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x03R\x06values\x12\x1b\n" +
+	"\x05child\x18\x03 \x01(\v2\x05.ItemR\x05child\"*\n" +
+	"\x05Batch\x12\x1b\n" +
+	"\x05items\x18\x01 \x03(\v2\x05.ItemR\x05items:\x04\xb8\xa6\x1f\x01\")\n" +
+	"\n" +
+	"PlainBatch\x12\x1b\n" +
+	"\x05items\x18\x01 \x03(\v2\x05.ItemR\x05items\"\x95\x01\n" +
+	"\aStrings\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05label\x18\x02 \x01(\tH\x01R\x05label\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x14\n" +
+	"\x04text\x18\x04 \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x05 \x01(\x03H\x00R\x06number:\x04\xc0\xa6\x1f\x01B\a\n" +
+	"\x05valueB\b\n" +
+	"\x06_label\"\xa9\x03\n" +
+	"\x04Maps\x12)\n" +
+	"\x06labels\x18\x01 \x03(\v2\x11.Maps.LabelsEntryR\x06labels\x12/\n" +
+	"\bcounters\x18\x02 \x03(\v2\x13.Maps.CountersEntryR\bcounters\x12)\n" +
+	"\x06ratios\x18\x03 \x03(\v2\x11.Maps.RatiosEntryR\x06ratios\x12&\n" +
+	"\x05items\x18\x04 \x03(\v2\x10.Maps.ItemsEntryR\x05items\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rCountersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a9\n" +
+	"\vRatiosEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a?\n" +
+	"\n" +
+	"ItemsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\x05value\x18\x02 \x01(\v2\x05.ItemR\x05value:\x028\x01B\x11Z\x0ftestproto/reuseb\x06proto3"
+
+var (
+	file_reuse_reuse_proto_rawDescOnce sync.Once
+	file_reuse_reuse_proto_rawDescData []byte
+)
+
+func file_reuse_reuse_proto_rawDescGZIP() []byte {
+	file_reuse_reuse_proto_rawDescOnce.Do(func() {
+		file_reuse_reuse_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reuse_reuse_proto_rawDesc), len(file_reuse_reuse_proto_rawDesc)))
+	})
+	return file_reuse_reuse_proto_rawDescData
+}
+
+var file_reuse_reuse_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_reuse_reuse_proto_goTypes = []any{
+	(*Item)(nil),       // 0: Item
+	(*Batch)(nil),      // 1: Batch
+	(*PlainBatch)(nil), // 2: PlainBatch
+	(*Strings)(nil),    // 3: Strings
+	(*Maps)(nil),       // 4: Maps
+	nil,                // 5: Maps.LabelsEntry
+	nil,                // 6: Maps.CountersEntry
+	nil,                // 7: Maps.RatiosEntry
+	nil,                // 8: Maps.ItemsEntry
+}
+var file_reuse_reuse_proto_depIdxs = []int32{
+	0, // 0: Item.child:type_name -> Item
+	0, // 1: Batch.items:type_name -> Item
+	0, // 2: PlainBatch.items:type_name -> Item
+	5, // 3: Maps.labels:type_name -> Maps.LabelsEntry
+	6, // 4: Maps.counters:type_name -> Maps.CountersEntry
+	7, // 5: Maps.ratios:type_name -> Maps.RatiosEntry
+	8, // 6: Maps.items:type_name -> Maps.ItemsEntry
+	0, // 7: Maps.ItemsEntry.value:type_name -> Item
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_reuse_reuse_proto_init() }
+func file_reuse_reuse_proto_init() {
+	if File_reuse_reuse_proto != nil {
+		return
+	}
+	file_reuse_reuse_proto_msgTypes[3].OneofWrappers = []any{
+		(*Strings_Text)(nil),
+		(*Strings_Number)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reuse_reuse_proto_rawDesc), len(file_reuse_reuse_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_reuse_reuse_proto_goTypes,
+		DependencyIndexes: file_reuse_reuse_proto_depIdxs,
+		MessageInfos:      file_reuse_reuse_proto_msgTypes,
+	}.Build()
+	File_reuse_reuse_proto = out.File
+	file_reuse_reuse_proto_goTypes = nil
+	file_reuse_reuse_proto_depIdxs = nil
+}
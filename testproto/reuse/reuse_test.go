@@ -0,0 +1,121 @@
+package reuse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReuseMessages(t *testing.T) {
+	first, err := (&Batch{Items: []*Item{
+		{Name: "a", Values: []int64{1, 2}, Child: &Item{Name: "child"}},
+		{Name: "b"},
+		{Name: "c"},
+	}}).MarshalVT()
+	require.NoError(t, err)
+	second := &Batch{Items: []*Item{{Name: "d"}, {Values: []int64{3}}}}
+	data, err := second.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Batch{}
+	require.NoError(t, msg.UnmarshalVT(first))
+	items := append([]*Item(nil), msg.Items...)
+
+	msg.Items = msg.Items[:0]
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, second.EqualVT(msg))
+	require.Same(t, items[0], msg.Items[0])
+	require.Same(t, items[1], msg.Items[1])
+	require.Nil(t, msg.Items[0].Child)
+	require.Empty(t, msg.Items[0].Values)
+}
+
+func TestNoReuseMessages(t *testing.T) {
+	data, err := (&PlainBatch{Items: []*Item{{Name: "a"}}}).MarshalVT()
+	require.NoError(t, err)
+
+	msg := &PlainBatch{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	items := append([]*Item(nil), msg.Items...)
+
+	msg.Items = msg.Items[:0]
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.NotSame(t, items[0], msg.Items[0])
+	require.Equal(t, "a", items[0].Name)
+}
+
+func TestReuseStrings(t *testing.T) {
+	label := "label"
+	original := &Strings{
+		Name:  "name",
+		Label: &label,
+		Tags:  []string{"a", "b"},
+		Value: &Strings_Text{Text: "text"},
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Strings{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		msg.Tags = msg.Tags[:0]
+		if err := msg.UnmarshalVT(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.True(t, original.EqualVT(msg))
+
+	changed := original.CloneVT()
+	changed.Name = "other"
+	changed.Tags[1] = "c"
+	changed.Value = &Strings_Number{Number: 1}
+	data, err = changed.MarshalVT()
+	require.NoError(t, err)
+
+	msg.Tags = msg.Tags[:0]
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, changed.EqualVT(msg))
+}
+
+func TestMapOverwrite(t *testing.T) {
+	original := &Maps{
+		Labels:   map[string]string{"host": "localhost", "region": "eu"},
+		Counters: map[string]int64{"requests": 10, "errors": 1},
+		Ratios:   map[string]float64{"hit": 0.5},
+		Items:    map[string]*Item{"first": {Name: "first"}},
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &Maps{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	// The keys and values are copied and do not refer to the decoded buffer.
+	clear(data)
+	require.True(t, original.EqualVT(msg))
+
+	counters, err := (&Maps{Counters: original.Counters, Labels: original.Labels}).MarshalVT()
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := msg.UnmarshalVT(counters); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+
+	changed := original.CloneVT()
+	changed.Labels["region"] = "us"
+	changed.Counters["errors"] = 2
+	changed.Counters["new"] = 3
+	data, err = changed.MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, changed.EqualVT(msg))
+
+	clear(data)
+	require.True(t, changed.EqualVT(msg))
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: shard/shard.proto
+
+package shard
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type First struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Second        *Second                `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *First) Reset() {
+	*x = First{}
+	mi := &file_shard_shard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *First) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*First) ProtoMessage() {}
+
+func (x *First) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use First.ProtoReflect.Descriptor instead.
+func (*First) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *First) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *First) GetSecond() *Second {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+type Second struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nested        *Second_Nested         `protobuf:"bytes,1,opt,name=nested,proto3" json:"nested,omitempty"`
+	Thirds        []*Third               `protobuf:"bytes,2,rep,name=thirds,proto3" json:"thirds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Second) Reset() {
+	*x = Second{}
+	mi := &file_shard_shard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Second) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Second) ProtoMessage() {}
+
+func (x *Second) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Second.ProtoReflect.Descriptor instead.
+func (*Second) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Second) GetNested() *Second_Nested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Second) GetThirds() []*Third {
+	if x != nil {
+		return x.Thirds
+	}
+	return nil
+}
+
+type Third struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*Third_Text
+	//	*Third_Fourth
+	Choice        isThird_Choice `protobuf_oneof:"choice"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Third) Reset() {
+	*x = Third{}
+	mi := &file_shard_shard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Third) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Third) ProtoMessage() {}
+
+func (x *Third) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Third.ProtoReflect.Descriptor instead.
+func (*Third) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Third) GetChoice() isThird_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *Third) GetText() string {
+	if x != nil {
+		if x, ok := x.Choice.(*Third_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Third) GetFourth() *Fourth {
+	if x != nil {
+		if x, ok := x.Choice.(*Third_Fourth); ok {
+			return x.Fourth
+		}
+	}
+	return nil
+}
+
+type isThird_Choice interface {
+	isThird_Choice()
+}
+
+type Third_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3,oneof"`
+}
+
+type Third_Fourth struct {
+	Fourth *Fourth `protobuf:"bytes,2,opt,name=fourth,proto3,oneof"`
+}
+
+func (*Third_Text) isThird_Choice() {}
+
+func (*Third_Fourth) isThird_Choice() {}
+
+type Fourth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fifths        map[string]*Fifth      `protobuf:"bytes,1,rep,name=fifths,proto3" json:"fifths,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fourth) Reset() {
+	*x = Fourth{}
+	mi := &file_shard_shard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fourth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fourth) ProtoMessage() {}
+
+func (x *Fourth) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fourth.ProtoReflect.Descriptor instead.
+func (*Fourth) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Fourth) GetFifths() map[string]*Fifth {
+	if x != nil {
+		return x.Fifths
+	}
+	return nil
+}
+
+type Fifth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fifth) Reset() {
+	*x = Fifth{}
+	mi := &file_shard_shard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fifth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fifth) ProtoMessage() {}
+
+func (x *Fifth) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fifth.ProtoReflect.Descriptor instead.
+func (*Fifth) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Fifth) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Second_Nested struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Second_Nested) Reset() {
+	*x = Second_Nested{}
+	mi := &file_shard_shard_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Second_Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Second_Nested) ProtoMessage() {}
+
+func (x *Second_Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_shard_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Second_Nested.ProtoReflect.Descriptor instead.
+func (*Second_Nested) Descriptor() ([]byte, []int) {
+	return file_shard_shard_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Second_Nested) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_shard_shard_proto protoreflect.FileDescriptor
+
+const file_shard_shard_proto_rawDesc = "" +
+	"\n" +
+	"\x11shard/shard.proto\x12\x05shard\"B\n" +
+	"\x05First\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x06second\x18\x02 \x01(\v2\r.shard.SecondR\x06second\"|\n" +
+	"\x06Second\x12,\n" +
+	"\x06nested\x18\x01 \x01(\v2\x14.shard.Second.NestedR\x06nested\x12$\n" +
+	"\x06thirds\x18\x02 \x03(\v2\f.shard.ThirdR\x06thirds\x1a\x1e\n" +
+	"\x06Nested\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\"P\n" +
+	"\x05Third\x12\x14\n" +
+	"\x04text\x18\x01 \x01(\tH\x00R\x04text\x12'\n" +
+	"\x06fourth\x18\x02 \x01(\v2\r.shard.FourthH\x00R\x06fourthB\b\n" +
+	"\x06choice\"\x84\x01\n" +
+	"\x06Fourth\x121\n" +
+	"\x06fifths\x18\x01 \x03(\v2\x19.shard.Fourth.FifthsEntryR\x06fifths\x1aG\n" +
+	"\vFifthsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\x05value\x18\x02 \x01(\v2\f.shard.FifthR\x05value:\x028\x01\"\x1b\n" +
+	"\x05Fifth\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2*\n" +
+	"\x04Echo\x12\"\n" +
+	"\x04Echo\x12\f.shard.First\x1a\f.shard.FirstB\x11Z\x0ftestproto/shardb\x06proto3"
+
+var (
+	file_shard_shard_proto_rawDescOnce sync.Once
+	file_shard_shard_proto_rawDescData []byte
+)
+
+func file_shard_shard_proto_rawDescGZIP() []byte {
+	file_shard_shard_proto_rawDescOnce.Do(func() {
+		file_shard_shard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shard_shard_proto_rawDesc), len(file_shard_shard_proto_rawDesc)))
+	})
+	return file_shard_shard_proto_rawDescData
+}
+
+var file_shard_shard_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_shard_shard_proto_goTypes = []any{
+	(*First)(nil),         // 0: shard.First
+	(*Second)(nil),        // 1: shard.Second
+	(*Third)(nil),         // 2: shard.Third
+	(*Fourth)(nil),        // 3: shard.Fourth
+	(*Fifth)(nil),         // 4: shard.Fifth
+	(*Second_Nested)(nil), // 5: shard.Second.Nested
+	nil,                   // 6: shard.Fourth.FifthsEntry
+}
+var file_shard_shard_proto_depIdxs = []int32{
+	1, // 0: shard.First.second:type_name -> shard.Second
+	5, // 1: shard.Second.nested:type_name -> shard.Second.Nested
+	2, // 2: shard.Second.thirds:type_name -> shard.Third
+	3, // 3: shard.Third.fourth:type_name -> shard.Fourth
+	6, // 4: shard.Fourth.fifths:type_name -> shard.Fourth.FifthsEntry
+	4, // 5: shard.Fourth.FifthsEntry.value:type_name -> shard.Fifth
+	0, // 6: shard.Echo.Echo:input_type -> shard.First
+	0, // 7: shard.Echo.Echo:output_type -> shard.First
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_shard_shard_proto_init() }
+func file_shard_shard_proto_init() {
+	if File_shard_shard_proto != nil {
+		return
+	}
+	file_shard_shard_proto_msgTypes[2].OneofWrappers = []any{
+		(*Third_Text)(nil),
+		(*Third_Fourth)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shard_shard_proto_rawDesc), len(file_shard_shard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shard_shard_proto_goTypes,
+		DependencyIndexes: file_shard_shard_proto_depIdxs,
+		MessageInfos:      file_shard_shard_proto_msgTypes,
+	}.Build()
+	File_shard_shard_proto = out.File
+	file_shard_shard_proto_goTypes = nil
+	file_shard_shard_proto_depIdxs = nil
+}
@@ -0,0 +1,44 @@
+package shard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestShardedRoundTrip(t *testing.T) {
+	msg := &First{
+		Name: "first",
+		Second: &Second{
+			Nested: &Second_Nested{Value: 1},
+			Thirds: []*Third{
+				{Choice: &Third_Text{Text: "text"}},
+				{Choice: &Third_Fourth{Fourth: &Fourth{Fifths: map[string]*Fifth{"a": {Data: []byte("a")}}}}},
+			},
+		},
+	}
+
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, proto.Size(msg), msg.SizeVT())
+
+	got := &First{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, proto.Equal(msg, got))
+	require.True(t, msg.EqualVT(got))
+	require.True(t, msg.CloneVT().EqualVT(msg))
+}
+
+func TestShardedExtensions(t *testing.T) {
+	msg := &Extended{Name: proto.String("extended")}
+	proto.SetExtension(msg, E_ExtLabel, "label")
+	proto.SetExtension(msg, E_ExtLast, &Last{Data: []byte("last")})
+
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	got := &Extended{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.Equal(t, "label", GetExtLabelVT(got))
+	require.True(t, proto.Equal(&Last{Data: []byte("last")}, GetExtLastVT(got)))
+}
@@ -0,0 +1,59 @@
+package sizecache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+// diamond returns a graph of depth levels in which each node holds the node of the next
+// level twice, as a child and as its linked node, so that it holds 2^depth paths to the
+// last node.
+func diamond(depth int) *Node {
+	node := &Node{Name: "last", Extra: &Node_Leaf{Leaf: &Leaf{Value: "leaf"}}}
+	for i := 0; i < depth; i++ {
+		node = &Node{Name: "node", Children: []*Node{node}, Extra: &Node_Linked{Linked: node}}
+	}
+	return node
+}
+
+func TestSizeCacheMarshal(t *testing.T) {
+	shared := diamond(4)
+	graph := &Graph{
+		Root:   shared,
+		Nodes:  []*Node{shared, {Name: "other", Extra: &Node_Label{Label: "label"}}, shared},
+		ByName: map[string]*Node{"shared": shared},
+		Leaf:   &Leaf{Value: "leaf"},
+	}
+
+	data, err := graph.MarshalVT()
+	require.NoError(t, err)
+	require.Len(t, data, graph.SizeVT())
+
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(graph)
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	decoded := &Graph{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, graph.EqualVT(decoded))
+}
+
+func TestSizeCacheShared(t *testing.T) {
+	node := diamond(16)
+	cache := protohelpers.GetSizeCache()
+	defer protohelpers.PutSizeCache(cache)
+	require.Equal(t, node.SizeVT(), node.SizeVTCached(cache))
+
+	// The sizes of the nodes are cached for the whole graph: sizing a graph holding
+	// 2^40 paths to the last node only sizes each node once.
+	deep := diamond(40)
+	deeper := &Node{Children: []*Node{deep, deep}}
+	n := deeper.SizeVTCached(cache)
+	l := deep.SizeVTCached(cache)
+	require.Greater(t, l, 1<<40)
+	require.Equal(t, 2*(1+l+protohelpers.SizeOfVarint(uint64(l))), n)
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: slicegrowth/chunked/chunked.proto
+
+package chunked
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_slicegrowth_chunked_chunked_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_slicegrowth_chunked_chunked_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_slicegrowth_chunked_chunked_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type Lists struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	Names         []string               `protobuf:"bytes,2,rep,name=names" json:"names,omitempty"`
+	Unpacked      []int64                `protobuf:"varint,3,rep,name=unpacked" json:"unpacked,omitempty"`
+	Packed        []int64                `protobuf:"varint,4,rep,packed,name=packed" json:"packed,omitempty"`
+	Blobs         [][]byte               `protobuf:"bytes,5,rep,name=blobs" json:"blobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lists) Reset() {
+	*x = Lists{}
+	mi := &file_slicegrowth_chunked_chunked_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lists) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lists) ProtoMessage() {}
+
+func (x *Lists) ProtoReflect() protoreflect.Message {
+	mi := &file_slicegrowth_chunked_chunked_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lists.ProtoReflect.Descriptor instead.
+func (*Lists) Descriptor() ([]byte, []int) {
+	return file_slicegrowth_chunked_chunked_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Lists) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Lists) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Lists) GetUnpacked() []int64 {
+	if x != nil {
+		return x.Unpacked
+	}
+	return nil
+}
+
+func (x *Lists) GetPacked() []int64 {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+func (x *Lists) GetBlobs() [][]byte {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+var File_slicegrowth_chunked_chunked_proto protoreflect.FileDescriptor
+
+const file_slicegrowth_chunked_chunked_proto_rawDesc = "" +
+	"\n" +
+	"!slicegrowth/chunked/chunked.proto\x12\x13slicegrowth.chunked\"\x1a\n" +
+	"\x04Item\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x9c\x01\n" +
+	"\x05Lists\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.slicegrowth.chunked.ItemR\x05items\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12\x1a\n" +
+	"\bunpacked\x18\x03 \x03(\x03R\bunpacked\x12\x1a\n" +
+	"\x06packed\x18\x04 \x03(\x03B\x02\x10\x01R\x06packed\x12\x14\n" +
+	"\x05blobs\x18\x05 \x03(\fR\x05blobsB\x1fZ\x1dtestproto/slicegrowth/chunked"
+
+var (
+	file_slicegrowth_chunked_chunked_proto_rawDescOnce sync.Once
+	file_slicegrowth_chunked_chunked_proto_rawDescData []byte
+)
+
+func file_slicegrowth_chunked_chunked_proto_rawDescGZIP() []byte {
+	file_slicegrowth_chunked_chunked_proto_rawDescOnce.Do(func() {
+		file_slicegrowth_chunked_chunked_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_slicegrowth_chunked_chunked_proto_rawDesc), len(file_slicegrowth_chunked_chunked_proto_rawDesc)))
+	})
+	return file_slicegrowth_chunked_chunked_proto_rawDescData
+}
+
+var file_slicegrowth_chunked_chunked_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_slicegrowth_chunked_chunked_proto_goTypes = []any{
+	(*Item)(nil),  // 0: slicegrowth.chunked.Item
+	(*Lists)(nil), // 1: slicegrowth.chunked.Lists
+}
+var file_slicegrowth_chunked_chunked_proto_depIdxs = []int32{
+	0, // 0: slicegrowth.chunked.Lists.items:type_name -> slicegrowth.chunked.Item
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_slicegrowth_chunked_chunked_proto_init() }
+func file_slicegrowth_chunked_chunked_proto_init() {
+	if File_slicegrowth_chunked_chunked_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_slicegrowth_chunked_chunked_proto_rawDesc), len(file_slicegrowth_chunked_chunked_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_slicegrowth_chunked_chunked_proto_goTypes,
+		DependencyIndexes: file_slicegrowth_chunked_chunked_proto_depIdxs,
+		MessageInfos:      file_slicegrowth_chunked_chunked_proto_msgTypes,
+	}.Build()
+	File_slicegrowth_chunked_chunked_proto = out.File
+	file_slicegrowth_chunked_chunked_proto_goTypes = nil
+	file_slicegrowth_chunked_chunked_proto_depIdxs = nil
+}
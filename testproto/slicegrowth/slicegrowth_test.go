@@ -0,0 +1,82 @@
+package slicegrowth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/testproto/slicegrowth/chunked"
+	"github.com/planetscale/vtprotobuf/testproto/slicegrowth/double"
+	"github.com/planetscale/vtprotobuf/testproto/slicegrowth/exact"
+)
+
+func marshalLists(t *testing.T, n int) []byte {
+	msg := &exact.Lists{}
+	for i := 0; i < n; i++ {
+		msg.Items = append(msg.Items, &exact.Item{Name: proto.String("item")})
+		msg.Names = append(msg.Names, "name")
+		msg.Unpacked = append(msg.Unpacked, int64(i))
+		msg.Packed = append(msg.Packed, int64(i))
+		msg.Blobs = append(msg.Blobs, []byte("blob"))
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+	return data
+}
+
+func TestDoubleSliceGrowth(t *testing.T) {
+	data := marshalLists(t, 5)
+
+	msg := &double.Lists{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Len(t, msg.Items, 5)
+	require.Equal(t, 8, cap(msg.Items))
+	require.Equal(t, 8, cap(msg.Names))
+	require.Equal(t, 8, cap(msg.Unpacked))
+	require.Equal(t, 5, cap(msg.Packed))
+	require.Equal(t, 8, cap(msg.Blobs))
+}
+
+func TestChunkedSliceGrowth(t *testing.T) {
+	data := marshalLists(t, 20)
+
+	msg := &chunked.Lists{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Len(t, msg.Names, 20)
+	require.Equal(t, 32, cap(msg.Items))
+	require.Equal(t, 32, cap(msg.Names))
+	require.Equal(t, 32, cap(msg.Unpacked))
+	require.Equal(t, 20, cap(msg.Packed))
+	require.Equal(t, 32, cap(msg.Blobs))
+}
+
+func TestExactSliceGrowth(t *testing.T) {
+	data := marshalLists(t, 7)
+
+	msg := &exact.Lists{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Equal(t, 7, cap(msg.Items))
+	require.Equal(t, 7, cap(msg.Names))
+	require.Equal(t, 7, cap(msg.Unpacked))
+	require.Equal(t, 7, cap(msg.Packed))
+	require.Equal(t, 7, cap(msg.Blobs))
+
+	// Merging grows the slices by the number of new elements only.
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Len(t, msg.Names, 14)
+	require.Equal(t, 14, cap(msg.Names))
+
+	fromProto := &exact.Lists{}
+	require.NoError(t, proto.Unmarshal(data, fromProto))
+	msg.Reset()
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, fromProto.EqualVT(msg))
+}
+
+func TestExactSliceGrowthMalformed(t *testing.T) {
+	data := marshalLists(t, 3)
+
+	msg := &exact.Lists{}
+	require.Error(t, msg.UnmarshalVT(data[:len(data)-1]))
+}
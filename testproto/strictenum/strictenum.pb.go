@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: strictenum/strictenum.proto
+
+package strictenum
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_UNSPECIFIED Level = 0
+	Level_LEVEL_LOW         Level = 1
+	Level_LEVEL_HIGH        Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_LOW",
+		2: "LEVEL_HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_LOW":         1,
+		"LEVEL_HIGH":        2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_strictenum_strictenum_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_strictenum_strictenum_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Level.Descriptor instead.
+func (Level) EnumDescriptor() ([]byte, []int) {
+	return file_strictenum_strictenum_proto_rawDescGZIP(), []int{0}
+}
+
+type StrictEnums struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Level  Level                  `protobuf:"varint,1,opt,name=level,proto3,enum=Level" json:"level,omitempty"`
+	Levels []Level                `protobuf:"varint,2,rep,packed,name=levels,proto3,enum=Level" json:"levels,omitempty"`
+	Maybe  *Level                 `protobuf:"varint,3,opt,name=maybe,proto3,enum=Level,oneof" json:"maybe,omitempty"`
+	ByName map[string]Level       `protobuf:"bytes,4,rep,name=by_name,json=byName,proto3" json:"by_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=Level"`
+	// Types that are valid to be assigned to Choice:
+	//
+	//	*StrictEnums_Picked
+	Choice        isStrictEnums_Choice `protobuf_oneof:"choice"`
+	Lenient       Level                `protobuf:"varint,6,opt,name=lenient,proto3,enum=Level" json:"lenient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrictEnums) Reset() {
+	*x = StrictEnums{}
+	mi := &file_strictenum_strictenum_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrictEnums) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrictEnums) ProtoMessage() {}
+
+func (x *StrictEnums) ProtoReflect() protoreflect.Message {
+	mi := &file_strictenum_strictenum_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrictEnums.ProtoReflect.Descriptor instead.
+func (*StrictEnums) Descriptor() ([]byte, []int) {
+	return file_strictenum_strictenum_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StrictEnums) GetLevel() Level {
+	if x != nil {
+		return x.Level
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *StrictEnums) GetLevels() []Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *StrictEnums) GetMaybe() Level {
+	if x != nil && x.Maybe != nil {
+		return *x.Maybe
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *StrictEnums) GetByName() map[string]Level {
+	if x != nil {
+		return x.ByName
+	}
+	return nil
+}
+
+func (x *StrictEnums) GetChoice() isStrictEnums_Choice {
+	if x != nil {
+		return x.Choice
+	}
+	return nil
+}
+
+func (x *StrictEnums) GetPicked() Level {
+	if x != nil {
+		if x, ok := x.Choice.(*StrictEnums_Picked); ok {
+			return x.Picked
+		}
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+func (x *StrictEnums) GetLenient() Level {
+	if x != nil {
+		return x.Lenient
+	}
+	return Level_LEVEL_UNSPECIFIED
+}
+
+type isStrictEnums_Choice interface {
+	isStrictEnums_Choice()
+}
+
+type StrictEnums_Picked struct {
+	Picked Level `protobuf:"varint,5,opt,name=picked,proto3,enum=Level,oneof"`
+}
+
+func (*StrictEnums_Picked) isStrictEnums_Choice() {}
+
+var File_strictenum_strictenum_proto protoreflect.FileDescriptor
+
+const file_strictenum_strictenum_proto_rawDesc = "" +
+	"\n" +
+	"\x1bstrictenum/strictenum.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"\xe4\x02\n" +
+	"\vStrictEnums\x12$\n" +
+	"\x05level\x18\x01 \x01(\x0e2\x06.LevelB\x06\xb2\xa9\x1f\x02\x18\x01R\x05level\x12&\n" +
+	"\x06levels\x18\x02 \x03(\x0e2\x06.LevelB\x06\xb2\xa9\x1f\x02\x18\x01R\x06levels\x12)\n" +
+	"\x05maybe\x18\x03 \x01(\x0e2\x06.LevelB\x06\xb2\xa9\x1f\x02\x18\x01H\x01R\x05maybe\x88\x01\x01\x129\n" +
+	"\aby_name\x18\x04 \x03(\v2\x18.StrictEnums.ByNameEntryB\x06\xb2\xa9\x1f\x02\x18\x01R\x06byName\x12(\n" +
+	"\x06picked\x18\x05 \x01(\x0e2\x06.LevelB\x06\xb2\xa9\x1f\x02\x18\x01H\x00R\x06picked\x12 \n" +
+	"\alenient\x18\x06 \x01(\x0e2\x06.LevelR\alenient\x1aA\n" +
+	"\vByNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x06.LevelR\x05value:\x028\x01B\b\n" +
+	"\x06choiceB\b\n" +
+	"\x06_maybe*=\n" +
+	"\x05Level\x12\x15\n" +
+	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tLEVEL_LOW\x10\x01\x12\x0e\n" +
+	"\n" +
+	"LEVEL_HIGH\x10\x02B\x16Z\x14testproto/strictenumb\x06proto3"
+
+var (
+	file_strictenum_strictenum_proto_rawDescOnce sync.Once
+	file_strictenum_strictenum_proto_rawDescData []byte
+)
+
+func file_strictenum_strictenum_proto_rawDescGZIP() []byte {
+	file_strictenum_strictenum_proto_rawDescOnce.Do(func() {
+		file_strictenum_strictenum_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_strictenum_strictenum_proto_rawDesc), len(file_strictenum_strictenum_proto_rawDesc)))
+	})
+	return file_strictenum_strictenum_proto_rawDescData
+}
+
+var file_strictenum_strictenum_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_strictenum_strictenum_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_strictenum_strictenum_proto_goTypes = []any{
+	(Level)(0),          // 0: Level
+	(*StrictEnums)(nil), // 1: StrictEnums
+	nil,                 // 2: StrictEnums.ByNameEntry
+}
+var file_strictenum_strictenum_proto_depIdxs = []int32{
+	0, // 0: StrictEnums.level:type_name -> Level
+	0, // 1: StrictEnums.levels:type_name -> Level
+	0, // 2: StrictEnums.maybe:type_name -> Level
+	2, // 3: StrictEnums.by_name:type_name -> StrictEnums.ByNameEntry
+	0, // 4: StrictEnums.picked:type_name -> Level
+	0, // 5: StrictEnums.lenient:type_name -> Level
+	0, // 6: StrictEnums.ByNameEntry.value:type_name -> Level
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_strictenum_strictenum_proto_init() }
+func file_strictenum_strictenum_proto_init() {
+	if File_strictenum_strictenum_proto != nil {
+		return
+	}
+	file_strictenum_strictenum_proto_msgTypes[0].OneofWrappers = []any{
+		(*StrictEnums_Picked)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_strictenum_strictenum_proto_rawDesc), len(file_strictenum_strictenum_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_strictenum_strictenum_proto_goTypes,
+		DependencyIndexes: file_strictenum_strictenum_proto_depIdxs,
+		EnumInfos:         file_strictenum_strictenum_proto_enumTypes,
+		MessageInfos:      file_strictenum_strictenum_proto_msgTypes,
+	}.Build()
+	File_strictenum_strictenum_proto = out.File
+	file_strictenum_strictenum_proto_goTypes = nil
+	file_strictenum_strictenum_proto_depIdxs = nil
+}
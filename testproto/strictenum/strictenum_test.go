@@ -0,0 +1,48 @@
+package strictenum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictEnumKnownValues(t *testing.T) {
+	msg := &StrictEnums{
+		Level:  Level_LEVEL_HIGH,
+		Levels: []Level{Level_LEVEL_LOW, Level_LEVEL_HIGH},
+		Maybe:  Level_LEVEL_LOW.Enum(),
+		ByName: map[string]Level{"a": Level_LEVEL_HIGH},
+		Choice: &StrictEnums_Picked{Picked: Level_LEVEL_LOW},
+	}
+	data, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	got := &StrictEnums{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, msg.EqualVT(got))
+}
+
+func TestStrictEnumUnknownValues(t *testing.T) {
+	for name, msg := range map[string]*StrictEnums{
+		"singular": {Level: 7},
+		"repeated": {Levels: []Level{Level_LEVEL_LOW, 9}},
+		"optional": {Maybe: Level(-1).Enum()},
+		"map":      {ByName: map[string]Level{"a": 3}},
+		"oneof":    {Choice: &StrictEnums_Picked{Picked: 42}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := msg.MarshalVT()
+			require.NoError(t, err)
+			require.ErrorContains(t, (&StrictEnums{}).UnmarshalVT(data), "invalid value")
+		})
+	}
+}
+
+func TestLenientEnumKeepsUnknownValue(t *testing.T) {
+	data, err := (&StrictEnums{Lenient: 7}).MarshalVT()
+	require.NoError(t, err)
+
+	got := &StrictEnums{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.Equal(t, Level(7), got.Lenient)
+}
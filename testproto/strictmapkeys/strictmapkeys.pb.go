@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: strictmapkeys/strictmapkeys.proto
+
+package strictmapkeys
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Value struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_strictmapkeys_strictmapkeys_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_strictmapkeys_strictmapkeys_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_strictmapkeys_strictmapkeys_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Value) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type StrictMapKeys struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        map[string]string      `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Values        map[int32]*Value       `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Lenient       map[string]string      `protobuf:"bytes,3,rep,name=lenient,proto3" json:"lenient,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrictMapKeys) Reset() {
+	*x = StrictMapKeys{}
+	mi := &file_strictmapkeys_strictmapkeys_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrictMapKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrictMapKeys) ProtoMessage() {}
+
+func (x *StrictMapKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_strictmapkeys_strictmapkeys_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrictMapKeys.ProtoReflect.Descriptor instead.
+func (*StrictMapKeys) Descriptor() ([]byte, []int) {
+	return file_strictmapkeys_strictmapkeys_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StrictMapKeys) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StrictMapKeys) GetValues() map[int32]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *StrictMapKeys) GetLenient() map[string]string {
+	if x != nil {
+		return x.Lenient
+	}
+	return nil
+}
+
+var File_strictmapkeys_strictmapkeys_proto protoreflect.FileDescriptor
+
+const file_strictmapkeys_strictmapkeys_proto_rawDesc = "" +
+	"\n" +
+	"!strictmapkeys/strictmapkeys.proto\x1a3github.com/planetscale/vtprotobuf/vtproto/ext.proto\"\x1b\n" +
+	"\x05Value\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\xf8\x02\n" +
+	"\rStrictMapKeys\x12:\n" +
+	"\x06labels\x18\x01 \x03(\v2\x1a.StrictMapKeys.LabelsEntryB\x06\xb2\xa9\x1f\x02(\x01R\x06labels\x12:\n" +
+	"\x06values\x18\x02 \x03(\v2\x1a.StrictMapKeys.ValuesEntryB\x06\xb2\xa9\x1f\x02(\x01R\x06values\x125\n" +
+	"\alenient\x18\x03 \x03(\v2\x1b.StrictMapKeys.LenientEntryR\alenient\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x1c\n" +
+	"\x05value\x18\x02 \x01(\v2\x06.ValueR\x05value:\x028\x01\x1a:\n" +
+	"\fLenientEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x19Z\x17testproto/strictmapkeysb\x06proto3"
+
+var (
+	file_strictmapkeys_strictmapkeys_proto_rawDescOnce sync.Once
+	file_strictmapkeys_strictmapkeys_proto_rawDescData []byte
+)
+
+func file_strictmapkeys_strictmapkeys_proto_rawDescGZIP() []byte {
+	file_strictmapkeys_strictmapkeys_proto_rawDescOnce.Do(func() {
+		file_strictmapkeys_strictmapkeys_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_strictmapkeys_strictmapkeys_proto_rawDesc), len(file_strictmapkeys_strictmapkeys_proto_rawDesc)))
+	})
+	return file_strictmapkeys_strictmapkeys_proto_rawDescData
+}
+
+var file_strictmapkeys_strictmapkeys_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_strictmapkeys_strictmapkeys_proto_goTypes = []any{
+	(*Value)(nil),         // 0: Value
+	(*StrictMapKeys)(nil), // 1: StrictMapKeys
+	nil,                   // 2: StrictMapKeys.LabelsEntry
+	nil,                   // 3: StrictMapKeys.ValuesEntry
+	nil,                   // 4: StrictMapKeys.LenientEntry
+}
+var file_strictmapkeys_strictmapkeys_proto_depIdxs = []int32{
+	2, // 0: StrictMapKeys.labels:type_name -> StrictMapKeys.LabelsEntry
+	3, // 1: StrictMapKeys.values:type_name -> StrictMapKeys.ValuesEntry
+	4, // 2: StrictMapKeys.lenient:type_name -> StrictMapKeys.LenientEntry
+	0, // 3: StrictMapKeys.ValuesEntry.value:type_name -> Value
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_strictmapkeys_strictmapkeys_proto_init() }
+func file_strictmapkeys_strictmapkeys_proto_init() {
+	if File_strictmapkeys_strictmapkeys_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_strictmapkeys_strictmapkeys_proto_rawDesc), len(file_strictmapkeys_strictmapkeys_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_strictmapkeys_strictmapkeys_proto_goTypes,
+		DependencyIndexes: file_strictmapkeys_strictmapkeys_proto_depIdxs,
+		MessageInfos:      file_strictmapkeys_strictmapkeys_proto_msgTypes,
+	}.Build()
+	File_strictmapkeys_strictmapkeys_proto = out.File
+	file_strictmapkeys_strictmapkeys_proto_goTypes = nil
+	file_strictmapkeys_strictmapkeys_proto_depIdxs = nil
+}
@@ -0,0 +1,60 @@
+package strictmapkeys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func appendEntry(b []byte, num protowire.Number, entry []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, entry)
+}
+
+func stringEntry(key, value string) []byte {
+	entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, key)
+	entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+	return protowire.AppendString(entry, value)
+}
+
+func TestStrictMapKeys(t *testing.T) {
+	original := &StrictMapKeys{
+		Labels:  map[string]string{"a": "1", "b": "2"},
+		Values:  map[int32]*Value{1: {Text: "one"}, 2: {Text: "two"}},
+		Lenient: map[string]string{"a": "1"},
+	}
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+
+	msg := &StrictMapKeys{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.True(t, original.EqualVT(msg))
+
+	// Decoding into a message that already holds the keys fails too.
+	require.ErrorContains(t, msg.UnmarshalVT(data), "proto: duplicate key")
+}
+
+func TestStrictMapKeysDuplicates(t *testing.T) {
+	data := appendEntry(nil, 1, stringEntry("a", "1"))
+	data = appendEntry(data, 1, stringEntry("a", "2"))
+	err := (&StrictMapKeys{}).UnmarshalVT(data)
+	require.EqualError(t, err, "proto: duplicate key a in map field StrictMapKeys.labels")
+	err = (&StrictMapKeys{}).UnmarshalVTUnsafe(data)
+	require.EqualError(t, err, "proto: duplicate key a in map field StrictMapKeys.labels")
+
+	// Entries without a key use the zero value of the key.
+	entry := protowire.AppendTag(nil, 2, protowire.BytesType)
+	entry = protowire.AppendBytes(entry, nil)
+	data = appendEntry(nil, 2, entry)
+	data = appendEntry(data, 2, entry)
+	err = (&StrictMapKeys{}).UnmarshalVT(data)
+	require.EqualError(t, err, "proto: duplicate key 0 in map field StrictMapKeys.values")
+
+	data = appendEntry(nil, 3, stringEntry("a", "1"))
+	data = appendEntry(data, 3, stringEntry("a", "2"))
+	msg := &StrictMapKeys{}
+	require.NoError(t, msg.UnmarshalVT(data))
+	require.Equal(t, map[string]string{"a": "2"}, msg.Lenient)
+}
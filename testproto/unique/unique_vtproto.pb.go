@@ -10,6 +10,7 @@ import (
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
 	unique "unique"
 	unsafe "unsafe"
 )
@@ -28,18 +29,10 @@ func (m *UniqueFieldExtension) CloneVT() *UniqueFieldExtension {
 	r := new(UniqueFieldExtension)
 	r.Foo = m.Foo
 	if rhs := m.Bar; rhs != nil {
-		tmpContainer := make(map[string]int64, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.Bar = tmpContainer
+		r.Bar = maps.Clone(rhs)
 	}
 	if rhs := m.Baz; rhs != nil {
-		tmpContainer := make(map[int64]string, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.Baz = tmpContainer
+		r.Baz = maps.Clone(rhs)
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -52,6 +45,36 @@ func (m *UniqueFieldExtension) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UniqueFieldExtensionCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UniqueFieldExtensionCloneSliceVT(in []*UniqueFieldExtension) []*UniqueFieldExtension {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UniqueFieldExtension, len(in))
+	clones := make([]UniqueFieldExtension, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Foo = m.Foo
+		if rhs := m.Bar; rhs != nil {
+			r.Bar = maps.Clone(rhs)
+		}
+		if rhs := m.Baz; rhs != nil {
+			r.Baz = maps.Clone(rhs)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *UniqueFieldExtension) EqualVT(that *UniqueFieldExtension) bool {
 	if this == that {
 		return true
@@ -243,6 +266,9 @@ func (m *UniqueFieldExtension) MarshalToSizedBufferVTStrict(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *UniqueFieldExtension) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *UniqueFieldExtension) SizeVT() (n int) {
 	if m == nil {
 		return 0
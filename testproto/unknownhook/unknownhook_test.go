@@ -0,0 +1,50 @@
+package unknownhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+type unknownField struct {
+	message protoreflect.FullName
+	num     protowire.Number
+	typ     protowire.Type
+}
+
+func observeUnknownFields(t *testing.T) *[]unknownField {
+	var fields []unknownField
+	protohelpers.SetUnknownFieldHook(func(message protoreflect.FullName, num protowire.Number, typ protowire.Type) {
+		fields = append(fields, unknownField{message, num, typ})
+	})
+	t.Cleanup(func() { protohelpers.SetUnknownFieldHook(nil) })
+	return &fields
+}
+
+func TestObserveUnknownFields(t *testing.T) {
+	fields := observeUnknownFields(t)
+	data, err := (&Newer{Name: "name", Added: 1, Extra: []byte("extra")}).MarshalVT()
+	require.NoError(t, err)
+
+	observed := &Observed{}
+	require.NoError(t, observed.UnmarshalVT(data))
+	require.Equal(t, "name", observed.Name)
+	require.NotEmpty(t, observed.unknownFields)
+
+	ignored := &ObservedIgnored{}
+	require.NoError(t, ignored.UnmarshalVT(data))
+	require.Empty(t, ignored.unknownFields)
+
+	require.NoError(t, (&Plain{}).UnmarshalVT(data))
+
+	require.Equal(t, []unknownField{
+		{"unknownhook.Observed", 2, protowire.VarintType},
+		{"unknownhook.Observed", 3, protowire.BytesType},
+		{"unknownhook.ObservedIgnored", 2, protowire.VarintType},
+		{"unknownhook.ObservedIgnored", 3, protowire.BytesType},
+	}, *fields)
+}
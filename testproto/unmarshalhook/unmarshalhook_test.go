@@ -0,0 +1,48 @@
+package unmarshalhook
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+type unmarshalCall struct {
+	message protoreflect.FullName
+	size    int
+	err     error
+}
+
+func observeUnmarshal(t *testing.T) *[]unmarshalCall {
+	var calls []unmarshalCall
+	protohelpers.SetUnmarshalHook(func(message protoreflect.FullName, size int, err error) {
+		calls = append(calls, unmarshalCall{message, size, err})
+	})
+	t.Cleanup(func() { protohelpers.SetUnmarshalHook(nil) })
+	return &calls
+}
+
+func TestObserveUnmarshal(t *testing.T) {
+	calls := observeUnmarshal(t)
+	data, err := (&Request{Name: "request", Items: []*Item{{Name: "a"}, {Name: "b"}}}).MarshalVT()
+	require.NoError(t, err)
+
+	// Items are not observed, and are not reported when decoded on their own either.
+	require.NoError(t, (&Request{}).UnmarshalVT(data))
+	require.NoError(t, (&Request{}).UnmarshalVTUnsafe(data))
+	require.NoError(t, (&Item{}).UnmarshalVT([]byte{0x0a, 0x01, 'c'}))
+	require.Error(t, (&Request{}).UnmarshalVT(data[:len(data)-1]))
+
+	require.Equal(t, []unmarshalCall{
+		{"unmarshalhook.Request", len(data), nil},
+		{"unmarshalhook.Request", len(data), nil},
+		{"unmarshalhook.Request", len(data) - 1, io.ErrUnexpectedEOF},
+	}, *calls)
+
+	protohelpers.SetUnmarshalHook(nil)
+	require.NoError(t, (&Request{}).UnmarshalVT(data))
+	require.Len(t, *calls, 3)
+}
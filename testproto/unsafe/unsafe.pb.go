@@ -30,6 +30,7 @@ type UnsafeTest struct {
 	//	*UnsafeTest_Sub3_
 	//	*UnsafeTest_Sub4_
 	//	*UnsafeTest_Sub5_
+	//	*UnsafeTest_Sub6_
 	Sub           isUnsafeTest_Sub `protobuf_oneof:"sub"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -117,6 +118,15 @@ func (x *UnsafeTest) GetSub5() *UnsafeTest_Sub5 {
 	return nil
 }
 
+func (x *UnsafeTest) GetSub6() *UnsafeTest_Sub6 {
+	if x != nil {
+		if x, ok := x.Sub.(*UnsafeTest_Sub6_); ok {
+			return x.Sub6
+		}
+	}
+	return nil
+}
+
 type isUnsafeTest_Sub interface {
 	isUnsafeTest_Sub()
 }
@@ -141,6 +151,10 @@ type UnsafeTest_Sub5_ struct {
 	Sub5 *UnsafeTest_Sub5 `protobuf:"bytes,5,opt,name=sub5,proto3,oneof"`
 }
 
+type UnsafeTest_Sub6_ struct {
+	Sub6 *UnsafeTest_Sub6 `protobuf:"bytes,6,opt,name=sub6,proto3,oneof"`
+}
+
 func (*UnsafeTest_Sub1_) isUnsafeTest_Sub() {}
 
 func (*UnsafeTest_Sub2_) isUnsafeTest_Sub() {}
@@ -151,6 +165,8 @@ func (*UnsafeTest_Sub4_) isUnsafeTest_Sub() {}
 
 func (*UnsafeTest_Sub5_) isUnsafeTest_Sub() {}
 
+func (*UnsafeTest_Sub6_) isUnsafeTest_Sub() {}
+
 type UnsafeTest_Sub1 struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	S             string                 `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
@@ -425,18 +441,71 @@ func (x *UnsafeTest_Sub5) GetFoo() map[string]string {
 	return nil
 }
 
+type UnsafeTest_Sub6 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	D             []float64              `protobuf:"fixed64,1,rep,packed,name=d,proto3" json:"d,omitempty"`
+	F             []float32              `protobuf:"fixed32,2,rep,packed,name=f,proto3" json:"f,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsafeTest_Sub6) Reset() {
+	*x = UnsafeTest_Sub6{}
+	mi := &file_unsafe_unsafe_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsafeTest_Sub6) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsafeTest_Sub6) ProtoMessage() {}
+
+func (x *UnsafeTest_Sub6) ProtoReflect() protoreflect.Message {
+	mi := &file_unsafe_unsafe_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsafeTest_Sub6.ProtoReflect.Descriptor instead.
+func (*UnsafeTest_Sub6) Descriptor() ([]byte, []int) {
+	return file_unsafe_unsafe_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *UnsafeTest_Sub6) GetD() []float64 {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+func (x *UnsafeTest_Sub6) GetF() []float32 {
+	if x != nil {
+		return x.F
+	}
+	return nil
+}
+
 var File_unsafe_unsafe_proto protoreflect.FileDescriptor
 
 const file_unsafe_unsafe_proto_rawDesc = "" +
 	"\n" +
-	"\x13unsafe/unsafe.proto\"\xac\x04\n" +
+	"\x13unsafe/unsafe.proto\"\xf8\x04\n" +
 	"\n" +
 	"UnsafeTest\x12&\n" +
 	"\x04sub1\x18\x01 \x01(\v2\x10.UnsafeTest.Sub1H\x00R\x04sub1\x12&\n" +
 	"\x04sub2\x18\x02 \x01(\v2\x10.UnsafeTest.Sub2H\x00R\x04sub2\x12&\n" +
 	"\x04sub3\x18\x03 \x01(\v2\x10.UnsafeTest.Sub3H\x00R\x04sub3\x12&\n" +
 	"\x04sub4\x18\x04 \x01(\v2\x10.UnsafeTest.Sub4H\x00R\x04sub4\x12&\n" +
-	"\x04sub5\x18\x05 \x01(\v2\x10.UnsafeTest.Sub5H\x00R\x04sub5\x1a\"\n" +
+	"\x04sub5\x18\x05 \x01(\v2\x10.UnsafeTest.Sub5H\x00R\x04sub5\x12&\n" +
+	"\x04sub6\x18\x06 \x01(\v2\x10.UnsafeTest.Sub6H\x00R\x04sub6\x1a\"\n" +
 	"\x04Sub1\x12\f\n" +
 	"\x01s\x18\x01 \x01(\tR\x01s\x12\f\n" +
 	"\x01b\x18\x02 \x01(\fR\x01b\x1a\"\n" +
@@ -456,7 +525,10 @@ const file_unsafe_unsafe_proto_rawDesc = "" +
 	"\x03foo\x18\x01 \x03(\v2\x19.UnsafeTest.Sub5.FooEntryR\x03foo\x1a6\n" +
 	"\bFooEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\"\n" +
+	"\x04Sub6\x12\f\n" +
+	"\x01d\x18\x01 \x03(\x01R\x01d\x12\f\n" +
+	"\x01f\x18\x02 \x03(\x02R\x01fB\x05\n" +
 	"\x03subB\x12Z\x10testproto/unsafeb\x06proto3"
 
 var (
@@ -471,7 +543,7 @@ func file_unsafe_unsafe_proto_rawDescGZIP() []byte {
 	return file_unsafe_unsafe_proto_rawDescData
 }
 
-var file_unsafe_unsafe_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_unsafe_unsafe_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_unsafe_unsafe_proto_goTypes = []any{
 	(*UnsafeTest)(nil),      // 0: UnsafeTest
 	(*UnsafeTest_Sub1)(nil), // 1: UnsafeTest.Sub1
@@ -479,8 +551,9 @@ var file_unsafe_unsafe_proto_goTypes = []any{
 	(*UnsafeTest_Sub3)(nil), // 3: UnsafeTest.Sub3
 	(*UnsafeTest_Sub4)(nil), // 4: UnsafeTest.Sub4
 	(*UnsafeTest_Sub5)(nil), // 5: UnsafeTest.Sub5
-	nil,                     // 6: UnsafeTest.Sub3.FooEntry
-	nil,                     // 7: UnsafeTest.Sub5.FooEntry
+	(*UnsafeTest_Sub6)(nil), // 6: UnsafeTest.Sub6
+	nil,                     // 7: UnsafeTest.Sub3.FooEntry
+	nil,                     // 8: UnsafeTest.Sub5.FooEntry
 }
 var file_unsafe_unsafe_proto_depIdxs = []int32{
 	1, // 0: UnsafeTest.sub1:type_name -> UnsafeTest.Sub1
@@ -488,13 +561,14 @@ var file_unsafe_unsafe_proto_depIdxs = []int32{
 	3, // 2: UnsafeTest.sub3:type_name -> UnsafeTest.Sub3
 	4, // 3: UnsafeTest.sub4:type_name -> UnsafeTest.Sub4
 	5, // 4: UnsafeTest.sub5:type_name -> UnsafeTest.Sub5
-	6, // 5: UnsafeTest.Sub3.foo:type_name -> UnsafeTest.Sub3.FooEntry
-	7, // 6: UnsafeTest.Sub5.foo:type_name -> UnsafeTest.Sub5.FooEntry
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6, // 5: UnsafeTest.sub6:type_name -> UnsafeTest.Sub6
+	7, // 6: UnsafeTest.Sub3.foo:type_name -> UnsafeTest.Sub3.FooEntry
+	8, // 7: UnsafeTest.Sub5.foo:type_name -> UnsafeTest.Sub5.FooEntry
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_unsafe_unsafe_proto_init() }
@@ -508,6 +582,7 @@ func file_unsafe_unsafe_proto_init() {
 		(*UnsafeTest_Sub3_)(nil),
 		(*UnsafeTest_Sub4_)(nil),
 		(*UnsafeTest_Sub5_)(nil),
+		(*UnsafeTest_Sub6_)(nil),
 	}
 	file_unsafe_unsafe_proto_msgTypes[4].OneofWrappers = []any{
 		(*UnsafeTest_Sub4_S)(nil),
@@ -519,7 +594,7 @@ func file_unsafe_unsafe_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_unsafe_unsafe_proto_rawDesc), len(file_unsafe_unsafe_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
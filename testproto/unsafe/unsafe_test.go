@@ -1,6 +1,7 @@
 package unsafe
 
 import (
+	"math"
 	"testing"
 	"unsafe"
 
@@ -209,3 +210,28 @@ func Test_UnmarshalVTUnsafe(t *testing.T) {
 		}
 	}
 }
+
+func Test_UnmarshalVTUnsafePackedFloats(t *testing.T) {
+	orig := &UnsafeTest{
+		Sub: &UnsafeTest_Sub6_{
+			Sub6: &UnsafeTest_Sub6{
+				D: []float64{0, 1.5, -2.25, math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(-1)},
+				F: []float32{0, 1.5, -2.25, math.MaxFloat32, math.SmallestNonzeroFloat32, float32(math.Inf(1))},
+			},
+		},
+	}
+	data, err := orig.MarshalVT()
+	require.NoError(t, err)
+
+	// Prefixing an unknown field shifts the packed fields to odd offsets, so the words are
+	// loaded unaligned.
+	data = append([]byte{0x38, 0x00}, data...)
+
+	decoded := &UnsafeTest{}
+	require.NoError(t, decoded.UnmarshalVTUnsafe(data))
+	assert.True(t, (orig.Sub).(*UnsafeTest_Sub6_).Sub6.EqualVT((decoded.Sub).(*UnsafeTest_Sub6_).Sub6))
+
+	// A packed field whose length is not a multiple of the element size is truncated.
+	truncated := &UnsafeTest_Sub6{}
+	require.Error(t, truncated.UnmarshalVTUnsafe([]byte{0x0a, 0x09, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+}
@@ -5,11 +5,16 @@
 package unsafe
 
 import (
+	binary "encoding/binary"
 	fmt "fmt"
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
+	maps "maps"
+	math "math"
+	slices "slices"
+	strings "strings"
 	unsafe "unsafe"
 )
 
@@ -42,6 +47,35 @@ func (m *UnsafeTest_Sub1) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTest_Sub1CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub1CloneSliceVT(in []*UnsafeTest_Sub1) []*UnsafeTest_Sub1 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub1, len(in))
+	clones := make([]UnsafeTest_Sub1, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.S = m.S
+		if rhs := m.B; rhs != nil {
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			r.B = tmpBytes
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest_Sub2) CloneVT() *UnsafeTest_Sub2 {
 	if m == nil {
 		return (*UnsafeTest_Sub2)(nil)
@@ -72,6 +106,43 @@ func (m *UnsafeTest_Sub2) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTest_Sub2CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub2CloneSliceVT(in []*UnsafeTest_Sub2) []*UnsafeTest_Sub2 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub2, len(in))
+	clones := make([]UnsafeTest_Sub2, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.S; rhs != nil {
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			r.S = tmpContainer
+		}
+		if rhs := m.B; rhs != nil {
+			tmpContainer := make([][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.B = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest_Sub3) CloneVT() *UnsafeTest_Sub3 {
 	if m == nil {
 		return (*UnsafeTest_Sub3)(nil)
@@ -97,6 +168,38 @@ func (m *UnsafeTest_Sub3) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTest_Sub3CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub3CloneSliceVT(in []*UnsafeTest_Sub3) []*UnsafeTest_Sub3 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub3, len(in))
+	clones := make([]UnsafeTest_Sub3, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Foo; rhs != nil {
+			tmpContainer := make(map[string][]byte, len(rhs))
+			for k, v := range rhs {
+				tmpBytes := make([]byte, len(v))
+				copy(tmpBytes, v)
+				tmpContainer[k] = tmpBytes
+			}
+			r.Foo = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest_Sub4) CloneVT() *UnsafeTest_Sub4 {
 	if m == nil {
 		return (*UnsafeTest_Sub4)(nil)
@@ -116,6 +219,32 @@ func (m *UnsafeTest_Sub4) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTest_Sub4CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub4CloneSliceVT(in []*UnsafeTest_Sub4) []*UnsafeTest_Sub4 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub4, len(in))
+	clones := make([]UnsafeTest_Sub4, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.Foo != nil {
+			r.Foo = m.Foo.(interface{ CloneVT() isUnsafeTest_Sub4_Foo }).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest_Sub4_S) CloneVT() isUnsafeTest_Sub4_Foo {
 	if m == nil {
 		return (*UnsafeTest_Sub4_S)(nil)
@@ -144,11 +273,7 @@ func (m *UnsafeTest_Sub5) CloneVT() *UnsafeTest_Sub5 {
 	}
 	r := new(UnsafeTest_Sub5)
 	if rhs := m.Foo; rhs != nil {
-		tmpContainer := make(map[string]string, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v
-		}
-		r.Foo = tmpContainer
+		r.Foo = maps.Clone(rhs)
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -161,6 +286,91 @@ func (m *UnsafeTest_Sub5) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTest_Sub5CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub5CloneSliceVT(in []*UnsafeTest_Sub5) []*UnsafeTest_Sub5 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub5, len(in))
+	clones := make([]UnsafeTest_Sub5, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.Foo; rhs != nil {
+			r.Foo = maps.Clone(rhs)
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func (m *UnsafeTest_Sub6) CloneVT() *UnsafeTest_Sub6 {
+	if m == nil {
+		return (*UnsafeTest_Sub6)(nil)
+	}
+	r := new(UnsafeTest_Sub6)
+	if rhs := m.D; rhs != nil {
+		tmpContainer := make([]float64, len(rhs))
+		copy(tmpContainer, rhs)
+		r.D = tmpContainer
+	}
+	if rhs := m.F; rhs != nil {
+		tmpContainer := make([]float32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.F = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *UnsafeTest_Sub6) CloneMessageVT() proto.Message {
+	return m.CloneVT()
+}
+
+// UnsafeTest_Sub6CloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTest_Sub6CloneSliceVT(in []*UnsafeTest_Sub6) []*UnsafeTest_Sub6 {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest_Sub6, len(in))
+	clones := make([]UnsafeTest_Sub6, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if rhs := m.D; rhs != nil {
+			tmpContainer := make([]float64, len(rhs))
+			copy(tmpContainer, rhs)
+			r.D = tmpContainer
+		}
+		if rhs := m.F; rhs != nil {
+			tmpContainer := make([]float32, len(rhs))
+			copy(tmpContainer, rhs)
+			r.F = tmpContainer
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest) CloneVT() *UnsafeTest {
 	if m == nil {
 		return (*UnsafeTest)(nil)
@@ -180,6 +390,32 @@ func (m *UnsafeTest) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// UnsafeTestCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func UnsafeTestCloneSliceVT(in []*UnsafeTest) []*UnsafeTest {
+	if in == nil {
+		return nil
+	}
+	out := make([]*UnsafeTest, len(in))
+	clones := make([]UnsafeTest, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		if m.Sub != nil {
+			r.Sub = m.Sub.(interface{ CloneVT() isUnsafeTest_Sub }).CloneVT()
+		}
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (m *UnsafeTest_Sub1_) CloneVT() isUnsafeTest_Sub {
 	if m == nil {
 		return (*UnsafeTest_Sub1_)(nil)
@@ -225,6 +461,15 @@ func (m *UnsafeTest_Sub5_) CloneVT() isUnsafeTest_Sub {
 	return r
 }
 
+func (m *UnsafeTest_Sub6_) CloneVT() isUnsafeTest_Sub {
+	if m == nil {
+		return (*UnsafeTest_Sub6_)(nil)
+	}
+	r := new(UnsafeTest_Sub6_)
+	r.Sub6 = m.Sub6.CloneVT()
+	return r
+}
+
 func (this *UnsafeTest_Sub1) EqualVT(that *UnsafeTest_Sub1) bool {
 	if this == that {
 		return true
@@ -253,15 +498,9 @@ func (this *UnsafeTest_Sub2) EqualVT(that *UnsafeTest_Sub2) bool {
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.S) != len(that.S) {
+	if !slices.Equal(this.S, that.S) {
 		return false
 	}
-	for i, vx := range this.S {
-		vy := that.S[i]
-		if vx != vy {
-			return false
-		}
-	}
 	if len(this.B) != len(that.B) {
 		return false
 	}
@@ -399,6 +638,28 @@ func (this *UnsafeTest_Sub5) EqualMessageVT(thatMsg proto.Message) bool {
 	}
 	return this.EqualVT(that)
 }
+func (this *UnsafeTest_Sub6) EqualVT(that *UnsafeTest_Sub6) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if !slices.Equal(this.D, that.D) {
+		return false
+	}
+	if !slices.Equal(this.F, that.F) {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *UnsafeTest_Sub6) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*UnsafeTest_Sub6)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
 func (this *UnsafeTest) EqualVT(that *UnsafeTest) bool {
 	if this == that {
 		return true
@@ -550,6 +811,31 @@ func (this *UnsafeTest_Sub5_) EqualVT(thatIface isUnsafeTest_Sub) bool {
 	return true
 }
 
+func (this *UnsafeTest_Sub6_) EqualVT(thatIface isUnsafeTest_Sub) bool {
+	that, ok := thatIface.(*UnsafeTest_Sub6_)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.Sub6, that.Sub6; p != q {
+		if p == nil {
+			p = &UnsafeTest_Sub6{}
+		}
+		if q == nil {
+			q = &UnsafeTest_Sub6{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *UnsafeTest_Sub1) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -822,6 +1108,59 @@ func (m *UnsafeTest_Sub5) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnsafeTest_Sub6) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeTest_Sub6) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *UnsafeTest_Sub6) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.F) > 0 {
+		for iNdEx := len(m.F) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.F[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.F)*4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.D) > 0 {
+		for iNdEx := len(m.D) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.D[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.D)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnsafeTest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -979,6 +1318,29 @@ func (m *UnsafeTest_Sub5_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *UnsafeTest_Sub6_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *UnsafeTest_Sub6_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sub6 != nil {
+		size, err := m.Sub6.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *UnsafeTest_Sub1) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -1256,6 +1618,59 @@ func (m *UnsafeTest_Sub5) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *UnsafeTest_Sub6) MarshalVTStrict() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVTStrict(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsafeTest_Sub6) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *UnsafeTest_Sub6) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.F) > 0 {
+		for iNdEx := len(m.F) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.F[iNdEx]))
+			i -= 4
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.F)*4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.D) > 0 {
+		for iNdEx := len(m.D) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.D[iNdEx]))
+			i -= 8
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(len(m.D)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnsafeTest) MarshalVTStrict() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
@@ -1286,6 +1701,13 @@ func (m *UnsafeTest) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if msg, ok := m.Sub.(*UnsafeTest_Sub6_); ok {
+		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
 	if msg, ok := m.Sub.(*UnsafeTest_Sub5_); ok {
 		size, err := msg.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
@@ -1439,6 +1861,50 @@ func (m *UnsafeTest_Sub5_) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *UnsafeTest_Sub6_) MarshalToVTStrict(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVTStrict(dAtA[:size])
+}
+
+func (m *UnsafeTest_Sub6_) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sub6 != nil {
+		size, err := m.Sub6.MarshalToSizedBufferVTStrict(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
+	} else {
+		i = protohelpers.EncodeVarint(dAtA, i, 0)
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UnsafeTest_Sub1) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest_Sub2) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest_Sub3) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest_Sub4) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest_Sub5) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest_Sub6) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
+func (m *UnsafeTest) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *UnsafeTest_Sub1) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1549,6 +2015,22 @@ func (m *UnsafeTest_Sub5) SizeVT() (n int) {
 	return n
 }
 
+func (m *UnsafeTest_Sub6) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.D) > 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(len(m.D)*8)) + len(m.D)*8
+	}
+	if len(m.F) > 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(len(m.F)*4)) + len(m.F)*4
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
 func (m *UnsafeTest) SizeVT() (n int) {
 	if m == nil {
 		return 0
@@ -1632,6 +2114,20 @@ func (m *UnsafeTest_Sub5_) SizeVT() (n int) {
 	}
 	return n
 }
+func (m *UnsafeTest_Sub6_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sub6 != nil {
+		l = m.Sub6.SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	} else {
+		n += 2
+	}
+	return n
+}
 func (m *UnsafeTest_Sub1) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1981,9 +2477,13 @@ func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
 					var mapbyteLen uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
@@ -2028,7 +2528,7 @@ func (m *UnsafeTest_Sub3) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Foo[mapkey] = mapvalue
+			m.Foo[strings.Clone(mapkey)] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2286,7 +2786,11 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapkey]); err != nil {
 						return err
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey == 0 {
+						mapkey = ""
+					} else {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -2318,7 +2822,11 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 					if err := protohelpers.ValidateUTF8(dAtA[iNdEx:postStringIndexmapvalue]); err != nil {
 						return err
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					if intStringLenmapvalue == 0 {
+						mapvalue = ""
+					} else {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
@@ -2335,7 +2843,9 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Foo[mapkey] = mapvalue
+			if old, ok := m.Foo[mapkey]; !ok || old != mapvalue {
+				m.Foo[strings.Clone(mapkey)] = strings.Clone(mapvalue)
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2359,6 +2869,165 @@ func (m *UnsafeTest_Sub5) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnsafeTest_Sub6) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub6: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub6: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.D = append(m.D, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.D) == 0 {
+					m.D = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.D = append(m.D, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+		case 2:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.F = append(m.F, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.F) == 0 {
+					m.F = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.F = append(m.F, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2593,6 +3262,47 @@ func (m *UnsafeTest) UnmarshalVT(dAtA []byte) error {
 				m.Sub = &UnsafeTest_Sub5_{Sub5: v}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub6_); ok {
+				if err := oneof.Sub6.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub6{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub6_{Sub6: v}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -3356,6 +4066,171 @@ func (m *UnsafeTest_Sub5) UnmarshalVTUnsafe(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnsafeTest_Sub6) UnmarshalVTUnsafe(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return protohelpers.ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsafeTest_Sub6: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsafeTest_Sub6: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.D = append(m.D, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.D) == 0 {
+					m.D = make([]float64, 0, elementCount)
+				}
+				for ; iNdEx+8 <= postIndex; iNdEx += 8 {
+					m.D = append(m.D, math.Float64frombits(binary.LittleEndian.Uint64(dAtA[iNdEx:])))
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.D = append(m.D, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+		case 2:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.F = append(m.F, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protohelpers.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return protohelpers.ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.F) == 0 {
+					m.F = make([]float32, 0, elementCount)
+				}
+				for ; iNdEx+4 <= postIndex; iNdEx += 4 {
+					m.F = append(m.F, math.Float32frombits(binary.LittleEndian.Uint32(dAtA[iNdEx:])))
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.F = append(m.F, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnsafeTest) UnmarshalVTUnsafe(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3590,6 +4465,47 @@ func (m *UnsafeTest) UnmarshalVTUnsafe(dAtA []byte) error {
 				m.Sub = &UnsafeTest_Sub5_{Sub5: v}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Sub.(*UnsafeTest_Sub6_); ok {
+				if err := oneof.Sub6.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &UnsafeTest_Sub6{}
+				if err := v.UnmarshalVTUnsafe(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Sub = &UnsafeTest_Sub6_{Sub6: v}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package utf8marshal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+const invalid = "\xff"
+
+func TestValidateOnMarshal(t *testing.T) {
+	label := invalid
+	for name, msg := range map[string]*Strings{
+		"singular":  {Name: invalid},
+		"optional":  {Label: &label},
+		"repeated":  {Tags: []string{"ok", invalid}},
+		"map key":   {Attributes: map[string]string{invalid: "ok"}},
+		"map value": {Attributes: map[string]string{"ok": invalid}},
+		"oneof":     {Value: &Strings_Text{Text: invalid}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := msg.MarshalVT()
+			require.ErrorIs(t, err, protohelpers.ErrInvalidUTF8)
+			require.ErrorContains(t, err, "field Strings.")
+
+			_, err = msg.MarshalVTStrict()
+			require.ErrorIs(t, err, protohelpers.ErrInvalidUTF8)
+		})
+	}
+}
+
+func TestValidateOnMarshalOverrides(t *testing.T) {
+	data, err := (&Strings{Name: "valid", Raw: invalid}).MarshalVT()
+	require.NoError(t, err)
+
+	// UnmarshalVT still validates the field, like proto.Unmarshal does.
+	require.ErrorIs(t, (&Strings{}).UnmarshalVT(data), protohelpers.ErrInvalidUTF8)
+
+	data, err = (&Legacy{Name: proto.String(invalid)}).MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, (&Legacy{}).UnmarshalVT(data))
+
+	_, err = (&Legacy{Checked: proto.String(invalid)}).MarshalVT()
+	require.ErrorContains(t, err, "field Legacy.checked")
+}
@@ -0,0 +1,36 @@
+package vendoredwkt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/testproto/vendoredwkt/timestamppb"
+)
+
+func TestVendoredWellKnownTypes(t *testing.T) {
+	original := &Event{
+		Name:    "event",
+		At:      &timestamppb.Timestamp{Seconds: 1, Nanos: 2},
+		History: []*timestamppb.Timestamp{{Seconds: 3}, {Nanos: 4}},
+		When:    &Event_Scheduled{Scheduled: &timestamppb.Timestamp{Seconds: 5}},
+	}
+	require.Equal(t, proto.Size(original), original.SizeVT())
+
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	got := &Event{}
+	require.NoError(t, proto.Unmarshal(data, got))
+	require.True(t, proto.Equal(original, got))
+
+	data, err = proto.Marshal(original)
+	require.NoError(t, err)
+	got = &Event{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, original.EqualVT(got))
+
+	clone := original.CloneVT()
+	require.True(t, original.EqualVT(clone))
+	require.NotSame(t, original.At, clone.At)
+}
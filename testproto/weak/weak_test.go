@@ -0,0 +1,26 @@
+package weak
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWeakField(t *testing.T) {
+	holder := &Holder{Name: proto.String("holder"), Dep: &Dep{Id: proto.Int32(1), Names: []string{"a", "b"}}}
+
+	data, err := holder.MarshalVT()
+	require.NoError(t, err)
+	want, err := proto.Marshal(holder)
+	require.NoError(t, err)
+	require.Equal(t, want, data)
+	require.Equal(t, len(want), holder.SizeVT())
+
+	decoded := &Holder{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, proto.Equal(holder, decoded))
+	require.True(t, holder.EqualVT(decoded))
+	require.True(t, holder.EqualVT(holder.CloneVT()))
+	require.False(t, holder.EqualVT(&Holder{Name: holder.Name}))
+}
@@ -67,6 +67,47 @@ func (m *MessageWithWKT) CloneMessageVT() proto.Message {
 	return m.CloneVT()
 }
 
+// MessageWithWKTCloneSliceVT returns a slice holding a deep copy of each message of in, or nil
+// for its nil messages. The copies are allocated together, so that the memory of all
+// of them is held as long as one of them is referenced.
+func MessageWithWKTCloneSliceVT(in []*MessageWithWKT) []*MessageWithWKT {
+	if in == nil {
+		return nil
+	}
+	out := make([]*MessageWithWKT, len(in))
+	clones := make([]MessageWithWKT, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		r := &clones[i]
+		r.Any = (*anypb.Any)((*anypb1.Any)(m.Any).CloneVT())
+		r.Duration = (*durationpb.Duration)((*durationpb1.Duration)(m.Duration).CloneVT())
+		r.Empty = (*emptypb.Empty)((*emptypb1.Empty)(m.Empty).CloneVT())
+		r.FieldMask = (*fieldmaskpb.FieldMask)((*fieldmaskpb1.FieldMask)(m.FieldMask).CloneVT())
+		r.Timestamp = (*timestamppb.Timestamp)((*timestamppb1.Timestamp)(m.Timestamp).CloneVT())
+		r.DoubleValue = (*wrapperspb.DoubleValue)((*wrapperspb1.DoubleValue)(m.DoubleValue).CloneVT())
+		r.FloatValue = (*wrapperspb.FloatValue)((*wrapperspb1.FloatValue)(m.FloatValue).CloneVT())
+		r.Int64Value = (*wrapperspb.Int64Value)((*wrapperspb1.Int64Value)(m.Int64Value).CloneVT())
+		r.Uint64Value = (*wrapperspb.UInt64Value)((*wrapperspb1.UInt64Value)(m.Uint64Value).CloneVT())
+		r.Int32Value = (*wrapperspb.Int32Value)((*wrapperspb1.Int32Value)(m.Int32Value).CloneVT())
+		r.Uint32Value = (*wrapperspb.UInt32Value)((*wrapperspb1.UInt32Value)(m.Uint32Value).CloneVT())
+		r.BoolValue = (*wrapperspb.BoolValue)((*wrapperspb1.BoolValue)(m.BoolValue).CloneVT())
+		r.StringValue = (*wrapperspb.StringValue)((*wrapperspb1.StringValue)(m.StringValue).CloneVT())
+		r.BytesValue = (*wrapperspb.BytesValue)((*wrapperspb1.BytesValue)(m.BytesValue).CloneVT())
+		r.StructValue = (*structpb.Struct)((*structpb1.Struct)(m.StructValue).CloneVT())
+		r.ValueValue = (*structpb.Value)((*structpb1.Value)(m.ValueValue).CloneVT())
+		r.ListvalueValue = (*structpb.ListValue)((*structpb1.ListValue)(m.ListvalueValue).CloneVT())
+		r.NullValue = m.NullValue
+		if len(m.unknownFields) > 0 {
+			r.unknownFields = make([]byte, len(m.unknownFields))
+			copy(r.unknownFields, m.unknownFields)
+		}
+		out[i] = r
+	}
+	return out
+}
+
 func (this *MessageWithWKT) EqualVT(that *MessageWithWKT) bool {
 	if this == that {
 		return true
@@ -565,6 +606,9 @@ func (m *MessageWithWKT) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageWithWKT) MergeFromWireVT(dAtA []byte) error {
+	return m.UnmarshalVT(dAtA)
+}
 func (m *MessageWithWKT) SizeVT() (n int) {
 	if m == nil {
 		return 0
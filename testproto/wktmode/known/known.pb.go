@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: wktmode/known/known.proto
+
+package known
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Delays []*durationpb.Duration `protobuf:"bytes,3,rep,name=delays,proto3" json:"delays,omitempty"`
+	// Types that are valid to be assigned to When:
+	//
+	//	*Event_Scheduled
+	When          isEvent_When `protobuf_oneof:"when"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_wktmode_known_known_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_wktmode_known_known_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_wktmode_known_known_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Event) GetDelays() []*durationpb.Duration {
+	if x != nil {
+		return x.Delays
+	}
+	return nil
+}
+
+func (x *Event) GetWhen() isEvent_When {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Event) GetScheduled() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.When.(*Event_Scheduled); ok {
+			return x.Scheduled
+		}
+	}
+	return nil
+}
+
+type isEvent_When interface {
+	isEvent_When()
+}
+
+type Event_Scheduled struct {
+	Scheduled *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled,proto3,oneof"`
+}
+
+func (*Event_Scheduled) isEvent_When() {}
+
+var File_wktmode_known_known_proto protoreflect.FileDescriptor
+
+const file_wktmode_known_known_proto_rawDesc = "" +
+	"\n" +
+	"\x19wktmode/known/known.proto\x12\rwktmode.known\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x121\n" +
+	"\x06delays\x18\x03 \x03(\v2\x19.google.protobuf.DurationR\x06delays\x12:\n" +
+	"\tscheduled\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tscheduledB\x06\n" +
+	"\x04whenB\x19Z\x17testproto/wktmode/knownb\x06proto3"
+
+var (
+	file_wktmode_known_known_proto_rawDescOnce sync.Once
+	file_wktmode_known_known_proto_rawDescData []byte
+)
+
+func file_wktmode_known_known_proto_rawDescGZIP() []byte {
+	file_wktmode_known_known_proto_rawDescOnce.Do(func() {
+		file_wktmode_known_known_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wktmode_known_known_proto_rawDesc), len(file_wktmode_known_known_proto_rawDesc)))
+	})
+	return file_wktmode_known_known_proto_rawDescData
+}
+
+var file_wktmode_known_known_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_wktmode_known_known_proto_goTypes = []any{
+	(*Event)(nil),                 // 0: wktmode.known.Event
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 2: google.protobuf.Duration
+}
+var file_wktmode_known_known_proto_depIdxs = []int32{
+	1, // 0: wktmode.known.Event.at:type_name -> google.protobuf.Timestamp
+	2, // 1: wktmode.known.Event.delays:type_name -> google.protobuf.Duration
+	1, // 2: wktmode.known.Event.scheduled:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_wktmode_known_known_proto_init() }
+func file_wktmode_known_known_proto_init() {
+	if File_wktmode_known_known_proto != nil {
+		return
+	}
+	file_wktmode_known_known_proto_msgTypes[0].OneofWrappers = []any{
+		(*Event_Scheduled)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wktmode_known_known_proto_rawDesc), len(file_wktmode_known_known_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_wktmode_known_known_proto_goTypes,
+		DependencyIndexes: file_wktmode_known_known_proto_depIdxs,
+		MessageInfos:      file_wktmode_known_known_proto_msgTypes,
+	}.Build()
+	File_wktmode_known_known_proto = out.File
+	file_wktmode_known_known_proto_goTypes = nil
+	file_wktmode_known_known_proto_depIdxs = nil
+}
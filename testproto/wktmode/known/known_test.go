@@ -0,0 +1,41 @@
+package known
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestWellKnownTypesMode tests that the VT methods generated with wkt=known, which
+// use the helpers of the types/known packages, agree with the proto package
+func TestWellKnownTypesMode(t *testing.T) {
+	original := &Event{
+		Name:   "event",
+		At:     timestamppb.New(time.Unix(1, 2)),
+		Delays: []*durationpb.Duration{durationpb.New(time.Second), durationpb.New(0)},
+		When:   &Event_Scheduled{Scheduled: timestamppb.New(time.Unix(3, 0))},
+	}
+	require.Equal(t, proto.Size(original), original.SizeVT())
+
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	got := &Event{}
+	require.NoError(t, proto.Unmarshal(data, got))
+	require.True(t, proto.Equal(original, got))
+
+	data, err = proto.Marshal(original)
+	require.NoError(t, err)
+	got = &Event{}
+	require.NoError(t, got.UnmarshalVT(data))
+	require.True(t, original.EqualVT(got))
+
+	clone := original.CloneVT()
+	require.True(t, original.EqualVT(clone))
+	require.NotSame(t, original.At, clone.At)
+	clone.Delays[0].Seconds++
+	require.False(t, original.EqualVT(clone))
+}
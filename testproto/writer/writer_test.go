@@ -0,0 +1,164 @@
+package writer
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+func archive() *Archive {
+	return &Archive{
+		Name:     "archive",
+		Chunks:   []*Chunk{{Offset: 1, Data: []byte("a")}, nil, {Data: bytes.Repeat([]byte("b"), 10000)}},
+		Index:    map[string]*Chunk{"a": {Offset: 1}},
+		Blobs:    map[int32][]byte{-1: []byte("blob")},
+		Sizes:    []int64{1, -1, 1 << 40},
+		Deltas:   []int32{-1, 0, 1},
+		Weights:  []float64{0.5, -2},
+		Flags:    []bool{true, false},
+		Tags:     []string{"x", ""},
+		Version:  proto.Uint32(0),
+		Ratio:    -0.0,
+		Scale:    1.5,
+		Sealed:   true,
+		Kind:     Kind_KIND_FILE,
+		Checksum: 1 << 60,
+		Zone:     -3,
+		Created:  timestamppb.New(timestamppb.Now().AsTime()),
+		Parent:   &Archive{Name: "parent", Source: &Archive_Inline{}},
+		Source:   &Archive_Url{Url: "url"},
+		Target:   &Archive_Raw{Raw: []byte("raw")},
+	}
+}
+
+func TestMarshalToWriter(t *testing.T) {
+	for _, m := range []*Archive{archive(), {}, {Source: &Archive_Inline{Inline: &Chunk{Offset: 1}}, Target: &Archive_Path{}}} {
+		expected, err := m.MarshalVT()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		n, err := m.MarshalToWriterVT(&buf)
+		require.NoError(t, err)
+		require.Equal(t, len(expected), n)
+		require.Equal(t, expected, buf.Bytes())
+	}
+
+	// The entries of maps are written in random order.
+	m := archive()
+	m.Index["b"] = &Chunk{Data: []byte("b")}
+	m.Index["c"] = nil
+	m.Blobs[2] = nil
+	var buf bytes.Buffer
+	n, err := m.MarshalToWriterVT(&buf)
+	require.NoError(t, err)
+	require.Equal(t, m.SizeVT(), n)
+	decoded := &Archive{}
+	require.NoError(t, decoded.UnmarshalVT(buf.Bytes()))
+	m.Index["c"] = &Chunk{}
+	m.Blobs[2] = []byte{}
+	require.True(t, m.EqualVT(decoded), "unexpected %v", decoded)
+
+	var null *Archive
+	n, err = null.MarshalToWriterVT(&buf)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestNegativeZero(t *testing.T) {
+	// -0 is not the zero value of the fields without presence, and proto.Marshal
+	// encodes it.
+	m := &Archive{Ratio: math.Copysign(0, -1), Scale: float32(math.Copysign(0, -1)), Count: 1}
+	expected, err := proto.Marshal(m)
+	require.NoError(t, err)
+	require.NotEmpty(t, expected)
+
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+	require.Equal(t, len(expected), m.SizeVT())
+	var buf bytes.Buffer
+	_, err = m.MarshalToWriterVT(&buf)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf.Bytes())
+
+	decoded := &Archive{}
+	require.NoError(t, decoded.UnmarshalVT(data))
+	require.True(t, math.Signbit(decoded.Ratio))
+	require.True(t, math.Signbit(float64(decoded.Scale)))
+}
+
+func TestMarshalToWriterLegacy(t *testing.T) {
+	m := &Legacy{
+		Id:      proto.String("id"),
+		Size:    proto.Int32(0),
+		Entry:   &Legacy_Entry{Key: proto.String("key")},
+		Offsets: []int64{-1, 1},
+		Data:    []byte{},
+	}
+	expected, err := m.MarshalVT()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	_, err = m.MarshalToWriterVT(&buf)
+	require.NoError(t, err)
+	require.Equal(t, expected, buf.Bytes())
+
+	_, expectedErr := (&Legacy{}).MarshalVT()
+	_, err = (&Legacy{}).MarshalToWriterVT(&buf)
+	require.EqualError(t, err, expectedErr.Error())
+
+	// A failed message completes its section of the Writer, which keeps writing the
+	// next messages.
+	buf.Reset()
+	sw := protohelpers.NewWriter(&buf)
+	_, err = (&Legacy{Entry: &Legacy_Entry{}}).MarshalToWriterVT(sw)
+	require.Error(t, err)
+	n, err := m.MarshalToWriterVT(sw)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), n)
+	require.Equal(t, expected, buf.Bytes())
+}
+
+// recorder records the sizes of the writes, and fails once it has written limit bytes.
+type recorder struct {
+	writes []int
+	n      int
+	limit  int
+}
+
+var errFull = errors.New("full")
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.writes = append(r.writes, len(b))
+	if r.limit > 0 && r.n+len(b) > r.limit {
+		return 0, errFull
+	}
+	r.n += len(b)
+	return len(b), nil
+}
+
+func TestMarshalToWriterBuffering(t *testing.T) {
+	m := &Archive{Chunks: []*Chunk{{Data: make([]byte, 1<<20)}}}
+	for i := 0; i < 1000; i++ {
+		m.Tags = append(m.Tags, "tag")
+	}
+
+	// The large bytes are written as is, the small fields are buffered.
+	r := &recorder{}
+	n, err := m.MarshalToWriterVT(r)
+	require.NoError(t, err)
+	require.Equal(t, m.SizeVT(), n)
+	require.Equal(t, n, r.n)
+	require.Contains(t, r.writes, 1<<20)
+	require.Less(t, len(r.writes), 5)
+
+	r = &recorder{limit: 1000}
+	_, err = m.MarshalToWriterVT(r)
+	require.ErrorIs(t, err, errFull)
+}
@@ -0,0 +1,99 @@
+package anypb
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+	"weak"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// unpacked is the result of the last UnmarshalNewCached call on an Any, along
+// with the TypeUrl and Value it was decoded from.
+type unpacked struct {
+	typeURL string
+	value   unsafe.Pointer
+	length  int
+	msg     proto.Message
+}
+
+func (u *unpacked) matches(m *Any) bool {
+	return u.typeURL == m.TypeUrl && u.value == unsafe.Pointer(unsafe.SliceData(m.Value)) && u.length == len(m.Value)
+}
+
+type cacheEntry struct {
+	last atomic.Pointer[unpacked]
+}
+
+// unpackCache holds one entry per Any that has been unpacked with
+// UnmarshalNewCached. Entries are keyed by a weak pointer and removed once the
+// Any they belong to is garbage collected.
+var unpackCache sync.Map // map[weak.Pointer[Any]]*cacheEntry
+
+// UnmarshalNewCached returns the message contained in m, like anypb.UnmarshalNew.
+// The decoded message is cached for m, and later calls return the same message
+// without decoding again, as long as m.TypeUrl is unchanged and m.Value still
+// refers to the same bytes. Assigning a new TypeUrl or Value invalidates the
+// cache; modifying the contents of Value in place is not detected, use
+// DropCached afterwards.
+//
+// The returned message is shared by every caller that unpacks m and must not
+// be modified.
+func (m *Any) UnmarshalNewCached() (proto.Message, error) {
+	key := weak.Make(m)
+	var entry *cacheEntry
+	if v, ok := unpackCache.Load(key); ok {
+		entry = v.(*cacheEntry)
+		if last := entry.last.Load(); last != nil && last.matches(m) {
+			return last.msg, nil
+		}
+	} else {
+		v, loaded := unpackCache.LoadOrStore(key, new(cacheEntry))
+		entry = v.(*cacheEntry)
+		if !loaded {
+			runtime.AddCleanup(m, func(key weak.Pointer[Any]) { unpackCache.Delete(key) }, key)
+		}
+	}
+
+	msg, err := m.unmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	entry.last.Store(&unpacked{
+		typeURL: m.TypeUrl,
+		value:   unsafe.Pointer(unsafe.SliceData(m.Value)),
+		length:  len(m.Value),
+		msg:     msg,
+	})
+	return msg, nil
+}
+
+// DropCached discards the message cached for m by UnmarshalNewCached, if any.
+func (m *Any) DropCached() {
+	if v, ok := unpackCache.Load(weak.Make(m)); ok {
+		v.(*cacheEntry).last.Store(nil)
+	}
+}
+
+// unmarshalNew decodes the message contained in m, using its UnmarshalVT method
+// when the message type has one.
+func (m *Any) unmarshalNew() (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(m.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	msg := mt.New().Interface()
+	if vt, ok := msg.(interface{ UnmarshalVT([]byte) error }); ok {
+		if err := vt.UnmarshalVT(m.Value); err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+	if err := proto.Unmarshal(m.Value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,46 @@
+package anypb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestUnmarshalNewCached(t *testing.T) {
+	packed, err := anypb.New(durationpb.New(5))
+	require.NoError(t, err)
+	m := (*Any)(packed)
+
+	first, err := m.UnmarshalNewCached()
+	require.NoError(t, err)
+	require.True(t, proto.Equal(durationpb.New(5), first))
+
+	second, err := m.UnmarshalNewCached()
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	// Assigning a new Value invalidates the cached message.
+	other, err := anypb.New(durationpb.New(7))
+	require.NoError(t, err)
+	m.Value = other.Value
+	third, err := m.UnmarshalNewCached()
+	require.NoError(t, err)
+	require.NotSame(t, first, third)
+	require.True(t, proto.Equal(durationpb.New(7), third))
+
+	// DropCached forces the next call to decode again.
+	m.DropCached()
+	fourth, err := m.UnmarshalNewCached()
+	require.NoError(t, err)
+	require.NotSame(t, third, fourth)
+	require.True(t, proto.Equal(third, fourth))
+}
+
+func TestUnmarshalNewCachedUnknownType(t *testing.T) {
+	m := &Any{TypeUrl: "type.googleapis.com/does.not.Exist"}
+	_, err := m.UnmarshalNewCached()
+	require.Error(t, err)
+}
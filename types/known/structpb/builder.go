@@ -0,0 +1,278 @@
+package structpb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+// Builder constructs trees of structpb.Value with few allocations. The messages and
+// the oneof wrappers of the trees built by a Builder are carved out of chunks of memory
+// it allocates for many of them at once, instead of being allocated one by one like
+// structpb.NewValue does.
+//
+// The trees built by a Builder share its chunks, which stay alive as long as any of
+// these trees is referenced. A Builder is not safe for concurrent use.
+type Builder struct {
+	values  slab[structpb.Value]
+	lists   slab[structpb.ListValue]
+	structs slab[structpb.Struct]
+	nulls   slab[structpb.Value_NullValue]
+	numbers slab[structpb.Value_NumberValue]
+	strs    slab[structpb.Value_StringValue]
+	bools   slab[structpb.Value_BoolValue]
+	ofLists slab[structpb.Value_ListValue]
+	ofStrs  slab[structpb.Value_StructValue]
+	elems   slab[*structpb.Value]
+
+	// stack holds the elements of the lists and the fields of the structs being decoded
+	// by DecodeJSON
+	stack []*structpb.Value
+	keys  []string
+}
+
+var builderPool = sync.Pool{New: func() any { return new(Builder) }}
+
+// BuilderFromVTPool returns a Builder from a pool, which is returned to it by
+// ReturnToVTPool.
+func BuilderFromVTPool() *Builder {
+	return builderPool.Get().(*Builder)
+}
+
+// ReturnToVTPool resets b and returns it to the pool of BuilderFromVTPool. The trees
+// built by b must not be used anymore, since the memory they use is reused.
+func (b *Builder) ReturnToVTPool() {
+	b.Reset()
+	builderPool.Put(b)
+}
+
+// Reset makes b reuse the memory of the trees it built for the next ones, so that
+// building trees of similar sizes only allocates the maps of their structs. The trees
+// built by b before Reset must not be used anymore.
+func (b *Builder) Reset() {
+	b.values.reset()
+	b.lists.reset()
+	b.structs.reset()
+	b.nulls.reset()
+	b.numbers.reset()
+	b.strs.reset()
+	b.bools.reset()
+	b.ofLists.reset()
+	b.ofStrs.reset()
+	b.elems.reset()
+	clear(b.stack[:cap(b.stack)])
+	b.stack = b.stack[:0]
+	b.keys = b.keys[:0]
+}
+
+// Null returns a new null Value.
+func (b *Builder) Null() *structpb.Value {
+	kind := b.nulls.new()
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// Number returns a new number Value.
+func (b *Builder) Number(n float64) *structpb.Value {
+	kind := b.numbers.new()
+	kind.NumberValue = n
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// String returns a new string Value.
+func (b *Builder) String(s string) *structpb.Value {
+	kind := b.strs.new()
+	kind.StringValue = s
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// Bool returns a new bool Value.
+func (b *Builder) Bool(x bool) *structpb.Value {
+	kind := b.bools.new()
+	kind.BoolValue = x
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// List returns a new list Value holding the given values, which are copied.
+func (b *Builder) List(values ...*structpb.Value) *structpb.Value {
+	list := b.lists.new()
+	if len(values) > 0 {
+		list.Values = b.elems.take(len(values))
+		copy(list.Values, values)
+	}
+	kind := b.ofLists.new()
+	kind.ListValue = list
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// Struct returns a new struct Value holding the given fields, which are not copied.
+func (b *Builder) Struct(fields map[string]*structpb.Value) *structpb.Value {
+	s := b.structs.new()
+	s.Fields = fields
+	kind := b.ofStrs.new()
+	kind.StructValue = s
+	v := b.values.new()
+	v.Kind = kind
+	return v
+}
+
+// DecodeJSON reads the next JSON value from dec and returns it as a Value, like
+// protojson.Unmarshal does for a structpb.Value. Numbers are decoded as float64,
+// whether or not dec uses json.Number.
+func (b *Builder) DecodeJSON(dec *json.Decoder) (*structpb.Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	v, err := b.decodeJSON(dec, tok)
+	if err != nil {
+		clear(b.stack)
+		b.stack = b.stack[:0]
+		b.keys = b.keys[:0]
+	}
+	return v, err
+}
+
+func (b *Builder) decodeJSON(dec *json.Decoder, tok json.Token) (*structpb.Value, error) {
+	switch tok := tok.(type) {
+	case nil:
+		return b.Null(), nil
+	case bool:
+		return b.Bool(tok), nil
+	case float64:
+		return b.Number(tok), nil
+	case json.Number:
+		n, err := tok.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return b.Number(n), nil
+	case string:
+		return b.String(tok), nil
+	case json.Delim:
+		switch tok {
+		case '[':
+			return b.decodeList(dec)
+		case '{':
+			return b.decodeStruct(dec)
+		}
+	}
+	return nil, fmt.Errorf("structpb: unexpected JSON token %v", tok)
+}
+
+func (b *Builder) decodeList(dec *json.Decoder) (*structpb.Value, error) {
+	start := len(b.stack)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if tok == json.Delim(']') {
+			break
+		}
+		v, err := b.decodeJSON(dec, tok)
+		if err != nil {
+			return nil, err
+		}
+		b.stack = append(b.stack, v)
+	}
+	v := b.List(b.stack[start:]...)
+	clear(b.stack[start:])
+	b.stack = b.stack[:start]
+	return v, nil
+}
+
+func (b *Builder) decodeStruct(dec *json.Decoder) (*structpb.Value, error) {
+	start, keys := len(b.stack), len(b.keys)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if tok == json.Delim('}') {
+			break
+		}
+		// The decoder only returns strings for the keys of objects.
+		b.keys = append(b.keys, tok.(string))
+		if tok, err = dec.Token(); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		v, err := b.decodeJSON(dec, tok)
+		if err != nil {
+			return nil, err
+		}
+		b.stack = append(b.stack, v)
+	}
+	fields := make(map[string]*structpb.Value, len(b.stack)-start)
+	for i, v := range b.stack[start:] {
+		fields[b.keys[keys+i]] = v
+	}
+	clear(b.stack[start:])
+	b.stack = b.stack[:start]
+	b.keys = b.keys[:keys]
+	return b.Struct(fields), nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// slab allocates values of type T in chunks of increasing size.
+type slab[T any] struct {
+	chunks [][]T
+	// chunk is the index of the chunk values are allocated from, and used the number
+	// of its values that are allocated
+	chunk, used int
+}
+
+const (
+	minChunk = 16
+	maxChunk = 1024
+)
+
+// new returns a pointer to a zero value of type T.
+func (s *slab[T]) new() *T {
+	return &s.take(1)[0]
+}
+
+// take returns a slice of n zero values of type T, whose capacity is n.
+func (s *slab[T]) take(n int) []T {
+	for s.chunk < len(s.chunks) && s.used+n > len(s.chunks[s.chunk]) {
+		s.chunk++
+		s.used = 0
+	}
+	if s.chunk == len(s.chunks) {
+		size := minChunk
+		if len(s.chunks) > 0 {
+			size = min(2*len(s.chunks[len(s.chunks)-1]), maxChunk)
+		}
+		s.chunks = append(s.chunks, make([]T, max(size, n)))
+	}
+	values := s.chunks[s.chunk][s.used : s.used+n : s.used+n]
+	s.used += n
+	return values
+}
+
+// reset zeroes the values allocated by s, to allocate them again.
+func (s *slab[T]) reset() {
+	for _, c := range s.chunks[:min(s.chunk+1, len(s.chunks))] {
+		clear(c)
+	}
+	s.chunk, s.used = 0, 0
+}
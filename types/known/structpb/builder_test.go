@@ -0,0 +1,102 @@
+package structpb
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const document = `{"name": "vtprotobuf", "stars": 1234.5, "public": true, "license": null,
+	"topics": ["go", "protobuf", [], {}], "owner": {"login": "planetscale", "ids": [1, 2, 3]}}`
+
+func TestBuilder(t *testing.T) {
+	b := &Builder{}
+	got := b.Struct(map[string]*structpb.Value{
+		"null":   b.Null(),
+		"number": b.Number(1.5),
+		"string": b.String("s"),
+		"bool":   b.Bool(true),
+		"list":   b.List(b.Number(1), b.List(), b.Struct(nil)),
+	})
+
+	want, err := structpb.NewValue(map[string]any{
+		"null":   nil,
+		"number": 1.5,
+		"string": "s",
+		"bool":   true,
+		"list":   []any{1, []any{}, map[string]any{}},
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, got))
+	require.True(t, (*Value)(want).EqualVT((*Value)(got)))
+}
+
+func TestBuilderDecodeJSON(t *testing.T) {
+	want := &structpb.Value{}
+	require.NoError(t, protojson.Unmarshal([]byte(document), want))
+
+	b := BuilderFromVTPool()
+	defer b.ReturnToVTPool()
+	for _, useNumber := range []bool{false, true} {
+		dec := json.NewDecoder(strings.NewReader(document + document))
+		if useNumber {
+			dec.UseNumber()
+		}
+		for i := 0; i < 2; i++ {
+			got, err := b.DecodeJSON(dec)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(want, got))
+		}
+		_, err := b.DecodeJSON(dec)
+		require.ErrorIs(t, err, io.EOF)
+	}
+
+	_, err := b.DecodeJSON(json.NewDecoder(strings.NewReader(`{"a": [1, 2`)))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Empty(t, b.stack)
+}
+
+func TestBuilderReset(t *testing.T) {
+	b := &Builder{}
+	first := b.List(b.String("a"), b.Number(1)).GetListValue()
+	b.Reset()
+	second := b.List(b.Bool(true))
+
+	// The memory of the first tree is reused for the second one.
+	require.Same(t, first, second.GetListValue())
+	require.True(t, proto.Equal(structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewBoolValue(true)}}), second))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Reset()
+		b.List(b.String("a"), b.Number(1), b.Null())
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkDecodeJSON(b *testing.B) {
+	b.Run("protojson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			v := &structpb.Value{}
+			if err := protojson.Unmarshal([]byte(document), v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("builder", func(b *testing.B) {
+		b.ReportAllocs()
+		builder := &Builder{}
+		for i := 0; i < b.N; i++ {
+			builder.Reset()
+			if _, err := builder.DecodeJSON(json.NewDecoder(strings.NewReader(document))); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,61 @@
+// Package vt provides typed, generic wrappers around the helper methods generated
+// by protoc-gen-go-vtproto, for libraries that want to (un)marshal and clone
+// messages of a type parameter without going through interface{}-based codecs.
+package vt
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// VtMarshaler is implemented by messages generated with the marshal feature.
+type VtMarshaler = vtproto.Marshaler
+
+// VtUnmarshaler is implemented by messages generated with the unmarshal feature.
+type VtUnmarshaler interface {
+	proto.Message
+	UnmarshalVT([]byte) error
+}
+
+// VtCloner is implemented by messages of type T generated with the clone feature.
+type VtCloner[T any] interface {
+	CloneVT() T
+}
+
+// Marshal returns the wire-format encoding of m.
+func Marshal[T VtMarshaler](m T) ([]byte, error) {
+	return m.MarshalVT()
+}
+
+// Unmarshal parses the wire-format message in data into a newly allocated
+// message of type T, which must be a pointer to a generated message struct.
+func Unmarshal[T VtUnmarshaler](data []byte) (T, error) {
+	var zero T
+	m := zero.ProtoReflect().Type().New().Interface().(T)
+	if err := m.UnmarshalVT(data); err != nil {
+		return zero, err
+	}
+	return m, nil
+}
+
+// UnmarshalWith is like Unmarshal, but obtains the message to decode into from
+// alloc, which must return an empty message. Passing the FromVTPool function
+// generated for a pooled message takes the message from its pool; the message
+// is returned to the pool if decoding fails.
+func UnmarshalWith[T VtUnmarshaler](data []byte, alloc func() T) (T, error) {
+	m := alloc()
+	if err := m.UnmarshalVT(data); err != nil {
+		if p, ok := any(m).(vtproto.Pooler); ok {
+			p.ReturnToVTPool()
+		}
+		var zero T
+		return zero, err
+	}
+	return m, nil
+}
+
+// Clone returns a deep copy of m.
+func Clone[T VtCloner[T]](m T) T {
+	return m.CloneVT()
+}
@@ -0,0 +1,44 @@
+package vt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+)
+
+func TestRoundTrip(t *testing.T) {
+	msg := &pool.MemoryPoolExtension{Foo1: "hello", Foo2: 42}
+
+	data, err := Marshal(msg)
+	require.NoError(t, err)
+
+	got, err := Unmarshal[*pool.MemoryPoolExtension](data)
+	require.NoError(t, err)
+	require.True(t, msg.EqualVT(got))
+
+	clone := Clone(msg)
+	require.True(t, msg.EqualVT(clone))
+	require.NotSame(t, msg, clone)
+}
+
+func TestUnmarshalError(t *testing.T) {
+	got, err := Unmarshal[*pool.MemoryPoolExtension]([]byte{0x0a, 0x05})
+	require.Error(t, err)
+	require.Nil(t, got)
+}
+
+func TestUnmarshalWithPool(t *testing.T) {
+	data, err := Marshal(&pool.MemoryPoolExtension{Foo1: "pooled"})
+	require.NoError(t, err)
+
+	got, err := UnmarshalWith(data, pool.MemoryPoolExtensionFromVTPool)
+	require.NoError(t, err)
+	require.Equal(t, "pooled", got.Foo1)
+	got.ReturnToVTPool()
+
+	got, err = UnmarshalWith([]byte{0x0a, 0x05}, pool.MemoryPoolExtensionFromVTPool)
+	require.Error(t, err)
+	require.Nil(t, got)
+}
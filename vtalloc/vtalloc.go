@@ -0,0 +1,93 @@
+// Package vtalloc defines the allocator used by the UnmarshalVTOptions methods generated
+// with the unmarshal_alloc feature, so that applications can decode messages into the
+// memory of an arena, a slab, or an instrumented allocator.
+package vtalloc
+
+import (
+	"unsafe"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Allocator allocates the memory of the decoded messages.
+//
+// The generated code keeps the messages, bytes and strings it is given in the decoded
+// message, and never returns them to the Allocator: their memory must stay valid as long
+// as the message is used. The slices and maps of repeated and map fields, and the
+// wrappers of oneof fields, are still allocated by the Go runtime.
+type Allocator interface {
+	// NewMessage returns a new empty message of type mt, e.g. mt.New().Interface().
+	NewMessage(mt protoreflect.MessageType) proto.Message
+	// Bytes returns a slice of length n, into which the generated code copies the value
+	// of a bytes field. Its content does not need to be zeroed.
+	Bytes(n int) []byte
+	// String returns a string holding the bytes of b, which are only valid during the
+	// call.
+	String(b []byte) string
+}
+
+// UnmarshalOptions configures the UnmarshalVTOptions methods.
+type UnmarshalOptions struct {
+	// Allocator allocates the messages, bytes and strings of the decoded message. The
+	// Heap allocator is used if it is nil.
+	Allocator Allocator
+}
+
+// Heap allocates the memory of the decoded messages with the Go runtime, like the
+// UnmarshalVT methods do.
+var Heap Allocator = heap{}
+
+type heap struct{}
+
+func (heap) NewMessage(mt protoreflect.MessageType) proto.Message {
+	return mt.New().Interface()
+}
+
+func (heap) Bytes(n int) []byte {
+	return make([]byte, n)
+}
+
+func (heap) String(b []byte) string {
+	return string(b)
+}
+
+// Slab is an Allocator carving the bytes and strings of the decoded messages out of
+// large chunks of memory, which are allocated once and then released together by the
+// garbage collector when none of the values they hold are used. The messages are
+// allocated with the Go runtime. A Slab is not safe for concurrent use.
+type Slab struct {
+	chunk []byte
+	size  int
+}
+
+// NewSlab returns a Slab allocating chunks of size bytes. The values larger than a
+// quarter of a chunk are allocated on their own.
+func NewSlab(size int) *Slab {
+	return &Slab{size: size}
+}
+
+func (s *Slab) NewMessage(mt protoreflect.MessageType) proto.Message {
+	return mt.New().Interface()
+}
+
+func (s *Slab) Bytes(n int) []byte {
+	if n > s.size/4 {
+		return make([]byte, n)
+	}
+	if n > len(s.chunk) {
+		s.chunk = make([]byte, s.size)
+	}
+	b := s.chunk[:n:n]
+	s.chunk = s.chunk[n:]
+	return b
+}
+
+func (s *Slab) String(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	buf := s.Bytes(len(b))
+	copy(buf, b)
+	return unsafe.String(&buf[0], len(buf))
+}
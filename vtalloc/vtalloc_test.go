@@ -0,0 +1,40 @@
+package vtalloc
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestSlab(t *testing.T) {
+	s := NewSlab(64)
+	a, b := s.Bytes(8), s.Bytes(8)
+	require.Len(t, a, 8)
+	require.Equal(t, 8, cap(a))
+	// Both come from the same chunk.
+	require.Equal(t, unsafe.Add(unsafe.Pointer(&a[0]), 8), unsafe.Pointer(&b[0]))
+
+	// Appending to a value does not overwrite the next one.
+	b[0] = 1
+	_ = append(a, 2)
+	require.Equal(t, byte(1), b[0])
+
+	// Large values are allocated on their own.
+	require.Len(t, s.Bytes(60), 60)
+
+	src := []byte("value")
+	str := s.String(src)
+	src[0] = 'X'
+	require.Equal(t, "value", str)
+	require.Equal(t, "", s.String(nil))
+
+	require.IsType(t, &emptypb.Empty{}, s.NewMessage((&emptypb.Empty{}).ProtoReflect().Type()))
+}
+
+func TestHeap(t *testing.T) {
+	require.Len(t, Heap.Bytes(3), 3)
+	require.Equal(t, "abc", Heap.String([]byte("abc")))
+	require.IsType(t, &emptypb.Empty{}, Heap.NewMessage((&emptypb.Empty{}).ProtoReflect().Type()))
+}
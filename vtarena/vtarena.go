@@ -0,0 +1,131 @@
+// Package vtarena provides an arena for the UnmarshalVTArena methods generated with the
+// unmarshal_arena feature, which allocate the messages, bytes and strings they decode
+// from the chunks of memory of the arena. Releasing the arena makes its chunks available
+// for the messages decoded next, typically by the next request, in one go.
+package vtarena
+
+import (
+	"reflect"
+	"unsafe"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/vtalloc"
+)
+
+// DefaultChunkSize is the size of the chunks of the arenas created with a size of 0.
+const DefaultChunkSize = 64 << 10
+
+// Arena is a vtalloc.Allocator bump-allocating the decoded values from chunks of memory.
+// The bytes and strings share chunks of bytes, and the messages of each type share
+// chunks holding an array of messages of their type, so that the garbage collector
+// scans them like any other message. The bytes and strings larger than a quarter of a
+// chunk are allocated on their own. The slices and maps of repeated and map fields, and
+// the wrappers of oneof fields, are still allocated by the Go runtime.
+//
+// An Arena is not safe for concurrent use. The nil *Arena allocates with the Go runtime,
+// like vtalloc.Heap.
+type Arena struct {
+	size int
+	// bytes holds the chunks of bytes, the one in use being bytes[chunk], of which off
+	// bytes are used
+	bytes [][]byte
+	chunk int
+	off   int
+	// messages holds the chunks of messages by type
+	messages map[protoreflect.MessageType]*messageChunks
+}
+
+var _ vtalloc.Allocator = (*Arena)(nil)
+
+// messageChunks holds the chunks of messages of a type, the one in use being
+// chunks[chunk], of which off messages are used.
+type messageChunks struct {
+	typ    reflect.Type
+	chunks []reflect.Value
+	chunk  int
+	off    int
+	// per is the number of messages of a chunk
+	per int
+}
+
+// New returns an Arena allocating chunks of size bytes, or of DefaultChunkSize bytes if
+// size is not positive.
+func New(size int) *Arena {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	return &Arena{size: size}
+}
+
+// NewMessage returns a new empty message of type mt.
+func (a *Arena) NewMessage(mt protoreflect.MessageType) proto.Message {
+	if a == nil {
+		return mt.New().Interface()
+	}
+	mc := a.messages[mt]
+	if mc == nil {
+		typ := reflect.TypeOf(mt.Zero().Interface()).Elem()
+		per := 1
+		if typ.Size() > 0 {
+			per = max(1, a.size/int(typ.Size()))
+		}
+		mc = &messageChunks{typ: typ, per: per}
+		if a.messages == nil {
+			a.messages = make(map[protoreflect.MessageType]*messageChunks)
+		}
+		a.messages[mt] = mc
+	}
+	if mc.chunk == len(mc.chunks) {
+		mc.chunks = append(mc.chunks, reflect.New(reflect.ArrayOf(mc.per, mc.typ)).Elem())
+	}
+	m := mc.chunks[mc.chunk].Index(mc.off).Addr().Interface().(proto.Message)
+	if mc.off++; mc.off == mc.per {
+		mc.chunk, mc.off = mc.chunk+1, 0
+	}
+	return m
+}
+
+// Bytes returns a slice of length n, whose content is not zeroed.
+func (a *Arena) Bytes(n int) []byte {
+	if a == nil || n > a.size/4 {
+		return make([]byte, n)
+	}
+	if a.chunk < len(a.bytes) && n > len(a.bytes[a.chunk])-a.off {
+		a.chunk, a.off = a.chunk+1, 0
+	}
+	if a.chunk == len(a.bytes) {
+		a.bytes = append(a.bytes, make([]byte, a.size))
+	}
+	b := a.bytes[a.chunk][a.off : a.off+n : a.off+n]
+	a.off += n
+	return b
+}
+
+// String returns a string holding the bytes of b.
+func (a *Arena) String(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	buf := a.Bytes(len(b))
+	copy(buf, b)
+	return unsafe.String(&buf[0], len(buf))
+}
+
+// Release makes all the memory of the arena available for the values allocated next.
+// The messages, bytes and strings allocated so far, and the messages holding them, must
+// not be used anymore: they are overwritten by the next values. The messages are zeroed,
+// so that the arena does not keep the values they refer to alive.
+func (a *Arena) Release() {
+	if a == nil {
+		return
+	}
+	a.chunk, a.off = 0, 0
+	for _, mc := range a.messages {
+		for i := 0; i <= mc.chunk && i < len(mc.chunks); i++ {
+			mc.chunks[i].SetZero()
+		}
+		mc.chunk, mc.off = 0, 0
+	}
+}
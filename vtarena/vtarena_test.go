@@ -0,0 +1,58 @@
+package vtarena
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestBytes(t *testing.T) {
+	a := New(64)
+	x, y := a.Bytes(8), a.Bytes(8)
+	require.Len(t, x, 8)
+	require.Equal(t, 8, cap(x))
+	// Both come from the same chunk.
+	require.Equal(t, unsafe.Add(unsafe.Pointer(&x[0]), 8), unsafe.Pointer(&y[0]))
+
+	// Large values are allocated on their own, the others from a new chunk when the
+	// current one is full.
+	require.Len(t, a.Bytes(60), 60)
+	for i := 0; i < 7; i++ {
+		a.Bytes(8)
+	}
+	require.Len(t, a.bytes, 2)
+
+	src := []byte("value")
+	str := a.String(src)
+	src[0] = 'X'
+	require.Equal(t, "value", str)
+	require.Equal(t, "", a.String(nil))
+
+	// Released chunks are reused.
+	a.Release()
+	require.Equal(t, unsafe.Pointer(&x[0]), unsafe.Pointer(&a.Bytes(1)[0]))
+}
+
+func TestNewMessage(t *testing.T) {
+	mt := (*durationpb.Duration)(nil).ProtoReflect().Type()
+	a := New(0)
+	first := a.NewMessage(mt).(*durationpb.Duration)
+	second := a.NewMessage(mt).(*durationpb.Duration)
+	require.Equal(t, unsafe.Add(unsafe.Pointer(first), unsafe.Sizeof(*first)), unsafe.Pointer(second))
+
+	// Released messages are zeroed and reused.
+	first.Seconds = 1
+	a.Release()
+	require.Zero(t, first.Seconds)
+	require.Same(t, first, a.NewMessage(mt))
+}
+
+func TestNil(t *testing.T) {
+	var a *Arena
+	require.Len(t, a.Bytes(3), 3)
+	require.Equal(t, "abc", a.String([]byte("abc")))
+	require.IsType(t, &durationpb.Duration{}, a.NewMessage((*durationpb.Duration)(nil).ProtoReflect().Type()))
+	a.Release()
+}
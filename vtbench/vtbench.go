@@ -0,0 +1,199 @@
+// Package vtbench benchmarks the methods generated by vtprotobuf against their
+// counterparts of the proto package, and reports the results as JSON for the dashboards
+// tracking the performance of each revision of a schema.
+//
+// The benchmark feature generates a benchmark running Benchmark for each message, and,
+// with the benchmark-json option, a test calling Main: it runs the same benchmarks with
+// testing.Benchmark when the VTBENCH_JSON environment variable is set, and appends their
+// results to the file it names as JSON lines, one Result per line:
+//
+//	VTBENCH_JSON=bench.jsonl go test -run TestVTBenchmarkJSON ./...
+package vtbench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+	"github.com/planetscale/vtprotobuf/vtquick"
+)
+
+// Env is the environment variable naming the file Main appends its results to, or "-"
+// for the standard output.
+const Env = "VTBENCH_JSON"
+
+// Size is the size of the values generated with vtquick for the messages benchmarked by
+// the generated code.
+const Size = 10
+
+// Message is implemented by the messages generated with the marshal, unmarshal and size
+// features, which can be benchmarked.
+type Message interface {
+	proto.Message
+	vtproto.Message
+	vtproto.Sizer
+}
+
+// Result is the result of a benchmark of an operation on a message.
+type Result struct {
+	// Message is the full name of the benchmarked message.
+	Message string `json:"message"`
+	// Op is the benchmarked operation: marshal, unmarshal or size.
+	Op string `json:"op"`
+	// Impl is vt for the generated methods, or proto for the proto package.
+	Impl string `json:"impl"`
+	// Bytes is the size of the encoding of the benchmarked value.
+	Bytes int `json:"bytes"`
+	// N is the number of iterations of the benchmark.
+	N int `json:"n"`
+	// NsPerOp, BytesPerOp and AllocsPerOp are the ns/op, B/op and allocs/op of the
+	// benchmark, as reported by go test -bench.
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op"`
+	AllocsPerOp int64   `json:"allocs_per_op"`
+}
+
+// op is a benchmarked operation on a message.
+type op struct {
+	name, impl string
+	run        func(b *testing.B)
+}
+
+func ops[T Message](m T) []op {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		panic(fmt.Sprintf("vtbench: %v", err))
+	}
+	return []op{
+		{"marshal", "vt", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := m.MarshalVT(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}},
+		{"marshal", "proto", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := proto.Marshal(m); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}},
+		{"unmarshal", "vt", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := m.ProtoReflect().New().Interface().(T).UnmarshalVT(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}},
+		{"unmarshal", "proto", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := proto.Unmarshal(data, m.ProtoReflect().New().Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}},
+		{"size", "vt", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m.SizeVT()
+			}
+		}},
+		{"size", "proto", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				proto.Size(m)
+			}
+		}},
+	}
+}
+
+// New returns the value of type T the generated code benchmarks, generated with vtquick
+// from a fixed seed so that the benchmarks of each run measure the same value.
+func New[T Message]() T {
+	return vtquick.New[T](rand.New(rand.NewSource(1)), Size)
+}
+
+// Benchmark runs the benchmarks of the operations on m as sub-benchmarks of b, named
+// after the operation and the implementation, e.g. marshal/vt.
+func Benchmark[T Message](b *testing.B, m T) {
+	for _, op := range ops(m) {
+		b.Run(op.name+"/"+op.impl, func(b *testing.B) {
+			b.ReportAllocs()
+			op.run(b)
+		})
+	}
+}
+
+// Case returns the benchmarks of m run by Main.
+type Case func() []Result
+
+// For returns the Case of the benchmarks of m.
+func For[T Message](m T) Case {
+	return func() []Result {
+		name := string(m.ProtoReflect().Descriptor().FullName())
+		bytes := proto.Size(m)
+		var results []Result
+		for _, op := range ops(m) {
+			r := testing.Benchmark(op.run)
+			results = append(results, Result{
+				Message:     name,
+				Op:          op.name,
+				Impl:        op.impl,
+				Bytes:       bytes,
+				N:           r.N,
+				NsPerOp:     float64(r.T.Nanoseconds()) / float64(max(r.N, 1)),
+				BytesPerOp:  r.AllocedBytesPerOp(),
+				AllocsPerOp: r.AllocsPerOp(),
+			})
+		}
+		return results
+	}
+}
+
+// WriteJSON writes results to w as JSON lines.
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	for _, r := range results {
+		if err := enc.Encode(r); err != nil {
+			return fmt.Errorf("vtbench: %w", err)
+		}
+	}
+	return nil
+}
+
+// Main runs the benchmarks of cases and appends their results to the file named by the
+// VTBENCH_JSON environment variable. It skips t when the variable is not set, so that the
+// benchmarks do not slow down the regular runs of the tests.
+func Main(t *testing.T, cases ...Case) {
+	path := os.Getenv(Env)
+	if path == "" {
+		t.Skipf("set %s to the file to write the results of the benchmarks to", Env)
+	}
+	var results []Result
+	for _, c := range cases {
+		results = append(results, c()...)
+	}
+
+	if path == "-" {
+		if err := WriteJSON(os.Stdout, results); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		t.Fatalf("vtbench: %v", err)
+	}
+	err = WriteJSON(f, results)
+	if cerr := f.Close(); err == nil && cerr != nil {
+		err = fmt.Errorf("vtbench: %w", cerr)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,66 @@
+package vtbench
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/testproto/bench"
+)
+
+func TestMainJSON(t *testing.T) {
+	// Run each benchmark once, instead of for a second.
+	benchtime := flag.Lookup("test.benchtime")
+	previous := benchtime.Value.String()
+	require.NoError(t, flag.Set("test.benchtime", "1x"))
+	defer flag.Set("test.benchtime", previous)
+
+	path := filepath.Join(t.TempDir(), "bench.jsonl")
+	t.Setenv(Env, path)
+	order := New[*bench.Order]()
+	require.True(t, proto.Equal(order, New[*bench.Order]()))
+	require.NotZero(t, proto.Size(order))
+
+	// The results of the runs are appended to the file.
+	for i := 0; i < 2; i++ {
+		t.Run("run", func(t *testing.T) {
+			Main(t, For(order))
+		})
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var results []Result
+	for scanner := bufio.NewScanner(f); scanner.Scan(); {
+		var r Result
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		results = append(results, r)
+	}
+	require.Len(t, results, 12)
+	require.Equal(t, Result{Message: "Order", Op: "marshal", Impl: "vt", Bytes: proto.Size(order), N: 1}, Result{
+		Message: results[0].Message, Op: results[0].Op, Impl: results[0].Impl, Bytes: results[0].Bytes, N: results[0].N,
+	})
+	for _, r := range results {
+		require.Greater(t, r.NsPerOp, 0.0)
+	}
+}
+
+func TestMainSkipped(t *testing.T) {
+	t.Setenv(Env, "")
+	var skipped bool
+	t.Run("main", func(t *testing.T) {
+		defer func() { skipped = t.Skipped() }()
+		Main(t, func() []Result {
+			t.Error("the benchmarks run without " + Env)
+			return nil
+		})
+	})
+	require.True(t, skipped)
+}
@@ -0,0 +1,46 @@
+// Package vtbuf provides the pooled output buffers returned by the MarshalVTBuffer
+// methods generated by protoc-gen-go-vtproto.
+package vtbuf
+
+import "github.com/planetscale/vtprotobuf/protohelpers"
+
+// Buffer holds the output of a MarshalVTBuffer call. Its memory is handed back to
+// the buffer pools of protohelpers.GetBuffer by Release, so Bytes must not be
+// retained afterwards.
+type Buffer []byte
+
+// Get returns a buffer of length size from the pool of its size class.
+func Get(size int) *Buffer {
+	return (*Buffer)(protohelpers.GetBuffer(size))
+}
+
+// Bytes returns the content of the buffer. It is only valid until Release is called.
+func (buf *Buffer) Bytes() []byte {
+	if buf == nil {
+		return nil
+	}
+	return *buf
+}
+
+// Len returns the length of the content of the buffer.
+func (buf *Buffer) Len() int {
+	if buf == nil {
+		return 0
+	}
+	return len(*buf)
+}
+
+// Copy returns a copy of the content of the buffer that remains valid after Release,
+// like the slice returned by MarshalVT.
+func (buf *Buffer) Copy() []byte {
+	if buf == nil {
+		return nil
+	}
+	return append([]byte(nil), *buf...)
+}
+
+// Release returns the buffer to its pool. Neither the buffer nor the slices returned
+// by Bytes can be used after calling Release. Releasing a nil buffer is a no-op.
+func (buf *Buffer) Release() {
+	protohelpers.PutBuffer((*[]byte)(buf))
+}
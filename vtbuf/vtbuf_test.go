@@ -0,0 +1,39 @@
+package vtbuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	for _, size := range []int{0, 1, 64, 65, 1000, 1 << 24, 1<<24 + 1} {
+		buf := Get(size)
+		require.Equal(t, size, buf.Len())
+		require.Len(t, buf.Bytes(), size)
+		if size <= 1<<24 {
+			require.Zero(t, cap(buf.Bytes())&(cap(buf.Bytes())-1), "capacity %d is not a size class", cap(buf.Bytes()))
+		}
+		buf.Release()
+	}
+}
+
+func TestCopy(t *testing.T) {
+	buf := Get(3)
+	copy(buf.Bytes(), "abc")
+	data := buf.Copy()
+	buf.Release()
+
+	reused := Get(3)
+	copy(reused.Bytes(), "xyz")
+	require.Equal(t, []byte("abc"), data)
+	reused.Release()
+}
+
+func TestNilBuffer(t *testing.T) {
+	var buf *Buffer
+	require.Nil(t, buf.Bytes())
+	require.Nil(t, buf.Copy())
+	require.Zero(t, buf.Len())
+	buf.Release()
+}
@@ -0,0 +1,178 @@
+// Package vtenvelope wraps messages into self-describing envelopes, which carry the full
+// name of the type of a message along with its encoding, so that the queues, logs and
+// caches holding messages of several types can decode them without knowing their type in
+// advance.
+//
+// An envelope is encoded like a google.protobuf.Any, with the type URL of the message,
+// URLPrefix followed by its full name, in field 1 and the encoding of the message in
+// field 2: envelopes can be decoded as Any messages, by other languages too, and the
+// encoded Any messages opened as envelopes.
+//
+// The messages are encoded with their vtprotobuf helpers, and decoded with the helpers
+// registered in vtregistry, from the memory pool of their type when it has one. The types
+// generated without the registry option must be registered with vtregistry.Register to
+// be decoded.
+package vtenvelope
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+	"github.com/planetscale/vtprotobuf/vtregistry"
+)
+
+// URLPrefix is the prefix of the type URLs of the envelopes, the default of anypb.New.
+const URLPrefix = "type.googleapis.com/"
+
+const (
+	typeURLField = 1
+	valueField   = 2
+)
+
+// Marshal returns the envelope of m.
+func Marshal(m proto.Message) ([]byte, error) {
+	return Append(nil, m)
+}
+
+// Append appends the envelope of m to b.
+func Append(b []byte, m proto.Message) ([]byte, error) {
+	name := m.ProtoReflect().Descriptor().FullName()
+	b = protowire.AppendTag(b, typeURLField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(len(URLPrefix)+len(name)))
+	b = append(b, URLPrefix...)
+	b = append(b, name...)
+
+	vt, ok := m.(vtproto.SizedMarshaler)
+	if !ok {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		return appendValue(b, data), nil
+	}
+	size := vt.SizeVT()
+	if size == 0 {
+		// Like Any, the empty value is left out.
+		return b, nil
+	}
+	b = protowire.AppendTag(b, valueField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(size))
+	b = slices.Grow(b, size)
+	if _, err := vt.MarshalToSizedBufferVT(b[len(b) : len(b)+size]); err != nil {
+		return nil, err
+	}
+	return b[:len(b)+size], nil
+}
+
+func appendValue(b, data []byte) []byte {
+	if len(data) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, valueField, protowire.BytesType)
+	return protowire.AppendBytes(b, data)
+}
+
+// ErrInvalidEnvelope is returned when the data of an envelope cannot be decoded.
+var ErrInvalidEnvelope = errors.New("vtenvelope: invalid envelope")
+
+// Open returns the full name of the type of the message in the envelope held by data and
+// the encoding of the message, which refers to data, without decoding the message, e.g.
+// to route it.
+func Open(data []byte) (protoreflect.FullName, []byte, error) {
+	var url string
+	var value []byte
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return "", nil, ErrInvalidEnvelope
+		}
+		data = data[n:]
+		if (num == typeURLField || num == valueField) && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return "", nil, ErrInvalidEnvelope
+			}
+			if num == typeURLField {
+				url = string(v)
+			} else {
+				value = v
+			}
+			data = data[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return "", nil, ErrInvalidEnvelope
+		}
+		data = data[n:]
+	}
+	if url == "" {
+		return "", nil, fmt.Errorf("%w: no type URL", ErrInvalidEnvelope)
+	}
+	name := protoreflect.FullName(url[strings.LastIndexByte(url, '/')+1:])
+	if !name.IsValid() {
+		return "", nil, fmt.Errorf("%w: invalid type URL %q", ErrInvalidEnvelope, url)
+	}
+	return name, value, nil
+}
+
+// Unmarshal decodes the message in the envelope held by data into a new message of its
+// type, taken from the memory pool of the type if it has one. The decoded message can be
+// returned to its pool with Release once it is not used anymore.
+func Unmarshal(data []byte) (proto.Message, error) {
+	name, value, err := Open(data)
+	if err != nil {
+		return nil, err
+	}
+	caps, ok := vtregistry.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("vtenvelope: message type %s is not registered", name)
+	}
+	var m proto.Message
+	if caps.FromVTPool != nil {
+		m = caps.FromVTPool()
+	} else {
+		m = caps.New()
+	}
+	if caps.UnmarshalVT != nil {
+		err = caps.UnmarshalVT(m, value)
+	} else {
+		err = proto.Unmarshal(value, m)
+	}
+	if err != nil {
+		Release(m)
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalTo decodes the message in the envelope held by data into m, which must be an
+// empty message of the type of the message.
+func UnmarshalTo(data []byte, m proto.Message) error {
+	name, value, err := Open(data)
+	if err != nil {
+		return err
+	}
+	if want := m.ProtoReflect().Descriptor().FullName(); name != want {
+		return fmt.Errorf("vtenvelope: envelope holds a %s, not a %s", name, want)
+	}
+	if vt, ok := m.(vtproto.Unmarshaler); ok {
+		return vt.UnmarshalVT(value)
+	}
+	return proto.Unmarshal(value, m)
+}
+
+// Release returns m to the memory pool of its type, if it has one. m must not be used
+// anymore.
+func Release(m proto.Message) {
+	if pooled, ok := m.(interface{ ReturnToVTPool() }); ok {
+		pooled.ReturnToVTPool()
+	}
+}
@@ -0,0 +1,95 @@
+package vtenvelope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/apipb"
+
+	"github.com/planetscale/vtprotobuf/testproto/registry"
+)
+
+func TestRoundTrip(t *testing.T) {
+	msg := &registry.Container{Local: &registry.Local{Name: "local"}, Api: &apipb.Api{Name: "api"}}
+	data, err := Marshal(msg)
+	require.NoError(t, err)
+
+	name, value, err := Open(data)
+	require.NoError(t, err)
+	require.Equal(t, protoreflect.FullName("registry.Container"), name)
+	require.Equal(t, msg.SizeVT(), len(value))
+
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.IsType(t, &registry.Container{}, got)
+	require.True(t, msg.EqualVT(got.(*registry.Container)))
+
+	into := &registry.Container{}
+	require.NoError(t, UnmarshalTo(data, into))
+	require.True(t, msg.EqualVT(into))
+	require.ErrorContains(t, UnmarshalTo(data, &registry.Local{}), "envelope holds a registry.Container, not a registry.Local")
+
+	// Empty messages have no value, like in an Any.
+	data, err = Append([]byte("prefix"), &registry.Local{})
+	require.NoError(t, err)
+	got, err = Unmarshal(data[len("prefix"):])
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&registry.Local{}, got))
+}
+
+func TestAny(t *testing.T) {
+	msg := &registry.Local{Name: "any"}
+	data, err := Marshal(msg)
+	require.NoError(t, err)
+	packed := &anypb.Any{}
+	require.NoError(t, proto.Unmarshal(data, packed))
+	got, err := packed.UnmarshalNew()
+	require.NoError(t, err)
+	require.True(t, proto.Equal(msg, got))
+
+	packed, err = anypb.New(msg)
+	require.NoError(t, err)
+	data, err = proto.Marshal(packed)
+	require.NoError(t, err)
+	got, err = Unmarshal(data)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(msg, got))
+}
+
+func TestPooled(t *testing.T) {
+	data, err := Marshal(&registry.Pooled{Name: "pooled", Tags: []string{"a", "b"}})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		got, err := Unmarshal(data)
+		require.NoError(t, err)
+		require.Equal(t, "pooled", got.(*registry.Pooled).Name)
+		require.Equal(t, []string{"a", "b"}, got.(*registry.Pooled).Tags)
+		Release(got)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"truncated":    {0x0a, 0x05, 'a'},
+		"no type URL":  {0x12, 0x00},
+		"invalid name": append([]byte{0x0a, 0x03}, "a/-"...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Unmarshal(data)
+			require.ErrorIs(t, err, ErrInvalidEnvelope)
+		})
+	}
+
+	data, err := Marshal(&apipb.Api{Name: "api"})
+	require.NoError(t, err)
+	_, err = Unmarshal(data)
+	require.EqualError(t, err, "vtenvelope: message type google.protobuf.Api is not registered")
+
+	data, err = Marshal(&registry.Local{Name: "local"})
+	require.NoError(t, err)
+	_, err = Unmarshal(data[:len(data)-1])
+	require.ErrorIs(t, err, ErrInvalidEnvelope)
+}
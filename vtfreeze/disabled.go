@@ -0,0 +1,6 @@
+//go:build !vtfreeze
+
+package vtfreeze
+
+// Enabled reports whether freezing is enabled, which requires the vtfreeze build tag.
+const Enabled = false
@@ -0,0 +1,9 @@
+package vtfreeze
+
+import "google.golang.org/protobuf/proto"
+
+// The tests run without the vtfreeze tag, so they call the functions behind Freeze and Check.
+
+func FreezeEnabled(m proto.Message) { freeze(m.ProtoReflect()) }
+
+func CheckEnabled(m proto.Message) { check(m) }
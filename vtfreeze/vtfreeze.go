@@ -0,0 +1,99 @@
+// Package vtfreeze detects the modification of messages that are meant to be immutable,
+// such as messages shared across goroutines once published. It backs the FreezeVT
+// methods generated by the freeze feature of protoc-gen-go-vtproto.
+//
+// Freezing is a debugging aid: it is only enabled in builds with the vtfreeze tag, and
+// is a no-op otherwise. Once frozen, a message is compared with a snapshot taken by
+// FreezeVT each time it is marshaled, and the marshaling panics if they differ.
+package vtfreeze
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// snapshots holds the snapshot of each frozen message, by address. Messages are not
+// referenced by the map, so that frozen messages can still be collected. finalized holds
+// the addresses of the messages whose finalizer drops their snapshot, which is only set
+// once even when they are thawed and frozen again.
+var snapshots, finalized sync.Map
+
+// Freeze marks m and the messages it holds as immutable.
+func Freeze(m proto.Message) {
+	if Enabled && m != nil {
+		freeze(m.ProtoReflect())
+	}
+}
+
+// Thaw drops the snapshots of m and of the messages it holds, so that they can be modified
+// again, e.g. when they are reset to be reused by a pool.
+func Thaw(m proto.Message) {
+	if Enabled && m != nil {
+		walk(m.ProtoReflect(), func(msg proto.Message) {
+			snapshots.Delete(reflect.ValueOf(msg).Pointer())
+		})
+	}
+}
+
+// Check panics if m was frozen and has been modified since.
+func Check(m proto.Message) {
+	if Enabled && m != nil {
+		check(m)
+	}
+}
+
+func freeze(m protoreflect.Message) {
+	walk(m, func(msg proto.Message) {
+		addr := reflect.ValueOf(msg).Pointer()
+		snapshots.Store(addr, proto.Clone(msg))
+		if _, set := finalized.LoadOrStore(addr, true); !set {
+			runtime.SetFinalizer(msg, func(any) {
+				snapshots.Delete(addr)
+				finalized.Delete(addr)
+			})
+		}
+	})
+}
+
+// walk calls f for m and for the messages it holds.
+func walk(m protoreflect.Message, f func(proto.Message)) {
+	if !m.IsValid() {
+		return
+	}
+	f(m.Interface())
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				walk(list.Get(i).Message(), f)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					walk(v.Message(), f)
+					return true
+				})
+			}
+		default:
+			walk(v.Message(), f)
+		}
+		return true
+	})
+}
+
+func check(m proto.Message) {
+	snapshot, frozen := snapshots.Load(reflect.ValueOf(m).Pointer())
+	if frozen && !proto.Equal(m, snapshot.(proto.Message)) {
+		panic(fmt.Sprintf("vtfreeze: %s was modified after being frozen", m.ProtoReflect().Descriptor().FullName()))
+	}
+}
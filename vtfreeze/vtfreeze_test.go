@@ -0,0 +1,85 @@
+package vtfreeze_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+	"github.com/planetscale/vtprotobuf/testproto/reuse"
+	"github.com/planetscale/vtprotobuf/vtfreeze"
+)
+
+func TestFreeze(t *testing.T) {
+	child := &reuse.Item{Name: "child"}
+	msg := &reuse.Batch{Items: []*reuse.Item{{Name: "a", Child: child}}}
+	vtfreeze.FreezeEnabled(msg)
+	vtfreeze.CheckEnabled(msg)
+	vtfreeze.CheckEnabled(child)
+
+	child.Name = "changed"
+	require.PanicsWithValue(t, "vtfreeze: Item was modified after being frozen", func() { vtfreeze.CheckEnabled(child) })
+	require.PanicsWithValue(t, "vtfreeze: Batch was modified after being frozen", func() { vtfreeze.CheckEnabled(msg) })
+
+	// Freezing again takes a new snapshot.
+	vtfreeze.FreezeEnabled(msg)
+	vtfreeze.CheckEnabled(msg)
+
+	// Messages that are not frozen can be modified.
+	other := &reuse.Item{}
+	other.Name = "other"
+	vtfreeze.CheckEnabled(other)
+}
+
+func TestFreezeDisabled(t *testing.T) {
+	if vtfreeze.Enabled {
+		t.Skip("built with the vtfreeze tag")
+	}
+	msg := &reuse.Item{Name: "a"}
+	msg.FreezeVT()
+	msg.Name = "b"
+	_, err := msg.MarshalVT()
+	require.NoError(t, err)
+}
+
+func TestFreezeVT(t *testing.T) {
+	if !vtfreeze.Enabled {
+		t.Skip("requires the vtfreeze tag")
+	}
+	msg := &reuse.Batch{Items: []*reuse.Item{{Name: "a"}}}
+	msg.FreezeVT()
+	_, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	msg.Items[0].Name = "b"
+	require.Panics(t, func() { _, _ = msg.MarshalVT() })
+	require.Panics(t, func() { _, _ = msg.Items[0].MarshalVT() })
+}
+
+func TestFreezePooled(t *testing.T) {
+	if !vtfreeze.Enabled {
+		t.Skip("requires the vtfreeze tag")
+	}
+	msg := pool.MemoryPoolExtensionFromVTPool()
+	msg.Foo1 = "a"
+	msg.Foo3 = pool.OptionalMessageFromVTPool()
+	msg.FreezeVT()
+	msg.ReturnToVTPool()
+
+	// The messages taken from the pool again are not frozen anymore, whether they are the
+	// one that was frozen or not.
+	for i := 0; i < 2; i++ {
+		msg = pool.MemoryPoolExtensionFromVTPool()
+		msg.Foo1 = "b"
+		msg.Foo3 = pool.OptionalMessageFromVTPool()
+		_, err := msg.MarshalVT()
+		require.NoError(t, err)
+		msg.ReturnToVTPool()
+	}
+
+	// They can be frozen again.
+	msg = pool.MemoryPoolExtensionFromVTPool()
+	msg.FreezeVT()
+	msg.Foo1 = "c"
+	require.Panics(t, func() { _, _ = msg.MarshalVT() })
+}
@@ -0,0 +1,175 @@
+// Package vthash computes stable hashes of the contents of messages, which equal messages
+// share whatever the order of their map entries, for deduplication and cache keys
+// without marshaling the messages first.
+//
+// The hashes do not depend on the process nor on the platform: they only depend on the
+// seed and on the values of the fields, so that they can be stored. They are not
+// cryptographic, and must not be relied on when the messages may have been built to
+// collide.
+//
+// The functions of the package mix the values of fields into a hash, for the HashVT
+// methods generated by the hash feature. Fields with implicit presence holding their
+// zero value are not mixed, and -0 and +0 are the same float, so that the messages that
+// EqualVT reports as equal have the same hash.
+package vthash
+
+import (
+	"math"
+	"math/bits"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Hasher is implemented by the messages generated with the hash feature. HashVT returns
+// seed for empty and nil messages.
+type Hasher interface {
+	HashVT(seed uint64) uint64
+}
+
+const (
+	k0 = 0xa0761d6478bd642f
+	k1 = 0xe7037ed1a0b428db
+	k2 = 0x8ebc6af09c88c6e3
+)
+
+// mix is the multiply-and-fold step of wyhash.
+func mix(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return hi ^ lo
+}
+
+// Field mixes the number of a field into h, before the message it holds is hashed
+// from the result.
+func Field(h uint64, num uint32) uint64 {
+	return mix(h^k0, uint64(num)^k1)
+}
+
+// Uint64 mixes the field num holding v into h. The other integers and the enums are
+// converted to uint64.
+func Uint64(h uint64, num uint32, v uint64) uint64 {
+	return mix(Field(h, num)^k2, v^k1)
+}
+
+// Bool mixes the field num holding v into h.
+func Bool(h uint64, num uint32, v bool) uint64 {
+	var u uint64
+	if v {
+		u = 1
+	}
+	return Uint64(h, num, u)
+}
+
+// Double mixes the double field num holding v into h.
+func Double(h uint64, num uint32, v float64) uint64 {
+	if v == 0 {
+		// -0 equals +0.
+		v = 0
+	}
+	return Uint64(h, num, math.Float64bits(v))
+}
+
+// Float mixes the float field num holding v into h.
+func Float(h uint64, num uint32, v float32) uint64 {
+	return Double(h, num, float64(v))
+}
+
+// String mixes the string field num holding v into h.
+func String(h uint64, num uint32, v string) uint64 {
+	return hashBytes(h, num, v)
+}
+
+// Bytes mixes the bytes field num holding v into h.
+func Bytes(h uint64, num uint32, v []byte) uint64 {
+	return hashBytes(h, num, v)
+}
+
+func hashBytes[T string | []byte](h uint64, num uint32, b T) uint64 {
+	h = Uint64(h, num, uint64(len(b)))
+	for ; len(b) >= 8; b = b[8:] {
+		v := uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 |
+			uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56
+		h = mix(h^k0, v^k1)
+	}
+	if len(b) > 0 {
+		var v uint64
+		for i := 0; i < len(b); i++ {
+			v |= uint64(b[i]) << (8 * i)
+		}
+		h = mix(h^k0, v^k2)
+	}
+	return h
+}
+
+// Message returns the hash of m from h, with its HashVT method if it has one, and by
+// walking its fields with reflection otherwise, e.g. for the messages of other packages.
+// Their fields are combined in any order, and their unknown fields are not hashed.
+func Message(h uint64, m proto.Message) uint64 {
+	if m == nil {
+		return h
+	}
+	if vt, ok := m.(Hasher); ok {
+		return vt.HashVT(h)
+	}
+	return reflectMessage(h, m.ProtoReflect())
+}
+
+func reflectMessage(h uint64, m protoreflect.Message) uint64 {
+	if !m.IsValid() {
+		return h
+	}
+	var sum uint64
+	var set bool
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		// Range visits the fields in an undefined order.
+		sum += reflectField(h, fd, v)
+		set = true
+		return true
+	})
+	if !set {
+		return h
+	}
+	return Uint64(h, 0, sum)
+}
+
+func reflectField(h uint64, fd protoreflect.FieldDescriptor, v protoreflect.Value) uint64 {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			h = reflectValue(h, fd, list.Get(i))
+		}
+		return h
+	case fd.IsMap():
+		var sum uint64
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			sum += reflectValue(reflectValue(h, fd.MapKey(), k.Value()), fd.MapValue(), v)
+			return true
+		})
+		return Uint64(h, uint32(fd.Number()), sum)
+	}
+	return reflectValue(h, fd, v)
+}
+
+func reflectValue(h uint64, fd protoreflect.FieldDescriptor, v protoreflect.Value) uint64 {
+	num := uint32(fd.Number())
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return Bool(h, num, v.Bool())
+	case protoreflect.EnumKind:
+		return Uint64(h, num, uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return Uint64(h, num, uint64(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return Uint64(h, num, v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return Double(h, num, v.Float())
+	case protoreflect.StringKind:
+		return String(h, num, v.String())
+	case protoreflect.BytesKind:
+		return Bytes(h, num, v.Bytes())
+	default:
+		return Message(Field(h, num), v.Message().Interface())
+	}
+}
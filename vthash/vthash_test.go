@@ -0,0 +1,34 @@
+package vthash
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestScalars(t *testing.T) {
+	require.Equal(t, Double(1, 2, 0), Double(1, 2, math.Copysign(0, -1)))
+	require.Equal(t, Double(1, 2, 1.5), Float(1, 2, 1.5))
+	require.Equal(t, String(1, 2, "abcdefghij"), Bytes(1, 2, []byte("abcdefghij")))
+	require.NotEqual(t, String(1, 2, "a"), String(1, 3, "a"))
+	require.NotEqual(t, String(1, 2, ""), String(1, 2, "\x00"))
+	require.NotEqual(t, Uint64(1, 2, 3), Uint64(2, 2, 3))
+	require.Equal(t, Bool(1, 2, true), Uint64(1, 2, 1))
+}
+
+func TestMessage(t *testing.T) {
+	require.Equal(t, uint64(5), Message(5, nil))
+	require.Equal(t, uint64(5), Message(5, (*durationpb.Duration)(nil)))
+	require.Equal(t, uint64(5), Message(5, &durationpb.Duration{}))
+	require.NotEqual(t, Message(5, &durationpb.Duration{Seconds: 1}), Message(5, &durationpb.Duration{Nanos: 1}))
+
+	list, err := structpb.NewList([]any{"a", 1.0, map[string]any{"x": true, "y": nil}})
+	require.NoError(t, err)
+	same, err := structpb.NewList([]any{"a", 1.0, map[string]any{"y": nil, "x": true}})
+	require.NoError(t, err)
+	require.Equal(t, Message(0, list), Message(0, same))
+	require.NotEqual(t, Message(0, list), Message(1, same))
+}
@@ -0,0 +1,117 @@
+// Package vtintern deduplicates identical immutable messages, such as configuration
+// objects decoded over and over again, into a single shared instance.
+package vtintern
+
+import (
+	"hash/maphash"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"weak"
+
+	"github.com/planetscale/vtprotobuf/vthash"
+)
+
+// Message is implemented by pointers to messages generated with the marshal and equal
+// features.
+type Message[T any] interface {
+	*T
+	MarshalVT() ([]byte, error)
+	EqualVT(*T) bool
+}
+
+// Hasher is implemented by the messages generated with the hash feature, whose hash is
+// then used instead of hashing their encoding.
+type Hasher = vthash.Hasher
+
+// Registry holds the shared instances of messages of type T. Instances are only
+// referenced weakly, and are dropped from the registry once they are not used anymore.
+// The zero value is not ready to use: registries are created with New.
+type Registry[T any, P Message[T]] struct {
+	seed      maphash.Seed
+	hashSeed  uint64
+	mu        sync.Mutex
+	instances map[uint64][]weak.Pointer[T]
+}
+
+// New returns an empty registry for messages of type T, e.g.:
+//
+//	var configs = vtintern.New[pb.Config]()
+func New[T any, P Message[T]]() *Registry[T, P] {
+	return &Registry[T, P]{
+		seed:      maphash.MakeSeed(),
+		hashSeed:  rand.Uint64(),
+		instances: make(map[uint64][]weak.Pointer[T]),
+	}
+}
+
+// Intern returns the shared instance of the messages equal to m. If there is none, m
+// becomes the shared instance. Interned messages must not be modified: they are shared
+// by all the callers of Intern for equal messages.
+//
+// Messages are hashed by their encoding unless they implement Hasher. Since map fields
+// are encoded in an unspecified order, equal messages holding several map entries may
+// not be deduplicated, unless they are generated with the hash feature.
+func (r *Registry[T, P]) Intern(m P) (P, error) {
+	if m == nil {
+		return nil, nil
+	}
+	h, err := r.hash(m)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, wp := range r.instances[h] {
+		if instance := P(wp.Value()); instance != nil && instance.EqualVT(m) {
+			return instance, nil
+		}
+	}
+	r.instances[h] = append(r.instances[h], weak.Make((*T)(m)))
+	runtime.AddCleanup((*T)(m), r.prune, h)
+	return m, nil
+}
+
+// Len returns the number of shared instances held by the registry.
+func (r *Registry[T, P]) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for _, bucket := range r.instances {
+		for _, wp := range bucket {
+			if wp.Value() != nil {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func (r *Registry[T, P]) hash(m P) (uint64, error) {
+	if h, ok := any(m).(Hasher); ok {
+		return h.HashVT(r.hashSeed), nil
+	}
+	data, err := m.MarshalVT()
+	if err != nil {
+		return 0, err
+	}
+	return maphash.Bytes(r.seed, data), nil
+}
+
+// prune drops the collected instances of the bucket of hash h.
+func (r *Registry[T, P]) prune(h uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bucket := r.instances[h][:0]
+	for _, wp := range r.instances[h] {
+		if wp.Value() != nil {
+			bucket = append(bucket, wp)
+		}
+	}
+	if len(bucket) == 0 {
+		delete(r.instances, h)
+	} else {
+		r.instances[h] = bucket
+	}
+}
@@ -0,0 +1,43 @@
+package vtintern
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/reuse"
+)
+
+func TestIntern(t *testing.T) {
+	items := New[reuse.Item]()
+
+	first, err := items.Intern(&reuse.Item{Name: "a", Values: []int64{1, 2}})
+	require.NoError(t, err)
+	second, err := items.Intern(&reuse.Item{Name: "a", Values: []int64{1, 2}})
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	other, err := items.Intern(&reuse.Item{Name: "b"})
+	require.NoError(t, err)
+	require.NotSame(t, first, other)
+	require.Equal(t, 2, items.Len())
+
+	none, err := items.Intern(nil)
+	require.NoError(t, err)
+	require.Nil(t, none)
+	runtime.KeepAlive(first)
+	runtime.KeepAlive(other)
+}
+
+func TestInternDropsUnusedInstances(t *testing.T) {
+	items := New[reuse.Item]()
+	_, err := items.Intern(&reuse.Item{Name: "unused"})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		runtime.GC()
+		return items.Len() == 0
+	}, time.Second, time.Millisecond)
+}
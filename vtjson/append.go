@@ -0,0 +1,157 @@
+package vtjson
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Appender is implemented by the messages generated with the json feature, whose
+// MarshalJSONVT method returns AppendJSONVT(nil).
+type Appender interface {
+	AppendJSONVT(b []byte) ([]byte, error)
+}
+
+// ErrInvalidUTF8 is returned when encoding a string that is not valid UTF-8, which
+// protojson rejects as well.
+var ErrInvalidUTF8 = errors.New("vtjson: invalid UTF-8")
+
+// The following functions append the values of fields to b as protojson encodes them
+// without whitespace, for the code generated by the json feature.
+
+// AppendString appends s quoted.
+func AppendString(b []byte, s string) ([]byte, error) {
+	if !utf8.ValidString(s) {
+		return b, ErrInvalidUTF8
+	}
+	// protojson escapes the same characters as the canonical form.
+	return appendString(b, s), nil
+}
+
+// AppendFloat appends f, a float or a double field as given by bitSize, as a number, or
+// as one of the strings "NaN", "Infinity" and "-Infinity".
+func AppendFloat(b []byte, f float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, `"NaN"`...)
+	case math.IsInf(f, 1):
+		return append(b, `"Infinity"`...)
+	case math.IsInf(f, -1):
+		return append(b, `"-Infinity"`...)
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	start := len(b)
+	b = strconv.AppendFloat(b, f, format, -1, bitSize)
+	// Like appendNumber, the exponent is not padded.
+	if n := len(b); format == 'e' && n-start >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+		b[n-2] = b[n-1]
+		b = b[:n-1]
+	}
+	return b
+}
+
+// AppendBytes appends v as a string, in standard base64 with padding.
+func AppendBytes(b []byte, v []byte) []byte {
+	b = append(b, '"')
+	b = base64.StdEncoding.AppendEncode(b, v)
+	return append(b, '"')
+}
+
+const (
+	maxTimestampSeconds  = 253402300799
+	minTimestampSeconds  = -62135596800
+	maxSecondsInDuration = 315576000000
+	maxNanos             = 999999999
+)
+
+// AppendTimestamp appends ts as an RFC 3339 date in UTC, with 0, 3, 6 or 9 fractional
+// digits. A nil ts is the Unix epoch.
+func AppendTimestamp(b []byte, ts *timestamppb.Timestamp) ([]byte, error) {
+	secs, nanos := ts.GetSeconds(), ts.GetNanos()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return b, fmt.Errorf("proto: google.protobuf.Timestamp: seconds out of range %v", secs)
+	}
+	if nanos < 0 || nanos > maxNanos {
+		return b, fmt.Errorf("proto: google.protobuf.Timestamp: nanos out of range %v", nanos)
+	}
+	b = append(b, '"')
+	b = time.Unix(secs, int64(nanos)).UTC().AppendFormat(b, "2006-01-02T15:04:05.000000000")
+	b = trimFraction(b)
+	return append(b, 'Z', '"'), nil
+}
+
+// AppendDuration appends d as a number of seconds with 0, 3, 6 or 9 fractional digits,
+// followed by "s". A nil d is a zero duration.
+func AppendDuration(b []byte, d *durationpb.Duration) ([]byte, error) {
+	secs, nanos := d.GetSeconds(), int64(d.GetNanos())
+	if secs < -maxSecondsInDuration || secs > maxSecondsInDuration {
+		return b, fmt.Errorf("proto: google.protobuf.Duration: seconds out of range %v", secs)
+	}
+	if nanos < -maxNanos || nanos > maxNanos {
+		return b, fmt.Errorf("proto: google.protobuf.Duration: nanos out of range %v", nanos)
+	}
+	if (secs > 0 && nanos < 0) || (secs < 0 && nanos > 0) {
+		return b, errors.New("proto: google.protobuf.Duration: signs of seconds and nanos do not match")
+	}
+	b = append(b, '"')
+	if secs < 0 || nanos < 0 {
+		b = append(b, '-')
+		secs, nanos = -secs, -nanos
+	}
+	b = strconv.AppendInt(b, secs, 10)
+	b = append(b, '.')
+	for div := int64(1e8); div > 0; div /= 10 {
+		b = append(b, byte('0'+nanos/div%10))
+	}
+	b = trimFraction(b)
+	return append(b, 's', '"'), nil
+}
+
+// trimFraction trims the nine fractional digits ending b to the 0, 3, 6 or 9 needed.
+func trimFraction(b []byte) []byte {
+	for i := 0; i < 2 && bytes.HasSuffix(b, []byte("000")); i++ {
+		b = b[:len(b)-3]
+	}
+	return bytes.TrimSuffix(b, []byte(".000"))
+}
+
+// AppendMessage appends m, with its AppendJSONVT method if it has one and with
+// AppendProto otherwise, e.g. for the messages of other packages.
+func AppendMessage(b []byte, m proto.Message) ([]byte, error) {
+	if vt, ok := m.(Appender); ok {
+		return vt.AppendJSONVT(b)
+	}
+	return AppendProto(b, m)
+}
+
+// AppendProto appends m as protojson.Marshal encodes it, without whitespace, for the
+// messages whose encoding is not generated: google.protobuf.Any, Struct, Value, ListValue
+// and FieldMask, and the messages holding extensions.
+func AppendProto(b []byte, m proto.Message) ([]byte, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return b, err
+	}
+	buf := bytes.NewBuffer(b)
+	if err := json.Compact(buf, data); err != nil {
+		return b, err
+	}
+	return buf.Bytes(), nil
+}
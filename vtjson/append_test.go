@@ -0,0 +1,45 @@
+package vtjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/reuse"
+)
+
+// fastAppender has an AppendJSONVT method, as generated by the json feature.
+type fastAppender struct {
+	*reuse.Item
+}
+
+func (m fastAppender) AppendJSONVT(b []byte) ([]byte, error) {
+	return append(b, `{"fast":true}`...), nil
+}
+
+func TestAppendMessage(t *testing.T) {
+	b, err := AppendMessage([]byte("["), &reuse.Item{Name: "a", Values: []int64{1, 2}})
+	require.NoError(t, err)
+	require.Equal(t, `[{"name":"a","values":["1","2"]}`, string(b))
+
+	b, err = AppendMessage(b, fastAppender{&reuse.Item{}})
+	require.NoError(t, err)
+	require.Equal(t, `[{"name":"a","values":["1","2"]}{"fast":true}`, string(b))
+}
+
+func TestAppendFloat(t *testing.T) {
+	for _, tt := range []struct {
+		f       float64
+		bitSize int
+		want    string
+	}{
+		{0, 64, "0"},
+		{1e-7, 64, "1e-7"},
+		{1e21, 64, "1e+21"},
+		{1e20, 64, "100000000000000000000"},
+		{float64(float32(0.1)), 32, "0.1"},
+		{float64(float32(0.1)), 64, "0.10000000149011612"},
+	} {
+		require.Equal(t, tt.want, string(AppendFloat(nil, tt.f, tt.bitSize)))
+	}
+}
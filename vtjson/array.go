@@ -0,0 +1,90 @@
+package vtjson
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Marshaler is implemented by the messages that encode themselves as JSON without
+// reflection.
+type Marshaler interface {
+	MarshalJSONVT() ([]byte, error)
+}
+
+// marshal returns the JSON encoding of m, with MarshalJSONVT if m has it and with
+// protojson otherwise.
+func marshal(m proto.Message) ([]byte, error) {
+	if vt, ok := m.(Marshaler); ok {
+		return vt.MarshalJSONVT()
+	}
+	return protojson.Marshal(m)
+}
+
+// ArrayWriter writes a JSON array of messages to an io.Writer one message at a time, so
+// that arrays of any length are written without holding them in memory.
+type ArrayWriter struct {
+	w   *bufio.Writer
+	n   int
+	err error
+}
+
+// NewArrayWriter returns an ArrayWriter writing the array to w, through a buffer. The
+// array is complete once Close returns.
+func NewArrayWriter(w io.Writer) *ArrayWriter {
+	return &ArrayWriter{w: bufio.NewWriter(w)}
+}
+
+// Write appends m to the array. The error of a failed Write is returned by all the
+// following calls.
+func (a *ArrayWriter) Write(m proto.Message) error {
+	if a.err != nil {
+		return a.err
+	}
+	data, err := marshal(m)
+	if err != nil {
+		a.err = err
+		return err
+	}
+	sep := byte(',')
+	if a.n == 0 {
+		sep = '['
+	}
+	a.w.WriteByte(sep)
+	_, a.err = a.w.Write(data)
+	a.n++
+	return a.err
+}
+
+// Close ends the array and flushes it. It does not close the underlying io.Writer.
+func (a *ArrayWriter) Close() error {
+	if a.err != nil {
+		return a.err
+	}
+	if a.n == 0 {
+		a.w.WriteByte('[')
+	}
+	a.w.WriteByte(']')
+	if a.err = a.w.Flush(); a.err == nil {
+		a.err = errClosed
+		return nil
+	}
+	return a.err
+}
+
+var errClosed = errors.New("vtjson: write to closed ArrayWriter")
+
+// EncodeArray writes msgs to w as a JSON array, encoding the messages one by one with
+// their MarshalJSONVT method, or protojson for the messages without it.
+func EncodeArray[T proto.Message](w io.Writer, msgs []T) error {
+	a := NewArrayWriter(w)
+	for _, m := range msgs {
+		if err := a.Write(m); err != nil {
+			return err
+		}
+	}
+	return a.Close()
+}
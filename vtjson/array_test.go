@@ -0,0 +1,59 @@
+package vtjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/reuse"
+)
+
+// fastItem implements Marshaler.
+type fastItem struct {
+	*reuse.Item
+}
+
+func (m fastItem) MarshalJSONVT() ([]byte, error) {
+	return []byte(`{"fast":true}`), nil
+}
+
+func TestEncodeArray(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, EncodeArray(&buf, []*reuse.Item{{Name: "a"}, {Name: "b", Values: []int64{1}}}))
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &items))
+	require.Equal(t, []map[string]any{{"name": "a"}, {"name": "b", "values": []any{"1"}}}, items)
+
+	buf.Reset()
+	require.NoError(t, EncodeArray[*reuse.Item](&buf, nil))
+	require.Equal(t, "[]", buf.String())
+
+	buf.Reset()
+	require.NoError(t, EncodeArray(&buf, []fastItem{{&reuse.Item{}}, {&reuse.Item{}}}))
+	require.Equal(t, `[{"fast":true},{"fast":true}]`, buf.String())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("failed")
+}
+
+func TestArrayWriter(t *testing.T) {
+	var buf bytes.Buffer
+	a := NewArrayWriter(&buf)
+	require.NoError(t, a.Write(&reuse.Item{Name: "a"}))
+	require.NoError(t, a.Write(fastItem{&reuse.Item{}}))
+	require.NoError(t, a.Close())
+	require.JSONEq(t, `[{"name":"a"},{"fast":true}]`, buf.String())
+	require.Error(t, a.Write(&reuse.Item{}))
+	require.Error(t, a.Close())
+
+	a = NewArrayWriter(failingWriter{})
+	require.NoError(t, a.Write(&reuse.Item{Name: "a"}))
+	require.EqualError(t, a.Close(), "failed")
+	require.EqualError(t, a.Write(&reuse.Item{}), "failed")
+}
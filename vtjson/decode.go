@@ -0,0 +1,863 @@
+package vtjson
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Unmarshaler is implemented by the messages generated with the json feature.
+type Unmarshaler interface {
+	UnmarshalJSONVT(data []byte) error
+}
+
+// Decodable is implemented by the messages generated with the json feature, whose
+// UnmarshalJSONVT method decodes them with DecodeJSONVT.
+type Decodable interface {
+	DecodeJSONVT(d *Decoder)
+}
+
+// UnmarshalOptions configures the decoding of messages, like protojson.UnmarshalOptions.
+type UnmarshalOptions struct {
+	// DiscardUnknown ignores the unknown fields and enum value names, which are
+	// rejected otherwise, like protojson.Unmarshal rejects them by default.
+	DiscardUnknown bool
+}
+
+// Unmarshal decodes the JSON encoding of a message held by data into m, after resetting
+// it, with the DecodeJSONVT method of m if it has one and with protojson otherwise.
+func (o UnmarshalOptions) Unmarshal(data []byte, m proto.Message) error {
+	vt, ok := m.(Decodable)
+	if !ok {
+		return protojson.UnmarshalOptions{DiscardUnknown: o.DiscardUnknown}.Unmarshal(data, m)
+	}
+	proto.Reset(m)
+	d := &Decoder{data: data, opts: o}
+	vt.DecodeJSONVT(d)
+	if d.err == nil {
+		d.space()
+		if d.pos < len(d.data) {
+			d.failf("unexpected data after the message")
+		}
+	}
+	return d.err
+}
+
+// maxDepth is the default recursion limit of protojson.
+const maxDepth = 10000
+
+// Decoder reads the JSON values of the fields of messages, for the DecodeJSONVT methods
+// generated by the json feature.
+//
+// The first error is kept and returned by Unmarshal: from then on the methods read zero
+// values and Next and NextItem return false, so that the generated code checks it once.
+type Decoder struct {
+	data  []byte
+	pos   int
+	err   error
+	opts  UnmarshalOptions
+	name  string
+	first bool
+	depth int
+}
+
+func (d *Decoder) failf(format string, args ...any) {
+	if d.err == nil {
+		d.err = fmt.Errorf("vtjson: offset %d: %s", d.pos, fmt.Sprintf(format, args...))
+	}
+}
+
+// invalid fails for the value of the given type ending at the current offset.
+func (d *Decoder) invalid(typ string, value []byte) {
+	d.failf("invalid value for %s: %s", typ, value)
+}
+
+// unexpected fails for the character at the current offset.
+func (d *Decoder) unexpected() {
+	if d.pos >= len(d.data) {
+		d.failf("unexpected end of JSON input")
+		return
+	}
+	d.failf("unexpected character %q", d.data[d.pos])
+}
+
+func (d *Decoder) space() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the first character of the next value, or 0 at the end of the data or
+// after an error.
+func (d *Decoder) peek() byte {
+	if d.err != nil {
+		return 0
+	}
+	d.space()
+	if d.pos >= len(d.data) {
+		return 0
+	}
+	return d.data[d.pos]
+}
+
+// Offset returns the offset of the next value in the data.
+func (d *Decoder) Offset() int {
+	return d.pos
+}
+
+// Object reads the opening of an object, whose members are then read in a loop on Next.
+func (d *Decoder) Object() {
+	d.open('{')
+}
+
+// Array reads the opening of an array, whose elements are then read in a loop on
+// NextItem.
+func (d *Decoder) Array() {
+	d.open('[')
+}
+
+func (d *Decoder) open(c byte) {
+	if d.peek() != c {
+		d.unexpected()
+		return
+	}
+	if d.depth++; d.depth > maxDepth {
+		d.failf("exceeded max recursion depth")
+		return
+	}
+	d.pos++
+	d.first = true
+}
+
+// Next reports whether the object has another member, whose name is then read by Name,
+// and reads the end of the object otherwise.
+func (d *Decoder) Next() bool {
+	return d.next('}')
+}
+
+// NextItem reports whether the array has another element and reads the end of the array
+// otherwise.
+func (d *Decoder) NextItem() bool {
+	return d.next(']')
+}
+
+func (d *Decoder) next(end byte) bool {
+	first := d.first
+	d.first = false
+	switch c := d.peek(); {
+	case d.err != nil:
+		return false
+	case c == end:
+		d.pos++
+		d.depth--
+		return false
+	case first:
+		return true
+	case c == ',':
+		d.pos++
+		return true
+	}
+	d.unexpected()
+	return false
+}
+
+// Name reads the name of the next member of an object, which refers to the data and
+// must not be retained.
+func (d *Decoder) Name() string {
+	b := d.str()
+	if d.peek() != ':' {
+		d.unexpected()
+		return ""
+	}
+	d.pos++
+	d.name = unsafe.String(unsafe.SliceData(b), len(b))
+	return d.name
+}
+
+// Unknown skips the value of the member whose name was read last, or fails as protojson
+// does for the unknown fields, unless they are discarded.
+func (d *Decoder) Unknown() {
+	if !d.opts.DiscardUnknown {
+		d.failf("unknown field %q", d.name)
+		return
+	}
+	d.skip()
+}
+
+// IsExtension returns true if the name read last is the name of an extension, in brackets.
+func (d *Decoder) IsExtension() bool {
+	return strings.HasPrefix(d.name, "[") && strings.HasSuffix(d.name, "]")
+}
+
+// Rewind decodes m, whose object starts at offset start, with protojson, for the
+// messages holding extensions, which only protojson resolves.
+func (d *Decoder) Rewind(start int, m proto.Message) {
+	if d.err != nil {
+		return
+	}
+	d.pos = start
+	d.depth--
+	d.ReadProto(m)
+}
+
+// Once reports whether the field i is read for the first time, and fails like protojson
+// for duplicate fields otherwise. seen holds a bit per field of the message.
+func (d *Decoder) Once(seen []uint64, i int) bool {
+	if seen[i/64]&(1<<(i%64)) != 0 {
+		d.failf("duplicate field %q", d.name)
+		return false
+	}
+	seen[i/64] |= 1 << (i % 64)
+	return true
+}
+
+// Oneof reports whether no member of the oneof i is read yet, and fails like protojson
+// otherwise. Its bit in seen follows the ones of the fields.
+func (d *Decoder) Oneof(seen []uint64, i int) bool {
+	if seen[i/64]&(1<<(i%64)) != 0 {
+		d.failf("field %q: a member of its oneof is already set", d.name)
+		return false
+	}
+	seen[i/64] |= 1 << (i % 64)
+	return true
+}
+
+// Duplicate fails for a key of a map read twice.
+func (d *Decoder) Duplicate() {
+	d.failf("duplicate map key %q", d.name)
+}
+
+// Missing fails for a required field which is not set.
+func (d *Decoder) Missing(field string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("proto: required field %s not set", field)
+	}
+}
+
+// Null reads a null and returns true if it is the next value.
+func (d *Decoder) Null() bool {
+	return d.peek() == 'n' && d.literal("null")
+}
+
+// literal reads lit if it is the next value.
+func (d *Decoder) literal(lit string) bool {
+	rest := d.data[d.pos:]
+	if !bytes.HasPrefix(rest, []byte(lit)) || len(rest) > len(lit) && !isDelim(rest[len(lit)]) {
+		return false
+	}
+	d.pos += len(lit)
+	return true
+}
+
+// isDelim returns true if c may follow a literal or a number.
+func isDelim(c byte) bool {
+	return !(c == '-' || c == '+' || c == '.' || c == '_' ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9')
+}
+
+// skip skips the next value, which must be valid.
+func (d *Decoder) skip() {
+	switch d.peek() {
+	case '{':
+		for d.Object(); d.Next(); {
+			d.Name()
+			d.skip()
+		}
+	case '[':
+		for d.Array(); d.NextItem(); {
+			d.skip()
+		}
+	case '"':
+		d.str()
+	case 't':
+		if !d.literal("true") {
+			d.unexpected()
+		}
+	case 'f':
+		if !d.literal("false") {
+			d.unexpected()
+		}
+	case 'n':
+		if !d.literal("null") {
+			d.unexpected()
+		}
+	default:
+		d.number()
+	}
+}
+
+// str reads a string and returns its value, which refers to the data unless it holds
+// escape sequences.
+func (d *Decoder) str() []byte {
+	if d.peek() != '"' {
+		d.unexpected()
+		return nil
+	}
+	start := d.pos + 1
+	for i := start; i < len(d.data); {
+		switch c := d.data[i]; {
+		case c == '"':
+			d.pos = i + 1
+			return d.data[start:i]
+		case c == '\\':
+			return d.unescape(append([]byte(nil), d.data[start:i]...), i)
+		case c < ' ':
+			d.pos = i
+			d.failf("invalid character %q in string", c)
+			return nil
+		case c < utf8.RuneSelf:
+			i++
+		default:
+			r, n := utf8.DecodeRune(d.data[i:])
+			if r == utf8.RuneError && n == 1 {
+				d.pos = i
+				d.failf("invalid UTF-8 in string")
+				return nil
+			}
+			i += n
+		}
+	}
+	d.pos = len(d.data)
+	d.unexpected()
+	return nil
+}
+
+// unescape appends the rest of the string starting at i to out, like protojson does.
+func (d *Decoder) unescape(out []byte, i int) []byte {
+	in := d.data
+	for i < len(in) {
+		switch r, n := utf8.DecodeRune(in[i:]); {
+		case r == utf8.RuneError && n == 1:
+			d.pos = i
+			d.failf("invalid UTF-8 in string")
+			return nil
+		case r < ' ':
+			d.pos = i
+			d.failf("invalid character %q in string", r)
+			return nil
+		case r == '"':
+			d.pos = i + 1
+			return out
+		case r != '\\':
+			out = append(out, in[i:i+n]...)
+			i += n
+			continue
+		}
+		d.pos = i
+		if i+1 >= len(in) {
+			d.pos = len(in)
+			d.unexpected()
+			return nil
+		}
+		switch c := in[i+1]; c {
+		case '"', '\\', '/':
+			out = append(out, c)
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'u':
+			r, ok := hex4(in[i+2:])
+			if !ok {
+				d.failf("invalid escape code in string")
+				return nil
+			}
+			i += 6
+			if utf16.IsSurrogate(r) {
+				low, ok := rune(0), false
+				if i+1 < len(in) && in[i] == '\\' && in[i+1] == 'u' {
+					low, ok = hex4(in[i+2:])
+				}
+				if r = utf16.DecodeRune(r, low); !ok || r == utf8.RuneError {
+					d.failf("invalid escape code in string")
+					return nil
+				}
+				i += 6
+			}
+			out = utf8.AppendRune(out, r)
+			continue
+		default:
+			d.failf("invalid escape code in string")
+			return nil
+		}
+		i += 2
+	}
+	d.pos = len(in)
+	d.unexpected()
+	return nil
+}
+
+func hex4(b []byte) (rune, bool) {
+	if len(b) < 4 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(string(b[:4]), 16, 16)
+	return rune(v), err == nil
+}
+
+// number reads a number and returns its text.
+func (d *Decoder) number() []byte {
+	d.space()
+	n := numberLen(d.data[d.pos:])
+	if n == 0 {
+		d.unexpected()
+		return nil
+	}
+	d.pos += n
+	return d.data[d.pos-n : d.pos]
+}
+
+// numberLen returns the length of the number starting b, as defined by RFC 7159, or 0.
+func numberLen(b []byte) int {
+	digits := func(i int) int {
+		for i < len(b) && '0' <= b[i] && b[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	i := 0
+	if i < len(b) && b[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(b) && b[i] == '0':
+		i++
+	case i < len(b) && '1' <= b[i] && b[i] <= '9':
+		i = digits(i)
+	default:
+		return 0
+	}
+	if i+1 < len(b) && b[i] == '.' && '0' <= b[i+1] && b[i+1] <= '9' {
+		i = digits(i + 1)
+	}
+	if i < len(b) && (b[i] == 'e' || b[i] == 'E') {
+		j := i + 1
+		if j < len(b) && (b[j] == '+' || b[j] == '-') {
+			j++
+		}
+		if k := digits(j); k > j {
+			i = k
+		}
+	}
+	if i < len(b) && !isDelim(b[i]) {
+		return 0
+	}
+	return i
+}
+
+// numberValue reads a number, or a string holding a number as protojson accepts them for
+// the numeric fields, and returns its text.
+func (d *Decoder) numberValue() ([]byte, bool) {
+	if d.peek() != '"' {
+		b := d.number()
+		return b, d.err == nil
+	}
+	b := d.str()
+	return b, d.err == nil && len(b) > 0 && numberLen(b) == len(b)
+}
+
+// integer returns the text of the number b in decimal notation, if it is an integer.
+func integer(b []byte) (string, bool) {
+	var neg bool
+	if b[0] == '-' {
+		neg, b = true, b[1:]
+	}
+	intp, frac, exp := b, []byte(nil), 0
+	if i := bytes.IndexAny(b, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(string(b[i+1:]), 10, 32)
+		if err != nil {
+			return "", false
+		}
+		intp, exp = b[:i], int(e)
+	}
+	if i := bytes.IndexByte(intp, '.'); i >= 0 {
+		intp, frac = intp[:i], bytes.TrimRight(intp[i+1:], "0")
+	}
+	intp = bytes.TrimLeft(intp, "0")
+	if len(intp) == 0 && len(frac) == 0 {
+		return "0", true
+	}
+	var num []byte
+	if exp >= 0 {
+		// Max uint64 value has 20 decimal digits.
+		if len(frac) > exp || len(intp)+exp > 20 {
+			return "", false
+		}
+		num = append(append(num, intp...), frac...)
+		for i := len(frac); i < exp; i++ {
+			num = append(num, '0')
+		}
+	} else {
+		index := len(intp) + exp
+		if len(frac) > 0 || index < 0 || len(bytes.TrimRight(intp[index:], "0")) > 0 {
+			return "", false
+		}
+		num = intp[:index]
+	}
+	if neg {
+		return "-" + string(num), true
+	}
+	return string(num), true
+}
+
+func (d *Decoder) readInt(typ string, bitSize int) int64 {
+	b, ok := d.numberValue()
+	if !ok {
+		d.invalid(typ, b)
+		return 0
+	}
+	s, ok := integer(b)
+	n, err := strconv.ParseInt(s, 10, bitSize)
+	if !ok || err != nil {
+		d.invalid(typ, b)
+		return 0
+	}
+	return n
+}
+
+func (d *Decoder) readUint(typ string, bitSize int) uint64 {
+	b, ok := d.numberValue()
+	if !ok {
+		d.invalid(typ, b)
+		return 0
+	}
+	s, ok := integer(b)
+	n, err := strconv.ParseUint(s, 10, bitSize)
+	if !ok || err != nil {
+		d.invalid(typ, b)
+		return 0
+	}
+	return n
+}
+
+func (d *Decoder) readFloat(typ string, bitSize int) float64 {
+	if d.peek() == '"' {
+		start := d.pos
+		switch string(d.str()) {
+		case "NaN":
+			return math.NaN()
+		case "Infinity":
+			return math.Inf(1)
+		case "-Infinity":
+			return math.Inf(-1)
+		}
+		d.pos = start
+	}
+	b, ok := d.numberValue()
+	if !ok {
+		d.invalid(typ, b)
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(b), bitSize)
+	if err != nil {
+		d.invalid(typ, b)
+		return 0
+	}
+	return f
+}
+
+// The following methods read the values of fields as protojson decodes them, failing for
+// the values of other types. The numbers may be strings.
+
+// ReadBool reads a bool.
+func (d *Decoder) ReadBool() bool {
+	switch d.peek() {
+	case 't':
+		if d.literal("true") {
+			return true
+		}
+	case 'f':
+		if d.literal("false") {
+			return false
+		}
+	}
+	d.unexpected()
+	return false
+}
+
+// ReadInt32 reads an int32, sint32 or sfixed32.
+func (d *Decoder) ReadInt32() int32 {
+	return int32(d.readInt("int32", 32))
+}
+
+// ReadInt64 reads an int64, sint64 or sfixed64.
+func (d *Decoder) ReadInt64() int64 {
+	return d.readInt("int64", 64)
+}
+
+// ReadUint32 reads a uint32 or fixed32.
+func (d *Decoder) ReadUint32() uint32 {
+	return uint32(d.readUint("uint32", 32))
+}
+
+// ReadUint64 reads a uint64 or fixed64.
+func (d *Decoder) ReadUint64() uint64 {
+	return d.readUint("uint64", 64)
+}
+
+// ReadFloat reads a float, which may be one of the strings "NaN", "Infinity" and
+// "-Infinity".
+func (d *Decoder) ReadFloat() float32 {
+	return float32(d.readFloat("float", 32))
+}
+
+// ReadDouble reads a double, which may be one of the strings "NaN", "Infinity" and
+// "-Infinity".
+func (d *Decoder) ReadDouble() float64 {
+	return d.readFloat("double", 64)
+}
+
+// ReadString reads a string, which must be valid UTF-8.
+func (d *Decoder) ReadString() string {
+	return string(d.str())
+}
+
+// ReadBytes reads bytes, encoded in standard or URL base64, with or without padding. The
+// bytes are never nil.
+func (d *Decoder) ReadBytes() []byte {
+	s := d.str()
+	if d.err != nil {
+		return nil
+	}
+	enc := base64.StdEncoding
+	if bytes.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	b, err := enc.AppendDecode(make([]byte, 0, enc.DecodedLen(len(s))), s)
+	if err != nil {
+		d.invalid("bytes", s)
+		return nil
+	}
+	return b
+}
+
+// ReadEnum reads the value of an enum, given the numbers of its values by name, as a name
+// or a number. It returns false for the names which are not in values, if the unknown
+// names are discarded.
+func (d *Decoder) ReadEnum(values map[string]int32) (int32, bool) {
+	if d.peek() != '"' {
+		b := d.number()
+		if d.err != nil {
+			return 0, false
+		}
+		s, ok := integer(b)
+		n, err := strconv.ParseInt(s, 10, 32)
+		if !ok || err != nil {
+			d.invalid("enum", b)
+			return 0, false
+		}
+		return int32(n), true
+	}
+	b := d.str()
+	if n, ok := values[string(b)]; ok {
+		return n, true
+	}
+	if !d.opts.DiscardUnknown {
+		d.invalid("enum", b)
+	}
+	return 0, false
+}
+
+// ReadNullValue reads a google.protobuf.NullValue, which may be null.
+func (d *Decoder) ReadNullValue() (int32, bool) {
+	if d.Null() {
+		return 0, true
+	}
+	return d.ReadEnum(structpb.NullValue_value)
+}
+
+// ReadStringKey reads the name of a member of an object holding a map with string keys.
+func (d *Decoder) ReadStringKey() string {
+	return strings.Clone(d.Name())
+}
+
+// ReadBoolKey reads the name of a member of an object holding a map with bool keys.
+func (d *Decoder) ReadBoolKey() bool {
+	switch d.Name() {
+	case "true":
+		return true
+	case "false":
+	default:
+		d.invalid("bool key", []byte(d.name))
+	}
+	return false
+}
+
+// ReadInt32Key reads the name of a member of an object holding a map with int32, sint32
+// or sfixed32 keys.
+func (d *Decoder) ReadInt32Key() int32 {
+	return int32(d.readIntKey("int32", 32))
+}
+
+// ReadInt64Key reads the name of a member of an object holding a map with int64, sint64
+// or sfixed64 keys.
+func (d *Decoder) ReadInt64Key() int64 {
+	return d.readIntKey("int64", 64)
+}
+
+// ReadUint32Key reads the name of a member of an object holding a map with uint32 or
+// fixed32 keys.
+func (d *Decoder) ReadUint32Key() uint32 {
+	return uint32(d.readUintKey("uint32", 32))
+}
+
+// ReadUint64Key reads the name of a member of an object holding a map with uint64 or
+// fixed64 keys.
+func (d *Decoder) ReadUint64Key() uint64 {
+	return d.readUintKey("uint64", 64)
+}
+
+func (d *Decoder) readIntKey(typ string, bitSize int) int64 {
+	n, err := strconv.ParseInt(d.Name(), 10, bitSize)
+	if err != nil && d.err == nil {
+		d.invalid(typ+" key", []byte(d.name))
+	}
+	return n
+}
+
+func (d *Decoder) readUintKey(typ string, bitSize int) uint64 {
+	n, err := strconv.ParseUint(d.Name(), 10, bitSize)
+	if err != nil && d.err == nil {
+		d.invalid(typ+" key", []byte(d.name))
+	}
+	return n
+}
+
+// ReadTimestamp reads a google.protobuf.Timestamp, as an RFC 3339 date.
+func (d *Decoder) ReadTimestamp() *timestamppb.Timestamp {
+	b := d.str()
+	if d.err != nil {
+		return nil
+	}
+	s := string(b)
+	t, err := time.Parse(time.RFC3339Nano, s)
+	secs := t.Unix()
+	// The fraction has at most 9 digits.
+	i, j := strings.LastIndexByte(s, '.'), strings.LastIndexAny(s, "Z-+")
+	if err != nil || secs < minTimestampSeconds || secs > maxTimestampSeconds || i >= 0 && j >= i && j-i > len(".999999999") {
+		d.invalid("google.protobuf.Timestamp", b)
+		return nil
+	}
+	return &timestamppb.Timestamp{Seconds: secs, Nanos: int32(t.Nanosecond())}
+}
+
+// ReadDuration reads a google.protobuf.Duration, as a number of seconds followed by "s".
+func (d *Decoder) ReadDuration() *durationpb.Duration {
+	b := d.str()
+	if d.err != nil {
+		return nil
+	}
+	secs, nanos, ok := parseDuration(b)
+	if !ok {
+		d.invalid("google.protobuf.Duration", b)
+		return nil
+	}
+	return &durationpb.Duration{Seconds: secs, Nanos: nanos}
+}
+
+// parseDuration parses a duration as protojson does: an optional sign, an integer and a
+// fraction of at most 9 digits, either of which may be empty but not both, and "s".
+func parseDuration(b []byte) (int64, int32, bool) {
+	b, ok := bytes.CutSuffix(b, []byte("s"))
+	if !ok || len(b) == 0 {
+		return 0, 0, false
+	}
+	var neg bool
+	switch b[0] {
+	case '-':
+		neg, b = true, b[1:]
+	case '+':
+		b = b[1:]
+	}
+	intp, frac, hasFrac := bytes.Cut(b, []byte("."))
+	if len(intp) == 0 && !hasFrac || len(intp) > 1 && intp[0] == '0' || len(frac) > 9 {
+		return 0, 0, false
+	}
+	var secs, nanos int64
+	for _, c := range intp {
+		if c < '0' || c > '9' {
+			return 0, 0, false
+		}
+		// The seconds out of range are rejected before they overflow.
+		if secs = secs*10 + int64(c-'0'); secs > maxSecondsInDuration {
+			return 0, 0, false
+		}
+	}
+	for i := 0; i < 9; i++ {
+		nanos *= 10
+		if i < len(frac) {
+			if frac[i] < '0' || frac[i] > '9' {
+				return 0, 0, false
+			}
+			nanos += int64(frac[i] - '0')
+		}
+	}
+	if neg {
+		secs, nanos = -secs, -nanos
+	}
+	return secs, int32(nanos), true
+}
+
+// ReadEmpty reads a google.protobuf.Empty, as an object without members.
+func (d *Decoder) ReadEmpty() *emptypb.Empty {
+	for d.Object(); d.Next(); {
+		d.Name()
+		d.Unknown()
+	}
+	return &emptypb.Empty{}
+}
+
+// ReadMessage reads m, which is empty, with its DecodeJSONVT method if it has one and
+// with ReadProto otherwise, e.g. for the messages of other packages.
+func (d *Decoder) ReadMessage(m proto.Message) {
+	if vt, ok := m.(Decodable); ok {
+		vt.DecodeJSONVT(d)
+		return
+	}
+	d.ReadProto(m)
+}
+
+// ReadProto reads m with protojson.Unmarshal, for the messages whose decoding is not
+// generated: google.protobuf.Any, Struct, Value, ListValue and FieldMask, and the
+// messages holding extensions.
+func (d *Decoder) ReadProto(m proto.Message) {
+	d.space()
+	start := d.pos
+	d.skip()
+	if d.err != nil {
+		return
+	}
+	err := protojson.UnmarshalOptions{DiscardUnknown: d.opts.DiscardUnknown}.Unmarshal(d.data[start:d.pos], m)
+	if err != nil {
+		d.pos = start
+		d.failf("%v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package vtjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestUnmarshalProto(t *testing.T) {
+	// The messages without DecodeJSONVT are decoded by protojson.
+	var st structpb.Struct
+	require.NoError(t, UnmarshalOptions{}.Unmarshal([]byte(`{"a":[1,"x"]}`), &st))
+	want, err := structpb.NewStruct(map[string]any{"a": []any{1, "x"}})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, &st))
+
+	var v structpb.Value
+	require.Error(t, UnmarshalOptions{}.Unmarshal([]byte(`{"a":1`), &v))
+}
+
+func TestInteger(t *testing.T) {
+	for in, want := range map[string]string{
+		"0": "0", "-0": "0", "12": "12", "1.0": "1", "1.50e1": "15", "1e2": "100", "100e-2": "1",
+		"-1.2e1": "-12", "0.0e5": "0", "18446744073709551615": "18446744073709551615",
+	} {
+		got, ok := integer([]byte(in))
+		require.True(t, ok, in)
+		require.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"1.5", "15e-1", "1e21", "1e-1", "1e99999999999"} {
+		_, ok := integer([]byte(in))
+		require.False(t, ok, in)
+	}
+}
@@ -0,0 +1,233 @@
+// Package vtjson implements helpers for the JSON encoding of messages.
+//
+// The JSON produced by protojson is not stable: its whitespace is randomized on purpose,
+// and the order of the keys of maps and of google.protobuf.Struct objects is not part of
+// its contract. Canonicalize rewrites it in the canonical form of RFC 8785, the JSON
+// Canonicalization Scheme, whose output only depends on the encoded values, so that
+// messages encoded as JSON can be hashed or signed.
+//
+// EncodeArray and ArrayWriter stream JSON arrays of messages, for exports too large to be
+// held in memory.
+//
+// The Append functions encode the values of fields like protojson, for the MarshalJSONVT
+// and AppendJSONVT methods generated by the json feature, and Decoder reads them like
+// protojson for its UnmarshalJSONVT and DecodeJSONVT methods.
+package vtjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalCanonical returns the JSON encoding of m by protojson, in canonical form.
+func MarshalCanonical(m proto.Message) ([]byte, error) {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return Canonicalize(data)
+}
+
+// Canonicalize returns the canonical form of the JSON value in data, as specified by RFC
+// 8785: the keys of objects are sorted by their UTF-16 code units, numbers are formatted
+// like ECMAScript does for IEEE 754 doubles, strings only escape the characters that must
+// be, and there is no whitespace.
+//
+// Numbers are decoded as doubles, so integers whose magnitude is larger than 2^53 lose
+// precision, which is why protojson encodes 64-bit integers as strings. Objects with
+// duplicate keys are rejected.
+func Canonicalize(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	c := canonicalizer{dec: dec}
+	out, err := c.value(make([]byte, 0, len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("vtjson: invalid data after top-level value")
+	}
+	return out, nil
+}
+
+type canonicalizer struct {
+	dec *json.Decoder
+}
+
+type member struct {
+	key   string
+	value []byte
+}
+
+// value appends the canonical form of the next value of the decoder to out.
+func (c *canonicalizer) value(out []byte) ([]byte, error) {
+	tok, err := c.dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	switch tok := tok.(type) {
+	case nil:
+		return append(out, "null"...), nil
+	case bool:
+		return strconv.AppendBool(out, tok), nil
+	case json.Number:
+		f, err := strconv.ParseFloat(string(tok), 64)
+		if err != nil {
+			return nil, fmt.Errorf("vtjson: invalid number %s", tok)
+		}
+		return appendNumber(out, f), nil
+	case string:
+		return appendString(out, tok), nil
+	case json.Delim:
+		if tok == '[' {
+			return c.array(out)
+		}
+		return c.object(out)
+	}
+	return nil, fmt.Errorf("vtjson: unexpected token %v", tok)
+}
+
+func (c *canonicalizer) array(out []byte) ([]byte, error) {
+	out = append(out, '[')
+	for i := 0; c.dec.More(); i++ {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		var err error
+		if out, err = c.value(out); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := c.dec.Token(); err != nil {
+		return nil, err
+	}
+	return append(out, ']'), nil
+}
+
+func (c *canonicalizer) object(out []byte) ([]byte, error) {
+	var members []member
+	for c.dec.More() {
+		tok, err := c.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		// The decoder only returns strings for the keys of objects.
+		key := tok.(string)
+		value, err := c.value(nil)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member{key, value})
+	}
+	if _, err := c.dec.Token(); err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return lessUTF16(members[i].key, members[j].key)
+	})
+	out = append(out, '{')
+	for i, m := range members {
+		if i > 0 {
+			if m.key == members[i-1].key {
+				return nil, fmt.Errorf("vtjson: duplicate key %q", m.key)
+			}
+			out = append(out, ',')
+		}
+		out = appendString(out, m.key)
+		out = append(out, ':')
+		out = append(out, m.value...)
+	}
+	return append(out, '}'), nil
+}
+
+// lessUTF16 compares a and b by their UTF-16 code units, which only differs from the
+// order of their UTF-8 bytes for the characters outside of the basic multilingual plane,
+// encoded as surrogate pairs.
+func lessUTF16(a, b string) bool {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			hia, loa := codeUnits(ra)
+			hib, lob := codeUnits(rb)
+			if hia != hib {
+				return hia < hib
+			}
+			return loa < lob
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return len(a) < len(b)
+}
+
+// codeUnits returns the UTF-16 code units of r: its surrogate pair, or r and 0 for the
+// characters of the basic multilingual plane.
+func codeUnits(r rune) (rune, rune) {
+	if hi, lo := utf16.EncodeRune(r); hi != utf8.RuneError {
+		return hi, lo
+	}
+	return r, 0
+}
+
+// appendNumber appends f formatted like the Number.prototype.toString of ECMAScript.
+func appendNumber(out []byte, f float64) []byte {
+	if f == 0 {
+		// Negative zero is formatted as zero as well.
+		return append(out, '0')
+	}
+	abs := math.Abs(f)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.AppendFloat(out, f, 'f', -1, 64)
+	}
+	start := len(out)
+	out = strconv.AppendFloat(out, f, 'e', -1, 64)
+	// ECMAScript does not pad the exponent: 1e-07 is formatted as 1e-7.
+	if n := len(out); n-start >= 4 && out[n-4] == 'e' && out[n-2] == '0' {
+		out[n-2] = out[n-1]
+		out = out[:n-1]
+	}
+	return out
+}
+
+// appendString appends s quoted, escaping the quote, the backslash and the control
+// characters only, with their short escapes where they have one.
+func appendString(out []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	out = append(out, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			out = append(out, '\\', c)
+		case c >= 0x20:
+			out = append(out, c)
+		case c == '\b':
+			out = append(out, '\\', 'b')
+		case c == '\f':
+			out = append(out, '\\', 'f')
+		case c == '\n':
+			out = append(out, '\\', 'n')
+		case c == '\r':
+			out = append(out, '\\', 'r')
+		case c == '\t':
+			out = append(out, '\\', 't')
+		default:
+			out = append(out, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		}
+	}
+	return append(out, '"')
+}
@@ -0,0 +1,89 @@
+package vtjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/planetscale/vtprotobuf/testproto/reuse"
+)
+
+func TestCanonicalize(t *testing.T) {
+	// The example of RFC 8785, section 3.2.2.
+	in := `{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+		"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+		"literals": [null, true, false]
+	}`
+	want := `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`
+	got, err := Canonicalize([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, want, string(got))
+}
+
+func TestCanonicalizeSortsByUTF16(t *testing.T) {
+	// The example of RFC 8785, section 3.2.3.
+	in := `{"\u20ac":"Euro Sign","\r":"Carriage Return","\ufb33":"Hebrew Letter Dalet With Dagesh","1":"One","\ud83d\ude00":"Emoji: Grinning Face","\u0080":"Control","\u00f6":"Latin Small Letter O With Diaeresis"}`
+	got, err := Canonicalize([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\",\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}", string(got))
+}
+
+func TestCanonicalizeSortsSurrogatePairs(t *testing.T) {
+	// The three emoji share the high surrogate \ud83d and only differ by their low one.
+	in := `{"\ud83d\ude42":3,"\ud83d\ude01":2,"\ud83d\ude00":1}`
+	got, err := Canonicalize([]byte(in))
+	require.NoError(t, err)
+	require.Equal(t, "{\"\U0001f600\":1,\"\U0001f601\":2,\"\U0001f642\":3}", string(got))
+}
+
+func TestCanonicalizeNumbers(t *testing.T) {
+	for in, want := range map[string]string{
+		"0":                      "0",
+		"-0":                     "0",
+		"1":                      "1",
+		"-1.5":                   "-1.5",
+		"1e21":                   "1e+21",
+		"1e20":                   "100000000000000000000",
+		"0.000001":               "0.000001",
+		"0.0000001":              "1e-7",
+		"9007199254740993":       "9007199254740992",
+		"1.7976931348623157e308": "1.7976931348623157e+308",
+		"5e-324":                 "5e-324",
+	} {
+		got, err := Canonicalize([]byte(in))
+		require.NoError(t, err)
+		require.Equal(t, want, string(got), in)
+	}
+}
+
+func TestCanonicalizeErrors(t *testing.T) {
+	for _, in := range []string{``, `{"a":1,"a":2}`, `[1,`, `{} {}`, `1e400`, `{"a"}`} {
+		_, err := Canonicalize([]byte(in))
+		require.Error(t, err, in)
+	}
+}
+
+func TestMarshalCanonical(t *testing.T) {
+	fields, err := structpb.NewStruct(map[string]any{"z": 1, "a": []any{"x", 2.5}})
+	require.NoError(t, err)
+	msg := &reuse.Maps{
+		Labels:   map[string]string{"region": "eu", "host": "localhost"},
+		Counters: map[string]int64{"requests": 10},
+	}
+	got, err := MarshalCanonical(msg)
+	require.NoError(t, err)
+	require.Equal(t, `{"counters":{"requests":"10"},"labels":{"host":"localhost","region":"eu"}}`, string(got))
+
+	// The canonical form does not depend on the formatting of protojson.
+	multiline, err := protojson.MarshalOptions{Multiline: true}.Marshal(fields)
+	require.NoError(t, err)
+	canonical, err := Canonicalize(multiline)
+	require.NoError(t, err)
+	got, err = MarshalCanonical(fields)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":["x",2.5],"z":1}`, string(got))
+	require.Equal(t, got, canonical)
+}
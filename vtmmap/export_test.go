@@ -0,0 +1,6 @@
+package vtmmap
+
+// ClosePoisoned closes m as Close does in debug builds.
+func ClosePoisoned(m *Mapping) error {
+	return m.close(true)
+}
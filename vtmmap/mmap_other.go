@@ -0,0 +1,26 @@
+//go:build !linux && !darwin
+
+package vtmmap
+
+import (
+	"io"
+	"os"
+)
+
+// mmap reads the file in memory on the platforms without mmap support.
+func mmap(f *os.File, size int64) ([]byte, error) {
+	data := make([]byte, size)
+	_, err := io.ReadFull(f, data)
+	return data, err
+}
+
+func munmap(data []byte) error {
+	return nil
+}
+
+func poison(data []byte) error {
+	for i := range data {
+		data[i] = Poison
+	}
+	return nil
+}
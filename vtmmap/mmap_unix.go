@@ -0,0 +1,34 @@
+//go:build linux || darwin
+
+package vtmmap
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func mmap(f *os.File, size int64) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	if int64(int(size)) != size {
+		return nil, fmt.Errorf("vtmmap: %s is too large to be mapped", f.Name())
+	}
+	return syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_PRIVATE)
+}
+
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
+
+// poison overwrites the private mapping of data, which is never written back to the file.
+func poison(data []byte) error {
+	if err := syscall.Mprotect(data, syscall.PROT_READ|syscall.PROT_WRITE); err != nil {
+		return err
+	}
+	for i := range data {
+		data[i] = Poison
+	}
+	return syscall.Mprotect(data, syscall.PROT_READ)
+}
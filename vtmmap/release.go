@@ -0,0 +1,7 @@
+//go:build !vtmmap_debug
+
+package vtmmap
+
+// Debug reports whether Close poisons the mapped memory, which requires the
+// vtmmap_debug build tag.
+const Debug = false
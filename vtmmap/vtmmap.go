@@ -0,0 +1,80 @@
+// Package vtmmap unmarshals messages directly from memory-mapped files, so that their
+// string and bytes fields alias the file instead of being copied.
+//
+// The messages must not be used once their Mapping is closed. To catch the code that
+// does, build with the vtmmap_debug tag: Close then overwrites the mapped memory with
+// Poison bytes and leaves it mapped, so that, instead of reading unrelated memory, the
+// aliased fields of the message show up as runs of Poison.
+package vtmmap
+
+import (
+	"errors"
+	"os"
+)
+
+// Poison is the byte written over the mapped memory by Close in debug builds.
+const Poison = 0xDD
+
+// Message is implemented by messages generated with the unmarshal_unsafe feature.
+type Message interface {
+	UnmarshalVTUnsafe([]byte) error
+}
+
+// Mapping is a file mapped in memory, which the messages unmarshaled from it alias.
+type Mapping struct {
+	data   []byte
+	closed bool
+}
+
+// ErrClosed is returned when closing a Mapping twice.
+var ErrClosed = errors.New("vtmmap: mapping already closed")
+
+// Open maps the file at path in memory and unmarshals its content into m with
+// UnmarshalVTUnsafe. The returned Mapping must be closed once m is not used anymore.
+func Open(path string, m Message) (*Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	data, err := mmap(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	mapping := &Mapping{data: data}
+	if err := m.UnmarshalVTUnsafe(data); err != nil {
+		mapping.Close()
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// Bytes returns the mapped content of the file. It must not be used after Close.
+func (m *Mapping) Bytes() []byte {
+	return m.data
+}
+
+// Close unmaps the file. The messages unmarshaled from it and the slices returned by
+// Bytes must not be used anymore.
+func (m *Mapping) Close() error {
+	return m.close(Debug)
+}
+
+func (m *Mapping) close(debug bool) error {
+	if m.closed {
+		return ErrClosed
+	}
+	m.closed = true
+	if len(m.data) == 0 {
+		return nil
+	}
+	if debug {
+		return poison(m.data)
+	}
+	return munmap(m.data)
+}
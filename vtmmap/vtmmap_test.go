@@ -0,0 +1,76 @@
+package vtmmap_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/unsafe"
+	"github.com/planetscale/vtprotobuf/vtmmap"
+)
+
+func writeMessage(t *testing.T, m *unsafe.UnsafeTest_Sub1) string {
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "message.bin")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestOpen(t *testing.T) {
+	original := &unsafe.UnsafeTest_Sub1{S: "from disk", B: []byte("bytes from disk")}
+	path := writeMessage(t, original)
+
+	msg := &unsafe.UnsafeTest_Sub1{}
+	mapping, err := vtmmap.Open(path, msg)
+	require.NoError(t, err)
+	require.True(t, original.EqualVT(msg))
+	require.NoError(t, mapping.Close())
+	require.ErrorIs(t, mapping.Close(), vtmmap.ErrClosed)
+
+	// The file is left untouched.
+	data, err := original.MarshalVT()
+	require.NoError(t, err)
+	onDisk, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, data, onDisk)
+}
+
+func TestOpenEmpty(t *testing.T) {
+	path := writeMessage(t, &unsafe.UnsafeTest_Sub1{})
+
+	msg := &unsafe.UnsafeTest_Sub1{}
+	mapping, err := vtmmap.Open(path, msg)
+	require.NoError(t, err)
+	require.Empty(t, mapping.Bytes())
+	require.Empty(t, msg.S)
+	require.NoError(t, mapping.Close())
+}
+
+func TestOpenMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "message.bin")
+	require.NoError(t, os.WriteFile(path, []byte{0x0a, 0x05, 'a'}, 0o600))
+
+	_, err := vtmmap.Open(path, &unsafe.UnsafeTest_Sub1{})
+	require.Error(t, err)
+
+	_, err = vtmmap.Open(filepath.Join(t.TempDir(), "missing.bin"), &unsafe.UnsafeTest_Sub1{})
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestClosePoisoned(t *testing.T) {
+	original := &unsafe.UnsafeTest_Sub1{S: "from disk", B: []byte("bytes from disk")}
+	path := writeMessage(t, original)
+
+	msg := &unsafe.UnsafeTest_Sub1{}
+	mapping, err := vtmmap.Open(path, msg)
+	require.NoError(t, err)
+	require.NoError(t, vtmmap.ClosePoisoned(mapping))
+
+	require.Equal(t, strings.Repeat("\xdd", len(original.S)), msg.S)
+	require.Equal(t, bytes.Repeat([]byte{vtmmap.Poison}, len(original.B)), msg.B)
+}
@@ -0,0 +1,134 @@
+package vtplan
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Set holds the plans of the messages of a set of files.
+type Set struct {
+	files *protoregistry.Files
+	plans map[protoreflect.FullName]*Plan
+	// stale holds the plans of the descriptors of the messages encoded by a Codec while
+	// it stores s that are not the descriptors of the plans of s, by descriptor.
+	stale sync.Map
+}
+
+// NewSet compiles the plans of all the messages of files, including the nested ones.
+func NewSet(files *protoregistry.Files) *Set {
+	s := &Set{files: files, plans: make(map[protoreflect.FullName]*Plan)}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		s.compileMessages(fd.Messages())
+		return true
+	})
+	return s
+}
+
+func (s *Set) compileMessages(messages protoreflect.MessageDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		compile(md, s.plans)
+		s.compileMessages(md.Messages())
+	}
+}
+
+// Files returns the files of the messages of s.
+func (s *Set) Files() *protoregistry.Files {
+	return s.files
+}
+
+// Plan returns the plan of the message named name, if it is in s.
+func (s *Set) Plan(name protoreflect.FullName) (*Plan, bool) {
+	p, ok := s.plans[name]
+	return p, ok
+}
+
+// Len returns the number of messages of s.
+func (s *Set) Len() int {
+	return len(s.plans)
+}
+
+// Codec decodes and encodes the messages of the files it loaded last. Load replaces the
+// set of plans atomically: the calls running concurrently keep using the previous set,
+// and the messages decoded before the schemas were reloaded are still encoded with the
+// plans of their own descriptors, which are compiled once until the next Load.
+//
+// The zero value of Codec holds no messages, and is ready to Load them.
+type Codec struct {
+	set atomic.Pointer[Set]
+}
+
+// Load compiles the messages of files, whose dependencies must be in files too, and
+// replaces the plans of c with them. The plans of c are left untouched when files are
+// not valid.
+func (c *Codec) Load(files *descriptorpb.FileDescriptorSet) error {
+	registry, err := protodesc.NewFiles(files)
+	if err != nil {
+		return fmt.Errorf("vtplan: %w", err)
+	}
+	c.Store(NewSet(registry))
+	return nil
+}
+
+// Store replaces the plans of c with s.
+func (c *Codec) Store(s *Set) {
+	c.set.Store(s)
+}
+
+// Set returns the plans of c, or nil when it has not loaded any.
+func (c *Codec) Set() *Set {
+	return c.set.Load()
+}
+
+// Plan returns the plan of the message named name, if c loaded it.
+func (c *Codec) Plan(name protoreflect.FullName) (*Plan, bool) {
+	s := c.set.Load()
+	if s == nil {
+		return nil, false
+	}
+	return s.Plan(name)
+}
+
+// Unmarshal decodes b into a new dynamic message of the message named name.
+func (c *Codec) Unmarshal(name protoreflect.FullName, b []byte) (protoreflect.Message, error) {
+	p, ok := c.Plan(name)
+	if !ok {
+		return nil, fmt.Errorf("vtplan: unknown message %s", name)
+	}
+	return p.Unmarshal(b)
+}
+
+// Marshal returns the encoding of m.
+func (c *Codec) Marshal(m protoreflect.Message) ([]byte, error) {
+	return c.planOf(m).Marshal(m)
+}
+
+// MarshalDeterministic returns the encoding of m, with the entries of maps sorted by key.
+func (c *Codec) MarshalDeterministic(m protoreflect.Message) ([]byte, error) {
+	return c.planOf(m).MarshalDeterministic(m)
+}
+
+// planOf returns the plan of the descriptor of m, which is compiled when m was decoded
+// with another version of its schema or with a schema c did not load, and kept with the
+// plans of c.
+func (c *Codec) planOf(m protoreflect.Message) *Plan {
+	desc := m.Descriptor()
+	s := c.set.Load()
+	if s == nil {
+		return Compile(desc)
+	}
+	if p, ok := s.plans[desc.FullName()]; ok && p.desc == desc {
+		return p
+	}
+	if p, ok := s.stale.Load(desc); ok {
+		return p.(*Plan)
+	}
+	p, _ := s.stale.LoadOrStore(desc, Compile(desc))
+	return p.(*Plan)
+}
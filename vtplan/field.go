@@ -0,0 +1,378 @@
+package vtplan
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func compileField(fd protoreflect.FieldDescriptor, plans map[protoreflect.FullName]*Plan) *field {
+	switch {
+	case fd.IsMap():
+		return mapField(fd, plans)
+	case fd.Message() != nil && fd.IsList():
+		return messageList(fd, compile(fd.Message(), plans))
+	case fd.Message() != nil:
+		return messageField(fd, compile(fd.Message(), plans))
+	case fd.IsList():
+		return scalarList(fd)
+	default:
+		return scalarField(fd)
+	}
+}
+
+func scalarField(fd protoreflect.FieldDescriptor) *field {
+	s := scalarOf(fd)
+	tag := protowire.SizeTag(fd.Number())
+	return &field{
+		fd: fd,
+		decode: func(m protoreflect.Message, b []byte, wt protowire.Type, _ int) (int, error) {
+			if wt != s.wire {
+				return 0, errMismatch
+			}
+			v, n := s.consume(b)
+			if n < 0 {
+				return 0, consumeError(fd, n)
+			}
+			m.Set(fd, v)
+			return n, nil
+		},
+		size: func(v protoreflect.Value, _ *encoding) int {
+			return tag + s.size(v)
+		},
+		encode: func(b []byte, v protoreflect.Value, _ *encoding) ([]byte, error) {
+			b = protowire.AppendTag(b, fd.Number(), s.wire)
+			return s.append(b, v), nil
+		},
+	}
+}
+
+func scalarList(fd protoreflect.FieldDescriptor) *field {
+	s := scalarOf(fd)
+	tag := protowire.SizeTag(fd.Number())
+	packed := fd.IsPacked()
+	return &field{
+		fd: fd,
+		decode: func(m protoreflect.Message, b []byte, wt protowire.Type, _ int) (int, error) {
+			if wt == protowire.BytesType && s.wire != protowire.BytesType {
+				// The repeated scalars are decoded whether they are packed or not.
+				body, n := protowire.ConsumeBytes(b)
+				if n < 0 {
+					return 0, parseError(n)
+				}
+				list := m.Mutable(fd).List()
+				for len(body) > 0 {
+					v, vn := s.consume(body)
+					if vn < 0 {
+						return 0, consumeError(fd, vn)
+					}
+					list.Append(v)
+					body = body[vn:]
+				}
+				return n, nil
+			}
+			if wt != s.wire {
+				return 0, errMismatch
+			}
+			v, n := s.consume(b)
+			if n < 0 {
+				return 0, consumeError(fd, n)
+			}
+			m.Mutable(fd).List().Append(v)
+			return n, nil
+		},
+		size: func(v protoreflect.Value, _ *encoding) int {
+			list := v.List()
+			n := 0
+			for i := 0; i < list.Len(); i++ {
+				n += s.size(list.Get(i))
+			}
+			if packed {
+				return tag + protowire.SizeBytes(n)
+			}
+			return n + tag*list.Len()
+		},
+		encode: func(b []byte, v protoreflect.Value, _ *encoding) ([]byte, error) {
+			list := v.List()
+			if packed {
+				n := 0
+				for i := 0; i < list.Len(); i++ {
+					n += s.size(list.Get(i))
+				}
+				b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+				b = protowire.AppendVarint(b, uint64(n))
+				for i := 0; i < list.Len(); i++ {
+					b = s.append(b, list.Get(i))
+				}
+				return b, nil
+			}
+			for i := 0; i < list.Len(); i++ {
+				b = protowire.AppendTag(b, fd.Number(), s.wire)
+				b = s.append(b, list.Get(i))
+			}
+			return b, nil
+		},
+	}
+}
+
+// consumeMessage returns the encoding of the message of fd at the start of b, which is
+// delimited by the end of its group when fd is a group, and the length of the field.
+func consumeMessage(fd protoreflect.FieldDescriptor, b []byte, wt protowire.Type) ([]byte, int, error) {
+	var body []byte
+	var n int
+	if fd.Kind() == protoreflect.GroupKind {
+		if wt != protowire.StartGroupType {
+			return nil, 0, errMismatch
+		}
+		body, n = protowire.ConsumeGroup(fd.Number(), b)
+	} else {
+		if wt != protowire.BytesType {
+			return nil, 0, errMismatch
+		}
+		body, n = protowire.ConsumeBytes(b)
+	}
+	if n < 0 {
+		return nil, 0, parseError(n)
+	}
+	return body, n, nil
+}
+
+// sizeMessage returns the size of the field fd holding m, and records the size of m in e.
+func sizeMessage(fd protoreflect.FieldDescriptor, p *Plan, m protoreflect.Message, e *encoding) int {
+	i := len(e.sizes)
+	e.sizes = append(e.sizes, 0)
+	n := p.size(m, e)
+	e.sizes[i] = n
+	return messageFieldSize(fd, n)
+}
+
+// messageFieldSize returns the size of the field fd holding a message of size n.
+func messageFieldSize(fd protoreflect.FieldDescriptor, n int) int {
+	if fd.Kind() == protoreflect.GroupKind {
+		return 2*protowire.SizeTag(fd.Number()) + n
+	}
+	return protowire.SizeTag(fd.Number()) + protowire.SizeBytes(n)
+}
+
+// appendMessage appends the field fd holding m, whose size is the next one of e.
+func appendMessage(b []byte, fd protoreflect.FieldDescriptor, p *Plan, m protoreflect.Message, e *encoding) ([]byte, error) {
+	n := e.nextSize()
+	if fd.Kind() == protoreflect.GroupKind {
+		b = protowire.AppendTag(b, fd.Number(), protowire.StartGroupType)
+		b, err := p.append(b, m, e)
+		if err != nil {
+			return nil, err
+		}
+		return protowire.AppendTag(b, fd.Number(), protowire.EndGroupType), nil
+	}
+	b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(n))
+	return p.append(b, m, e)
+}
+
+func messageField(fd protoreflect.FieldDescriptor, p *Plan) *field {
+	return &field{
+		fd: fd,
+		decode: func(m protoreflect.Message, b []byte, wt protowire.Type, depth int) (int, error) {
+			body, n, err := consumeMessage(fd, b, wt)
+			if err != nil {
+				return 0, err
+			}
+			// The message is merged into the one already set, like the generated code does.
+			if err := p.decode(m.Mutable(fd).Message(), body, depth+1); err != nil {
+				return 0, err
+			}
+			return n, nil
+		},
+		size: func(v protoreflect.Value, e *encoding) int {
+			return sizeMessage(fd, p, v.Message(), e)
+		},
+		encode: func(b []byte, v protoreflect.Value, e *encoding) ([]byte, error) {
+			return appendMessage(b, fd, p, v.Message(), e)
+		},
+	}
+}
+
+func messageList(fd protoreflect.FieldDescriptor, p *Plan) *field {
+	return &field{
+		fd: fd,
+		decode: func(m protoreflect.Message, b []byte, wt protowire.Type, depth int) (int, error) {
+			body, n, err := consumeMessage(fd, b, wt)
+			if err != nil {
+				return 0, err
+			}
+			list := m.Mutable(fd).List()
+			element := list.NewElement()
+			if err := p.decode(element.Message(), body, depth+1); err != nil {
+				return 0, err
+			}
+			list.Append(element)
+			return n, nil
+		},
+		size: func(v protoreflect.Value, e *encoding) int {
+			list := v.List()
+			n := 0
+			for i := 0; i < list.Len(); i++ {
+				n += sizeMessage(fd, p, list.Get(i).Message(), e)
+			}
+			return n
+		},
+		encode: func(b []byte, v protoreflect.Value, e *encoding) ([]byte, error) {
+			list := v.List()
+			var err error
+			for i := 0; i < list.Len(); i++ {
+				if b, err = appendMessage(b, fd, p, list.Get(i).Message(), e); err != nil {
+					return nil, err
+				}
+			}
+			return b, nil
+		},
+	}
+}
+
+func mapField(fd protoreflect.FieldDescriptor, plans map[protoreflect.FullName]*Plan) *field {
+	kfd, vfd := fd.MapKey(), fd.MapValue()
+	key := scalarOf(kfd)
+	var value scalar
+	var p *Plan
+	if vfd.Message() != nil {
+		p = compile(vfd.Message(), plans)
+	} else {
+		value = scalarOf(vfd)
+	}
+	tag := protowire.SizeTag(fd.Number())
+
+	return &field{
+		fd: fd,
+		decode: func(m protoreflect.Message, b []byte, wt protowire.Type, depth int) (int, error) {
+			if wt != protowire.BytesType {
+				return 0, errMismatch
+			}
+			body, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, parseError(n)
+			}
+			// The key and the value missing from an entry are the zero values of their kind.
+			mp := m.Mutable(fd).Map()
+			k, v := kfd.Default(), vfd.Default()
+			if p != nil {
+				v = mp.NewValue()
+			}
+			for len(body) > 0 {
+				num, ewt, tn := protowire.ConsumeTag(body)
+				if tn < 0 {
+					return 0, parseError(tn)
+				}
+				body = body[tn:]
+				var vn int
+				switch {
+				case num == 1 && ewt == key.wire:
+					k, vn = key.consume(body)
+					if vn < 0 {
+						return 0, consumeError(kfd, vn)
+					}
+				case num == 2 && p != nil:
+					mb, mn, err := consumeMessage(vfd, body, ewt)
+					if err == errMismatch {
+						vn = protowire.ConsumeFieldValue(num, ewt, body)
+						break
+					}
+					if err != nil {
+						return 0, err
+					}
+					if err := p.decode(v.Message(), mb, depth+1); err != nil {
+						return 0, err
+					}
+					vn = mn
+				case num == 2 && ewt == value.wire:
+					v, vn = value.consume(body)
+					if vn < 0 {
+						return 0, consumeError(vfd, vn)
+					}
+				default:
+					vn = protowire.ConsumeFieldValue(num, ewt, body)
+				}
+				if vn < 0 {
+					return 0, parseError(vn)
+				}
+				body = body[vn:]
+			}
+			mp.Set(k.MapKey(), v)
+			return n, nil
+		},
+		size: func(v protoreflect.Value, e *encoding) int {
+			n := 0
+			rangeMap(v.Map(), kfd.Kind(), e.deterministic, func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				e.keys = append(e.keys, k)
+				entry := protowire.SizeTag(1) + key.size(k.Value())
+				if p != nil {
+					entry += sizeMessage(vfd, p, v.Message(), e)
+				} else {
+					entry += protowire.SizeTag(2) + value.size(v)
+				}
+				n += tag + protowire.SizeBytes(entry)
+				return true
+			})
+			return n
+		},
+		encode: func(b []byte, v protoreflect.Value, e *encoding) ([]byte, error) {
+			// The entries are encoded in the order of the keys recorded by size.
+			mp := v.Map()
+			var err error
+			for i := mp.Len(); i > 0; i-- {
+				k := e.nextKey()
+				v := mp.Get(k)
+				entry := protowire.SizeTag(1) + key.size(k.Value())
+				if p != nil {
+					entry += messageFieldSize(vfd, e.sizes[0])
+				} else {
+					entry += protowire.SizeTag(2) + value.size(v)
+				}
+				b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+				b = protowire.AppendVarint(b, uint64(entry))
+				b = protowire.AppendTag(b, 1, key.wire)
+				b = key.append(b, k.Value())
+				if p != nil {
+					if b, err = appendMessage(b, vfd, p, v.Message(), e); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				b = protowire.AppendTag(b, 2, value.wire)
+				b = value.append(b, v)
+			}
+			return b, nil
+		},
+	}
+}
+
+// rangeMap calls f for the entries of mp, sorted by key when deterministic is set.
+func rangeMap(mp protoreflect.Map, kind protoreflect.Kind, deterministic bool, f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if !deterministic {
+		mp.Range(f)
+		return
+	}
+	keys := make([]protoreflect.MapKey, 0, mp.Len())
+	mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		switch kind {
+		case protoreflect.BoolKind:
+			return !keys[i].Bool() && keys[j].Bool()
+		case protoreflect.StringKind:
+			return keys[i].String() < keys[j].String()
+		case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+			return keys[i].Uint() < keys[j].Uint()
+		default:
+			return keys[i].Int() < keys[j].Int()
+		}
+	})
+	for _, k := range keys {
+		if !f(k, mp.Get(k)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,465 @@
+// Package vtplan decodes and encodes the messages whose descriptors are only known at run
+// time, e.g. in the sidecars and proxies that load the schemas of their upstreams instead
+// of being regenerated when they change.
+//
+// Compile walks the fields of a message descriptor once, and builds a Plan holding a
+// table of decoding and encoding functions indexed by field number, specialized for the
+// kind and the cardinality of each field. Decoding looks the functions up by field number
+// instead of resolving the descriptor of each field, which is what makes the generic
+// decoding of proto.Unmarshal slower for dynamic messages.
+//
+// The plans decode into any protoreflect.Message of their descriptor: messages created
+// with dynamicpb, or the messages of generated types. Their unknown fields are kept, and
+// extensions are decoded as unknown fields. Like the protobuf runtime and the generated
+// code, the values of closed enums are not checked. Codec holds the plans of a set of files, which
+// is replaced atomically when the schemas are reloaded.
+package vtplan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// maxDepth is the maximum nesting of the decoded messages, like the default recursion
+// limit of proto.Unmarshal.
+const maxDepth = 10000
+
+// maxDense is the largest field number looked up in the dense table of a plan; the
+// fields with larger numbers are looked up in a map.
+const maxDense = 1024
+
+var (
+	// ErrInvalidUTF8 is returned when a string field that must hold UTF-8 does not.
+	ErrInvalidUTF8 = errors.New("vtplan: invalid UTF-8")
+	// ErrDepth is returned when the decoded messages are nested deeper than the
+	// recursion limit.
+	ErrDepth = errors.New("vtplan: exceeded maximum recursion depth")
+
+	// errMismatch is returned by the decoders of fields when the wire type of the field
+	// is not the one of its kind, in which case the field is kept as an unknown field.
+	errMismatch = errors.New("vtplan: wire type mismatch")
+)
+
+// Plan decodes and encodes the messages of a descriptor. Plans are immutable, and may be
+// used concurrently.
+type Plan struct {
+	desc     protoreflect.MessageDescriptor
+	dense    []*field
+	sparse   map[protowire.Number]*field
+	fields   []*field
+	required []protoreflect.FieldDescriptor
+}
+
+// field is the entry of a field in the table of its plan.
+type field struct {
+	fd     protoreflect.FieldDescriptor
+	decode func(m protoreflect.Message, b []byte, wt protowire.Type, depth int) (int, error)
+	size   func(v protoreflect.Value, e *encoding) int
+	encode func(b []byte, v protoreflect.Value, e *encoding) ([]byte, error)
+}
+
+// encoding holds the sizes of the messages nested in an encoded message and the keys of
+// its maps, which are recorded in the order they are encoded when computing its size, so
+// that encoding it does not compute the size of each nested message again at each level.
+type encoding struct {
+	deterministic bool
+	sizes         []int
+	keys          []protoreflect.MapKey
+}
+
+// nextSize returns the size of the next nested message.
+func (e *encoding) nextSize() int {
+	n := e.sizes[0]
+	e.sizes = e.sizes[1:]
+	return n
+}
+
+// nextKey returns the key of the next map entry.
+func (e *encoding) nextKey() protoreflect.MapKey {
+	k := e.keys[0]
+	e.keys = e.keys[1:]
+	return k
+}
+
+// Compile returns the plan of the messages of desc, compiling the plans of the messages
+// of its fields too.
+func Compile(desc protoreflect.MessageDescriptor) *Plan {
+	return compile(desc, make(map[protoreflect.FullName]*Plan))
+}
+
+func compile(desc protoreflect.MessageDescriptor, plans map[protoreflect.FullName]*Plan) *Plan {
+	if p, ok := plans[desc.FullName()]; ok {
+		return p
+	}
+	p := &Plan{desc: desc}
+	// The plan is registered before its fields are compiled, for the recursive messages.
+	plans[desc.FullName()] = p
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		f := compileField(fd, plans)
+		p.fields = append(p.fields, f)
+		if num := fd.Number(); num < maxDense {
+			if int(num) >= len(p.dense) {
+				p.dense = append(p.dense, make([]*field, int(num)+1-len(p.dense))...)
+			}
+			p.dense[num] = f
+		} else {
+			if p.sparse == nil {
+				p.sparse = make(map[protowire.Number]*field)
+			}
+			p.sparse[num] = f
+		}
+		if fd.Cardinality() == protoreflect.Required {
+			p.required = append(p.required, fd)
+		}
+	}
+	// The fields are encoded in the order of the protobuf runtime: the members of oneofs
+	// after the other fields, grouped by oneof.
+	sort.Slice(p.fields, func(i, j int) bool {
+		x, y := p.fields[i].fd, p.fields[j].fd
+		ox, oy := x.ContainingOneof(), y.ContainingOneof()
+		if inOneof(ox) != inOneof(oy) {
+			return !inOneof(ox)
+		}
+		if inOneof(ox) && ox != oy {
+			return ox.Index() < oy.Index()
+		}
+		return x.Number() < y.Number()
+	})
+	return p
+}
+
+func inOneof(od protoreflect.OneofDescriptor) bool {
+	return od != nil && !od.IsSynthetic()
+}
+
+// Descriptor returns the descriptor of the messages of p.
+func (p *Plan) Descriptor() protoreflect.MessageDescriptor {
+	return p.desc
+}
+
+func (p *Plan) lookup(num protowire.Number) *field {
+	if num < protowire.Number(len(p.dense)) {
+		return p.dense[num]
+	}
+	return p.sparse[num]
+}
+
+// New returns a new empty dynamic message of the descriptor of p.
+func (p *Plan) New() protoreflect.Message {
+	return dynamicpb.NewMessage(p.desc)
+}
+
+// Unmarshal decodes b into a new dynamic message of the descriptor of p.
+func (p *Plan) Unmarshal(b []byte) (protoreflect.Message, error) {
+	m := p.New()
+	if err := p.UnmarshalInto(b, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalInto decodes b into m, which must be a message of the descriptor of p. The
+// fields of b are merged into the ones already set in m.
+func (p *Plan) UnmarshalInto(b []byte, m protoreflect.Message) error {
+	if err := p.check(m); err != nil {
+		return err
+	}
+	return p.decode(m, b, 0)
+}
+
+func (p *Plan) check(m protoreflect.Message) error {
+	if got := m.Descriptor(); got != p.desc {
+		return fmt.Errorf("vtplan: message %s does not have the descriptor of the plan of %s", got.FullName(), p.desc.FullName())
+	}
+	return nil
+}
+
+func (p *Plan) decode(m protoreflect.Message, b []byte, depth int) error {
+	if depth > maxDepth {
+		return ErrDepth
+	}
+	var unknown []byte
+	for len(b) > 0 {
+		num, wt, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return parseError(n)
+		}
+		if wt == protowire.EndGroupType {
+			return fmt.Errorf("vtplan: %s: unexpected end group", p.desc.FullName())
+		}
+		if f := p.lookup(num); f != nil {
+			fn, err := f.decode(m, b[n:], wt, depth)
+			if err == nil {
+				b = b[n+fn:]
+				continue
+			}
+			if err != errMismatch {
+				return err
+			}
+		}
+		fn := protowire.ConsumeFieldValue(num, wt, b[n:])
+		if fn < 0 {
+			return parseError(fn)
+		}
+		unknown = append(unknown, b[:n+fn]...)
+		b = b[n+fn:]
+	}
+	if len(unknown) > 0 {
+		m.SetUnknown(append(m.GetUnknown(), unknown...))
+	}
+	return p.checkRequired(m)
+}
+
+func (p *Plan) checkRequired(m protoreflect.Message) error {
+	for _, fd := range p.required {
+		if !m.Has(fd) {
+			return fmt.Errorf("vtplan: required field %s not set", fd.FullName())
+		}
+	}
+	return nil
+}
+
+// Size returns the size of the encoding of m, which must be a message of the descriptor
+// of p.
+func (p *Plan) Size(m protoreflect.Message) int {
+	return p.size(m, &encoding{})
+}
+
+func (p *Plan) size(m protoreflect.Message, e *encoding) int {
+	n := 0
+	for _, f := range p.fields {
+		if m.Has(f.fd) {
+			n += f.size(m.Get(f.fd), e)
+		}
+	}
+	return n + len(m.GetUnknown())
+}
+
+// Marshal returns the encoding of m, which must be a message of the descriptor of p. The
+// entries of maps are encoded in the order of their iteration.
+func (p *Plan) Marshal(m protoreflect.Message) ([]byte, error) {
+	return p.marshal(m, false)
+}
+
+// MarshalDeterministic returns the encoding of m like Marshal, with the entries of maps
+// sorted by key.
+func (p *Plan) MarshalDeterministic(m protoreflect.Message) ([]byte, error) {
+	return p.marshal(m, true)
+}
+
+func (p *Plan) marshal(m protoreflect.Message, deterministic bool) ([]byte, error) {
+	if err := p.check(m); err != nil {
+		return nil, err
+	}
+	e := &encoding{deterministic: deterministic}
+	n := p.size(m, e)
+	return p.append(make([]byte, 0, n), m, e)
+}
+
+func (p *Plan) append(b []byte, m protoreflect.Message, e *encoding) ([]byte, error) {
+	if err := p.checkRequired(m); err != nil {
+		return nil, err
+	}
+	var err error
+	for _, f := range p.fields {
+		if !m.Has(f.fd) {
+			continue
+		}
+		if b, err = f.encode(b, m.Get(f.fd), e); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, m.GetUnknown()...), nil
+}
+
+func parseError(n int) error {
+	return fmt.Errorf("vtplan: %w", protowire.ParseError(n))
+}
+
+// scalar encodes and decodes the values of a kind of fields that are not messages.
+type scalar struct {
+	wire    protowire.Type
+	consume func(b []byte) (protoreflect.Value, int)
+	size    func(v protoreflect.Value) int
+	append  func(b []byte, v protoreflect.Value) []byte
+}
+
+func varint(decode func(uint64) protoreflect.Value, encode func(protoreflect.Value) uint64) scalar {
+	return scalar{
+		wire: protowire.VarintType,
+		consume: func(b []byte) (protoreflect.Value, int) {
+			v, n := protowire.ConsumeVarint(b)
+			return decode(v), n
+		},
+		size: func(v protoreflect.Value) int { return protowire.SizeVarint(encode(v)) },
+		append: func(b []byte, v protoreflect.Value) []byte {
+			return protowire.AppendVarint(b, encode(v))
+		},
+	}
+}
+
+func fixed32(decode func(uint32) protoreflect.Value, encode func(protoreflect.Value) uint32) scalar {
+	return scalar{
+		wire: protowire.Fixed32Type,
+		consume: func(b []byte) (protoreflect.Value, int) {
+			v, n := protowire.ConsumeFixed32(b)
+			return decode(v), n
+		},
+		size: func(protoreflect.Value) int { return 4 },
+		append: func(b []byte, v protoreflect.Value) []byte {
+			return protowire.AppendFixed32(b, encode(v))
+		},
+	}
+}
+
+func fixed64(decode func(uint64) protoreflect.Value, encode func(protoreflect.Value) uint64) scalar {
+	return scalar{
+		wire: protowire.Fixed64Type,
+		consume: func(b []byte) (protoreflect.Value, int) {
+			v, n := protowire.ConsumeFixed64(b)
+			return decode(v), n
+		},
+		size: func(protoreflect.Value) int { return 8 },
+		append: func(b []byte, v protoreflect.Value) []byte {
+			return protowire.AppendFixed64(b, encode(v))
+		},
+	}
+}
+
+var scalars = map[protoreflect.Kind]scalar{
+	protoreflect.BoolKind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfBool(v != 0) },
+		func(v protoreflect.Value) uint64 { return protowire.EncodeBool(v.Bool()) }),
+	protoreflect.EnumKind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfEnum(protoreflect.EnumNumber(int32(v))) },
+		func(v protoreflect.Value) uint64 { return uint64(int64(v.Enum())) }),
+	protoreflect.Int32Kind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfInt32(int32(v)) },
+		func(v protoreflect.Value) uint64 { return uint64(v.Int()) }),
+	protoreflect.Sint32Kind: varint(
+		func(v uint64) protoreflect.Value {
+			return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(v & math.MaxUint32)))
+		},
+		func(v protoreflect.Value) uint64 { return protowire.EncodeZigZag(v.Int()) }),
+	protoreflect.Uint32Kind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfUint32(uint32(v)) },
+		func(v protoreflect.Value) uint64 { return v.Uint() }),
+	protoreflect.Int64Kind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfInt64(int64(v)) },
+		func(v protoreflect.Value) uint64 { return uint64(v.Int()) }),
+	protoreflect.Sint64Kind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfInt64(protowire.DecodeZigZag(v)) },
+		func(v protoreflect.Value) uint64 { return protowire.EncodeZigZag(v.Int()) }),
+	protoreflect.Uint64Kind: varint(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfUint64(v) },
+		func(v protoreflect.Value) uint64 { return v.Uint() }),
+	protoreflect.Fixed32Kind: fixed32(
+		func(v uint32) protoreflect.Value { return protoreflect.ValueOfUint32(v) },
+		func(v protoreflect.Value) uint32 { return uint32(v.Uint()) }),
+	protoreflect.Sfixed32Kind: fixed32(
+		func(v uint32) protoreflect.Value { return protoreflect.ValueOfInt32(int32(v)) },
+		func(v protoreflect.Value) uint32 { return uint32(v.Int()) }),
+	protoreflect.FloatKind: fixed32(
+		func(v uint32) protoreflect.Value { return protoreflect.ValueOfFloat32(math.Float32frombits(v)) },
+		func(v protoreflect.Value) uint32 { return math.Float32bits(float32(v.Float())) }),
+	protoreflect.Fixed64Kind: fixed64(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfUint64(v) },
+		func(v protoreflect.Value) uint64 { return v.Uint() }),
+	protoreflect.Sfixed64Kind: fixed64(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfInt64(int64(v)) },
+		func(v protoreflect.Value) uint64 { return uint64(v.Int()) }),
+	protoreflect.DoubleKind: fixed64(
+		func(v uint64) protoreflect.Value { return protoreflect.ValueOfFloat64(math.Float64frombits(v)) },
+		func(v protoreflect.Value) uint64 { return math.Float64bits(v.Float()) }),
+	protoreflect.StringKind: {
+		wire: protowire.BytesType,
+		consume: func(b []byte) (protoreflect.Value, int) {
+			v, n := protowire.ConsumeBytes(b)
+			return protoreflect.ValueOfString(string(v)), n
+		},
+		size: func(v protoreflect.Value) int { return protowire.SizeBytes(len(v.String())) },
+		append: func(b []byte, v protoreflect.Value) []byte {
+			return protowire.AppendString(b, v.String())
+		},
+	},
+	protoreflect.BytesKind: {
+		wire: protowire.BytesType,
+		consume: func(b []byte) (protoreflect.Value, int) {
+			v, n := protowire.ConsumeBytes(b)
+			return protoreflect.ValueOfBytes(append(make([]byte, 0, len(v)), v...)), n
+		},
+		size: func(v protoreflect.Value) int { return protowire.SizeBytes(len(v.Bytes())) },
+		append: func(b []byte, v protoreflect.Value) []byte {
+			return protowire.AppendBytes(b, v.Bytes())
+		},
+	},
+}
+
+// scalarOf returns the scalar of the values of fd, which checks the UTF-8 of the strings
+// that must hold it when decoding.
+func scalarOf(fd protoreflect.FieldDescriptor) scalar {
+	s := scalars[fd.Kind()]
+	if enforceUTF8(fd) {
+		consume := s.consume
+		s.consume = func(b []byte) (protoreflect.Value, int) {
+			v, n := consume(b)
+			if n >= 0 && !utf8.ValidString(v.String()) {
+				return v, errUTF8
+			}
+			return v, n
+		}
+	}
+	return s
+}
+
+// errUTF8 is the negative length returned by the consume functions of strings that are
+// not valid UTF-8, besides the ones of protowire.
+const errUTF8 = -100
+
+// consumeError returns the error of the negative length n returned by a consume function.
+func consumeError(fd protoreflect.FieldDescriptor, n int) error {
+	if n == errUTF8 {
+		return fmt.Errorf("%w in field %s", ErrInvalidUTF8, fd.FullName())
+	}
+	return parseError(n)
+}
+
+// enforceUTF8 returns true if the string field fd must hold valid UTF-8: always in proto3,
+// never in proto2, and according to the utf8_validation feature with the editions.
+func enforceUTF8(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	switch fd.ParentFile().Syntax() {
+	case protoreflect.Proto2:
+		return false
+	case protoreflect.Proto3:
+		return true
+	}
+	for d := protoreflect.Descriptor(fd); d != nil; d = d.Parent() {
+		var features *descriptorpb.FeatureSet
+		switch opts := d.Options().(type) {
+		case *descriptorpb.FieldOptions:
+			features = opts.GetFeatures()
+		case *descriptorpb.MessageOptions:
+			features = opts.GetFeatures()
+		case *descriptorpb.FileOptions:
+			features = opts.GetFeatures()
+		}
+		if features != nil && features.Utf8Validation != nil {
+			return features.GetUtf8Validation() == descriptorpb.FeatureSet_VERIFY
+		}
+	}
+	return true
+}
@@ -0,0 +1,336 @@
+package vtplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/planetscale/vtprotobuf/testproto/editions"
+	"github.com/planetscale/vtprotobuf/testproto/hashvt"
+	"github.com/planetscale/vtprotobuf/testproto/proto2"
+)
+
+func newItem() *hashvt.Item {
+	scalars := &hashvt.Scalars{
+		D: 1.5, F: -2.5, I32: -3, I64: -4, U32: 5, U64: 6, S32: -7, S64: -8,
+		Fx32: 9, Fx64: 10, Sfx32: -11, Sfx64: -12, B: true, S: "s", By: []byte("by"),
+		Kind: hashvt.Kind_KIND_A, OptI32: proto.Int32(0), OptD: proto.Float64(0),
+	}
+	attributes, _ := structpb.NewStruct(map[string]any{"a": 1, "b": []any{"c", true}})
+	return &hashvt.Item{
+		Name:       "item",
+		Scalars:    scalars,
+		Values:     []int64{1, -1, 1 << 40},
+		Children:   []*hashvt.Scalars{scalars, {}},
+		Counts:     map[string]int64{"a": 1, "b": 2, "c": 0},
+		ById:       map[int32]*hashvt.Scalars{-1: scalars, 2: {}},
+		Flags:      map[bool]hashvt.Kind{true: hashvt.Kind_KIND_A, false: 7},
+		Choice:     &hashvt.Item_Msg{Msg: scalars},
+		Created:    timestamppb.New(timestamppb.Now().AsTime()),
+		Attributes: attributes,
+		Blobs:      [][]byte{[]byte("x"), {}},
+	}
+}
+
+// TestRoundTrip tests that the plans decode and encode the same messages as the protobuf
+// runtime, both into dynamic messages and into the messages of generated types.
+func TestRoundTrip(t *testing.T) {
+	for name, original := range map[string]proto.Message{
+		"proto3": newItem(),
+		"editions": editions.OpaqueFields_builder{
+			I32:        proto.Int32(-1),
+			S:          proto.String(""),
+			ImplicitS:  "implicit",
+			Packed:     []int32{1, -2, 300},
+			Expanded:   []uint32{7, 8},
+			Kinds:      []editions.OpaqueKind{editions.OpaqueKind_OPAQUE_KIND_A, 7},
+			ById:       map[int32]*editions.OpaqueMessage{1: editions.OpaqueMessage_builder{Data: []byte("d")}.Build()},
+			ChoiceKind: editions.OpaqueKind_OPAQUE_KIND_B.Enum(),
+			Delimited:  editions.OpaqueMessage_builder{Id: proto.Int32(3)}.Build(),
+			RequiredId: proto.Int32(0),
+		}.Build(),
+		"groups": &proto2.OneofGroups{
+			Id:     proto.Int32(1),
+			Choice: &proto2.OneofGroups_Other_{Other: &proto2.OneofGroups_Other{Nested: &proto2.OneofGroups_Other_Deep_{Deep: &proto2.OneofGroups_Other_Deep{Label: proto.String("deep")}}}},
+			Single: &proto2.OneofGroups_Single{Value: proto.Int32(2)},
+		},
+		"closedEnum": &proto2.EnumMessage{
+			RequiredField: proto2.EnumMessage_TEN.Enum(),
+			RepeatedField: []proto2.EnumMessage_Num{proto2.EnumMessage_NINE},
+			PackedField:   []proto2.EnumMessage_Num{proto2.EnumMessage_EIGHT, proto2.EnumMessage_SEVEN},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+			require.NoError(t, err)
+			plan := Compile(original.ProtoReflect().Descriptor())
+
+			dynamic, err := plan.Unmarshal(data)
+			require.NoError(t, err)
+			require.Equal(t, proto.Size(original), plan.Size(dynamic))
+			encoded, err := plan.MarshalDeterministic(dynamic)
+			require.NoError(t, err)
+			require.Equal(t, data, encoded)
+
+			encoded, err = plan.Marshal(dynamic)
+			require.NoError(t, err)
+			again := original.ProtoReflect().New().Interface()
+			require.NoError(t, proto.Unmarshal(encoded, again))
+			require.True(t, proto.Equal(original, again))
+
+			generated := original.ProtoReflect().New()
+			require.NoError(t, plan.UnmarshalInto(data, generated))
+			require.True(t, proto.Equal(original, generated.Interface()))
+			encoded, err = plan.MarshalDeterministic(original.ProtoReflect())
+			require.NoError(t, err)
+			require.Equal(t, data, encoded)
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	plan := Compile((&hashvt.Item{}).ProtoReflect().Descriptor())
+
+	// The fields of messages are merged, the last value of scalars wins and the elements
+	// of repeated fields are appended, whether they are packed or not.
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, "first")
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, protowire.AppendVarint(protowire.AppendTag(nil, 3, protowire.VarintType), 1))
+	data = protowire.AppendTag(data, 3, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, "second")
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, protowire.AppendVarint(protowire.AppendTag(nil, 4, protowire.VarintType), 2))
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, protowire.AppendVarint(protowire.AppendVarint(nil, 2), 3))
+	// A field of another wire type than the one of its kind, and a field of the schema
+	// the plan does not know, are kept as unknown fields.
+	unknown := protowire.AppendTag(nil, 9, protowire.Fixed32Type)
+	unknown = protowire.AppendFixed32(unknown, 1)
+	unknown = protowire.AppendTag(unknown, 100, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+	data = append(data, unknown...)
+
+	m, err := plan.Unmarshal(data)
+	require.NoError(t, err)
+	expected := &hashvt.Item{}
+	require.NoError(t, proto.Unmarshal(data, expected))
+	encoded, err := plan.Marshal(m)
+	require.NoError(t, err)
+	again := &hashvt.Item{}
+	require.NoError(t, proto.Unmarshal(encoded, again))
+	require.True(t, proto.Equal(expected, again))
+	require.Equal(t, "second", again.Name)
+	require.Equal(t, int32(1), again.Scalars.I32)
+	require.Equal(t, int64(2), again.Scalars.I64)
+	require.Equal(t, []int64{1, 2, 3}, again.Values)
+	require.Equal(t, unknown, []byte(m.GetUnknown()))
+
+	// The strings of proto3 must be valid UTF-8.
+	data = protowire.AppendTag(nil, 1, protowire.BytesType)
+	data = protowire.AppendBytes(data, []byte{0xff})
+	_, err = plan.Unmarshal(data)
+	require.ErrorIs(t, err, ErrInvalidUTF8)
+
+	_, err = plan.Unmarshal([]byte{0x0a, 0x05})
+	require.ErrorContains(t, err, "vtplan: ")
+
+	require.ErrorContains(t, plan.UnmarshalInto(nil, (&hashvt.Scalars{}).ProtoReflect()), "does not have the descriptor")
+}
+
+func TestProto2(t *testing.T) {
+	plan := Compile((&proto2.EnumMessage{}).ProtoReflect().Descriptor())
+
+	// The values of closed enums are not checked, like the protobuf runtime does.
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 10)
+	data = protowire.AppendTag(data, 2, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	m, err := plan.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, protoreflect.EnumNumber(1), m.Get(m.Descriptor().Fields().ByNumber(2)).Enum())
+
+	// The required fields must be set.
+	_, err = plan.Unmarshal(nil)
+	require.ErrorContains(t, err, "vtplan: required field EnumMessage.required_field not set")
+	_, err = plan.Marshal(plan.New())
+	require.ErrorContains(t, err, "vtplan: required field EnumMessage.required_field not set")
+}
+
+// fileSet returns the set of the files of messages and of their dependencies.
+func fileSet(messages ...proto.Message) *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, m := range messages {
+		add(m.ProtoReflect().Descriptor().ParentFile())
+	}
+	return set
+}
+
+func TestCodecReload(t *testing.T) {
+	var codec Codec
+	_, err := codec.Unmarshal("hashvt.Item", nil)
+	require.ErrorContains(t, err, "vtplan: unknown message hashvt.Item")
+
+	set := fileSet(&hashvt.Item{})
+	require.NoError(t, codec.Load(set))
+	_, ok := codec.Plan("hashvt.Scalars")
+	require.True(t, ok)
+	_, ok = codec.Plan("google.protobuf.Struct")
+	require.True(t, ok)
+
+	original := newItem()
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(original)
+	require.NoError(t, err)
+	extra := protowire.AppendTag(nil, 100, protowire.BytesType)
+	extra = protowire.AppendString(extra, "extra")
+	data = append(data, extra...)
+
+	before, err := codec.Unmarshal("hashvt.Item", data)
+	require.NoError(t, err)
+	require.Equal(t, extra, []byte(before.GetUnknown()))
+
+	// The new version of the schema adds the field the previous one kept unknown.
+	set = proto.Clone(set).(*descriptorpb.FileDescriptorSet)
+	for _, file := range set.File {
+		if file.GetPackage() != "hashvt" {
+			continue
+		}
+		for _, message := range file.MessageType {
+			if message.GetName() == "Item" {
+				message.Field = append(message.Field, &descriptorpb.FieldDescriptorProto{
+					Name:     proto.String("extra"),
+					JsonName: proto.String("extra"),
+					Number:   proto.Int32(100),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				})
+			}
+		}
+	}
+	require.NoError(t, codec.Load(set))
+
+	after, err := codec.Unmarshal("hashvt.Item", data)
+	require.NoError(t, err)
+	require.Empty(t, after.GetUnknown())
+	require.Equal(t, "extra", after.Get(after.Descriptor().Fields().ByName("extra")).String())
+
+	// The messages decoded with the previous version are still encoded with it.
+	encoded, err := codec.MarshalDeterministic(before)
+	require.NoError(t, err)
+	require.Equal(t, data, encoded)
+	stale := codec.planOf(before)
+	require.Same(t, stale, codec.planOf(before))
+	current, _ := codec.Plan("hashvt.Item")
+	require.NotSame(t, current, stale)
+	encoded, err = codec.MarshalDeterministic(after)
+	require.NoError(t, err)
+	again, err := codec.Unmarshal("hashvt.Item", encoded)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(after.Interface(), again.Interface()))
+
+	// The plans are kept when the files are not valid.
+	set.File = set.File[len(set.File)-1:]
+	require.ErrorContains(t, codec.Load(set), "vtplan: ")
+	_, ok = codec.Plan("hashvt.Item")
+	require.True(t, ok)
+}
+
+// TestMarshalNested tests the encoding of messages nested in lists and maps at many
+// levels, whose sizes are computed before encoding them.
+func TestMarshalNested(t *testing.T) {
+	value := structpb.NewStringValue("leaf")
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			value = structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"value":  value,
+				"number": structpb.NewNumberValue(float64(i)),
+				"flag":   structpb.NewBoolValue(true),
+			}})
+		} else {
+			value = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNullValue(), value}})
+		}
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	require.NoError(t, err)
+	plan := Compile(value.ProtoReflect().Descriptor())
+
+	encoded, err := plan.MarshalDeterministic(value.ProtoReflect())
+	require.NoError(t, err)
+	require.Equal(t, data, encoded)
+
+	encoded, err = plan.Marshal(value.ProtoReflect())
+	require.NoError(t, err)
+	require.Len(t, encoded, len(data))
+	again := &structpb.Value{}
+	require.NoError(t, proto.Unmarshal(encoded, again))
+	require.True(t, proto.Equal(value, again))
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	item := newItem()
+	plan := Compile(item.ProtoReflect().Descriptor())
+
+	b.Run("plan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := plan.Marshal(item.ProtoReflect()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("proto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := proto.Marshal(item); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data, err := proto.Marshal(newItem())
+	require.NoError(b, err)
+	plan := Compile((&hashvt.Item{}).ProtoReflect().Descriptor())
+
+	b.Run("plan", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := plan.Unmarshal(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("proto", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := proto.Unmarshal(data, plan.New().Interface()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,72 @@
+// Package vtpool provides a typed surface over the memory pools generated by the
+// pool feature of protoc-gen-go-vtproto.
+package vtpool
+
+// Backend stores the messages of a generated pool. *sync.Pool, which the generated
+// code uses by default, implements it. Get returns nil, or a value of another type,
+// when the backend is empty, in which case a new message is allocated.
+type Backend interface {
+	Get() any
+	Put(any)
+}
+
+// BackendSetter is implemented by pointers to messages generated with the pool feature.
+type BackendSetter[T any] interface {
+	*T
+	SetVTPoolBackend(Backend)
+}
+
+// SetBackend replaces the backend of the generated pool of T, or restores its default
+// sync.Pool if b is nil. It is not safe to call concurrently with the use of the pool,
+// and is meant to be called at startup, e.g.:
+//
+//	vtpool.SetBackend[pb.MyMessage](freelist)
+func SetBackend[T any, P BackendSetter[T]](b Backend) {
+	P(nil).SetVTPoolBackend(b)
+}
+
+// Poolable is implemented by pointers to messages generated with the pool feature.
+type Poolable[T any] interface {
+	*T
+	ResetVT()
+	ReturnToVTPool()
+}
+
+// Pool hands out messages of type T from the pool generated for T.
+type Pool[T any] struct {
+	get func() *T
+	put func(*T)
+}
+
+// New returns a Pool backed by the generated pool of T. get is the FromVTPool
+// function generated for T, e.g.:
+//
+//	var messages = vtpool.New(pb.MyMessageFromVTPool)
+func New[T any, P Poolable[T]](get func() *T) *Pool[T] {
+	return &Pool[T]{
+		get: get,
+		put: func(m *T) { P(m).ReturnToVTPool() },
+	}
+}
+
+// Get returns an empty message from the pool, or a newly allocated one if the pool is empty.
+func (p *Pool[T]) Get() *T {
+	return p.get()
+}
+
+// Put resets m and returns it to the pool. m must not be used after calling Put.
+// Putting a nil message is a no-op.
+func (p *Pool[T]) Put(m *T) {
+	if m == nil {
+		return
+	}
+	p.put(m)
+}
+
+// With calls fn with a message from the pool and returns the message to the pool
+// once fn returns, even if it panics. fn must not retain the message.
+func (p *Pool[T]) With(fn func(*T) error) error {
+	m := p.Get()
+	defer p.Put(m)
+	return fn(m)
+}
@@ -0,0 +1,88 @@
+package vtpool_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+	"github.com/planetscale/vtprotobuf/vtpool"
+)
+
+func TestPoolGetPut(t *testing.T) {
+	messages := vtpool.New(pool.MemoryPoolExtensionFromVTPool)
+
+	m := messages.Get()
+	require.NotNil(t, m)
+	m.Foo1 = "hello"
+	messages.Put(m)
+	messages.Put(nil)
+
+	// Messages coming out of the pool are always empty.
+	got := messages.Get()
+	require.Empty(t, got.Foo1)
+}
+
+func TestPoolWith(t *testing.T) {
+	messages := vtpool.New(pool.MemoryPoolExtensionFromVTPool)
+	data, err := (&pool.MemoryPoolExtension{Foo1: "with"}).MarshalVT()
+	require.NoError(t, err)
+
+	var seen string
+	require.NoError(t, messages.With(func(m *pool.MemoryPoolExtension) error {
+		if err := m.UnmarshalVT(data); err != nil {
+			return err
+		}
+		seen = m.Foo1
+		return nil
+	}))
+	require.Equal(t, "with", seen)
+
+	errFailed := errors.New("failed")
+	require.ErrorIs(t, messages.With(func(*pool.MemoryPoolExtension) error { return errFailed }), errFailed)
+}
+
+// freelist is a Backend that keeps the returned messages in a slice.
+type freelist struct {
+	free []any
+	gets int
+}
+
+func (f *freelist) Get() any {
+	f.gets++
+	if len(f.free) == 0 {
+		return nil
+	}
+	m := f.free[len(f.free)-1]
+	f.free = f.free[:len(f.free)-1]
+	return m
+}
+
+func (f *freelist) Put(m any) {
+	f.free = append(f.free, m)
+}
+
+func TestSetBackend(t *testing.T) {
+	backend := &freelist{}
+	vtpool.SetBackend[pool.MemoryPoolExtension](backend)
+	t.Cleanup(func() { vtpool.SetBackend[pool.MemoryPoolExtension](nil) })
+
+	// An empty backend makes the pool allocate a new message.
+	m := pool.MemoryPoolExtensionFromVTPool()
+	require.NotNil(t, m)
+	require.Equal(t, 1, backend.gets)
+
+	m.Foo1 = "hello"
+	m.ReturnToVTPool()
+	require.Len(t, backend.free, 1)
+
+	got := pool.MemoryPoolExtensionFromVTPool()
+	require.Same(t, m, got)
+	require.Empty(t, got.Foo1)
+
+	vtpool.SetBackend[pool.MemoryPoolExtension](nil)
+	got.ReturnToVTPool()
+	require.Empty(t, backend.free)
+	require.Equal(t, 2, backend.gets)
+}
@@ -22,18 +22,322 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Dedup int32
+
+const (
+	Dedup_DEDUP_NONE Dedup = 0
+	// Equal adjacent values share the same string.
+	Dedup_DEDUP_ADJACENT Dedup = 1
+	// All equal values decoded by the same UnmarshalVT call share the same
+	// string, which is looked up in a set built while decoding.
+	Dedup_DEDUP_ALL Dedup = 2
+)
+
+// Enum value maps for Dedup.
+var (
+	Dedup_name = map[int32]string{
+		0: "DEDUP_NONE",
+		1: "DEDUP_ADJACENT",
+		2: "DEDUP_ALL",
+	}
+	Dedup_value = map[string]int32{
+		"DEDUP_NONE":     0,
+		"DEDUP_ADJACENT": 1,
+		"DEDUP_ALL":      2,
+	}
+)
+
+func (x Dedup) Enum() *Dedup {
+	p := new(Dedup)
+	*p = x
+	return p
+}
+
+func (x Dedup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dedup) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_enumTypes[0].Descriptor()
+}
+
+func (Dedup) Type() protoreflect.EnumType {
+	return &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_enumTypes[0]
+}
+
+func (x Dedup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Dedup) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Dedup(num)
+	return nil
+}
+
+// Deprecated: Use Dedup.Descriptor instead.
+func (Dedup) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP(), []int{0}
+}
+
+// These options apply to all the messages of the file, and are overridden by
+// the message options of the same name set on a message
+type FileOpts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pool_all generates pools for all the messages, like the mempool option.
+	PoolAll              *bool `protobuf:"varint,1,opt,name=pool_all,json=poolAll" json:"pool_all,omitempty"`
+	IgnoreUnknownFields  *bool `protobuf:"varint,2,opt,name=ignore_unknown_fields,json=ignoreUnknownFields" json:"ignore_unknown_fields,omitempty"`
+	ReuseMessages        *bool `protobuf:"varint,3,opt,name=reuse_messages,json=reuseMessages" json:"reuse_messages,omitempty"`
+	ReuseStrings         *bool `protobuf:"varint,4,opt,name=reuse_strings,json=reuseStrings" json:"reuse_strings,omitempty"`
+	MarshalBuffer        *bool `protobuf:"varint,5,opt,name=marshal_buffer,json=marshalBuffer" json:"marshal_buffer,omitempty"`
+	ObserveUnknownFields *bool `protobuf:"varint,6,opt,name=observe_unknown_fields,json=observeUnknownFields" json:"observe_unknown_fields,omitempty"`
+	// features lists the features to generate for the file, separated by '+'
+	// like the features flag, which it replaces along with the profiles.
+	Features         *string       `protobuf:"bytes,7,opt,name=features" json:"features,omitempty"`
+	ObserveUnmarshal *bool         `protobuf:"varint,8,opt,name=observe_unmarshal,json=observeUnmarshal" json:"observe_unmarshal,omitempty"`
+	SizeCache        *bool         `protobuf:"varint,9,opt,name=size_cache,json=sizeCache" json:"size_cache,omitempty"`
+	CycleCheck       *bool         `protobuf:"varint,10,opt,name=cycle_check,json=cycleCheck" json:"cycle_check,omitempty"`
+	DecodeBudget     *DecodeBudget `protobuf:"bytes,11,opt,name=decode_budget,json=decodeBudget" json:"decode_budget,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FileOpts) Reset() {
+	*x = FileOpts{}
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOpts) ProtoMessage() {}
+
+func (x *FileOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOpts.ProtoReflect.Descriptor instead.
+func (*FileOpts) Descriptor() ([]byte, []int) {
+	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileOpts) GetPoolAll() bool {
+	if x != nil && x.PoolAll != nil {
+		return *x.PoolAll
+	}
+	return false
+}
+
+func (x *FileOpts) GetIgnoreUnknownFields() bool {
+	if x != nil && x.IgnoreUnknownFields != nil {
+		return *x.IgnoreUnknownFields
+	}
+	return false
+}
+
+func (x *FileOpts) GetReuseMessages() bool {
+	if x != nil && x.ReuseMessages != nil {
+		return *x.ReuseMessages
+	}
+	return false
+}
+
+func (x *FileOpts) GetReuseStrings() bool {
+	if x != nil && x.ReuseStrings != nil {
+		return *x.ReuseStrings
+	}
+	return false
+}
+
+func (x *FileOpts) GetMarshalBuffer() bool {
+	if x != nil && x.MarshalBuffer != nil {
+		return *x.MarshalBuffer
+	}
+	return false
+}
+
+func (x *FileOpts) GetObserveUnknownFields() bool {
+	if x != nil && x.ObserveUnknownFields != nil {
+		return *x.ObserveUnknownFields
+	}
+	return false
+}
+
+func (x *FileOpts) GetFeatures() string {
+	if x != nil && x.Features != nil {
+		return *x.Features
+	}
+	return ""
+}
+
+func (x *FileOpts) GetObserveUnmarshal() bool {
+	if x != nil && x.ObserveUnmarshal != nil {
+		return *x.ObserveUnmarshal
+	}
+	return false
+}
+
+func (x *FileOpts) GetSizeCache() bool {
+	if x != nil && x.SizeCache != nil {
+		return *x.SizeCache
+	}
+	return false
+}
+
+func (x *FileOpts) GetCycleCheck() bool {
+	if x != nil && x.CycleCheck != nil {
+		return *x.CycleCheck
+	}
+	return false
+}
+
+func (x *FileOpts) GetDecodeBudget() *DecodeBudget {
+	if x != nil {
+		return x.DecodeBudget
+	}
+	return nil
+}
+
+// DecodeBudget bounds the resources UnmarshalVT allocates for a message and all
+// the messages it holds. The limits that are not set or zero are not enforced.
+type DecodeBudget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_bytes caps the total size of the string, bytes and unknown fields.
+	MaxBytes *uint64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes" json:"max_bytes,omitempty"`
+	// max_elements caps the total number of elements of the repeated fields.
+	MaxElements *uint64 `protobuf:"varint,2,opt,name=max_elements,json=maxElements" json:"max_elements,omitempty"`
+	// max_map_entries caps the total number of entries of the map fields.
+	MaxMapEntries *uint64 `protobuf:"varint,3,opt,name=max_map_entries,json=maxMapEntries" json:"max_map_entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeBudget) Reset() {
+	*x = DecodeBudget{}
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeBudget) ProtoMessage() {}
+
+func (x *DecodeBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeBudget.ProtoReflect.Descriptor instead.
+func (*DecodeBudget) Descriptor() ([]byte, []int) {
+	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DecodeBudget) GetMaxBytes() uint64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *DecodeBudget) GetMaxElements() uint64 {
+	if x != nil && x.MaxElements != nil {
+		return *x.MaxElements
+	}
+	return 0
+}
+
+func (x *DecodeBudget) GetMaxMapEntries() uint64 {
+	if x != nil && x.MaxMapEntries != nil {
+		return *x.MaxMapEntries
+	}
+	return 0
+}
+
 // These options should be used during schema definition,
 // applying them to some of the fields in protobuf
 type Opts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Unique        *bool                  `protobuf:"varint,1,opt,name=unique" json:"unique,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Unique *bool                  `protobuf:"varint,1,opt,name=unique" json:"unique,omitempty"`
+	// max_count caps the number of elements a repeated field may hold after
+	// UnmarshalVT. The backing slice is allocated once with this capacity and
+	// decoding fails instead of growing it further.
+	MaxCount *uint32 `protobuf:"varint,2,opt,name=max_count,json=maxCount" json:"max_count,omitempty"`
+	// strict_enum makes UnmarshalVT fail when an enum field contains a value
+	// that is not declared in its enum definition.
+	StrictEnum *bool `protobuf:"varint,3,opt,name=strict_enum,json=strictEnum" json:"strict_enum,omitempty"`
+	// dedup makes UnmarshalVT share the memory of equal values of a repeated
+	// string field instead of allocating a string for each of them.
+	Dedup *Dedup `protobuf:"varint,4,opt,name=dedup,enum=vtproto.Dedup" json:"dedup,omitempty"`
+	// strict_map_keys makes UnmarshalVT fail when a map field contains a key
+	// that is already in the map, instead of keeping the last value.
+	StrictMapKeys *bool `protobuf:"varint,5,opt,name=strict_map_keys,json=strictMapKeys" json:"strict_map_keys,omitempty"`
+	// mem_buffer makes UnmarshalVT alias the data of a bytes field instead of
+	// copying it. The data must be kept untouched for the lifetime of the
+	// message, which MemCodec in codec/grpc does by holding the received
+	// mem.Buffer until the message is released.
+	MemBuffer *bool `protobuf:"varint,6,opt,name=mem_buffer,json=memBuffer" json:"mem_buffer,omitempty"`
+	// validate_utf8_marshal makes MarshalVT fail when the string field, or the
+	// string keys and values of the map field, are not valid UTF-8. It overrides
+	// the validate-utf8-marshal flag for the field.
+	ValidateUtf8Marshal *bool `protobuf:"varint,7,opt,name=validate_utf8_marshal,json=validateUtf8Marshal" json:"validate_utf8_marshal,omitempty"`
+	// immutable makes CloneVT share the data of a bytes field, or of the bytes
+	// values of a map field, with the clone instead of copying it. The data must
+	// not be modified in place once the message is cloned.
+	Immutable *bool `protobuf:"varint,8,opt,name=immutable" json:"immutable,omitempty"`
+	// pooled_bytes makes UnmarshalVT and ResetVT manage the data of a singular or
+	// repeated bytes field with the size-classed buffer pools of protohelpers, so
+	// that the data of a field is always held in a buffer sized for it. The data
+	// belongs to the message: it must not be used once the message is reset or
+	// decoded again.
+	PooledBytes *bool `protobuf:"varint,9,opt,name=pooled_bytes,json=pooledBytes" json:"pooled_bytes,omitempty"`
+	// explicit_presence makes MarshalVT encode a singular scalar field with
+	// implicit presence even when it holds its zero value, like protoc-gen-go
+	// encodes the field once declared optional and set. Readers can then be
+	// migrated to the optional field before its writers are. The Go field itself
+	// is generated by protoc-gen-go and keeps its representation.
+	ExplicitPresence *bool `protobuf:"varint,10,opt,name=explicit_presence,json=explicitPresence" json:"explicit_presence,omitempty"`
+	// fast_accessor generates a GetXxxVT(m) function returning the value of a
+	// singular extension, which reads the extension fields of m directly instead
+	// of going through the reflection of proto.GetExtension when m is declared in
+	// the same Go package as the extension.
+	FastAccessor *bool `protobuf:"varint,11,opt,name=fast_accessor,json=fastAccessor" json:"fast_accessor,omitempty"`
+	// max_len caps the length in bytes of the string or bytes field, or of the
+	// string and bytes keys and values of the map field, that UnmarshalVT
+	// decodes. Longer values fail with a *protohelpers.LengthError. It
+	// overrides the max-len flag for the field.
+	MaxLen        *uint64 `protobuf:"varint,12,opt,name=max_len,json=maxLen" json:"max_len,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Opts) Reset() {
 	*x = Opts{}
-	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[0]
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +349,7 @@ func (x *Opts) String() string {
 func (*Opts) ProtoMessage() {}
 
 func (x *Opts) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[0]
+	mi := &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +362,7 @@ func (x *Opts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Opts.ProtoReflect.Descriptor instead.
 func (*Opts) Descriptor() ([]byte, []int) {
-	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP(), []int{0}
+	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Opts) GetUnique() bool {
@@ -68,6 +372,83 @@ func (x *Opts) GetUnique() bool {
 	return false
 }
 
+func (x *Opts) GetMaxCount() uint32 {
+	if x != nil && x.MaxCount != nil {
+		return *x.MaxCount
+	}
+	return 0
+}
+
+func (x *Opts) GetStrictEnum() bool {
+	if x != nil && x.StrictEnum != nil {
+		return *x.StrictEnum
+	}
+	return false
+}
+
+func (x *Opts) GetDedup() Dedup {
+	if x != nil && x.Dedup != nil {
+		return *x.Dedup
+	}
+	return Dedup_DEDUP_NONE
+}
+
+func (x *Opts) GetStrictMapKeys() bool {
+	if x != nil && x.StrictMapKeys != nil {
+		return *x.StrictMapKeys
+	}
+	return false
+}
+
+func (x *Opts) GetMemBuffer() bool {
+	if x != nil && x.MemBuffer != nil {
+		return *x.MemBuffer
+	}
+	return false
+}
+
+func (x *Opts) GetValidateUtf8Marshal() bool {
+	if x != nil && x.ValidateUtf8Marshal != nil {
+		return *x.ValidateUtf8Marshal
+	}
+	return false
+}
+
+func (x *Opts) GetImmutable() bool {
+	if x != nil && x.Immutable != nil {
+		return *x.Immutable
+	}
+	return false
+}
+
+func (x *Opts) GetPooledBytes() bool {
+	if x != nil && x.PooledBytes != nil {
+		return *x.PooledBytes
+	}
+	return false
+}
+
+func (x *Opts) GetExplicitPresence() bool {
+	if x != nil && x.ExplicitPresence != nil {
+		return *x.ExplicitPresence
+	}
+	return false
+}
+
+func (x *Opts) GetFastAccessor() bool {
+	if x != nil && x.FastAccessor != nil {
+		return *x.FastAccessor
+	}
+	return false
+}
+
+func (x *Opts) GetMaxLen() uint64 {
+	if x != nil && x.MaxLen != nil {
+		return *x.MaxLen
+	}
+	return 0
+}
+
 var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
@@ -85,6 +466,70 @@ var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes = []protoi
 		Tag:           "varint,64102,opt,name=ignore_unknown_fields",
 		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64103,
+		Name:          "vtproto.reuse_messages",
+		Tag:           "varint,64103,opt,name=reuse_messages",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64104,
+		Name:          "vtproto.reuse_strings",
+		Tag:           "varint,64104,opt,name=reuse_strings",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64105,
+		Name:          "vtproto.marshal_buffer",
+		Tag:           "varint,64105,opt,name=marshal_buffer",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64106,
+		Name:          "vtproto.observe_unknown_fields",
+		Tag:           "varint,64106,opt,name=observe_unknown_fields",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64107,
+		Name:          "vtproto.observe_unmarshal",
+		Tag:           "varint,64107,opt,name=observe_unmarshal",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64108,
+		Name:          "vtproto.size_cache",
+		Tag:           "varint,64108,opt,name=size_cache",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         64109,
+		Name:          "vtproto.cycle_check",
+		Tag:           "varint,64109,opt,name=cycle_check",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*DecodeBudget)(nil),
+		Field:         64110,
+		Name:          "vtproto.decode_budget",
+		Tag:           "bytes,64110,opt,name=decode_budget",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
 		ExtensionType: (*Opts)(nil),
@@ -93,6 +538,14 @@ var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes = []protoi
 		Tag:           "bytes,64150,opt,name=options",
 		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*FileOpts)(nil),
+		Field:         64160,
+		Name:          "vtproto.file",
+		Tag:           "bytes,64160,opt,name=file",
+		Filename:      "github.com/planetscale/vtprotobuf/vtproto/ext.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -101,24 +554,121 @@ var (
 	E_Mempool = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[0]
 	// optional bool ignore_unknown_fields = 64102;
 	E_IgnoreUnknownFields = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[1]
+	// reuse_messages makes UnmarshalVT decode repeated message fields into the
+	// elements kept in the capacity of their slice instead of allocating new ones.
+	//
+	// optional bool reuse_messages = 64103;
+	E_ReuseMessages = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[2]
+	// reuse_strings makes UnmarshalVT keep the current value of string fields
+	// instead of allocating a new string when the decoded value is the same.
+	//
+	// optional bool reuse_strings = 64104;
+	E_ReuseStrings = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[3]
+	// marshal_buffer generates a MarshalVTBuffer method that marshals the message
+	// into a pooled vtbuf.Buffer instead of a newly allocated slice.
+	//
+	// optional bool marshal_buffer = 64105;
+	E_MarshalBuffer = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[4]
+	// observe_unknown_fields makes UnmarshalVT report each unknown field to the
+	// hook registered with protohelpers.SetUnknownFieldHook.
+	//
+	// optional bool observe_unknown_fields = 64106;
+	E_ObserveUnknownFields = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[5]
+	// observe_unmarshal makes UnmarshalVT report its input size and result to the
+	// hook registered with protohelpers.SetUnmarshalHook.
+	//
+	// optional bool observe_unmarshal = 64107;
+	E_ObserveUnmarshal = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[6]
+	// size_cache generates a SizeVTCached method computing the size of the message
+	// once per marshal call, from a cache keyed by message address, for graphs in
+	// which the same message is held by many parents. MarshalVT uses it.
+	//
+	// optional bool size_cache = 64108;
+	E_SizeCache = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[7]
+	// cycle_check makes MarshalVT fail with a *protohelpers.CycleError when the
+	// message holds itself, or is deeper than protohelpers.MaxMarshalDepth, instead
+	// of overflowing the stack.
+	//
+	// optional bool cycle_check = 64109;
+	E_CycleCheck = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[8]
+	// decode_budget makes UnmarshalVT check the data against the budget before
+	// decoding it, failing with a *protohelpers.BudgetError when it exceeds it.
+	//
+	// optional vtproto.DecodeBudget decode_budget = 64110;
+	E_DecodeBudget = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[9]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
 var (
 	// optional vtproto.Opts options = 64150;
-	E_Options = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[2]
+	E_Options = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[10]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional vtproto.FileOpts file = 64160;
+	E_File = &file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes[11]
 )
 
 var File_github_com_planetscale_vtprotobuf_vtproto_ext_proto protoreflect.FileDescriptor
 
 const file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDesc = "" +
 	"\n" +
-	"3github.com/planetscale/vtprotobuf/vtproto/ext.proto\x12\avtproto\x1a google/protobuf/descriptor.proto\"\x1e\n" +
+	"3github.com/planetscale/vtprotobuf/vtproto/ext.proto\x12\avtproto\x1a google/protobuf/descriptor.proto\"\xc7\x03\n" +
+	"\bFileOpts\x12\x19\n" +
+	"\bpool_all\x18\x01 \x01(\bR\apoolAll\x122\n" +
+	"\x15ignore_unknown_fields\x18\x02 \x01(\bR\x13ignoreUnknownFields\x12%\n" +
+	"\x0ereuse_messages\x18\x03 \x01(\bR\rreuseMessages\x12#\n" +
+	"\rreuse_strings\x18\x04 \x01(\bR\freuseStrings\x12%\n" +
+	"\x0emarshal_buffer\x18\x05 \x01(\bR\rmarshalBuffer\x124\n" +
+	"\x16observe_unknown_fields\x18\x06 \x01(\bR\x14observeUnknownFields\x12\x1a\n" +
+	"\bfeatures\x18\a \x01(\tR\bfeatures\x12+\n" +
+	"\x11observe_unmarshal\x18\b \x01(\bR\x10observeUnmarshal\x12\x1d\n" +
+	"\n" +
+	"size_cache\x18\t \x01(\bR\tsizeCache\x12\x1f\n" +
+	"\vcycle_check\x18\n" +
+	" \x01(\bR\n" +
+	"cycleCheck\x12:\n" +
+	"\rdecode_budget\x18\v \x01(\v2\x15.vtproto.DecodeBudgetR\fdecodeBudget\"v\n" +
+	"\fDecodeBudget\x12\x1b\n" +
+	"\tmax_bytes\x18\x01 \x01(\x04R\bmaxBytes\x12!\n" +
+	"\fmax_elements\x18\x02 \x01(\x04R\vmaxElements\x12&\n" +
+	"\x0fmax_map_entries\x18\x03 \x01(\x04R\rmaxMapEntries\"\xa9\x03\n" +
 	"\x04Opts\x12\x16\n" +
-	"\x06unique\x18\x01 \x01(\bR\x06unique:;\n" +
+	"\x06unique\x18\x01 \x01(\bR\x06unique\x12\x1b\n" +
+	"\tmax_count\x18\x02 \x01(\rR\bmaxCount\x12\x1f\n" +
+	"\vstrict_enum\x18\x03 \x01(\bR\n" +
+	"strictEnum\x12$\n" +
+	"\x05dedup\x18\x04 \x01(\x0e2\x0e.vtproto.DedupR\x05dedup\x12&\n" +
+	"\x0fstrict_map_keys\x18\x05 \x01(\bR\rstrictMapKeys\x12\x1d\n" +
+	"\n" +
+	"mem_buffer\x18\x06 \x01(\bR\tmemBuffer\x122\n" +
+	"\x15validate_utf8_marshal\x18\a \x01(\bR\x13validateUtf8Marshal\x12\x1c\n" +
+	"\timmutable\x18\b \x01(\bR\timmutable\x12!\n" +
+	"\fpooled_bytes\x18\t \x01(\bR\vpooledBytes\x12+\n" +
+	"\x11explicit_presence\x18\n" +
+	" \x01(\bR\x10explicitPresence\x12#\n" +
+	"\rfast_accessor\x18\v \x01(\bR\ffastAccessor\x12\x17\n" +
+	"\amax_len\x18\f \x01(\x04R\x06maxLen*:\n" +
+	"\x05Dedup\x12\x0e\n" +
+	"\n" +
+	"DEDUP_NONE\x10\x00\x12\x12\n" +
+	"\x0eDEDUP_ADJACENT\x10\x01\x12\r\n" +
+	"\tDEDUP_ALL\x10\x02:;\n" +
 	"\amempool\x12\x1f.google.protobuf.MessageOptions\x18\xe5\xf4\x03 \x01(\bR\amempool:U\n" +
 	"\x15ignore_unknown_fields\x12\x1f.google.protobuf.MessageOptions\x18\xe6\xf4\x03 \x01(\bR\x13ignoreUnknownFields:H\n" +
-	"\aoptions\x12\x1d.google.protobuf.FieldOptions\x18\x96\xf5\x03 \x01(\v2\r.vtproto.OptsR\aoptionsBI\n" +
+	"\x0ereuse_messages\x12\x1f.google.protobuf.MessageOptions\x18\xe7\xf4\x03 \x01(\bR\rreuseMessages:F\n" +
+	"\rreuse_strings\x12\x1f.google.protobuf.MessageOptions\x18\xe8\xf4\x03 \x01(\bR\freuseStrings:H\n" +
+	"\x0emarshal_buffer\x12\x1f.google.protobuf.MessageOptions\x18\xe9\xf4\x03 \x01(\bR\rmarshalBuffer:W\n" +
+	"\x16observe_unknown_fields\x12\x1f.google.protobuf.MessageOptions\x18\xea\xf4\x03 \x01(\bR\x14observeUnknownFields:N\n" +
+	"\x11observe_unmarshal\x12\x1f.google.protobuf.MessageOptions\x18\xeb\xf4\x03 \x01(\bR\x10observeUnmarshal:@\n" +
+	"\n" +
+	"size_cache\x12\x1f.google.protobuf.MessageOptions\x18\xec\xf4\x03 \x01(\bR\tsizeCache:B\n" +
+	"\vcycle_check\x12\x1f.google.protobuf.MessageOptions\x18\xed\xf4\x03 \x01(\bR\n" +
+	"cycleCheck:]\n" +
+	"\rdecode_budget\x12\x1f.google.protobuf.MessageOptions\x18\xee\xf4\x03 \x01(\v2\x15.vtproto.DecodeBudgetR\fdecodeBudget:H\n" +
+	"\aoptions\x12\x1d.google.protobuf.FieldOptions\x18\x96\xf5\x03 \x01(\v2\r.vtproto.OptsR\aoptions:E\n" +
+	"\x04file\x12\x1c.google.protobuf.FileOptions\x18\xa0\xf5\x03 \x01(\v2\x11.vtproto.FileOptsR\x04fileBI\n" +
 	"\x13com.google.protobufB\aVTProtoZ)github.com/planetscale/vtprotobuf/vtproto"
 
 var (
@@ -133,22 +683,40 @@ func file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescGZIP() []by
 	return file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDescData
 }
 
-var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_goTypes = []any{
-	(*Opts)(nil),                        // 0: vtproto.Opts
-	(*descriptorpb.MessageOptions)(nil), // 1: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 2: google.protobuf.FieldOptions
+	(Dedup)(0),                          // 0: vtproto.Dedup
+	(*FileOpts)(nil),                    // 1: vtproto.FileOpts
+	(*DecodeBudget)(nil),                // 2: vtproto.DecodeBudget
+	(*Opts)(nil),                        // 3: vtproto.Opts
+	(*descriptorpb.MessageOptions)(nil), // 4: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 5: google.protobuf.FieldOptions
+	(*descriptorpb.FileOptions)(nil),    // 6: google.protobuf.FileOptions
 }
 var file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_depIdxs = []int32{
-	1, // 0: vtproto.mempool:extendee -> google.protobuf.MessageOptions
-	1, // 1: vtproto.ignore_unknown_fields:extendee -> google.protobuf.MessageOptions
-	2, // 2: vtproto.options:extendee -> google.protobuf.FieldOptions
-	0, // 3: vtproto.options:type_name -> vtproto.Opts
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	3, // [3:4] is the sub-list for extension type_name
-	0, // [0:3] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: vtproto.FileOpts.decode_budget:type_name -> vtproto.DecodeBudget
+	0,  // 1: vtproto.Opts.dedup:type_name -> vtproto.Dedup
+	4,  // 2: vtproto.mempool:extendee -> google.protobuf.MessageOptions
+	4,  // 3: vtproto.ignore_unknown_fields:extendee -> google.protobuf.MessageOptions
+	4,  // 4: vtproto.reuse_messages:extendee -> google.protobuf.MessageOptions
+	4,  // 5: vtproto.reuse_strings:extendee -> google.protobuf.MessageOptions
+	4,  // 6: vtproto.marshal_buffer:extendee -> google.protobuf.MessageOptions
+	4,  // 7: vtproto.observe_unknown_fields:extendee -> google.protobuf.MessageOptions
+	4,  // 8: vtproto.observe_unmarshal:extendee -> google.protobuf.MessageOptions
+	4,  // 9: vtproto.size_cache:extendee -> google.protobuf.MessageOptions
+	4,  // 10: vtproto.cycle_check:extendee -> google.protobuf.MessageOptions
+	4,  // 11: vtproto.decode_budget:extendee -> google.protobuf.MessageOptions
+	5,  // 12: vtproto.options:extendee -> google.protobuf.FieldOptions
+	6,  // 13: vtproto.file:extendee -> google.protobuf.FileOptions
+	2,  // 14: vtproto.decode_budget:type_name -> vtproto.DecodeBudget
+	3,  // 15: vtproto.options:type_name -> vtproto.Opts
+	1,  // 16: vtproto.file:type_name -> vtproto.FileOpts
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	14, // [14:17] is the sub-list for extension type_name
+	2,  // [2:14] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_init() }
@@ -161,13 +729,14 @@ func file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDesc), len(file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
-			NumExtensions: 3,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 12,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_goTypes,
 		DependencyIndexes: file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_depIdxs,
+		EnumInfos:         file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_enumTypes,
 		MessageInfos:      file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_msgTypes,
 		ExtensionInfos:    file_github_com_planetscale_vtprotobuf_vtproto_ext_proto_extTypes,
 	}.Build()
@@ -0,0 +1,96 @@
+package vtproto
+
+import (
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtalloc"
+	"github.com/planetscale/vtprotobuf/vtarena"
+)
+
+// The interfaces below are implemented by the methods that protoc-gen-go-vtproto
+// generates for messages, one per feature. Libraries working with generated messages
+// can use them instead of declaring their own copies.
+
+// Marshaler is implemented by messages generated with the marshal feature.
+type Marshaler interface {
+	MarshalVT() ([]byte, error)
+}
+
+// StrictMarshaler is implemented by messages generated with the marshal_strict
+// feature, which encodes fields in field number order.
+type StrictMarshaler interface {
+	MarshalVTStrict() ([]byte, error)
+}
+
+// DeterministicMarshaler is implemented by messages generated with the
+// marshal_deterministic feature, which encodes map entries in the order of their keys.
+type DeterministicMarshaler interface {
+	MarshalVTDeterministic() ([]byte, error)
+}
+
+// WriterMarshaler is implemented by messages generated with the marshal_writer feature,
+// which writes the encoding to an io.Writer without holding all of it in memory.
+type WriterMarshaler interface {
+	MarshalToWriterVT(io.Writer) (int, error)
+}
+
+// SizedMarshaler is implemented by messages generated with both the marshal and
+// size features, and marshals into a buffer of exactly SizeVT bytes.
+type SizedMarshaler interface {
+	Sizer
+	MarshalToSizedBufferVT([]byte) (int, error)
+}
+
+// Unmarshaler is implemented by messages generated with the unmarshal feature.
+type Unmarshaler interface {
+	UnmarshalVT([]byte) error
+}
+
+// OptionsUnmarshaler is implemented by messages generated with the unmarshal_alloc
+// feature, which allocates the decoded values with the allocator of the options.
+type OptionsUnmarshaler interface {
+	UnmarshalVTOptions([]byte, vtalloc.UnmarshalOptions) error
+}
+
+// ArenaUnmarshaler is implemented by messages generated with the unmarshal_arena
+// feature, which allocates the decoded values from an arena.
+type ArenaUnmarshaler interface {
+	UnmarshalVTArena(*vtarena.Arena, []byte) error
+}
+
+// Message is implemented by messages generated with both the marshal and unmarshal
+// features, and is what the codecs of this module require.
+type Message interface {
+	Marshaler
+	Unmarshaler
+}
+
+// Sizer is implemented by messages generated with the size feature.
+type Sizer interface {
+	SizeVT() int
+}
+
+// Cloner is implemented by messages generated with the clone feature. The typed
+// CloneVT method is not part of the interface, as its result is the message type.
+type Cloner interface {
+	CloneMessageVT() proto.Message
+}
+
+// Equaler is implemented by messages generated with the equal feature.
+type Equaler interface {
+	EqualMessageVT(proto.Message) bool
+}
+
+// Resetter is implemented by messages generated with the pool feature, and resets
+// the message while keeping the memory it holds for reuse.
+type Resetter interface {
+	ResetVT()
+}
+
+// Pooler is implemented by messages generated with the pool feature.
+type Pooler interface {
+	Resetter
+	ReturnToVTPool()
+}
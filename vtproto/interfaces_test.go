@@ -0,0 +1,23 @@
+package vtproto_test
+
+import (
+	"github.com/planetscale/vtprotobuf/testproto/alloc"
+	"github.com/planetscale/vtprotobuf/testproto/arena"
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+	"github.com/planetscale/vtprotobuf/testproto/required"
+	"github.com/planetscale/vtprotobuf/testproto/writer"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+var (
+	_ vtproto.Message                = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.StrictMarshaler        = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.SizedMarshaler         = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.Cloner                 = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.Equaler                = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.Pooler                 = (*pool.MemoryPoolExtension)(nil)
+	_ vtproto.DeterministicMarshaler = (*required.Tree)(nil)
+	_ vtproto.WriterMarshaler        = (*writer.Archive)(nil)
+	_ vtproto.OptionsUnmarshaler     = (*alloc.Mail)(nil)
+	_ vtproto.ArenaUnmarshaler       = (*arena.Request)(nil)
+)
@@ -0,0 +1,247 @@
+// Package vtquick implements property-based tests checking that the methods
+// generated by protoc-gen-go-vtproto agree with google.golang.org/protobuf on
+// arbitrary messages. The tests generated by the quick feature use it with
+// testing/quick; it can also be used directly.
+package vtquick
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// maxDepth bounds the nesting of the messages generated by Fill, for recursive messages.
+const maxDepth = 8
+
+// New returns a new message of type T, which must be a pointer to a generated
+// message struct, filled by Fill.
+func New[T proto.Message](r *rand.Rand, size int) T {
+	var zero T
+	m := zero.ProtoReflect().Type().New().Interface().(T)
+	Fill(m, r, size)
+	return m
+}
+
+// Fill sets the fields of m to random values. size bounds the number of values
+// set, which is the number of elements of repeated and map fields and the nesting
+// depth of message fields included. Required fields are always set, and oneofs
+// have at most one of their fields set. Enum fields only take declared values.
+func Fill(m proto.Message, r *rand.Rand, size int) {
+	g := &generator{r: r, budget: size}
+	g.message(m.ProtoReflect(), 0)
+}
+
+type generator struct {
+	r      *rand.Rand
+	budget int
+}
+
+// take consumes one value from the budget, and returns false if it is exhausted.
+func (g *generator) take() bool {
+	if g.budget <= 0 {
+		return false
+	}
+	g.budget--
+	return true
+}
+
+func (g *generator) message(m protoreflect.Message, depth int) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsWeak() {
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			// Only the first field of a oneof picks which of its fields is set.
+			if oneof.Fields().Get(0) != fd {
+				continue
+			}
+			if depth < maxDepth && g.take() {
+				picked := oneof.Fields().Get(g.r.Intn(oneof.Fields().Len()))
+				g.set(m, picked, depth)
+			}
+			continue
+		}
+		if fd.Cardinality() == protoreflect.Required {
+			g.set(m, fd, depth)
+			continue
+		}
+		if depth >= maxDepth || g.r.Intn(4) == 0 || !g.take() {
+			continue
+		}
+		g.set(m, fd, depth)
+	}
+}
+
+func (g *generator) set(m protoreflect.Message, fd protoreflect.FieldDescriptor, depth int) {
+	switch {
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		for n := g.r.Intn(g.budget/2 + 1); n > 0 && g.take(); n-- {
+			if isMessage(fd) {
+				g.message(list.AppendMutable().Message(), depth+1)
+			} else {
+				list.Append(g.scalar(fd))
+			}
+		}
+	case fd.IsMap():
+		mp := m.Mutable(fd).Map()
+		for n := g.r.Intn(g.budget/2 + 1); n > 0 && g.take(); n-- {
+			key := g.scalar(fd.MapKey()).MapKey()
+			if isMessage(fd.MapValue()) {
+				g.message(mp.Mutable(key).Message(), depth+1)
+			} else {
+				mp.Set(key, g.scalar(fd.MapValue()))
+			}
+		}
+	case isMessage(fd):
+		g.message(m.Mutable(fd).Message(), depth+1)
+	default:
+		m.Set(fd, g.scalar(fd))
+	}
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// edge picks one of the values in edges for a quarter of the calls, so that the limits
+// of each type are covered, and returns false otherwise.
+func edge[T any](r *rand.Rand, edges ...T) (T, bool) {
+	if r.Intn(4) == 0 {
+		return edges[r.Intn(len(edges))], true
+	}
+	var zero T
+	return zero, false
+}
+
+func (g *generator) scalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	r := g.r
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(r.Intn(2) == 0)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(r.Intn(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if v, ok := edge[int32](r, 0, -1, math.MinInt32, math.MaxInt32); ok {
+			return protoreflect.ValueOfInt32(v)
+		}
+		return protoreflect.ValueOfInt32(int32(r.Uint32()) >> r.Intn(32))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if v, ok := edge[int64](r, 0, -1, math.MinInt64, math.MaxInt64); ok {
+			return protoreflect.ValueOfInt64(v)
+		}
+		return protoreflect.ValueOfInt64(int64(r.Uint64()) >> r.Intn(64))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if v, ok := edge[uint32](r, 0, 1, math.MaxUint32); ok {
+			return protoreflect.ValueOfUint32(v)
+		}
+		return protoreflect.ValueOfUint32(r.Uint32() >> r.Intn(32))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if v, ok := edge[uint64](r, 0, 1, math.MaxUint64); ok {
+			return protoreflect.ValueOfUint64(v)
+		}
+		return protoreflect.ValueOfUint64(r.Uint64() >> r.Intn(64))
+	case protoreflect.FloatKind:
+		if v, ok := edge(r, 0, float32(math.Copysign(0, -1)), math.MaxFloat32, math.SmallestNonzeroFloat32, float32(math.Inf(1))); ok {
+			return protoreflect.ValueOfFloat32(v)
+		}
+		return protoreflect.ValueOfFloat32(float32(r.NormFloat64()))
+	case protoreflect.DoubleKind:
+		if v, ok := edge(r, 0, math.Copysign(0, -1), math.MaxFloat64, math.SmallestNonzeroFloat64, math.Inf(-1)); ok {
+			return protoreflect.ValueOfFloat64(v)
+		}
+		return protoreflect.ValueOfFloat64(r.NormFloat64() * 1e6)
+	case protoreflect.StringKind:
+		runes := make([]rune, r.Intn(16))
+		for i := range runes {
+			if r.Intn(2) == 0 {
+				runes[i] = rune(' ' + r.Intn('~'-' '))
+			} else {
+				// Any code point but the surrogates, which are not valid UTF-8.
+				runes[i] = rune(r.Intn(0xd800))
+			}
+		}
+		return protoreflect.ValueOfString(string(runes))
+	case protoreflect.BytesKind:
+		b := make([]byte, r.Intn(16))
+		r.Read(b)
+		return protoreflect.ValueOfBytes(b)
+	default:
+		panic(fmt.Sprintf("vtquick: unexpected kind %v", fd.Kind()))
+	}
+}
+
+// Agree checks that the generated methods of m agree with the proto package: the
+// data marshaled by MarshalVT must be decoded by proto.Unmarshal into a message equal
+// to m, and the data marshaled by proto.Marshal must be decoded by UnmarshalVT into a
+// message equal to m. SizeVT, CloneMessageVT and EqualMessageVT are checked as well.
+// The methods that m does not have are skipped.
+func Agree(m proto.Message) error {
+	name := m.ProtoReflect().Descriptor().FullName()
+	if vt, ok := m.(vtproto.Sizer); ok {
+		if got, want := vt.SizeVT(), proto.Size(m); got != want {
+			return fmt.Errorf("%s: SizeVT returned %d, proto.Size returned %d", name, got, want)
+		}
+	}
+	if vt, ok := m.(vtproto.Marshaler); ok {
+		data, err := vt.MarshalVT()
+		if err != nil {
+			return fmt.Errorf("%s: MarshalVT: %w", name, err)
+		}
+		got := m.ProtoReflect().Type().New().Interface()
+		if err := proto.Unmarshal(data, got); err != nil {
+			return fmt.Errorf("%s: proto.Unmarshal of MarshalVT output: %w", name, err)
+		}
+		if !proto.Equal(m, got) {
+			return fmt.Errorf("%s: MarshalVT output decodes to a different message\nwant: %v\ngot:  %v", name, m, got)
+		}
+	}
+	if _, ok := m.(vtproto.Unmarshaler); ok {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			return fmt.Errorf("%s: proto.Marshal: %w", name, err)
+		}
+		got := m.ProtoReflect().Type().New().Interface()
+		if err := got.(vtproto.Unmarshaler).UnmarshalVT(data); err != nil {
+			return fmt.Errorf("%s: UnmarshalVT of proto.Marshal output: %w", name, err)
+		}
+		if !proto.Equal(m, got) {
+			return fmt.Errorf("%s: UnmarshalVT decodes a different message\nwant: %v\ngot:  %v", name, m, got)
+		}
+	}
+	if vt, ok := m.(vtproto.Cloner); ok {
+		clone := vt.CloneMessageVT()
+		if !proto.Equal(m, clone) {
+			return fmt.Errorf("%s: CloneMessageVT returned a different message\nwant: %v\ngot:  %v", name, m, clone)
+		}
+		if vt, ok := m.(vtproto.Equaler); ok && !vt.EqualMessageVT(clone) {
+			return fmt.Errorf("%s: EqualMessageVT returned false for a clone", name)
+		}
+	}
+	return nil
+}
+
+// Check runs property with testing/quick, and reports the first error returned by
+// property as a test failure. The argument of property is generated by testing/quick,
+// and usually implements quick.Generator with New.
+func Check[T any](t testing.TB, property func(T) error, config *quick.Config) {
+	t.Helper()
+	var failure error
+	err := quick.Check(func(v T) bool {
+		failure = property(v)
+		return failure == nil
+	}, config)
+	if err != nil {
+		t.Fatal(failure)
+	}
+}
@@ -0,0 +1,352 @@
+// Package vtregistry keeps track of the vtprotobuf helpers available for each
+// message type, so that code handling messages it did not generate itself can
+// dispatch to them without repeated interface assertions.
+//
+// Files generated with the registry option register every message they declare
+// in an init function. Generated code for fields whose message type lives in a
+// different package keeps one Type handle per message type, which resolves the
+// capabilities of that type the first time it is used and falls back to the
+// google.golang.org/protobuf/proto package for the operations the type does not
+// implement.
+package vtregistry
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Capabilities is the set of vtprotobuf helpers implemented by a message type.
+// Every function receives messages of the registered type; operations that are
+// not implemented by the type are nil.
+type Capabilities struct {
+	// New returns a new empty message of the type
+	New                                 func() proto.Message
+	SizeVT                              func(m proto.Message) int
+	MarshalToSizedBufferVT              func(m proto.Message, dAtA []byte) (int, error)
+	MarshalToSizedBufferVTStrict        func(m proto.Message, dAtA []byte) (int, error)
+	MarshalToSizedBufferVTDeterministic func(m proto.Message, dAtA []byte) (int, error)
+	UnmarshalVT                         func(m proto.Message, dAtA []byte) error
+	UnmarshalVTUnsafe                   func(m proto.Message, dAtA []byte) error
+	CloneVT                             func(m proto.Message) proto.Message
+	EqualVT                             func(a, b proto.Message) bool
+	// FromVTPool returns an empty message of the type from its memory pool, for the
+	// types generated with the pool feature and registered with RegisterPooledType
+	FromVTPool func() proto.Message
+	// ReturnToVTPool resets m and returns it to the memory pool of the type
+	ReturnToVTPool func(m proto.Message)
+}
+
+// Flags is a set of the vtprotobuf helpers implemented by a message type, see
+// Capabilities.Flags.
+type Flags uint
+
+const (
+	HasSizeVT Flags = 1 << iota
+	HasMarshalToSizedBufferVT
+	HasMarshalToSizedBufferVTStrict
+	HasUnmarshalVT
+	HasUnmarshalVTUnsafe
+	HasCloneVT
+	HasEqualVT
+	HasMarshalToSizedBufferVTDeterministic
+	HasFromVTPool
+	HasReturnToVTPool
+)
+
+// Flags returns the set of the helpers in caps.
+func (caps Capabilities) Flags() Flags {
+	var flags Flags
+	for _, op := range []struct {
+		ok   bool
+		flag Flags
+	}{
+		{caps.SizeVT != nil, HasSizeVT},
+		{caps.MarshalToSizedBufferVT != nil, HasMarshalToSizedBufferVT},
+		{caps.MarshalToSizedBufferVTStrict != nil, HasMarshalToSizedBufferVTStrict},
+		{caps.UnmarshalVT != nil, HasUnmarshalVT},
+		{caps.UnmarshalVTUnsafe != nil, HasUnmarshalVTUnsafe},
+		{caps.CloneVT != nil, HasCloneVT},
+		{caps.EqualVT != nil, HasEqualVT},
+		{caps.MarshalToSizedBufferVTDeterministic != nil, HasMarshalToSizedBufferVTDeterministic},
+		{caps.FromVTPool != nil, HasFromVTPool},
+		{caps.ReturnToVTPool != nil, HasReturnToVTPool},
+	} {
+		if op.ok {
+			flags |= op.flag
+		}
+	}
+	return flags
+}
+
+var registry sync.Map // map[protoreflect.FullName]Capabilities
+
+// Register derives the capabilities of the type of m from its method set and
+// registers them under the full name of its descriptor. m may be a typed nil
+// pointer. Registering a name again replaces the previous entry.
+func Register(m proto.Message) {
+	RegisterCapabilities(m.ProtoReflect().Descriptor().FullName(), CapabilitiesOf(m))
+}
+
+// RegisterType is like Register for the message type *T, registered under the given
+// full name, whose constructor allocates the message without reflection. Files generated
+// with the registry option register their messages with it.
+func RegisterType[T any, P interface {
+	*T
+	proto.Message
+}](name protoreflect.FullName) {
+	caps := CapabilitiesOf(P(nil))
+	caps.New = func() proto.Message { return P(new(T)) }
+	RegisterCapabilities(name, caps)
+}
+
+// RegisterPooledType is like RegisterType for the message types generated with the pool
+// feature, whose messages are taken from their memory pool by fromVTPool, the
+// <Message>FromVTPool function generated for the type. Files generated with the registry
+// option register their pooled messages with it.
+func RegisterPooledType[T any, P interface {
+	*T
+	proto.Message
+}](name protoreflect.FullName, fromVTPool func() *T) {
+	caps := CapabilitiesOf(P(nil))
+	caps.New = func() proto.Message { return P(new(T)) }
+	caps.FromVTPool = func() proto.Message { return P(fromVTPool()) }
+	RegisterCapabilities(name, caps)
+}
+
+// RegisterCapabilities registers caps for the message type with the given full name.
+func RegisterCapabilities(name protoreflect.FullName, caps Capabilities) {
+	registry.Store(name, caps)
+}
+
+// Lookup returns the capabilities registered for the message type with the given full name.
+func Lookup(name protoreflect.FullName) (Capabilities, bool) {
+	caps, ok := registry.Load(name)
+	if !ok {
+		return Capabilities{}, false
+	}
+	return caps.(Capabilities), true
+}
+
+// LookupURL returns the capabilities registered for the message type of the given type
+// URL of a google.protobuf.Any, whose last path segment is the full name of the type.
+func LookupURL(url string) (Capabilities, bool) {
+	return Lookup(protoreflect.FullName(url[strings.LastIndexByte(url, '/')+1:]))
+}
+
+// Range calls f with the full name and the capabilities of each registered message type,
+// in no particular order, until f returns false.
+func Range(f func(name protoreflect.FullName, caps Capabilities) bool) {
+	registry.Range(func(name, caps any) bool {
+		return f(name.(protoreflect.FullName), caps.(Capabilities))
+	})
+}
+
+// New returns a new empty message of the registered message type with the given full name.
+func New(name protoreflect.FullName) (proto.Message, bool) {
+	caps, ok := Lookup(name)
+	if !ok {
+		return nil, false
+	}
+	return caps.New(), true
+}
+
+// Unmarshal decodes data into a new message of the registered message type with the given
+// full name, with its UnmarshalVT helper or with the proto package if it has none.
+func Unmarshal(name protoreflect.FullName, data []byte) (proto.Message, error) {
+	caps, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("vtregistry: message type %s is not registered", name)
+	}
+	m := caps.New()
+	var err error
+	if caps.UnmarshalVT != nil {
+		err = caps.UnmarshalVT(m, data)
+	} else {
+		err = proto.Unmarshal(data, m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CapabilitiesOf derives the capabilities of the type of m from its method set.
+// m may be a typed nil pointer.
+func CapabilitiesOf(m proto.Message) Capabilities {
+	var caps Capabilities
+	mt := m.ProtoReflect().Type()
+	caps.New = func() proto.Message { return mt.New().Interface() }
+	if _, ok := m.(interface{ SizeVT() int }); ok {
+		caps.SizeVT = func(m proto.Message) int {
+			return m.(interface{ SizeVT() int }).SizeVT()
+		}
+	}
+	if _, ok := m.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		caps.MarshalToSizedBufferVT = func(m proto.Message, dAtA []byte) (int, error) {
+			return m.(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}).MarshalToSizedBufferVT(dAtA)
+		}
+	}
+	if _, ok := m.(interface {
+		MarshalToSizedBufferVTStrict([]byte) (int, error)
+	}); ok {
+		caps.MarshalToSizedBufferVTStrict = func(m proto.Message, dAtA []byte) (int, error) {
+			return m.(interface {
+				MarshalToSizedBufferVTStrict([]byte) (int, error)
+			}).MarshalToSizedBufferVTStrict(dAtA)
+		}
+	}
+	if _, ok := m.(interface {
+		MarshalToSizedBufferVTDeterministic([]byte) (int, error)
+	}); ok {
+		caps.MarshalToSizedBufferVTDeterministic = func(m proto.Message, dAtA []byte) (int, error) {
+			return m.(interface {
+				MarshalToSizedBufferVTDeterministic([]byte) (int, error)
+			}).MarshalToSizedBufferVTDeterministic(dAtA)
+		}
+	}
+	if _, ok := m.(interface{ UnmarshalVT([]byte) error }); ok {
+		caps.UnmarshalVT = func(m proto.Message, dAtA []byte) error {
+			return m.(interface{ UnmarshalVT([]byte) error }).UnmarshalVT(dAtA)
+		}
+	}
+	if _, ok := m.(interface{ UnmarshalVTUnsafe([]byte) error }); ok {
+		caps.UnmarshalVTUnsafe = func(m proto.Message, dAtA []byte) error {
+			return m.(interface{ UnmarshalVTUnsafe([]byte) error }).UnmarshalVTUnsafe(dAtA)
+		}
+	}
+	if _, ok := m.(interface{ CloneMessageVT() proto.Message }); ok {
+		caps.CloneVT = func(m proto.Message) proto.Message {
+			return m.(interface{ CloneMessageVT() proto.Message }).CloneMessageVT()
+		}
+	}
+	if _, ok := m.(interface{ EqualMessageVT(proto.Message) bool }); ok {
+		caps.EqualVT = func(a, b proto.Message) bool {
+			return a.(interface{ EqualMessageVT(proto.Message) bool }).EqualMessageVT(b)
+		}
+	}
+	if _, ok := m.(interface{ ReturnToVTPool() }); ok {
+		caps.ReturnToVTPool = func(m proto.Message) {
+			m.(interface{ ReturnToVTPool() }).ReturnToVTPool()
+		}
+	}
+	return caps
+}
+
+// Type is a handle to the capabilities of the message type T, which must be a
+// pointer to a generated message struct. The capabilities are looked up in the
+// registry, or derived from the method set of T if it has not been registered,
+// the first time the handle is used. The zero value is ready to use.
+type Type[T proto.Message] struct {
+	once sync.Once
+	ops  Capabilities
+}
+
+func (t *Type[T]) resolve() *Capabilities {
+	t.once.Do(func() {
+		var zero T
+		caps, ok := Lookup(zero.ProtoReflect().Descriptor().FullName())
+		if !ok {
+			caps = CapabilitiesOf(zero)
+		}
+
+		// Fill in the gaps so that every operation can be called unconditionally.
+		if caps.SizeVT == nil {
+			caps.SizeVT = proto.Size
+		}
+		if caps.MarshalToSizedBufferVT == nil {
+			caps.MarshalToSizedBufferVT = marshalToSizedBuffer
+		}
+		if caps.MarshalToSizedBufferVTStrict == nil {
+			caps.MarshalToSizedBufferVTStrict = marshalToSizedBuffer
+		}
+		if caps.MarshalToSizedBufferVTDeterministic == nil {
+			caps.MarshalToSizedBufferVTDeterministic = marshalToSizedBufferDeterministic
+		}
+		if caps.UnmarshalVT == nil {
+			caps.UnmarshalVT = func(m proto.Message, dAtA []byte) error {
+				return proto.Unmarshal(dAtA, m)
+			}
+		}
+		if caps.UnmarshalVTUnsafe == nil {
+			caps.UnmarshalVTUnsafe = caps.UnmarshalVT
+		}
+		if caps.CloneVT == nil {
+			caps.CloneVT = proto.Clone
+		}
+		if caps.EqualVT == nil {
+			caps.EqualVT = proto.Equal
+		}
+		t.ops = caps
+	})
+	return &t.ops
+}
+
+// marshalToSizedBuffer implements MarshalToSizedBufferVT for messages without
+// vtprotobuf helpers: the encoded message is written at the end of dAtA.
+func marshalToSizedBuffer(m proto.Message, dAtA []byte) (int, error) {
+	encoded, err := proto.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA[len(dAtA)-len(encoded):], encoded), nil
+}
+
+// marshalToSizedBufferDeterministic is like marshalToSizedBuffer, with the
+// Deterministic option of the proto package.
+func marshalToSizedBufferDeterministic(m proto.Message, dAtA []byte) (int, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return 0, err
+	}
+	return copy(dAtA[len(dAtA)-len(encoded):], encoded), nil
+}
+
+// SizeVT returns the encoded size of m.
+func (t *Type[T]) SizeVT(m T) int {
+	return t.resolve().SizeVT(m)
+}
+
+// MarshalToSizedBufferVT encodes m at the end of dAtA and returns the number of bytes written.
+func (t *Type[T]) MarshalToSizedBufferVT(m T, dAtA []byte) (int, error) {
+	return t.resolve().MarshalToSizedBufferVT(m, dAtA)
+}
+
+// MarshalToSizedBufferVTStrict is like MarshalToSizedBufferVT, but fields are
+// encoded in field number order when T supports it.
+func (t *Type[T]) MarshalToSizedBufferVTStrict(m T, dAtA []byte) (int, error) {
+	return t.resolve().MarshalToSizedBufferVTStrict(m, dAtA)
+}
+
+// MarshalToSizedBufferVTDeterministic is like MarshalToSizedBufferVT, but map
+// entries are encoded in the order of their keys.
+func (t *Type[T]) MarshalToSizedBufferVTDeterministic(m T, dAtA []byte) (int, error) {
+	return t.resolve().MarshalToSizedBufferVTDeterministic(m, dAtA)
+}
+
+// UnmarshalVT decodes dAtA into m.
+func (t *Type[T]) UnmarshalVT(m T, dAtA []byte) error {
+	return t.resolve().UnmarshalVT(m, dAtA)
+}
+
+// UnmarshalVTUnsafe decodes dAtA into m, aliasing dAtA when T supports it.
+func (t *Type[T]) UnmarshalVTUnsafe(m T, dAtA []byte) error {
+	return t.resolve().UnmarshalVTUnsafe(m, dAtA)
+}
+
+// CloneVT returns a deep copy of m.
+func (t *Type[T]) CloneVT(m T) T {
+	return t.resolve().CloneVT(m).(T)
+}
+
+// EqualVT reports whether a and b are equal.
+func (t *Type[T]) EqualVT(a, b T) bool {
+	return t.resolve().EqualVT(a, b)
+}
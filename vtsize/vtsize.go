@@ -0,0 +1,204 @@
+// Package vtsize records the distribution of the encoded sizes of messages, by type,
+// for capacity planning of the queues, caches and buffers that hold them. Sizes are
+// recorded in histograms with logarithmic buckets, like HDR histograms, whose
+// quantiles are within about 3% of the recorded sizes.
+package vtsize
+
+import (
+	"math/bits"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+const (
+	// subBits is the log2 of the number of buckets each power of two is divided in.
+	subBits  = 5
+	subCount = 1 << subBits
+	// buckets is the number of buckets needed for sizes below 1<<32, which covers
+	// the 2GB limit of encoded messages. Larger sizes are counted in the last bucket.
+	buckets = (32 - subBits + 1) * subCount
+)
+
+// bucket returns the index of the bucket counting size.
+func bucket(size uint64) int {
+	if size < subCount {
+		return int(size)
+	}
+	e := bits.Len64(size) - subBits - 1
+	i := (e+1)*subCount + int(size>>e) - subCount
+	return min(i, buckets-1)
+}
+
+// bounds returns the smallest and largest sizes counted by bucket i.
+func bounds(i int) (lo, hi int) {
+	if i < subCount {
+		return i, i
+	}
+	e := i/subCount - 1
+	m := i%subCount + subCount
+	return m << e, (m+1)<<e - 1
+}
+
+// Histogram records a distribution of sizes. It is safe for concurrent use, and its
+// zero value is ready to use.
+type Histogram struct {
+	counts [buckets]atomic.Uint64
+	count  atomic.Uint64
+	sum    atomic.Uint64
+	max    atomic.Uint64
+}
+
+// Record adds size to the distribution.
+func (h *Histogram) Record(size int) {
+	if size < 0 {
+		return
+	}
+	h.counts[bucket(uint64(size))].Add(1)
+	h.count.Add(1)
+	h.sum.Add(uint64(size))
+	for {
+		max := h.max.Load()
+		if uint64(size) <= max || h.max.CompareAndSwap(max, uint64(size)) {
+			return
+		}
+	}
+}
+
+// Snapshot returns a copy of the distribution. Sizes recorded concurrently may or
+// may not be part of it.
+func (h *Histogram) Snapshot() *Snapshot {
+	s := &Snapshot{
+		count: h.count.Load(),
+		sum:   h.sum.Load(),
+		max:   int(h.max.Load()),
+	}
+	for i := range h.counts {
+		s.counts[i] = h.counts[i].Load()
+	}
+	return s
+}
+
+// Snapshot is a copy of the distribution recorded by a Histogram.
+type Snapshot struct {
+	counts     [buckets]uint64
+	count, sum uint64
+	max        int
+}
+
+// Count returns the number of recorded sizes.
+func (s *Snapshot) Count() uint64 { return s.count }
+
+// Sum returns the sum of the recorded sizes.
+func (s *Snapshot) Sum() uint64 { return s.sum }
+
+// Max returns the largest recorded size.
+func (s *Snapshot) Max() int { return s.max }
+
+// Mean returns the mean of the recorded sizes, or 0 if there is none.
+func (s *Snapshot) Mean() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return float64(s.sum) / float64(s.count)
+}
+
+// Quantile returns the size below which the given fraction of the recorded sizes
+// are, e.g. 0.99 for the 99th percentile. The size is rounded up to the largest
+// size of its bucket, and is at most Max. It returns 0 if no size is recorded.
+func (s *Snapshot) Quantile(q float64) int {
+	if s.count == 0 {
+		return 0
+	}
+	rank := uint64(q * float64(s.count))
+	rank = min(max(rank, 1), s.count)
+	var seen uint64
+	for i, c := range s.counts {
+		if seen += c; seen >= rank {
+			_, hi := bounds(i)
+			return min(hi, s.max)
+		}
+	}
+	return s.max
+}
+
+// Buckets calls fn for each non-empty bucket of the distribution, in increasing
+// order of sizes, with the smallest and largest sizes counted by the bucket.
+func (s *Snapshot) Buckets(fn func(lo, hi int, count uint64)) {
+	for i, c := range s.counts {
+		if c > 0 {
+			lo, hi := bounds(i)
+			fn(lo, hi, c)
+		}
+	}
+}
+
+// Message is implemented by messages generated with the marshal and size features.
+type Message interface {
+	proto.Message
+	vtproto.Marshaler
+	vtproto.Sizer
+}
+
+// Recorder records the sizes of messages in a Histogram per message type. It is safe
+// for concurrent use, and its zero value is ready to use.
+type Recorder struct {
+	types sync.Map // protoreflect.FullName -> *Histogram
+}
+
+// Default is the Recorder used by the package-level functions.
+var Default = &Recorder{}
+
+// Histogram returns the histogram of the messages with the given full name.
+func (r *Recorder) Histogram(name protoreflect.FullName) *Histogram {
+	if h, ok := r.types.Load(name); ok {
+		return h.(*Histogram)
+	}
+	h, _ := r.types.LoadOrStore(name, new(Histogram))
+	return h.(*Histogram)
+}
+
+// Marshal marshals m with MarshalVT and records the size of its encoding.
+func (r *Recorder) Marshal(m Message) ([]byte, error) {
+	data, err := m.MarshalVT()
+	if err == nil {
+		r.Histogram(m.ProtoReflect().Descriptor().FullName()).Record(len(data))
+	}
+	return data, err
+}
+
+// Size returns SizeVT of m and records it.
+func (r *Recorder) Size(m Message) int {
+	size := m.SizeVT()
+	r.Histogram(m.ProtoReflect().Descriptor().FullName()).Record(size)
+	return size
+}
+
+// Export calls fn with a snapshot of the histogram of each message type recorded
+// so far, in the order of their full names, for exporting them to a metrics system.
+func (r *Recorder) Export(fn func(name protoreflect.FullName, s *Snapshot)) {
+	var names []protoreflect.FullName
+	r.types.Range(func(name, _ any) bool {
+		names = append(names, name.(protoreflect.FullName))
+		return true
+	})
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	for _, name := range names {
+		fn(name, r.Histogram(name).Snapshot())
+	}
+}
+
+// Marshal marshals m and records its size in the Default recorder.
+func Marshal(m Message) ([]byte, error) {
+	return Default.Marshal(m)
+}
+
+// Size returns the size of m and records it in the Default recorder.
+func Size(m Message) int {
+	return Default.Size(m)
+}
@@ -0,0 +1,79 @@
+package vtsize
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/testproto/pool"
+)
+
+func TestBuckets(t *testing.T) {
+	sizes := []uint64{0, 1, 31, 32, 33, 63, 64, 65, 1000, 1 << 20, 1<<20 + 1, math.MaxInt32, 1<<32 - 1}
+	for i := 0; i < 1000; i++ {
+		sizes = append(sizes, uint64(rand.Int63n(1<<32)))
+	}
+	for _, size := range sizes {
+		lo, hi := bounds(bucket(size))
+		require.LessOrEqual(t, uint64(lo), size)
+		require.GreaterOrEqual(t, uint64(hi), size)
+		require.LessOrEqual(t, float64(hi-lo), float64(size)/subCount, "bucket of %d is too wide", size)
+	}
+	require.Equal(t, buckets-1, bucket(math.MaxUint64))
+}
+
+func TestQuantile(t *testing.T) {
+	var h Histogram
+	require.Zero(t, h.Snapshot().Quantile(0.5))
+
+	sizes := make([]int, 10000)
+	for i := range sizes {
+		sizes[i] = int(rand.ExpFloat64() * 1000)
+		h.Record(sizes[i])
+	}
+	sort.Ints(sizes)
+
+	s := h.Snapshot()
+	require.EqualValues(t, len(sizes), s.Count())
+	require.Equal(t, sizes[len(sizes)-1], s.Max())
+	for _, q := range []float64{0.5, 0.9, 0.99, 1} {
+		want := sizes[int(q*float64(len(sizes)))-1]
+		require.InEpsilon(t, want, s.Quantile(q), 1.0/subCount+0.001, "quantile %v", q)
+	}
+
+	var total uint64
+	s.Buckets(func(lo, hi int, count uint64) {
+		require.LessOrEqual(t, lo, hi)
+		total += count
+	})
+	require.Equal(t, s.Count(), total)
+}
+
+func TestRecorder(t *testing.T) {
+	var r Recorder
+	small := &pool.MemoryPoolExtension{Foo1: "small"}
+	large := &pool.MemoryPoolExtension{Foo1: strings.Repeat("x", 1000)}
+
+	data, err := r.Marshal(small)
+	require.NoError(t, err)
+	require.Equal(t, len(data), r.Size(small))
+	require.Equal(t, large.SizeVT(), r.Size(large))
+	r.Size(&pool.OptionalMessage{})
+
+	var names []protoreflect.FullName
+	r.Export(func(name protoreflect.FullName, s *Snapshot) {
+		names = append(names, name)
+		if name == "MemoryPoolExtension" {
+			require.EqualValues(t, 3, s.Count())
+			require.Equal(t, large.SizeVT(), s.Max())
+			require.EqualValues(t, 2*len(data)+large.SizeVT(), s.Sum())
+			require.Equal(t, len(data), s.Quantile(0.5))
+		}
+	})
+	require.Equal(t, []protoreflect.FullName{"MemoryPoolExtension", "OptionalMessage"}, names)
+}
@@ -0,0 +1,105 @@
+package vtverify
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Main runs the vtverify command with the arguments of the program, and exits with a
+// non-zero status if a payload is handled differently by the generated code:
+//
+//	vtverify -descriptor_set=schema.binpb -type=package.Message payload...
+//
+// The descriptor set is a google.protobuf.FileDescriptorSet, as written by the
+// --descriptor_set_out option of protoc or by buf build, which holds the files of the
+// message type and of their dependencies. The payloads are files, or directories whose
+// files are all verified, each holding a single encoded message.
+func Main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	f := flag.NewFlagSet("vtverify", flag.ContinueOnError)
+	f.SetOutput(stderr)
+	descriptorSet := f.String("descriptor_set", "", "path of the FileDescriptorSet holding the message type")
+	typeName := f.String("type", "", "full name of the message type of the payloads")
+	if err := f.Parse(args); err != nil {
+		return 2
+	}
+	if *descriptorSet == "" || *typeName == "" || f.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: vtverify -descriptor_set=FILE -type=NAME PAYLOAD...")
+		return 2
+	}
+
+	md, err := loadMessage(*descriptorSet, protoreflect.FullName(*typeName))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	var payloads, mismatches int
+	for _, root := range f.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			payloads++
+			if err := Verify(md, data); err != nil {
+				if _, ok := err.(*Mismatch); !ok {
+					return err
+				}
+				mismatches++
+				fmt.Fprintf(stdout, "%s: %v\n", path, err)
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+	}
+	fmt.Fprintf(stdout, "%d payloads, %d mismatches\n", payloads, mismatches)
+	if mismatches > 0 {
+		return 1
+	}
+	return 0
+}
+
+// loadMessage returns the descriptor of the message type name in the descriptor set at
+// path.
+func loadMessage(path string, name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("vtverify: invalid descriptor set %s: %w", path, err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("vtverify: invalid descriptor set %s: %w", path, err)
+	}
+	d, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("vtverify: message type %s not found in %s", name, path)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("vtverify: %s is not a message type", name)
+	}
+	return md, nil
+}
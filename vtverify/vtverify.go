@@ -0,0 +1,186 @@
+// Package vtverify checks that the code generated by protoc-gen-go-vtproto handles
+// payloads like the google.golang.org/protobuf packages do, as a release gate run
+// against samples of production traffic.
+//
+// Each payload is decoded with the UnmarshalVT method of the generated message and, as
+// a reference, by the proto package into a dynamic message built from a descriptor of
+// the schema. The decoded messages must be equal, and re-encoding the decoded message
+// with the generated methods must produce the bytes of the proto package.
+//
+// The generated messages are found in the global registry of the proto package, so the
+// programs running the checks must link the packages of the generated code, e.g.:
+//
+//	package main
+//
+//	import (
+//		_ "example.com/project/pb"
+//
+//		"github.com/planetscale/vtprotobuf/vtverify"
+//	)
+//
+//	func main() {
+//		vtverify.Main()
+//	}
+package vtverify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/planetscale/vtprotobuf/vtregistry"
+)
+
+// Kind is the kind of a Mismatch.
+type Kind int
+
+const (
+	// DecodeMismatch is reported when only one of the generated code and the proto
+	// package fails to decode a payload.
+	DecodeMismatch Kind = iota + 1
+	// SemanticMismatch is reported when the generated code decodes a payload into a
+	// message that differs from the one of the proto package.
+	SemanticMismatch
+	// EncodeMismatch is reported when the generated code re-encodes a decoded payload
+	// into different bytes than the proto package.
+	EncodeMismatch
+)
+
+func (k Kind) String() string {
+	switch k {
+	case DecodeMismatch:
+		return "decode"
+	case SemanticMismatch:
+		return "semantic"
+	case EncodeMismatch:
+		return "encode"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Mismatch is returned by Verify when the generated code and the proto package handle
+// a payload differently.
+type Mismatch struct {
+	// Message is the full name of the message type of the payload
+	Message protoreflect.FullName
+	Kind    Kind
+	// Detail describes the difference
+	Detail string
+}
+
+func (m *Mismatch) Error() string {
+	return fmt.Sprintf("vtverify: %s mismatch for %s: %s", m.Kind, m.Message, m.Detail)
+}
+
+// ErrNotGenerated is returned by Verify when the generated message of a type is not
+// linked in the program, or has no UnmarshalVT, SizeVT and MarshalToSizedBufferVT
+// methods.
+var ErrNotGenerated = errors.New("vtverify: no generated message")
+
+// Verify returns a *Mismatch if the generated message of the message type md, found in
+// protoregistry.GlobalTypes, decodes or encodes data differently than the proto package
+// does with a dynamic message of type md. Payloads rejected by both are not reported.
+//
+// The bytes are compared with the ones of proto.MarshalOptions{Deterministic: true},
+// which the generated code produces with the marshal_deterministic feature. Without it,
+// the encoding is only compared for the message types without maps, whose entries are
+// marshaled in random order.
+func Verify(md protoreflect.MessageDescriptor, data []byte) error {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return fmt.Errorf("%w for %s", ErrNotGenerated, md.FullName())
+	}
+	return VerifyType(md, mt, data)
+}
+
+// VerifyType is like Verify for the generated message type mt.
+func VerifyType(md protoreflect.MessageDescriptor, mt protoreflect.MessageType, data []byte) error {
+	msg := mt.New().Interface()
+	caps := vtregistry.CapabilitiesOf(msg)
+	if caps.UnmarshalVT == nil || caps.SizeVT == nil || caps.MarshalToSizedBufferVT == nil {
+		return fmt.Errorf("%w for %s", ErrNotGenerated, md.FullName())
+	}
+	mismatch := func(kind Kind, format string, args ...any) error {
+		return &Mismatch{Message: md.FullName(), Kind: kind, Detail: fmt.Sprintf(format, args...)}
+	}
+
+	ref := dynamicpb.NewMessage(md)
+	refErr := proto.UnmarshalOptions{Resolver: noExtensions}.Unmarshal(data, ref)
+	vtErr := caps.UnmarshalVT(msg, data)
+	switch {
+	case refErr != nil && vtErr != nil:
+		return nil
+	case refErr != nil:
+		return mismatch(DecodeMismatch, "UnmarshalVT succeeds, proto fails with %v", refErr)
+	case vtErr != nil:
+		return mismatch(DecodeMismatch, "UnmarshalVT fails with %v, proto succeeds", vtErr)
+	}
+
+	// The message decoded by the generated code is compared through its encoding by the
+	// proto package, which does not depend on the generated methods.
+	decoded := dynamicpb.NewMessage(md)
+	encoded, err := proto.MarshalOptions{AllowPartial: true}.Marshal(msg)
+	if err == nil {
+		err = proto.UnmarshalOptions{AllowPartial: true, Resolver: noExtensions}.Unmarshal(encoded, decoded)
+	}
+	if err != nil {
+		return mismatch(SemanticMismatch, "cannot convert the decoded message: %v", err)
+	}
+	if !proto.Equal(ref, decoded) {
+		return mismatch(SemanticMismatch, "UnmarshalVT decodes %v, proto decodes %v", decoded, ref)
+	}
+
+	expected, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(ref)
+	if err != nil {
+		return mismatch(EncodeMismatch, "proto fails to encode with %v", err)
+	}
+	size := caps.SizeVT(msg)
+	if size != len(expected) {
+		return mismatch(EncodeMismatch, "SizeVT returns %d, proto encodes %d bytes", size, len(expected))
+	}
+	marshal := caps.MarshalToSizedBufferVTDeterministic
+	if marshal == nil {
+		if hasMaps(md, make(map[protoreflect.FullName]bool)) {
+			return nil
+		}
+		marshal = caps.MarshalToSizedBufferVT
+	}
+	buf := make([]byte, size)
+	n, err := marshal(msg, buf)
+	if err != nil {
+		return mismatch(EncodeMismatch, "the generated code fails to encode with %v", err)
+	}
+	if got := buf[len(buf)-n:]; !bytes.Equal(got, expected) {
+		return mismatch(EncodeMismatch, "the generated code encodes %x, proto encodes %x", got, expected)
+	}
+	return nil
+}
+
+// noExtensions resolves no extensions, so that the dynamic messages keep their extension
+// fields as unknown fields: the extension types of the global registry extend the
+// generated messages and not the dynamic ones.
+var noExtensions = new(protoregistry.Types)
+
+// hasMaps returns true if messages of type md can hold map fields.
+func hasMaps(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			return true
+		}
+		if fd.Message() != nil && hasMaps(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package vtverify
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/planetscale/vtprotobuf/testproto/ignore_unknown_fields"
+	"github.com/planetscale/vtprotobuf/testproto/immutable"
+	"github.com/planetscale/vtprotobuf/testproto/presence"
+	"github.com/planetscale/vtprotobuf/testproto/strictenum"
+)
+
+func requireMismatch(t *testing.T, err error, kind Kind) {
+	t.Helper()
+	var mismatch *Mismatch
+	require.True(t, errors.As(err, &mismatch), "unexpected error: %v", err)
+	require.Equal(t, kind, mismatch.Kind)
+}
+
+func blobPayload(t *testing.T) []byte {
+	blob := &immutable.Blob{
+		Name:   "blob",
+		Chunks: [][]byte{[]byte("a"), []byte("b")},
+		Parts:  map[string][]byte{"c": []byte("c"), "a": []byte("a"), "b": []byte("b")},
+		Inline: &immutable.Blob_Data{Data: []byte("data")},
+	}
+	data, err := proto.Marshal(blob)
+	require.NoError(t, err)
+	return data
+}
+
+func TestVerify(t *testing.T) {
+	md := (&immutable.Blob{}).ProtoReflect().Descriptor()
+	require.NoError(t, Verify(md, blobPayload(t)))
+	require.NoError(t, Verify(md, nil))
+
+	// Unknown fields are kept by both.
+	data := protowire.AppendTag(blobPayload(t), 100, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	require.NoError(t, Verify(md, data))
+
+	// Invalid payloads are rejected by both.
+	data = blobPayload(t)
+	require.NoError(t, Verify(md, data[:len(data)-1]))
+}
+
+func TestVerifyMismatches(t *testing.T) {
+	// strict_enum rejects the values proto accepts.
+	data := protowire.AppendTag(nil, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 42)
+	err := Verify((&strictenum.StrictEnums{}).ProtoReflect().Descriptor(), data)
+	requireMismatch(t, err, DecodeMismatch)
+
+	// ignore_unknown_fields drops the fields proto keeps.
+	data = protowire.AppendTag(nil, 100, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	err = Verify((&ignore_unknown_fields.IgnoreUnknownFieldsExtension{}).ProtoReflect().Descriptor(), data)
+	requireMismatch(t, err, SemanticMismatch)
+
+	// explicit_presence encodes the zero values proto skips.
+	err = Verify((&presence.Staged{}).ProtoReflect().Descriptor(), nil)
+	requireMismatch(t, err, EncodeMismatch)
+	require.Contains(t, err.Error(), "encode mismatch for presence.Staged")
+}
+
+func TestVerifyNotGenerated(t *testing.T) {
+	err := Verify((&emptypb.Empty{}).ProtoReflect().Descriptor(), nil)
+	require.ErrorIs(t, err, ErrNotGenerated)
+}
+
+// writeDescriptorSet writes the descriptor set of file and of its dependencies in dir.
+func writeDescriptorSet(t *testing.T, dir string, file protoreflect.FileDescriptor) string {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(file)
+	data, err := proto.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "schema.binpb")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+	return path
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	schema := writeDescriptorSet(t, dir, immutable.File_immutable_immutable_proto)
+	corpus := filepath.Join(dir, "corpus")
+	require.NoError(t, os.Mkdir(corpus, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(corpus, "1.bin"), blobPayload(t), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(corpus, "2.bin"), nil, 0o644))
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-descriptor_set", schema, "-type", "immutable.Blob", corpus}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	require.Equal(t, "2 payloads, 0 mismatches\n", stdout.String())
+
+	schema = writeDescriptorSet(t, dir, presence.File_presence_presence_proto)
+	stdout.Reset()
+	code = run([]string{"-descriptor_set", schema, "-type", "presence.Staged", corpus}, &stdout, &stderr)
+	require.Equal(t, 1, code, stderr.String())
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, "2 payloads, 2 mismatches", lines[2])
+
+	stderr.Reset()
+	code = run([]string{"-descriptor_set", schema, "-type", "presence.Missing", corpus}, &stdout, &stderr)
+	require.Equal(t, 2, code)
+	require.Contains(t, stderr.String(), "presence.Missing not found")
+}